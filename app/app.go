@@ -52,9 +52,7 @@ import (
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
-	"github.com/cosmos/cosmos-sdk/x/gov"
 	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
-	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	nfttypes "github.com/cosmos/cosmos-sdk/x/nft"
 	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
@@ -67,6 +65,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
@@ -114,6 +113,8 @@ import (
 	"github.com/petri-labs/warmage/x/gauge"
 	gaugekeeper "github.com/petri-labs/warmage/x/gauge/keeper"
 	gaugetypes "github.com/petri-labs/warmage/x/gauge/types"
+	customgov "github.com/petri-labs/warmage/x/gov"
+	customgovkeeper "github.com/petri-labs/warmage/x/gov/keeper"
 	"github.com/petri-labs/warmage/x/maker"
 	makerclient "github.com/petri-labs/warmage/x/maker/client"
 	makerkeeper "github.com/petri-labs/warmage/x/maker/keeper"
@@ -172,6 +173,8 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		makerclient.BatchSetBackingProposalHandler,
 		makerclient.BatchSetCollateralProposalHandler,
 		oracleclient.RegisterTargetProposalHandler,
+		oracleclient.UpdateTargetProposalHandler,
+		oracleclient.DeregisterTargetProposalHandler,
 	)
 
 	return govProposalHandlers
@@ -191,7 +194,7 @@ var (
 		capability.AppModuleBasic{},
 		customstaking.AppModuleBasic{},
 		distr.AppModuleBasic{},
-		gov.NewAppModuleBasic(getGovProposalHandlers()...),
+		customgov.NewAppModuleBasic(getGovProposalHandlers()...),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
@@ -270,6 +273,7 @@ type Warmage struct {
 	cdc               *codec.LegacyAmino
 	appCodec          codec.Codec
 	interfaceRegistry types.InterfaceRegistry
+	txConfig          client.TxConfig
 
 	invCheckPeriod uint
 
@@ -285,7 +289,7 @@ type Warmage struct {
 	StakingKeeper    customstakingkeeper.Keeper
 	SlashingKeeper   slashingkeeper.Keeper
 	DistrKeeper      distrkeeper.Keeper
-	GovKeeper        govkeeper.Keeper
+	GovKeeper        customgovkeeper.Keeper
 	CrisisKeeper     crisiskeeper.Keeper
 	UpgradeKeeper    upgradekeeper.Keeper
 	ParamsKeeper     paramskeeper.Keeper
@@ -297,6 +301,7 @@ type Warmage struct {
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
+	ScopedOracleKeeper   capabilitykeeper.ScopedKeeper
 
 	// Ethermint keepers
 	EvmKeeper       *evmkeeper.Keeper
@@ -373,6 +378,7 @@ func NewWarmage(
 		cdc:               cdc,
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
+		txConfig:          encodingConfig.TxConfig,
 		invCheckPeriod:    invCheckPeriod,
 		keys:              keys,
 		tkeys:             tkeys,
@@ -390,6 +396,7 @@ func NewWarmage(
 	// grant capabilities for the ibc and ibc-transfer modules
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	scopedOracleKeeper := app.CapabilityKeeper.ScopeToModule(oracletypes.ModuleName)
 
 	app.CapabilityKeeper.Seal()
 
@@ -439,7 +446,7 @@ func NewWarmage(
 		stakingtypes.NewMultiStakingHooks(
 			app.DistrKeeper.Hooks(),
 			app.SlashingKeeper.Hooks(),
-			app.GravityKeeper.Hooks(),
+			gravityStakingHooks{&app.GravityKeeper},
 		),
 	)
 
@@ -476,6 +483,7 @@ func NewWarmage(
 		app.AccountKeeper, app.BankKeeper, &stakingKeeper, app.FeeMarketKeeper,
 		tracer,
 	)
+	app.VeKeeper.SetEvmKeeper(app.EvmKeeper)
 
 	app.Erc20Keeper = *erc20keeper.NewKeeper(
 		appCodec,
@@ -496,9 +504,14 @@ func NewWarmage(
 		app.BankKeeper,
 		app.DistrKeeper,
 		app.StakingKeeper,
+		app.VeKeeper,
+		app.Erc20Keeper,
+		app.EvmKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		scopedOracleKeeper,
 		distrtypes.ModuleName,
 	)
-	oracleModule := oracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper)
 
 	app.MakerKeeper = *makerkeeper.NewKeeper(
 		appCodec,
@@ -510,7 +523,12 @@ func NewWarmage(
 		app.BankKeeper,
 		app.OracleKeeper,
 	)
-	makerModule := maker.NewAppModule(appCodec, app.MakerKeeper, app.AccountKeeper, app.BankKeeper)
+	// No DEX swap router is set, so leverage and deleverage only route through
+	// the backing pools and reject any other collateral with ErrNoSwapRoute.
+	app.OracleKeeper.SetMakerKeeper(app.MakerKeeper)
+	oracleModule := oracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper)
+	oracleIBCModule := oracle.NewIBCModule(app.OracleKeeper)
+	makerModule := maker.NewAppModule(appCodec, app.MakerKeeper, app.AccountKeeper, app.BankKeeper, app.OracleKeeper)
 
 	nftModule := vekeeper.NewNftAppModule(nft.NewAppModule(appCodec, nftKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry), app.NftKeeper)
 
@@ -530,6 +548,7 @@ func NewWarmage(
 	app.EvmKeeper = app.EvmKeeper.SetHooks(
 		evmkeeper.NewMultiEvmHooks(
 			app.Erc20Keeper.EvmHooks(),
+			app.VeKeeper.EvmHooks(),
 		),
 	)
 
@@ -571,14 +590,15 @@ func NewWarmage(
 		AddRoute(mgravitytypes.RouterKey, mgravitykeeper.NewGravityProposalHandler(app.GravityKeeper)).
 		AddRoute(bech32ibctypes.RouterKey, bech32ibc.NewBech32IBCProposalHandler(app.Bech32IbcKeeper))
 
-	app.GovKeeper = govkeeper.NewKeeper(
+	app.GovKeeper = customgovkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
-		&stakingKeeper, govRouter,
+		&stakingKeeper, app.NftKeeper, app.VeKeeper, govRouter,
 	)
 
-	// Create static IBC router, add transfer route, then set and seal it
+	// Create static IBC router, add transfer and oracle routes, then set and seal it
 	ibcRouter := ibcporttypes.NewRouter()
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferIBCModule)
+	ibcRouter.AddRoute(oracletypes.ModuleName, oracleIBCModule)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	/****  Module Options ****/
@@ -600,7 +620,7 @@ func NewWarmage(
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
-		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
+		customgov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		customstaking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
@@ -734,7 +754,7 @@ func NewWarmage(
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
-		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
+		customgov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
 		customstaking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
@@ -794,6 +814,7 @@ func NewWarmage(
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedOracleKeeper = scopedOracleKeeper
 
 	app.tpsCounter = newTPSCounter(logger)
 	go func() {
@@ -844,7 +865,13 @@ func (app *Warmage) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 		panic(err)
 	}
 	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
-	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
+	res := app.mm.InitGenesis(ctx, app.appCodec, genesisState)
+
+	// the ve NFT contract is deployed once the EVM is initialized
+	if _, err := app.VeKeeper.DeployVeNftContract(ctx); err != nil {
+		panic(err)
+	}
+	return res
 }
 
 // LoadHeight loads a particular height
@@ -894,6 +921,26 @@ func (app *Warmage) InterfaceRegistry() types.InterfaceRegistry {
 	return app.interfaceRegistry
 }
 
+// GetStakingKeeper implements the TestingApp interface of ibc-go testing.
+func (app *Warmage) GetStakingKeeper() stakingkeeper.Keeper {
+	return app.StakingKeeper.Keeper
+}
+
+// GetIBCKeeper implements the TestingApp interface of ibc-go testing.
+func (app *Warmage) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
+// GetScopedIBCKeeper implements the TestingApp interface of ibc-go testing.
+func (app *Warmage) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return app.ScopedIBCKeeper
+}
+
+// GetTxConfig implements the TestingApp interface of ibc-go testing.
+func (app *Warmage) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 // GetKey returns the KVStoreKey for the provided store key.
 //
 // NOTE: This is solely to be used for testing purposes.
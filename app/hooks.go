@@ -0,0 +1,57 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	mgravitykeeper "github.com/Gravity-Bridge/Gravity-Bridge/module/x/multigravity/keeper"
+)
+
+var _ stakingtypes.StakingHooks = gravityStakingHooks{}
+
+// gravityStakingHooks forwards the staking hooks to the gravity keeper. The
+// staking hooks are registered before the gravity keeper is constructed, so
+// the keeper is referenced instead of copied.
+type gravityStakingHooks struct {
+	keeper *mgravitykeeper.Keeper
+}
+
+func (h gravityStakingHooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
+	h.keeper.Hooks().AfterValidatorCreated(ctx, valAddr)
+}
+
+func (h gravityStakingHooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) {
+	h.keeper.Hooks().BeforeValidatorModified(ctx, valAddr)
+}
+
+func (h gravityStakingHooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	h.keeper.Hooks().AfterValidatorRemoved(ctx, consAddr, valAddr)
+}
+
+func (h gravityStakingHooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	h.keeper.Hooks().AfterValidatorBonded(ctx, consAddr, valAddr)
+}
+
+func (h gravityStakingHooks) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	h.keeper.Hooks().AfterValidatorBeginUnbonding(ctx, consAddr, valAddr)
+}
+
+func (h gravityStakingHooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	h.keeper.Hooks().BeforeDelegationCreated(ctx, delAddr, valAddr)
+}
+
+func (h gravityStakingHooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	h.keeper.Hooks().BeforeDelegationSharesModified(ctx, delAddr, valAddr)
+}
+
+func (h gravityStakingHooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	h.keeper.Hooks().BeforeDelegationRemoved(ctx, delAddr, valAddr)
+}
+
+func (h gravityStakingHooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	h.keeper.Hooks().AfterDelegationModified(ctx, delAddr, valAddr)
+}
+
+func (h gravityStakingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {
+	h.keeper.Hooks().BeforeValidatorSlashed(ctx, valAddr, fraction)
+}
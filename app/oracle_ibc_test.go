@@ -0,0 +1,171 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	"github.com/tharsis/ethermint/encoding"
+
+	"github.com/petri-labs/warmage/app"
+	warmage "github.com/petri-labs/warmage/types"
+	"github.com/petri-labs/warmage/x/oracle/keeper"
+	"github.com/petri-labs/warmage/x/oracle/types"
+)
+
+// testingApp adapts the app to the ibc testing chains, which fund the genesis
+// accounts in the default bond denom.
+type testingApp struct {
+	*app.Warmage
+}
+
+func (a testingApp) InitChain(req abci.RequestInitChain) abci.ResponseInitChain {
+	var genesisState app.GenesisState
+	if err := json.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
+		panic(err)
+	}
+	var bankGenesis banktypes.GenesisState
+	a.AppCodec().MustUnmarshalJSON(genesisState[banktypes.ModuleName], &bankGenesis)
+	for i, balance := range bankGenesis.Balances {
+		for j, coin := range balance.Coins {
+			if coin.Denom == sdk.DefaultBondDenom {
+				bankGenesis.Balances[i].Coins[j].Denom = warmage.AttoMageDenom
+			}
+		}
+	}
+	genesisState[banktypes.ModuleName] = a.AppCodec().MustMarshalJSON(&bankGenesis)
+	req.AppStateBytes, _ = json.Marshal(genesisState)
+	return a.Warmage.InitChain(req)
+}
+
+func setupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	a := app.NewWarmage(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, app.DefaultNodeHome, 0, encCfg, simapp.EmptyAppOptions{})
+	return testingApp{a.(*app.Warmage)}, app.NewDefaultGenesisState()
+}
+
+// setEthSender replaces the sender of the chain with an eth_secp256k1 account,
+// since the ante handler rejects the other key types.
+func setEthSender(t *testing.T, coord *ibctesting.Coordinator, chain *ibctesting.TestChain) {
+	a := chain.App.(testingApp).Warmage
+	key, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+
+	ctx := chain.GetContext()
+	addr := sdk.AccAddress(key.PubKey().Address())
+	acc := a.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	require.NoError(t, acc.SetPubKey(key.PubKey()))
+	a.AccountKeeper.SetAccount(ctx, acc)
+
+	chain.SenderPrivKey = key
+	chain.SenderAccount = a.AccountKeeper.GetAccount(ctx, addr)
+	coord.CommitBlock(chain)
+}
+
+func setupOraclePath(t *testing.T) *ibctesting.Path {
+	app.SetupConfig()
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+
+	coord := &ibctesting.Coordinator{T: t, CurrentTime: time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)}
+	chainA := ibctesting.NewTestChain(t, coord, "warmage_9000-1")
+	chainB := ibctesting.NewTestChain(t, coord, "warmage_9001-1")
+	coord.Chains = map[string]*ibctesting.TestChain{chainA.ChainID: chainA, chainB.ChainID: chainB}
+	setEthSender(t, coord, chainA)
+	setEthSender(t, coord, chainB)
+
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = types.PortID
+	path.EndpointB.ChannelConfig.PortID = types.PortID
+	path.EndpointA.ChannelConfig.Version = types.Version
+	path.EndpointB.ChannelConfig.Version = types.Version
+	path.EndpointA.ChannelConfig.Order = channeltypes.UNORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED
+	coord.Setup(path)
+	return path
+}
+
+func TestInterchainExchangeRate(t *testing.T) {
+	path := setupOraclePath(t)
+	chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+	keeperA := chainA.App.(testingApp).OracleKeeper
+	keeperB := chainB.App.(testingApp).OracleKeeper
+
+	// keep the exchange rate of chain B from being cleared at the vote period end
+	ctxB := chainB.GetContext()
+	paramsB := keeperB.GetParams(ctxB)
+	paramsB.VotePeriod = 1000
+	keeperB.SetParams(ctxB, paramsB)
+	exchangeRate := sdk.NewDecWithPrec(125, 1)
+	keeperB.SetExchangeRate(ctxB, "uatom", exchangeRate)
+	chainB.Coordinator.CommitBlock(chainB)
+
+	// the target denom must have a supply on chain A, which registers an erc20
+	// contract with the block proposer as the EVM coinbase
+	appA := chainA.App.(testingApp)
+	header := chainA.CurrentHeader
+	header.ProposerAddress = chainA.Vals.Proposer.Address
+	mintCtx := chainA.GetContext().WithBlockHeader(header)
+	appA.BankKeeper.SetDenomMetaData(mintCtx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "ATOM", Exponent: 6},
+		},
+		Base:    "uatom",
+		Display: "ATOM",
+		Name:    "ATOM",
+		Symbol:  "ATOM",
+	})
+	require.NoError(t, appA.BankKeeper.MintCoins(mintCtx, ibctransfertypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))))
+
+	// an unknown channel is rejected
+	proposal := &types.RegisterTargetProposal{
+		TargetParams: types.TargetParams{
+			Denom:         "uatom",
+			Source:        types.TARGET_SOURCE_INTERCHAIN_ORACLE,
+			SourceChannel: "channel-9",
+			SourceDenom:   "uatom",
+		},
+	}
+	require.NoError(t, proposal.ValidateBasic())
+	require.ErrorIs(t, keeper.HandleRegisterTargetProposal(chainA.GetContext(), keeperA, proposal), types.ErrInvalidChannel)
+
+	ctxA := chainA.GetContext()
+	proposal.TargetParams.SourceChannel = path.EndpointA.ChannelID
+	require.NoError(t, keeper.HandleRegisterTargetProposal(ctxA, keeperA, proposal))
+	packet, err := keeperA.SendExchangeRateRequest(ctxA, path.EndpointA.ChannelID, types.ExchangeRateRequestPacketData{
+		Queries: []types.ExchangeRateQuery{{Denom: "uatom"}},
+	})
+	require.NoError(t, err)
+	chainA.Coordinator.CommitBlock(chainA)
+
+	require.NoError(t, path.RelayPacket(packet))
+
+	ctxA = chainA.GetContext()
+	rate, found := keeperA.GetInterchainExchangeRate(ctxA, "uatom")
+	require.True(t, found)
+	require.Equal(t, exchangeRate, rate.ExchangeRate)
+	require.Positive(t, rate.Height)
+
+	keeperA.UpdateInterchainExchangeRates(ctxA)
+	got, err := keeperA.GetExchangeRate(ctxA, "uatom")
+	require.NoError(t, err)
+	require.Equal(t, exchangeRate, got)
+
+	// stale exchange rates are not applied
+	keeperA.DeleteExchangeRate(ctxA, "uatom")
+	staleCtx := ctxA.WithBlockTime(rate.Time.Add(keeperA.InterchainRateMaxAge(ctxA) + time.Second))
+	keeperA.UpdateInterchainExchangeRates(staleCtx)
+	_, err = keeperA.GetExchangeRate(staleCtx, "uatom")
+	require.Error(t, err)
+}
@@ -1,6 +1,8 @@
 package app_test
 
 import (
+	"encoding/json"
+	"math/rand"
 	"os"
 	"testing"
 	"time"
@@ -11,13 +13,20 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simulationtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingsim "github.com/cosmos/cosmos-sdk/x/staking/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/petri-labs/warmage/app"
+	warmage "github.com/petri-labs/warmage/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtypes "github.com/tendermint/tendermint/types"
+	ethermintapp "github.com/tharsis/ethermint/app"
 	"github.com/tharsis/ethermint/encoding"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 )
 
 func init() {
@@ -63,8 +72,14 @@ func BenchmarkSimulation(b *testing.B) {
 	simapp.FlagEnabledValue = true
 	simapp.FlagCommitValue = true
 
+	// The simulated stakes are far below 1 mage, so the default power
+	// reduction of the SDK is restored to give the genesis validators power.
+	sdk.DefaultPowerReduction = sdk.NewIntFromUint64(1_000_000)
+
 	config, db, dir, logger, _, err := simapp.SetupSimulation("goleveldb-app-sim", "Simulation")
 	require.NoError(b, err, "simulation setup failed")
+	// the chain ID must be of the EIP-155 form required by the EVM
+	config.ChainID = "warmage_9000-1"
 
 	b.Cleanup(func() {
 		db.Close()
@@ -94,9 +109,9 @@ func BenchmarkSimulation(b *testing.B) {
 		b,
 		os.Stdout,
 		simApp.GetBaseApp(),
-		simapp.AppStateFn(simApp.AppCodec(), simApp.SimulationManager()),
-		simulationtypes.RandomAccounts,
-		simapp.SimulationOperations(simApp, simApp.AppCodec(), config),
+		appStateFn(simApp.AppCodec(), simApp.SimulationManager()),
+		ethermintapp.RandomAccounts,
+		simulationOperations(simApp, simApp.AppCodec(), config),
 		simApp.ModuleAccountAddrs(),
 		config,
 		simApp.AppCodec(),
@@ -111,3 +126,79 @@ func BenchmarkSimulation(b *testing.B) {
 		simapp.PrintStats(db)
 	}
 }
+
+// appStateFn returns the simulated app state of simapp.AppStateFn, with the
+// bond denom of the SDK simulation replaced by mage. Only mage can be held at
+// genesis, since the bank genesis registers any other coin to erc20 before the
+// EVM is set up.
+func appStateFn(cdc codec.JSONCodec, simManager *module.SimulationManager) simulationtypes.AppStateFn {
+	return func(r *rand.Rand, accs []simulationtypes.Account, config simulationtypes.Config,
+	) (json.RawMessage, []simulationtypes.Account, string, time.Time) {
+		appState, simAccs, chainID, genesisTimestamp := simapp.AppStateFn(cdc, simManager)(r, accs, config)
+
+		rawState := make(map[string]json.RawMessage)
+		if err := json.Unmarshal(appState, &rawState); err != nil {
+			panic(err)
+		}
+
+		replaceBondDenom := func(coins sdk.Coins) sdk.Coins {
+			bonded := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, coins.AmountOf(sdk.DefaultBondDenom)))
+			return coins.Sub(bonded).Add(sdk.NewCoin(warmage.AttoMageDenom, bonded.AmountOf(sdk.DefaultBondDenom)))
+		}
+
+		bankState := new(banktypes.GenesisState)
+		cdc.MustUnmarshalJSON(rawState[banktypes.ModuleName], bankState)
+		for i, balance := range bankState.Balances {
+			bankState.Balances[i].Coins = replaceBondDenom(balance.Coins)
+		}
+		bankState.Supply = replaceBondDenom(bankState.Supply)
+		rawState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankState)
+
+		stakingState := new(stakingtypes.GenesisState)
+		cdc.MustUnmarshalJSON(rawState[stakingtypes.ModuleName], stakingState)
+		stakingState.Params.BondDenom = warmage.AttoMageDenom
+		rawState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(stakingState)
+
+		govState := new(govtypes.GenesisState)
+		cdc.MustUnmarshalJSON(rawState[govtypes.ModuleName], govState)
+		govState.DepositParams.MinDeposit = replaceBondDenom(govState.DepositParams.MinDeposit)
+		rawState[govtypes.ModuleName] = cdc.MustMarshalJSON(govState)
+
+		evmState := new(evmtypes.GenesisState)
+		cdc.MustUnmarshalJSON(rawState[evmtypes.ModuleName], evmState)
+		evmState.Params.EvmDenom = warmage.AttoMageDenom
+		rawState[evmtypes.ModuleName] = cdc.MustMarshalJSON(evmState)
+
+		appState, err := json.Marshal(rawState)
+		if err != nil {
+			panic(err)
+		}
+		return appState, simAccs, chainID, genesisTimestamp
+	}
+}
+
+// simulationOperations returns the operations of simapp.SimulationOperations,
+// without creating validators or editing their commission, since the ante
+// handler rejects the commissions below the minimum that the staking
+// simulation generates.
+func simulationOperations(app SimApp, cdc codec.JSONCodec, config simulationtypes.Config) []simulationtypes.WeightedOperation {
+	simState := module.SimulationState{
+		AppParams: make(simulationtypes.AppParams),
+		Cdc:       cdc,
+	}
+	if config.ParamsFile != "" {
+		bz, err := os.ReadFile(config.ParamsFile)
+		if err != nil {
+			panic(err)
+		}
+		if err := json.Unmarshal(bz, &simState.AppParams); err != nil {
+			panic(err)
+		}
+	}
+	simState.AppParams[stakingsim.OpWeightMsgCreateValidator] = json.RawMessage("0")
+	simState.AppParams[stakingsim.OpWeightMsgEditValidator] = json.RawMessage("0")
+
+	simState.ParamChanges = app.SimulationManager().GenerateParamChanges(config.Seed)
+	simState.Contents = app.SimulationManager().GetProposalContents(simState)
+	return app.SimulationManager().WeightedOperations(simState)
+}
@@ -28,6 +28,7 @@ import (
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 	"github.com/petri-labs/warmage/app"
 	warmage "github.com/petri-labs/warmage/types"
+	"github.com/petri-labs/warmage/x/oracle/client/feeder"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -234,6 +235,7 @@ func initRootCmd(
 		queryCommand(moduleBasics),
 		txCommand(moduleBasics),
 		ethermintclient.KeyCommands(defaultNodeHome),
+		feeder.NewPriceFeederCmd(),
 	)
 
 	// add user given sub commands.
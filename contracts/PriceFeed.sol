@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+
+pragma solidity 0.8.21;
+
+/**
+ * @dev Chainlink AggregatorV3Interface compatible price feed of a denom,
+ * deployed by the oracle module.
+ *
+ * The contract only serves what the oracle module writes into its storage.
+ * Only the latest round is kept, so getRoundData reverts for any other round.
+ *
+ * The oracle module writes the storage directly, so the storage layout below
+ * must be kept in sync with x/oracle/types/price_feed.go.
+ */
+contract PriceFeed {
+  // slot 0
+  uint80 private _roundId;
+  // slot 1
+  int256 private _answer;
+  // slot 2
+  uint256 private _startedAt;
+  // slot 3
+  uint256 private _updatedAt;
+  // slot 4
+  uint8 private _decimals;
+  // slot 5
+  string private _description;
+
+  uint256 public constant version = 1;
+
+  constructor(uint8 decimals_, string memory description_) {
+    _decimals = decimals_;
+    _description = description_;
+  }
+
+  function decimals() external view returns (uint8) {
+    return _decimals;
+  }
+
+  function description() external view returns (string memory) {
+    return _description;
+  }
+
+  function getRoundData(uint80 roundId_)
+    external
+    view
+    returns (
+      uint80 roundId,
+      int256 answer,
+      uint256 startedAt,
+      uint256 updatedAt,
+      uint80 answeredInRound
+    )
+  {
+    require(roundId_ == _roundId, "PriceFeed: no data present");
+    return latestRoundData();
+  }
+
+  function latestRoundData()
+    public
+    view
+    returns (
+      uint80 roundId,
+      int256 answer,
+      uint256 startedAt,
+      uint256 updatedAt,
+      uint80 answeredInRound
+    )
+  {
+    return (_roundId, _answer, _startedAt, _updatedAt, _roundId);
+  }
+}
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: Apache-2.0
+
+pragma solidity 0.8.21;
+
+/**
+ * @dev Receiver interface of ERC721 safe transfers.
+ */
+interface IERC721Receiver {
+  function onERC721Received(address operator, address from, uint256 tokenId, bytes calldata data) external returns (bytes4);
+}
+
+/**
+ * @dev ERC721 contract of the ve NFTs, deployed by the ve module.
+ *
+ * Ownership, balances and approvals are kept the way any ERC721 keeps them,
+ * while the ve module mirrors the NFTs of the veNFT class into the storage of
+ * the contract, and syncs transfers made by the contract back into the nft
+ * module by the Transfer events. The balanceOfNFT view decays the voting power
+ * from the latest user checkpoint mirrored into the lock struct, as the ve
+ * module does.
+ *
+ * The ve module writes the storage directly, so the storage layout below must
+ * be kept in sync with x/ve/types/erc721.go.
+ */
+contract VeNFT {
+  struct Lock {
+    int128 amount;
+    uint256 end;
+    bool isPermanent;
+    uint256 bias;
+    uint256 slope;
+    uint256 timestamp;
+    uint256 permanent;
+  }
+
+  // slot 0
+  mapping(uint256 => address) private _owners;
+  // slot 1
+  mapping(address => uint256) private _balances;
+  // slot 2
+  mapping(uint256 => address) private _tokenApprovals;
+  // slot 3
+  mapping(address => mapping(address => bool)) private _operatorApprovals;
+  // slot 4
+  mapping(uint256 => Lock) private _locks;
+
+  string private _name;
+  string private _symbol;
+
+  event Transfer(address indexed from, address indexed to, uint256 indexed tokenId);
+  event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId);
+  event ApprovalForAll(address indexed owner, address indexed operator, bool approved);
+
+  constructor(string memory name_, string memory symbol_) {
+    _name = name_;
+    _symbol = symbol_;
+  }
+
+  function name() external view returns (string memory) {
+    return _name;
+  }
+
+  function symbol() external view returns (string memory) {
+    return _symbol;
+  }
+
+  function supportsInterface(bytes4 interfaceId) external pure returns (bool) {
+    return interfaceId == 0x01ffc9a7 || interfaceId == 0x80ac58cd;
+  }
+
+  function balanceOf(address owner) external view returns (uint256) {
+    require(owner != address(0), "VeNFT: zero address");
+    return _balances[owner];
+  }
+
+  function ownerOf(uint256 tokenId) public view returns (address) {
+    address owner = _owners[tokenId];
+    require(owner != address(0), "VeNFT: nonexistent token");
+    return owner;
+  }
+
+  function getApproved(uint256 tokenId) external view returns (address) {
+    ownerOf(tokenId);
+    return _tokenApprovals[tokenId];
+  }
+
+  function isApprovedForAll(address owner, address operator) public view returns (bool) {
+    return _operatorApprovals[owner][operator];
+  }
+
+  function approve(address to, uint256 tokenId) external {
+    address owner = ownerOf(tokenId);
+    require(to != owner, "VeNFT: approval to owner");
+    require(msg.sender == owner || isApprovedForAll(owner, msg.sender), "VeNFT: not owner nor approved for all");
+
+    _tokenApprovals[tokenId] = to;
+    emit Approval(owner, to, tokenId);
+  }
+
+  function setApprovalForAll(address operator, bool approved) external {
+    require(operator != msg.sender, "VeNFT: approve to caller");
+
+    _operatorApprovals[msg.sender][operator] = approved;
+    emit ApprovalForAll(msg.sender, operator, approved);
+  }
+
+  function transferFrom(address from, address to, uint256 tokenId) external {
+    _transfer(from, to, tokenId);
+  }
+
+  function safeTransferFrom(address from, address to, uint256 tokenId) external {
+    _transfer(from, to, tokenId);
+    _checkOnERC721Received(from, to, tokenId, "");
+  }
+
+  function safeTransferFrom(address from, address to, uint256 tokenId, bytes calldata data) external {
+    _transfer(from, to, tokenId);
+    _checkOnERC721Received(from, to, tokenId, data);
+  }
+
+  /**
+   * @dev Returns the voting power of the ve, decayed from the latest user
+   * checkpoint. Permanent locks do not decay.
+   */
+  function balanceOfNFT(uint256 tokenId) external view returns (uint256) {
+    Lock storage lock = _locks[tokenId];
+    uint256 decay = lock.slope * (block.timestamp - lock.timestamp);
+    uint256 power = lock.bias > decay ? lock.bias - decay : 0;
+    return power + lock.permanent;
+  }
+
+  function locked(uint256 tokenId) external view returns (int128 amount, uint256 end, bool isPermanent) {
+    Lock storage lock = _locks[tokenId];
+    return (lock.amount, lock.end, lock.isPermanent);
+  }
+
+  function _transfer(address from, address to, uint256 tokenId) private {
+    require(to != address(0), "VeNFT: transfer to zero address");
+    address owner = ownerOf(tokenId);
+    require(owner == from, "VeNFT: transfer from incorrect owner");
+    require(
+      msg.sender == owner || _tokenApprovals[tokenId] == msg.sender || isApprovedForAll(owner, msg.sender),
+      "VeNFT: not owner nor approved"
+    );
+
+    delete _tokenApprovals[tokenId];
+    _balances[from] -= 1;
+    _balances[to] += 1;
+    _owners[tokenId] = to;
+    emit Transfer(from, to, tokenId);
+  }
+
+  function _checkOnERC721Received(address from, address to, uint256 tokenId, bytes memory data) private {
+    if (to.code.length == 0) {
+      return;
+    }
+    try IERC721Receiver(to).onERC721Received(msg.sender, from, tokenId, data) returns (bytes4 retval) {
+      require(retval == IERC721Receiver.onERC721Received.selector, "VeNFT: transfer to non ERC721Receiver");
+    } catch (bytes memory reason) {
+      if (reason.length == 0) {
+        revert("VeNFT: transfer to non ERC721Receiver");
+      }
+      assembly {
+        revert(add(32, reason), mload(reason))
+      }
+    }
+  }
+}
@@ -0,0 +1,27 @@
+package contracts
+
+import (
+	_ "embed" // embed compiled smart contract
+	"encoding/json"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+var (
+	//go:embed compiled_contracts/PriceFeed.json
+	PriceFeedJSON []byte // nolint: golint
+
+	// PriceFeedContract is the compiled price feed contract
+	PriceFeedContract evmtypes.CompiledContract
+)
+
+func init() {
+	err := json.Unmarshal(PriceFeedJSON, &PriceFeedContract)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(PriceFeedContract.Bin) == 0 {
+		panic("load contract failed")
+	}
+}
@@ -0,0 +1,27 @@
+package contracts
+
+import (
+	_ "embed" // embed compiled smart contract
+	"encoding/json"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+var (
+	//go:embed compiled_contracts/VeNFT.json
+	VeNFTJSON []byte // nolint: golint
+
+	// VeNFTContract is the compiled ve NFT contract
+	VeNFTContract evmtypes.CompiledContract
+)
+
+func init() {
+	err := json.Unmarshal(VeNFTJSON, &VeNFTContract)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(VeNFTContract.Bin) == 0 {
+		panic("load contract failed")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/petri-labs/warmage/x/oracle/keeper"
@@ -45,6 +46,12 @@ func OracleKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		capabilitykeeper.ScopedKeeper{},
 		distrtypes.ModuleName,
 	)
 
@@ -0,0 +1,115 @@
+package gov
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/petri-labs/warmage/x/gov/keeper"
+)
+
+// EndBlocker is the same as the one of the cosmos gov module, except that proposals are tallied by
+// the Tally of the custom keeper, which counts ve voting power as well.
+func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(govtypes.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	logger := keeper.Logger(ctx)
+
+	// delete inactive proposal from store and its deposits
+	keeper.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal govtypes.Proposal) bool {
+		keeper.DeleteProposal(ctx, proposal.ProposalId)
+		keeper.DeleteDeposits(ctx, proposal.ProposalId)
+
+		// called when proposal become inactive
+		keeper.AfterProposalFailedMinDeposit(ctx, proposal.ProposalId)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				govtypes.EventTypeInactiveProposal,
+				sdk.NewAttribute(govtypes.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+				sdk.NewAttribute(govtypes.AttributeKeyProposalResult, govtypes.AttributeValueProposalDropped),
+			),
+		)
+
+		logger.Info(
+			"proposal did not meet minimum deposit; deleted",
+			"proposal", proposal.ProposalId,
+			"title", proposal.GetTitle(),
+			"min_deposit", keeper.GetDepositParams(ctx).MinDeposit.String(),
+			"total_deposit", proposal.TotalDeposit.String(),
+		)
+
+		return false
+	})
+
+	// fetch active proposals whose voting periods have ended (are passed the block time)
+	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal govtypes.Proposal) bool {
+		var tagValue, logMsg string
+
+		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+
+		if burnDeposits {
+			keeper.DeleteDeposits(ctx, proposal.ProposalId)
+		} else {
+			keeper.RefundDeposits(ctx, proposal.ProposalId)
+		}
+
+		if passes {
+			handler := keeper.Router().GetRoute(proposal.ProposalRoute())
+			cacheCtx, writeCache := ctx.CacheContext()
+
+			// The proposal handler may execute state mutating logic depending
+			// on the proposal content. If the handler fails, no state mutation
+			// is written and the error message is logged.
+			err := handler(cacheCtx, proposal.GetContent())
+			if err == nil {
+				proposal.Status = govtypes.StatusPassed
+				tagValue = govtypes.AttributeValueProposalPassed
+				logMsg = "passed"
+
+				// The cached context is created with a new EventManager. However, since
+				// the proposal handler execution was successful, we want to track/keep
+				// any events emitted, so we re-emit to "merge" the events into the
+				// original Context's EventManager.
+				ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+				// write state to the underlying multi-store
+				writeCache()
+			} else {
+				proposal.Status = govtypes.StatusFailed
+				tagValue = govtypes.AttributeValueProposalFailed
+				logMsg = fmt.Sprintf("passed, but failed on execution: %s", err)
+			}
+		} else {
+			proposal.Status = govtypes.StatusRejected
+			tagValue = govtypes.AttributeValueProposalRejected
+			logMsg = "rejected"
+		}
+
+		proposal.FinalTallyResult = tallyResults
+
+		keeper.SetProposal(ctx, proposal)
+		keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+		// when proposal become active
+		keeper.AfterProposalVotingPeriodEnded(ctx, proposal.ProposalId)
+
+		logger.Info(
+			"proposal tallied",
+			"proposal", proposal.ProposalId,
+			"title", proposal.GetTitle(),
+			"result", logMsg,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				govtypes.EventTypeActiveProposal,
+				sdk.NewAttribute(govtypes.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+				sdk.NewAttribute(govtypes.AttributeKeyProposalResult, tagValue),
+			),
+		)
+		return false
+	})
+}
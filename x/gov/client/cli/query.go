@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/petri-labs/warmage/x/gov/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryVoterPower() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voter-power [proposal-id] [voter-addr]",
+		Short: "shows the effective voting power of a voter on a proposal, including ve voting power",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VoterPower(context.Background(), &types.QueryVoterPowerRequest{
+				ProposalId: proposalID,
+				Voter:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
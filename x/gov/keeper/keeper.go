@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/petri-labs/warmage/x/gov/types"
+)
+
+type Keeper struct {
+	govkeeper.Keeper
+	storeKey      sdk.StoreKey
+	stakingKeeper types.StakingKeeper
+	nftKeeper     types.NftKeeper
+	veKeeper      types.VeKeeper
+}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key sdk.StoreKey,
+	ps paramtypes.Subspace,
+	ak govtypes.AccountKeeper,
+	bk govtypes.BankKeeper,
+	sk types.StakingKeeper,
+	nk types.NftKeeper,
+	vk types.VeKeeper,
+	rtr govtypes.Router,
+) Keeper {
+	return Keeper{
+		Keeper:        govkeeper.NewKeeper(cdc, key, ps, ak, bk, sk, rtr),
+		storeKey:      key,
+		stakingKeeper: sk,
+		nftKeeper:     nk,
+		veKeeper:      vk,
+	}
+}
+
+func (k *Keeper) SetHooks(gh govtypes.GovHooks) *Keeper {
+	k.Keeper = *k.Keeper.SetHooks(gh)
+	return k
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/petri-labs/warmage/app"
+	warmage "github.com/petri-labs/warmage/types"
+	vekeeper "github.com/petri-labs/warmage/x/ve/keeper"
+	vetypes "github.com/petri-labs/warmage/x/ve/types"
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	"github.com/tendermint/tendermint/version"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+	ctx sdk.Context
+	app *app.Warmage
+
+	valAddr sdk.ValAddress
+	voter   sdk.AccAddress
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	require := suite.Require()
+	pks := simapp.CreateTestPubKeys(2)
+
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Version: tmversion.Consensus{
+			Block: version.BlockProtocol,
+		},
+		ChainID: "warmage_5000-101",
+		Height:  1,
+		Time:    time.Now().UTC(),
+	})
+
+	// bonded validator with a stake of 5 * 10^18
+	suite.valAddr = sdk.ValAddress(pks[0].Address())
+	stake := suite.app.StakingKeeper.TokensFromConsensusPower(suite.ctx, 5)
+	err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sdk.AccAddress(suite.valAddr), sdk.NewCoins(sdk.NewCoin(warmage.BaseDenom, stake)))
+	require.NoError(err)
+	tstaking := teststaking.NewHelper(suite.T(), suite.ctx, suite.app.StakingKeeper.Keeper)
+	tstaking.Denom = warmage.BaseDenom
+	tstaking.CreateValidator(suite.valAddr, pks[0], stake, true)
+	_, err = suite.app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(suite.ctx)
+	require.NoError(err)
+
+	// voter without stake, locking 10^19 in ve
+	suite.voter = sdk.AccAddress(pks[1].Address())
+	amount := sdk.NewCoin(warmage.BaseDenom, sdk.NewIntWithDecimal(1, 20))
+	err = app.FundAccount(suite.app.BankKeeper, suite.ctx, suite.voter, sdk.NewCoins(amount))
+	require.NoError(err)
+	suite.createVe(sdk.NewIntWithDecimal(1, 19))
+}
+
+func (suite *KeeperTestSuite) createVe(amount sdk.Int) {
+	_, err := vekeeper.NewMsgServerImpl(suite.app.VeKeeper).Create(sdk.WrapSDKContext(suite.ctx), &vetypes.MsgCreate{
+		Sender:       suite.voter.String(),
+		To:           suite.voter.String(),
+		Amount:       sdk.NewCoin(warmage.BaseDenom, amount),
+		LockDuration: vetypes.MaxLockTime,
+	})
+	suite.Require().NoError(err)
+}
+
+// submitProposal submits a proposal and starts its voting period one hour later.
+func (suite *KeeperTestSuite) submitProposal() govtypes.Proposal {
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, govtypes.NewTextProposal("title", "description"))
+	suite.Require().NoError(err)
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour)).WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	suite.app.GovKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+	proposal, found := suite.app.GovKeeper.GetProposal(suite.ctx, proposal.ProposalId)
+	suite.Require().True(found)
+	return proposal
+}
@@ -0,0 +1,255 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/petri-labs/warmage/x/gov/types"
+	vetypes "github.com/petri-labs/warmage/x/ve/types"
+)
+
+// Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
+// voters, which is their bonded stake plus the ve voting power at the voting start time of the proposal.
+// It overrides the Tally of the cosmos gov keeper.
+func (k Keeper) Tally(ctx sdk.Context, proposal govtypes.Proposal) (passes bool, burnDeposits bool, tallyResults govtypes.TallyResult) {
+	results := make(map[govtypes.VoteOption]sdk.Dec)
+	results[govtypes.OptionYes] = sdk.ZeroDec()
+	results[govtypes.OptionAbstain] = sdk.ZeroDec()
+	results[govtypes.OptionNo] = sdk.ZeroDec()
+	results[govtypes.OptionNoWithVeto] = sdk.ZeroDec()
+
+	totalVotingPower := sdk.ZeroDec()
+	currValidators := k.getBondedValidators(ctx)
+
+	k.IterateVotes(ctx, proposal.ProposalId, func(vote govtypes.Vote) bool {
+		// if validator, just record it in the map
+		voter, err := sdk.AccAddressFromBech32(vote.Voter)
+		if err != nil {
+			panic(err)
+		}
+
+		valAddrStr := sdk.ValAddress(voter.Bytes()).String()
+		if val, ok := currValidators[valAddrStr]; ok {
+			val.Vote = vote.Options
+			currValidators[valAddrStr] = val
+		}
+
+		// iterate over all delegations from voter, deduct from any delegated-to validators
+		k.stakingKeeper.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+			valAddrStr := delegation.GetValidatorAddr().String()
+
+			if val, ok := currValidators[valAddrStr]; ok {
+				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+				currValidators[valAddrStr] = val
+
+				// delegation shares * bonded / total shares
+				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+
+				for _, option := range vote.Options {
+					subPower := votingPower.Mul(option.Weight)
+					results[option.Option] = results[option.Option].Add(subPower)
+				}
+				totalVotingPower = totalVotingPower.Add(votingPower)
+			}
+
+			return false
+		})
+
+		// ve voting power is never inherited by validators
+		_, vePower := k.GetVePowers(ctx, proposal, voter)
+		if vePower.IsPositive() {
+			votingPower := vePower.ToDec()
+			for _, option := range vote.Options {
+				subPower := votingPower.Mul(option.Weight)
+				results[option.Option] = results[option.Option].Add(subPower)
+			}
+			totalVotingPower = totalVotingPower.Add(votingPower)
+		}
+
+		k.deleteVote(ctx, vote.ProposalId, voter)
+		return false
+	})
+
+	// iterate over the validators again to tally their voting power
+	for _, val := range currValidators {
+		if len(val.Vote) == 0 {
+			continue
+		}
+
+		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
+		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+
+		for _, option := range val.Vote {
+			subPower := votingPower.Mul(option.Weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
+		totalVotingPower = totalVotingPower.Add(votingPower)
+	}
+
+	tallyParams := k.GetTallyParams(ctx)
+	tallyResults = govtypes.NewTallyResultFromMap(results)
+
+	// The bonded stake includes the ve delegated to bonded validators, which is not in the bonded pool
+	totalPower := k.getTotalVePower(ctx, proposal)
+	for _, val := range currValidators {
+		totalPower = totalPower.Add(val.BondedTokens)
+	}
+
+	// If there is no staked coins nor ve voting power, the proposal fails
+	if totalPower.IsZero() {
+		return false, false, tallyResults
+	}
+
+	// If there is not enough quorum of votes, the proposal fails
+	percentVoting := totalVotingPower.Quo(totalPower.ToDec())
+	if percentVoting.LT(tallyParams.Quorum) {
+		return false, true, tallyResults
+	}
+
+	// If no one votes (everyone abstains), proposal fails
+	if totalVotingPower.Sub(results[govtypes.OptionAbstain]).Equal(sdk.ZeroDec()) {
+		return false, false, tallyResults
+	}
+
+	// If more than 1/3 of voters veto, proposal fails
+	if results[govtypes.OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.VetoThreshold) {
+		return false, true, tallyResults
+	}
+
+	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
+	if results[govtypes.OptionYes].Quo(totalVotingPower.Sub(results[govtypes.OptionAbstain])).GT(tallyParams.Threshold) {
+		return true, false, tallyResults
+	}
+
+	// If more than 1/2 of non-abstaining voters vote No, proposal fails
+	return false, false, tallyResults
+}
+
+// GetVoterPower returns the effective voting power of the voter on the proposal, as the voter would be
+// counted by Tally with the votes cast so far.
+func (k Keeper) GetVoterPower(ctx sdk.Context, proposal govtypes.Proposal, voter sdk.AccAddress) types.QueryVoterPowerResponse {
+	currValidators := k.getBondedValidators(ctx)
+
+	// deduct the delegations of the other voters from their validators
+	k.IterateVotes(ctx, proposal.ProposalId, func(vote govtypes.Vote) bool {
+		if vote.Voter == voter.String() {
+			return false
+		}
+		otherVoter, err := sdk.AccAddressFromBech32(vote.Voter)
+		if err != nil {
+			panic(err)
+		}
+		k.stakingKeeper.IterateDelegations(ctx, otherVoter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+			valAddrStr := delegation.GetValidatorAddr().String()
+			if val, ok := currValidators[valAddrStr]; ok {
+				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+				currValidators[valAddrStr] = val
+			}
+			return false
+		})
+		return false
+	})
+
+	delegationPower := sdk.ZeroDec()
+	k.stakingKeeper.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+		valAddrStr := delegation.GetValidatorAddr().String()
+		if val, ok := currValidators[valAddrStr]; ok {
+			val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+			currValidators[valAddrStr] = val
+
+			delegationPower = delegationPower.Add(delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+		}
+		return false
+	})
+
+	validatorPower := sdk.ZeroDec()
+	if val, ok := currValidators[sdk.ValAddress(voter.Bytes()).String()]; ok {
+		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
+		validatorPower = sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+	}
+
+	vePowers, vePower := k.GetVePowers(ctx, proposal, voter)
+
+	return types.QueryVoterPowerResponse{
+		DelegationPower: delegationPower,
+		ValidatorPower:  validatorPower,
+		VePowers:        vePowers,
+		VePower:         vePower,
+		TotalPower:      delegationPower.Add(validatorPower).Add(vePower.ToDec()),
+	}
+}
+
+// GetVePowers returns the voting power at the voting start time of the proposal of every veNFT owned
+// by the voter, and their total. The amount of a ve delegated to validators is excluded, since it is
+// already counted as the delegation of the voter.
+func (k Keeper) GetVePowers(ctx sdk.Context, proposal govtypes.Proposal, voter sdk.AccAddress) (vePowers []types.VePower, total sdk.Int) {
+	atTime := votingStartTime(ctx, proposal)
+	total = sdk.ZeroInt()
+	for _, veNft := range k.nftKeeper.GetNFTsOfClassByOwner(ctx, vetypes.VeNftClass.Id, voter) {
+		power := k.getVePower(ctx, vetypes.Uint64FromVeID(veNft.Id), atTime)
+		if !power.IsPositive() {
+			continue
+		}
+		vePowers = append(vePowers, types.VePower{
+			VeId:  veNft.Id,
+			Power: power,
+		})
+		total = total.Add(power)
+	}
+	return vePowers, total
+}
+
+// getTotalVePower returns the total ve voting power at the voting start time of the proposal, excluding
+// the amounts of ve delegated to validators, which are part of the bonded stake.
+func (k Keeper) getTotalVePower(ctx sdk.Context, proposal govtypes.Proposal) sdk.Int {
+	atTime := votingStartTime(ctx, proposal)
+	total := k.veKeeper.GetTotalVotingPower(ctx, atTime, 0)
+	for _, veNft := range k.nftKeeper.GetNFTsOfClass(ctx, vetypes.VeNftClass.Id) {
+		veID := vetypes.Uint64FromVeID(veNft.Id)
+		power := k.veKeeper.GetVotingPower(ctx, veID, atTime, 0)
+		total = total.Sub(power.Sub(k.getVePower(ctx, veID, atTime)))
+	}
+	if total.IsNegative() {
+		return sdk.ZeroInt()
+	}
+	return total
+}
+
+// getVePower returns the voting power of the ve at the given time, less the amount delegated to validators.
+func (k Keeper) getVePower(ctx sdk.Context, veID uint64, atTime uint64) sdk.Int {
+	power := k.veKeeper.GetVotingPower(ctx, veID, atTime, 0)
+	delegated := k.stakingKeeper.GetVeDelegatedAmount(ctx, veID)
+	if power.LTE(delegated) {
+		return sdk.ZeroInt()
+	}
+	return power.Sub(delegated)
+}
+
+func (k Keeper) getBondedValidators(ctx sdk.Context) map[string]govtypes.ValidatorGovInfo {
+	currValidators := make(map[string]govtypes.ValidatorGovInfo)
+	k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
+		currValidators[validator.GetOperator().String()] = govtypes.NewValidatorGovInfo(
+			validator.GetOperator(),
+			validator.GetBondedTokens(),
+			validator.GetDelegatorShares(),
+			sdk.ZeroDec(),
+			govtypes.WeightedVoteOptions{},
+		)
+		return false
+	})
+	return currValidators
+}
+
+func (k Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(govtypes.VoteKey(proposalID, voterAddr))
+}
+
+// votingStartTime returns the time at which the ve voting power is measured for the proposal, so that
+// locking after the voting period has started gains no voting power.
+func votingStartTime(ctx sdk.Context, proposal govtypes.Proposal) uint64 {
+	if proposal.VotingStartTime.Unix() > 0 {
+		return uint64(proposal.VotingStartTime.Unix())
+	}
+	return uint64(ctx.BlockTime().Unix())
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	sdkstakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/petri-labs/warmage/x/gov/types"
+	stakingtypes "github.com/petri-labs/warmage/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_Tally() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.GovKeeper
+
+	proposal := suite.submitProposal()
+	startTime := uint64(proposal.VotingStartTime.Unix())
+	vePower := suite.app.VeKeeper.GetVotingPower(suite.ctx, 1, startTime, 0)
+	require.True(vePower.GT(sdk.NewIntWithDecimal(5, 18)))
+	stake := suite.app.StakingKeeper.TokensFromConsensusPower(suite.ctx, 5)
+
+	// locking after the voting start gains no voting power
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour)).WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	suite.createVe(sdk.NewIntWithDecimal(1, 19))
+
+	require.NoError(k.AddVote(suite.ctx, proposal.ProposalId, suite.voter, govtypes.NewNonSplitVoteOption(govtypes.OptionYes)))
+	require.NoError(k.AddVote(suite.ctx, proposal.ProposalId, sdk.AccAddress(suite.valAddr), govtypes.NewNonSplitVoteOption(govtypes.OptionNo)))
+
+	passes, burnDeposits, tallyResults := k.Tally(suite.ctx, proposal)
+	require.True(passes)
+	require.False(burnDeposits)
+	require.Equal(vePower, tallyResults.Yes)
+	require.Equal(stake, tallyResults.No)
+	require.True(tallyResults.Abstain.IsZero())
+	require.True(tallyResults.NoWithVeto.IsZero())
+
+	// votes are deleted once tallied
+	require.Empty(k.GetVotes(suite.ctx, proposal.ProposalId))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_Tally_Quorum() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.GovKeeper
+
+	// ve voting power of about 2 * 10^19 against a bonded stake of 5 * 10^18
+	suite.createVe(sdk.NewIntWithDecimal(1, 19))
+	proposal := suite.submitProposal()
+
+	// the validator alone does not meet the quorum of the bonded stake plus the ve voting power
+	require.NoError(k.AddVote(suite.ctx, proposal.ProposalId, sdk.AccAddress(suite.valAddr), govtypes.NewNonSplitVoteOption(govtypes.OptionYes)))
+	passes, burnDeposits, _ := k.Tally(suite.ctx, proposal)
+	require.False(passes)
+	require.True(burnDeposits)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_Tally_VeDelegated() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.GovKeeper
+
+	// the voter delegates part of the ve to the validator
+	delegated := sdk.NewIntWithDecimal(4, 18)
+	validator, found := suite.app.StakingKeeper.GetValidator(suite.ctx, suite.valAddr)
+	require.True(found)
+	_, err := suite.app.StakingKeeper.VeDelegate(suite.ctx, suite.voter, delegated, stakingtypes.VeTokensSlice{{VeId: 1, Tokens: delegated}}, sdkstakingtypes.Unbonded, validator, true)
+	require.NoError(err)
+
+	proposal := suite.submitProposal()
+	vePower := suite.app.VeKeeper.GetVotingPower(suite.ctx, 1, uint64(proposal.VotingStartTime.Unix()), 0)
+	stake := suite.app.StakingKeeper.TokensFromConsensusPower(suite.ctx, 5)
+
+	// the delegated amount counts once, as the delegation of the voter
+	res, err := k.VoterPower(sdk.WrapSDKContext(suite.ctx), &types.QueryVoterPowerRequest{ProposalId: proposal.ProposalId, Voter: suite.voter.String()})
+	require.NoError(err)
+	require.Equal(delegated.ToDec(), res.DelegationPower)
+	require.Equal(vePower.Sub(delegated), res.VePower)
+	require.Equal(vePower.ToDec(), res.TotalPower)
+
+	require.NoError(k.AddVote(suite.ctx, proposal.ProposalId, suite.voter, govtypes.NewNonSplitVoteOption(govtypes.OptionYes)))
+	require.NoError(k.AddVote(suite.ctx, proposal.ProposalId, sdk.AccAddress(suite.valAddr), govtypes.NewNonSplitVoteOption(govtypes.OptionNo)))
+
+	passes, burnDeposits, tallyResults := k.Tally(suite.ctx, proposal)
+	require.True(passes)
+	require.False(burnDeposits)
+	require.Equal(vePower, tallyResults.Yes)
+	require.Equal(stake, tallyResults.No)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_VoterPower() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.GovKeeper
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := k.VoterPower(ctx, nil)
+	require.Error(err)
+	_, err = k.VoterPower(ctx, &types.QueryVoterPowerRequest{ProposalId: 1, Voter: suite.voter.String()})
+	require.Error(err)
+
+	proposal := suite.submitProposal()
+	ctx = sdk.WrapSDKContext(suite.ctx)
+	_, err = k.VoterPower(ctx, &types.QueryVoterPowerRequest{ProposalId: proposal.ProposalId, Voter: "xxx"})
+	require.Error(err)
+
+	vePower := suite.app.VeKeeper.GetVotingPower(suite.ctx, 1, uint64(proposal.VotingStartTime.Unix()), 0)
+	res, err := k.VoterPower(ctx, &types.QueryVoterPowerRequest{ProposalId: proposal.ProposalId, Voter: suite.voter.String()})
+	require.NoError(err)
+	require.True(res.DelegationPower.IsZero())
+	require.True(res.ValidatorPower.IsZero())
+	require.Equal([]types.VePower{{VeId: "ve-1", Power: vePower}}, res.VePowers)
+	require.Equal(vePower, res.VePower)
+	require.Equal(vePower.ToDec(), res.TotalPower)
+
+	stake := suite.app.StakingKeeper.TokensFromConsensusPower(suite.ctx, 5).ToDec()
+	res, err = k.VoterPower(ctx, &types.QueryVoterPowerRequest{ProposalId: proposal.ProposalId, Voter: sdk.AccAddress(suite.valAddr).String()})
+	require.NoError(err)
+	require.Equal(stake, res.DelegationPower)
+	require.True(res.ValidatorPower.IsZero())
+	require.Empty(res.VePowers)
+	require.True(res.VePower.IsZero())
+	require.Equal(stake, res.TotalPower)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_TallyResult() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.GovKeeper
+
+	proposal := suite.submitProposal()
+	require.NoError(k.AddVote(suite.ctx, proposal.ProposalId, suite.voter, govtypes.NewNonSplitVoteOption(govtypes.OptionYes)))
+
+	res, err := k.TallyResult(sdk.WrapSDKContext(suite.ctx), &govtypes.QueryTallyResultRequest{ProposalId: proposal.ProposalId})
+	require.NoError(err)
+	require.Equal(suite.app.VeKeeper.GetVotingPower(suite.ctx, 1, uint64(proposal.VotingStartTime.Unix()), 0), res.Tally.Yes)
+}
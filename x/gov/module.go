@@ -0,0 +1,84 @@
+package gov
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/petri-labs/warmage/x/gov/client/cli"
+	"github.com/petri-labs/warmage/x/gov/keeper"
+	"github.com/petri-labs/warmage/x/gov/types"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+var (
+	_ module.AppModule           = AppModule{}
+	_ module.AppModuleBasic      = AppModuleBasic{}
+	_ module.AppModuleSimulation = AppModule{}
+)
+
+type AppModuleBasic struct {
+	gov.AppModuleBasic
+}
+
+func NewAppModuleBasic(proposalHandlers ...govclient.ProposalHandler) AppModuleBasic {
+	return AppModuleBasic{
+		AppModuleBasic: gov.NewAppModuleBasic(proposalHandlers...),
+	}
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the gov module.
+func (b AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	b.AppModuleBasic.RegisterGRPCGatewayRoutes(clientCtx, mux)
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// GetQueryCmd returns the root query command for the gov module.
+func (b AppModuleBasic) GetQueryCmd() *cobra.Command {
+	cmd := b.AppModuleBasic.GetQueryCmd()
+	cmd.AddCommand(cli.CmdQueryVoterPower())
+	return cmd
+}
+
+type AppModule struct {
+	gov.AppModule
+
+	keeper keeper.Keeper
+}
+
+func NewAppModule(cdc codec.Codec, keeper keeper.Keeper, ak govtypes.AccountKeeper, bk govtypes.BankKeeper) AppModule {
+	return AppModule{
+		AppModule: gov.NewAppModule(cdc, keeper.Keeper, ak, bk),
+		keeper:    keeper,
+	}
+}
+
+// RegisterServices registers module services.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	govtypes.RegisterMsgServer(cfg.MsgServer(), govkeeper.NewMsgServerImpl(am.keeper.Keeper))
+	govtypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := govkeeper.NewMigrator(am.keeper.Keeper)
+	err := cfg.RegisterMigration(govtypes.ModuleName, 1, m.Migrate1to2)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// EndBlock returns the end blocker for the gov module. It returns no validator
+// updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.keeper)
+	return []abci.ValidatorUpdate{}
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// StakingKeeper defines the expected staking keeper, which also tracks the amount of ve delegated to validators.
+type StakingKeeper interface {
+	govtypes.StakingKeeper
+	GetVeDelegatedAmount(ctx sdk.Context, veID uint64) sdk.Int
+}
+
+// NftKeeper defines the expected interface needed to query NFT tokens.
+type NftKeeper interface {
+	GetNFTsOfClass(ctx sdk.Context, classID string) (nfts []nft.NFT)
+	GetNFTsOfClassByOwner(ctx sdk.Context, classID string, owner sdk.AccAddress) (nfts []nft.NFT)
+}
+
+type VeKeeper interface {
+	GetVotingPower(ctx sdk.Context, veID uint64, atTime uint64, atBlock int64) sdk.Int
+	GetTotalVotingPower(ctx sdk.Context, atTime uint64, atBlock int64) sdk.Int
+}
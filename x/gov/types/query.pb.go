@@ -0,0 +1,1053 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: warmage/gov/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryVoterPowerRequest struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Voter      string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+}
+
+func (m *QueryVoterPowerRequest) Reset()         { *m = QueryVoterPowerRequest{} }
+func (m *QueryVoterPowerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoterPowerRequest) ProtoMessage()    {}
+func (*QueryVoterPowerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4b52b266d78f1d0, []int{0}
+}
+func (m *QueryVoterPowerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoterPowerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoterPowerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoterPowerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoterPowerRequest.Merge(m, src)
+}
+func (m *QueryVoterPowerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoterPowerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoterPowerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoterPowerRequest proto.InternalMessageInfo
+
+func (m *QueryVoterPowerRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *QueryVoterPowerRequest) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+// VePower is the voting power of a veNFT at the voting start time of a
+// proposal.
+type VePower struct {
+	VeId  string                                 `protobuf:"bytes,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	Power github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=power,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"power"`
+}
+
+func (m *VePower) Reset()         { *m = VePower{} }
+func (m *VePower) String() string { return proto.CompactTextString(m) }
+func (*VePower) ProtoMessage()    {}
+func (*VePower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4b52b266d78f1d0, []int{1}
+}
+func (m *VePower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VePower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VePower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VePower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VePower.Merge(m, src)
+}
+func (m *VePower) XXX_Size() int {
+	return m.Size()
+}
+func (m *VePower) XXX_DiscardUnknown() {
+	xxx_messageInfo_VePower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VePower proto.InternalMessageInfo
+
+func (m *VePower) GetVeId() string {
+	if m != nil {
+		return m.VeId
+	}
+	return ""
+}
+
+type QueryVoterPowerResponse struct {
+	// voting power of the bonded stake delegated by the voter
+	DelegationPower github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=delegation_power,json=delegationPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegation_power"`
+	// voting power inherited by the voter as a bonded validator, from the
+	// delegators who have not voted themselves
+	ValidatorPower github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=validator_power,json=validatorPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_power"`
+	// voting power of each veNFT owned by the voter
+	VePowers []VePower `protobuf:"bytes,3,rep,name=ve_powers,json=vePowers,proto3" json:"ve_powers"`
+	// total voting power of the veNFTs owned by the voter
+	VePower github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=ve_power,json=vePower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"ve_power"`
+	// effective voting power of the voter
+	TotalPower github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=total_power,json=totalPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_power"`
+}
+
+func (m *QueryVoterPowerResponse) Reset()         { *m = QueryVoterPowerResponse{} }
+func (m *QueryVoterPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoterPowerResponse) ProtoMessage()    {}
+func (*QueryVoterPowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4b52b266d78f1d0, []int{2}
+}
+func (m *QueryVoterPowerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoterPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoterPowerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoterPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoterPowerResponse.Merge(m, src)
+}
+func (m *QueryVoterPowerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoterPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoterPowerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoterPowerResponse proto.InternalMessageInfo
+
+func (m *QueryVoterPowerResponse) GetVePowers() []VePower {
+	if m != nil {
+		return m.VePowers
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryVoterPowerRequest)(nil), "warmage.gov.v1.QueryVoterPowerRequest")
+	proto.RegisterType((*VePower)(nil), "warmage.gov.v1.VePower")
+	proto.RegisterType((*QueryVoterPowerResponse)(nil), "warmage.gov.v1.QueryVoterPowerResponse")
+}
+
+func init() { proto.RegisterFile("warmage/gov/v1/query.proto", fileDescriptor_d4b52b266d78f1d0) }
+
+var fileDescriptor_d4b52b266d78f1d0 = []byte{
+	// 479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xe3, 0x26, 0xa6, 0xcd, 0x44, 0x6a, 0xd1, 0x52, 0xd1, 0x28, 0x42, 0x4e, 0x94, 0x43,
+	0x09, 0x87, 0x7a, 0xd5, 0x72, 0x03, 0x71, 0x49, 0x7b, 0xc9, 0xa9, 0xe0, 0x43, 0x11, 0x5c, 0xa2,
+	0x4d, 0xbc, 0x5a, 0x2c, 0x1c, 0x8f, 0xeb, 0xdd, 0x6c, 0xa8, 0xaa, 0x5e, 0x78, 0x02, 0x24, 0xde,
+	0x00, 0x89, 0x1b, 0x0f, 0xd2, 0x63, 0x25, 0x2e, 0x88, 0x43, 0x85, 0x12, 0x1e, 0x04, 0x79, 0xd7,
+	0x6e, 0x4b, 0x41, 0x42, 0xe4, 0x64, 0x8f, 0x67, 0xe7, 0xfb, 0xfd, 0xcf, 0xcc, 0x42, 0x6b, 0xc6,
+	0xb2, 0x09, 0x13, 0x9c, 0x0a, 0xd4, 0x54, 0xef, 0xd2, 0xe3, 0x29, 0xcf, 0x4e, 0xfc, 0x34, 0x43,
+	0x85, 0x64, 0xbd, 0xc8, 0xf9, 0x02, 0xb5, 0xaf, 0x77, 0x5b, 0x9b, 0x02, 0x05, 0x9a, 0x14, 0xcd,
+	0xdf, 0xec, 0xa9, 0xd6, 0x03, 0x81, 0x28, 0x62, 0x4e, 0x59, 0x1a, 0x51, 0x96, 0x24, 0xa8, 0x98,
+	0x8a, 0x30, 0x91, 0x36, 0xdb, 0x3d, 0x84, 0xfb, 0x2f, 0x72, 0xe4, 0x11, 0x2a, 0x9e, 0x3d, 0xc7,
+	0x19, 0xcf, 0x02, 0x7e, 0x3c, 0xe5, 0x52, 0x91, 0x36, 0x34, 0xd2, 0x0c, 0x53, 0x94, 0x2c, 0x1e,
+	0x46, 0x61, 0xd3, 0xe9, 0x38, 0xbd, 0x5a, 0x00, 0xe5, 0xa7, 0x41, 0x48, 0x36, 0xc1, 0xd5, 0x79,
+	0x55, 0x73, 0xa5, 0xe3, 0xf4, 0xea, 0x81, 0x0d, 0xba, 0x21, 0xac, 0x1e, 0x71, 0x03, 0x22, 0xf7,
+	0xc0, 0xd5, 0xbc, 0xac, 0xad, 0x07, 0x35, 0xcd, 0x07, 0x21, 0x39, 0x00, 0x37, 0xc5, 0x59, 0x59,
+	0xd5, 0xf7, 0xcf, 0x2f, 0xdb, 0x95, 0xef, 0x97, 0xed, 0x6d, 0x11, 0xa9, 0x37, 0xd3, 0x91, 0x3f,
+	0xc6, 0x09, 0x1d, 0xa3, 0x9c, 0xa0, 0x2c, 0x1e, 0x3b, 0x32, 0x7c, 0x4b, 0xd5, 0x49, 0xca, 0xa5,
+	0x3f, 0x48, 0x54, 0x60, 0x8b, 0xbb, 0x9f, 0xab, 0xb0, 0xf5, 0xc7, 0x7f, 0xcb, 0x14, 0x13, 0xc9,
+	0xc9, 0x2b, 0xb8, 0x1b, 0xf2, 0x98, 0x0b, 0xe3, 0x73, 0x68, 0xc5, 0x9c, 0xff, 0x16, 0x3b, 0xe0,
+	0xe3, 0x60, 0xe3, 0x9a, 0x63, 0x1d, 0xbd, 0x84, 0x0d, 0xcd, 0xe2, 0x28, 0x64, 0x0a, 0xb3, 0xe1,
+	0xb2, 0x36, 0x72, 0xf2, 0xfa, 0x15, 0xc6, 0x82, 0x9f, 0x40, 0x5d, 0x73, 0x4b, 0x94, 0xcd, 0x6a,
+	0xa7, 0xda, 0x6b, 0xec, 0x6d, 0xf9, 0xbf, 0x8f, 0xd7, 0x2f, 0xda, 0xda, 0xaf, 0xe5, 0x5a, 0xc1,
+	0x9a, 0xb6, 0xa1, 0x24, 0x03, 0x58, 0x2b, 0x6b, 0x9b, 0xb5, 0xa5, 0x9a, 0xba, 0x5a, 0xb0, 0xc8,
+	0x21, 0x34, 0x14, 0x2a, 0x16, 0x17, 0x34, 0x77, 0x29, 0x6f, 0x60, 0x10, 0x06, 0xb8, 0xf7, 0xc5,
+	0x01, 0xd7, 0xcc, 0x89, 0x7c, 0x72, 0x00, 0xae, 0x87, 0x45, 0xb6, 0x6f, 0xbb, 0xfb, 0xfb, 0x16,
+	0xb6, 0x1e, 0xfe, 0xf3, 0x9c, 0x9d, 0x7a, 0x77, 0xff, 0xfd, 0xd7, 0x9f, 0x1f, 0x57, 0x9e, 0x91,
+	0xa7, 0xf4, 0xd6, 0x8d, 0x29, 0x37, 0x56, 0xd2, 0xd3, 0x1b, 0xfb, 0x7c, 0x46, 0xcd, 0xba, 0x5a,
+	0xa3, 0xf4, 0xd4, 0x04, 0x67, 0xfd, 0xfd, 0xf3, 0xb9, 0xe7, 0x5c, 0xcc, 0x3d, 0xe7, 0xc7, 0xdc,
+	0x73, 0x3e, 0x2c, 0xbc, 0xca, 0xc5, 0xc2, 0xab, 0x7c, 0x5b, 0x78, 0x95, 0xd7, 0x8f, 0x6e, 0x98,
+	0x4f, 0xb9, 0xca, 0xa2, 0x9d, 0x98, 0x8d, 0xe4, 0x95, 0xd6, 0x3b, 0xa3, 0x66, 0x7a, 0x30, 0xba,
+	0x63, 0x6e, 0xd6, 0xe3, 0x5f, 0x03, 0x00, 0x33, 0x3a, 0x06, 0x30, 0xbb, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// VoterPower queries the effective voting power of a voter on a proposal.
+	VoterPower(ctx context.Context, in *QueryVoterPowerRequest, opts ...grpc.CallOption) (*QueryVoterPowerResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) VoterPower(ctx context.Context, in *QueryVoterPowerRequest, opts ...grpc.CallOption) (*QueryVoterPowerResponse, error) {
+	out := new(QueryVoterPowerResponse)
+	err := c.cc.Invoke(ctx, "/warmage.gov.v1.Query/VoterPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// VoterPower queries the effective voting power of a voter on a proposal.
+	VoterPower(context.Context, *QueryVoterPowerRequest) (*QueryVoterPowerResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) VoterPower(ctx context.Context, req *QueryVoterPowerRequest) (*QueryVoterPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoterPower not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_VoterPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoterPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoterPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.gov.v1.Query/VoterPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoterPower(ctx, req.(*QueryVoterPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "warmage.gov.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VoterPower",
+			Handler:    _Query_VoterPower_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "warmage/gov/v1/query.proto",
+}
+
+func (m *QueryVoterPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoterPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoterPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VePower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VePower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VePower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Power.Size()
+		i -= size
+		if _, err := m.Power.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoterPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoterPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoterPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalPower.Size()
+		i -= size
+		if _, err := m.TotalPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.VePower.Size()
+		i -= size
+		if _, err := m.VePower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.VePowers) > 0 {
+		for iNdEx := len(m.VePowers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VePowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.ValidatorPower.Size()
+		i -= size
+		if _, err := m.ValidatorPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.DelegationPower.Size()
+		i -= size
+		if _, err := m.DelegationPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryVoterPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *VePower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Power.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVoterPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DelegationPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ValidatorPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.VePowers) > 0 {
+		for _, e := range m.VePowers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.VePower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryVoterPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoterPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoterPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VePower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VePower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VePower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoterPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoterPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoterPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegationPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VePowers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VePowers = append(m.VePowers, VePower{})
+			if err := m.VePowers[len(m.VePowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VePower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VePower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
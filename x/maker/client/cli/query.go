@@ -34,6 +34,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetBackingPoolCmd(),
 		GetCollateralPoolCmd(),
 		GetCollateralOfAccountCmd(),
+		GetCollateralManagersCmd(),
 		GetTotalBackingCmd(),
 		GetTotalCollateralCmd(),
 		GetBackingRatioCmd(),
@@ -246,6 +247,36 @@ func GetCollateralOfAccountCmd() *cobra.Command {
 	return cmd
 }
 
+func GetCollateralManagersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collateral-managers [account]",
+		Short: "Gets the authorized collateral position managers of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCollateralManagersRequest{
+				Account: args[0],
+			}
+
+			res, err := queryClient.CollateralManagers(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetTotalBackingCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "backing-total",
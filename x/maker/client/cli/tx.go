@@ -30,6 +30,8 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		NewMintBySwapCmd(),
 		NewBurnBySwapCmd(),
+		NewBasketMintCmd(),
+		NewBasketBurnCmd(),
 		NewBuyBackingCmd(),
 		NewSellBackingCmd(),
 		NewMintByCollateralCmd(),
@@ -37,6 +39,11 @@ func GetTxCmd() *cobra.Command {
 		NewDepositCollateralCmd(),
 		NewRedeemCollateralCmd(),
 		NewLiquidateCollateralCmd(),
+		NewTransferPositionCmd(),
+		NewAuthorizeManagerCmd(),
+		NewRevokeManagerCmd(),
+		NewLeverageCmd(),
+		NewDeleverageCmd(),
 	)
 
 	return cmd
@@ -180,6 +187,157 @@ func NewBurnBySwapCmd() *cobra.Command {
 	return cmd
 }
 
+func NewBasketMintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "basket-mint [mint_out] [receiver]",
+		Short: "Mint by swapping in a basket of backing assets and mage coin",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress().String()
+
+			mintOut, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			var receiver string
+			if len(args) == 2 {
+				receiver = args[1]
+				if _, err := sdk.AccAddressFromBech32(receiver); err != nil {
+					return fmt.Errorf("invalid receiver bech32 address %w", err)
+				}
+			} else {
+				receiver = sender
+			}
+
+			backingInMaxStr, err := cmd.Flags().GetString(FlagBackingInMax)
+			if err != nil {
+				return err
+			}
+			backingInMax, err := sdk.ParseCoinsNormalized(backingInMaxStr)
+			if err != nil {
+				return fmt.Errorf("--%s: %w", FlagBackingInMax, err)
+			}
+
+			mageInMaxStr, err := cmd.Flags().GetString(FlagMageInMax)
+			if err != nil {
+				return err
+			}
+			mageInMax, err := sdk.ParseCoinNormalized(mageInMaxStr)
+			if err != nil {
+				return fmt.Errorf("--%s: %w", FlagMageInMax, err)
+			}
+
+			fullBacking, err := cmd.Flags().GetBool(FlagFullBacking)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBasketMint{
+				Sender:       sender,
+				To:           receiver,
+				MintOutMin:   mintOut,
+				BackingInMax: backingInMax,
+				MageInMax:    mageInMax,
+				FullBacking:  fullBacking,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagBackingInMax, "", "Maximum backing-in coins, separated by commas")
+	cmd.Flags().String(FlagMageInMax, "0umage", "Maximum mage-in coin")
+	cmd.Flags().Bool(FlagFullBacking, false, "Whether to mint with full backing")
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewBasketBurnCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "basket-burn [burn_in] [receiver]",
+		Short: "Burn by swapping out a basket of backing assets and mage coin",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress().String()
+
+			burnIn, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			var receiver string
+			if len(args) == 2 {
+				receiver = args[1]
+				if _, err := sdk.AccAddressFromBech32(receiver); err != nil {
+					return fmt.Errorf("invalid receiver bech32 address %w", err)
+				}
+			} else {
+				receiver = sender
+			}
+
+			backingOutMinStr, err := cmd.Flags().GetString(FlagBackingOutMin)
+			if err != nil {
+				return err
+			}
+			// parse each coin separately, since zero minimum amounts are allowed
+			var backingOutMin sdk.Coins
+			for _, coinStr := range strings.Split(backingOutMinStr, ",") {
+				coin, err := sdk.ParseCoinNormalized(strings.TrimSpace(coinStr))
+				if err != nil {
+					return fmt.Errorf("--%s: %w", FlagBackingOutMin, err)
+				}
+				backingOutMin = append(backingOutMin, coin)
+			}
+			backingOutMin = backingOutMin.Sort()
+
+			mageOutMinStr, err := cmd.Flags().GetString(FlagMageOutMin)
+			if err != nil {
+				return err
+			}
+			mageOutMin, err := sdk.ParseCoinNormalized(mageOutMinStr)
+			if err != nil {
+				return fmt.Errorf("--%s: %w", FlagMageOutMin, err)
+			}
+
+			msg := &types.MsgBasketBurn{
+				Sender:        sender,
+				To:            receiver,
+				BurnIn:        burnIn,
+				BackingOutMin: backingOutMin,
+				MageOutMin:    mageOutMin,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagBackingOutMin, "", "Minimum backing-out coins, separated by commas")
+	cmd.Flags().String(FlagMageOutMin, "", "Minimum mage-out coin")
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewBuyBackingCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "buy-backing [mage_in] [receiver]",
@@ -316,13 +474,18 @@ func NewMintByCollateralCmd() *cobra.Command {
 				return err
 			}
 
+			owner, err := cmd.Flags().GetString(FlagOwner)
+			if err != nil {
+				return err
+			}
+
 			var receiver string
-			if len(args) == 2 {
+			if len(args) == 3 {
 				receiver = args[2]
 				if _, err := sdk.AccAddressFromBech32(receiver); err != nil {
 					return fmt.Errorf("invalid receiver bech32 address %w", err)
 				}
-			} else {
+			} else if len(owner) == 0 {
 				receiver = sender
 			}
 
@@ -331,6 +494,7 @@ func NewMintByCollateralCmd() *cobra.Command {
 				To:              receiver,
 				CollateralDenom: collateralDenom,
 				MintOut:         mintOut,
+				Owner:           owner,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -341,6 +505,8 @@ func NewMintByCollateralCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagOwner, "", "Owner of the collateral account, if managed by sender")
+
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -365,10 +531,16 @@ func NewBurnByCollateralCmd() *cobra.Command {
 				return err
 			}
 
+			owner, err := cmd.Flags().GetString(FlagOwner)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgBurnByCollateral{
 				Sender:          sender,
 				CollateralDenom: collateralDenom,
 				RepayInMax:      repayInMax,
+				Owner:           owner,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -379,6 +551,8 @@ func NewBurnByCollateralCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagOwner, "", "Owner of the collateral account, if managed by sender")
+
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -452,13 +626,18 @@ func NewRedeemCollateralCmd() *cobra.Command {
 				return err
 			}
 
+			owner, err := cmd.Flags().GetString(FlagOwner)
+			if err != nil {
+				return err
+			}
+
 			var receiver string
 			if len(args) == 3 {
 				receiver = args[2]
 				if _, err := sdk.AccAddressFromBech32(receiver); err != nil {
 					return fmt.Errorf("invalid receiver bech32 address %w", err)
 				}
-			} else {
+			} else if len(owner) == 0 {
 				receiver = sender
 			}
 
@@ -467,6 +646,7 @@ func NewRedeemCollateralCmd() *cobra.Command {
 				To:            receiver,
 				CollateralOut: collateral,
 				MageOut:       mage,
+				Owner:         owner,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -477,6 +657,8 @@ func NewRedeemCollateralCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagOwner, "", "Owner of the collateral account, if managed by sender")
+
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -533,6 +715,169 @@ func NewLiquidateCollateralCmd() *cobra.Command {
 	return cmd
 }
 
+func NewTransferPositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-position [collateral_denom] [receiver]",
+		Short: "Transfer an entire collateral position to another account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgTransferPosition{
+				Sender:          cliCtx.GetFromAddress().String(),
+				To:              args[1],
+				CollateralDenom: args[0],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewAuthorizeManagerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorize-manager [manager]",
+		Short: "Authorize an account to manage collateral positions of the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAuthorizeManager{
+				Sender:  cliCtx.GetFromAddress().String(),
+				Manager: args[0],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewRevokeManagerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-manager [manager]",
+		Short: "Revoke the authorization of a collateral position manager",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRevokeManager{
+				Sender:  cliCtx.GetFromAddress().String(),
+				Manager: args[0],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewLeverageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "leverage [collateral_denom] [target_ltv] [max_slippage]",
+		Short: "Leverage a collateral position up to the target loan-to-value",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			targetLTV, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+			maxSlippage, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgLeverage{
+				Sender:          cliCtx.GetFromAddress().String(),
+				CollateralDenom: args[0],
+				TargetLtv:       targetLTV,
+				MaxSlippage:     maxSlippage,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewDeleverageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deleverage [collateral_denom] [target_ltv] [max_slippage]",
+		Short: "Deleverage a collateral position down to the target loan-to-value",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			targetLTV, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+			maxSlippage, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDeleverage{
+				Sender:          cliCtx.GetFromAddress().String(),
+				CollateralDenom: args[0],
+				TargetLtv:       targetLTV,
+				MaxSlippage:     maxSlippage,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewRegisterBackingProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "register-backing [proposal-file]",
@@ -876,4 +1221,6 @@ const (
 	FlagMageInMax     = "mage-in-max"
 	FlagBackingOutMin = "backing-out-min"
 	FlagMageOutMin    = "mage-out-min"
+	FlagFullBacking   = "full-backing"
+	FlagOwner         = "owner"
 )
@@ -14,6 +14,43 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	k.SetParams(ctx, genState.Params)
 	k.SetBackingRatio(ctx, genState.BackingRatio)
 
+	for _, params := range genState.BackingRiskParams {
+		k.SetBackingRiskParams(ctx, params)
+	}
+	for _, params := range genState.CollateralRiskParams {
+		k.SetCollateralRiskParams(ctx, params)
+	}
+	for _, pool := range genState.BackingPools {
+		k.SetPoolBacking(ctx, pool)
+	}
+	for _, pool := range genState.CollateralPools {
+		k.SetPoolCollateral(ctx, pool)
+	}
+	if genState.TotalBacking != nil {
+		k.SetTotalBacking(ctx, *genState.TotalBacking)
+	}
+	if genState.TotalCollateral != nil {
+		k.SetTotalCollateral(ctx, *genState.TotalCollateral)
+	}
+	for _, col := range genState.AccountCollaterals {
+		addr, err := sdk.AccAddressFromBech32(col.Account)
+		if err != nil {
+			panic(err)
+		}
+		k.SetAccountCollateral(ctx, addr, col)
+	}
+	for _, m := range genState.CollateralManagers {
+		owner, err := sdk.AccAddressFromBech32(m.Owner)
+		if err != nil {
+			panic(err)
+		}
+		manager, err := sdk.AccAddressFromBech32(m.Manager)
+		if err != nil {
+			panic(err)
+		}
+		k.SetCollateralManager(ctx, owner, manager)
+	}
+
 	// check if the module account exists
 	moduleAcc := k.GetMakerAccount(ctx)
 	if moduleAcc == nil {
@@ -26,6 +63,29 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 	genesis.BackingRatio = k.GetBackingRatio(ctx)
+	genesis.BackingRiskParams = k.GetAllBackingRiskParams(ctx)
+	genesis.CollateralRiskParams = k.GetAllCollateralRiskParams(ctx)
+	genesis.BackingPools = k.GetAllPoolBacking(ctx)
+	genesis.CollateralPools = k.GetAllPoolCollateral(ctx)
+
+	if total, found := k.GetTotalBacking(ctx); found {
+		genesis.TotalBacking = &total
+	}
+	if total, found := k.GetTotalCollateral(ctx); found {
+		genesis.TotalCollateral = &total
+	}
+
+	k.IterateAccountCollateral(ctx, func(col types.AccountCollateral) bool {
+		genesis.AccountCollaterals = append(genesis.AccountCollaterals, col)
+		return false
+	})
+	k.IterateCollateralManagers(ctx, func(owner, manager sdk.AccAddress) bool {
+		genesis.CollateralManagers = append(genesis.CollateralManagers, types.CollateralManager{
+			Owner:   owner.String(),
+			Manager: manager.String(),
+		})
+		return false
+	})
 
 	return genesis
 }
@@ -8,6 +8,7 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/petri-labs/warmage/app"
+	warmage "github.com/petri-labs/warmage/types"
 	"github.com/petri-labs/warmage/x/maker"
 	"github.com/petri-labs/warmage/x/maker/types"
 )
@@ -54,3 +55,52 @@ func (suite *GenesisTestSuite) TestMakerExportGenesis() {
 	suite.Require().Equal(sdk.OneDec(), genesisExported.BackingRatio)
 	suite.Require().Equal(types.DefaultParams(), genesisExported.Params)
 }
+
+func (suite *GenesisTestSuite) TestMakerGenesisRoundTrip() {
+	app := suite.app
+	makerKeeper := app.MakerKeeper
+
+	owner := sdk.AccAddress([]byte("owner_______________"))
+	manager := sdk.AccAddress([]byte("manager_____________"))
+	maxCollateral := sdk.NewInt(1000_000000)
+	genState := *types.DefaultGenesis()
+	genState.CollateralRiskParams = []types.CollateralRiskParams{{
+		CollateralDenom: "eth",
+		Enabled:         true,
+		MaxCollateral:   &maxCollateral,
+	}}
+	genState.CollateralPools = []types.PoolCollateral{{
+		Collateral:         sdk.NewCoin("eth", sdk.NewInt(100)),
+		WarDebt:            sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(50)),
+		MageCollateralized: sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt()),
+	}}
+	genState.TotalCollateral = &types.TotalCollateral{
+		WarDebt:            sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(50)),
+		MageCollateralized: sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt()),
+	}
+	genState.AccountCollaterals = []types.AccountCollateral{{
+		Account:             owner.String(),
+		Collateral:          sdk.NewCoin("eth", sdk.NewInt(100)),
+		WarDebt:             sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(50)),
+		MageCollateralized:  sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt()),
+		LastInterest:        sdk.NewCoin(warmage.MicroUSWDenom, sdk.ZeroInt()),
+		LastSettlementBlock: 1,
+	}}
+	genState.CollateralManagers = []types.CollateralManager{{
+		Owner:   owner.String(),
+		Manager: manager.String(),
+	}}
+	suite.Require().NoError(genState.Validate())
+
+	suite.Require().NotPanics(func() {
+		maker.InitGenesis(suite.ctx, makerKeeper, genState)
+	})
+	suite.Require().True(makerKeeper.IsCollateralManager(suite.ctx, owner, manager))
+
+	genesisExported := maker.ExportGenesis(suite.ctx, makerKeeper)
+	suite.Require().Equal(genState.CollateralRiskParams, genesisExported.CollateralRiskParams)
+	suite.Require().Equal(genState.CollateralPools, genesisExported.CollateralPools)
+	suite.Require().Equal(genState.TotalCollateral, genesisExported.TotalCollateral)
+	suite.Require().Equal(genState.AccountCollaterals, genesisExported.AccountCollaterals)
+	suite.Require().Equal(genState.CollateralManagers, genesisExported.CollateralManagers)
+}
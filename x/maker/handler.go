@@ -24,6 +24,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgBurnBySwap:
 			res, err := msgServer.BurnBySwap(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgBasketMint:
+			res, err := msgServer.BasketMint(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgBasketBurn:
+			res, err := msgServer.BasketBurn(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgBuyBacking:
 			res, err := msgServer.BuyBacking(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -45,6 +51,21 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgLiquidateCollateral:
 			res, err := msgServer.LiquidateCollateral(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgTransferPosition:
+			res, err := msgServer.TransferPosition(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAuthorizeManager:
+			res, err := msgServer.AuthorizeManager(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRevokeManager:
+			res, err := msgServer.RevokeManager(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgLeverage:
+			res, err := msgServer.Leverage(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDeleverage:
+			res, err := msgServer.Deleverage(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
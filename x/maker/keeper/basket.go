@@ -0,0 +1,377 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	warmage "github.com/petri-labs/warmage/types"
+	"github.com/petri-labs/warmage/x/maker/types"
+)
+
+// basketLeg is the part of a basket swap which is settled against one backing pool.
+type basketLeg struct {
+	params types.BackingRiskParams
+	price  sdk.Dec
+	pool   types.PoolBacking
+	// backing coin in (mint) or out (burn)
+	backing sdk.Coin
+	// war minted including fee (mint), or war burned excluding fee (burn)
+	war sdk.Coin
+	// mage burned (mint) or minted (burn)
+	mage sdk.Coin
+	// mint or burn fee
+	fee sdk.Coin
+}
+
+func (k Keeper) calculateBasketMint(
+	ctx sdk.Context,
+	backingInMax sdk.Coins,
+	mageInMax sdk.Coin,
+	fullBacking bool,
+) (
+	legs []basketLeg,
+	backingIn sdk.Coins,
+	mageIn sdk.Coin,
+	mintOut sdk.Coin,
+	mintFee sdk.Coin,
+	err error,
+) {
+	err = k.checkMintPriceLowerBound(ctx)
+	if err != nil {
+		return
+	}
+
+	legs, err = k.getBasketLegs(ctx, backingInMax.GetDenomByIndex, len(backingInMax))
+	if err != nil {
+		return
+	}
+
+	magePrice, err := k.oracleKeeper.GetExchangeRate(ctx, warmage.AttoMageDenom)
+	if err != nil {
+		return
+	}
+
+	backingRatio := k.GetBackingRatio(ctx)
+
+	backingInMaxInUSD := sdk.ZeroDec()
+	caps := make([]sdk.Dec, len(legs))
+	for i, leg := range legs {
+		caps[i] = leg.price.MulInt(backingInMax[i].Amount)
+		backingInMaxInUSD = backingInMaxInUSD.Add(caps[i])
+	}
+	mageInMaxInUSD := magePrice.MulInt(mageInMax.Amount)
+
+	mintTotalInUSD := sdk.ZeroDec()
+	backingInUSD := sdk.ZeroDec()
+	mageIn = sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt())
+
+	if backingRatio.GTE(sdk.OneDec()) || fullBacking {
+		// full/over backing, or user selects full backing
+		mintTotalInUSD = backingInMaxInUSD
+		backingInUSD = backingInMaxInUSD
+	} else if backingRatio.IsZero() {
+		// full algorithmic
+		mintTotalInUSD = mageInMaxInUSD
+		mageIn.Amount = mageInMax.Amount
+	} else {
+		// fractional
+		max1 := backingInMaxInUSD.Quo(backingRatio)
+		max2 := mageInMaxInUSD.Quo(sdk.OneDec().Sub(backingRatio))
+		if backingInMaxInUSD.IsPositive() && (mageInMax.IsZero() || max1.LTE(max2)) {
+			mintTotalInUSD = max1
+			backingInUSD = backingInMaxInUSD
+			mageIn.Amount = mintTotalInUSD.Mul(sdk.OneDec().Sub(backingRatio)).QuoRoundUp(magePrice).RoundInt()
+			if mageInMax.IsPositive() && mageInMax.IsLT(mageIn) {
+				mageIn.Amount = mageInMax.Amount
+			}
+		} else {
+			mintTotalInUSD = max2
+			mageIn.Amount = mageInMax.Amount
+			backingInUSD = sdk.MinDec(mintTotalInUSD.Mul(backingRatio), backingInMaxInUSD)
+		}
+	}
+
+	mintTotal := sdk.NewCoin(warmage.MicroUSWDenom, mintTotalInUSD.Quo(warmage.MicroUSWTarget).TruncateInt())
+
+	// allocate backing value among pools, towards target weights
+	var shares []sdk.Dec
+	if backingInUSD.IsPositive() {
+		shares, err = allocateByTargetWeights(legs, caps, backingInUSD, true)
+	} else {
+		// no backing in; just attribute minted war to pools
+		shares, err = allocateByTargetWeights(legs, nil, mintTotalInUSD, true)
+	}
+	if err != nil {
+		return
+	}
+
+	wars := splitByShares(mintTotal.Amount, shares)
+	mages := splitByShares(mageIn.Amount, shares)
+
+	mintFee = sdk.NewCoin(warmage.MicroUSWDenom, sdk.ZeroInt())
+	for i := range legs {
+		leg := &legs[i]
+
+		amount := sdk.ZeroInt()
+		if backingInUSD.IsPositive() {
+			amount = sdk.MinInt(shares[i].QuoRoundUp(leg.price).RoundInt(), backingInMax[i].Amount)
+		}
+		leg.backing = sdk.NewCoin(leg.params.BackingDenom, amount)
+		leg.war = sdk.NewCoin(warmage.MicroUSWDenom, wars[i])
+		leg.mage = sdk.NewCoin(warmage.AttoMageDenom, mages[i])
+		leg.fee = computeFee(leg.war, leg.params.MintFee)
+
+		if leg.params.MaxWarMint != nil && leg.pool.WarMinted.Amount.Add(leg.war.Amount).GT(*leg.params.MaxWarMint) {
+			err = sdkerrors.Wrapf(types.ErrWarCeiling, "war over ceiling of backing pool %s", leg.params.BackingDenom)
+			return
+		}
+		if leg.params.MaxBacking != nil && leg.pool.Backing.Amount.Add(leg.backing.Amount).GT(*leg.params.MaxBacking) {
+			err = sdkerrors.Wrapf(types.ErrBackingCeiling, "backing over ceiling of backing pool %s", leg.params.BackingDenom)
+			return
+		}
+
+		backingIn = backingIn.Add(leg.backing)
+		mintFee = mintFee.Add(leg.fee)
+	}
+
+	mintOut = mintTotal.Sub(mintFee)
+	return
+}
+
+func (k Keeper) calculateBasketBurn(
+	ctx sdk.Context,
+	burnIn sdk.Coin,
+	backingDenoms []string,
+) (
+	legs []basketLeg,
+	backingOut sdk.Coins,
+	mageOut sdk.Coin,
+	burnFee sdk.Coin,
+	err error,
+) {
+	err = k.checkBurnPriceUpperBound(ctx)
+	if err != nil {
+		return
+	}
+
+	denoms := make([]string, len(backingDenoms))
+	copy(denoms, backingDenoms)
+	sort.Strings(denoms)
+	for i := 1; i < len(denoms); i++ {
+		if denoms[i] == denoms[i-1] {
+			err = sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "duplicate backing denom: %s", denoms[i])
+			return
+		}
+	}
+
+	legs, err = k.getBasketLegs(ctx, func(i int) string { return denoms[i] }, len(denoms))
+	if err != nil {
+		return
+	}
+
+	magePrice, err := k.oracleKeeper.GetExchangeRate(ctx, warmage.AttoMageDenom)
+	if err != nil {
+		return
+	}
+
+	backingRatio := sdk.MinDec(k.GetBackingRatio(ctx), sdk.OneDec())
+
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	available := make([]sdk.Int, len(legs))
+	caps := make([]sdk.Dec, len(legs))
+	for i, leg := range legs {
+		moduleOwnedBacking := k.bankKeeper.GetBalance(ctx, moduleAddr, leg.params.BackingDenom)
+		available[i] = sdk.MinInt(leg.pool.Backing.Amount, moduleOwnedBacking.Amount)
+		caps[i] = leg.price.MulInt(available[i])
+	}
+
+	burnInInUSD := burnIn.Amount.ToDec().Mul(warmage.MicroUSWTarget)
+
+	// allocate backing value among pools, towards target weights
+	var shares []sdk.Dec
+	if backingRatio.IsPositive() {
+		shares, err = allocateByTargetWeights(legs, caps, burnInInUSD.Mul(backingRatio), false)
+	} else {
+		// no backing out; just attribute burned war to pools
+		shares, err = allocateByTargetWeights(legs, nil, burnInInUSD, false)
+	}
+	if err != nil {
+		return
+	}
+
+	burns := splitByShares(burnIn.Amount, shares)
+
+	mageOut = sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt())
+	burnFee = sdk.NewCoin(warmage.MicroUSWDenom, sdk.ZeroInt())
+	for i := range legs {
+		leg := &legs[i]
+
+		burn := sdk.NewCoin(warmage.MicroUSWDenom, burns[i])
+		leg.fee = computeFee(burn, leg.params.BurnFee)
+		leg.war = burn.Sub(leg.fee)
+		burnActualInUSD := leg.war.Amount.ToDec().Mul(warmage.MicroUSWTarget)
+
+		leg.backing = sdk.NewCoin(leg.params.BackingDenom, burnActualInUSD.Mul(backingRatio).QuoTruncate(leg.price).TruncateInt())
+		leg.mage = sdk.NewCoin(warmage.AttoMageDenom, burnActualInUSD.Mul(sdk.OneDec().Sub(backingRatio)).QuoTruncate(magePrice).TruncateInt())
+
+		if available[i].LT(leg.backing.Amount) {
+			err = sdkerrors.Wrapf(types.ErrBackingCoinInsufficient, "backing coin out(%s) > balance(%s)", leg.backing, available[i])
+			return
+		}
+
+		backingOut = backingOut.Add(leg.backing)
+		mageOut = mageOut.Add(leg.mage)
+		burnFee = burnFee.Add(leg.fee)
+	}
+
+	return
+}
+
+// getBasketLegs loads the available risk params, prices and pools of the basket backing denoms.
+func (k Keeper) getBasketLegs(ctx sdk.Context, denomAt func(i int) string, count int) ([]basketLeg, error) {
+	if count == 0 {
+		return nil, sdkerrors.Wrap(types.ErrBackingCoinNotFound, "empty backing basket")
+	}
+
+	legs := make([]basketLeg, count)
+	for i := range legs {
+		denom := denomAt(i)
+
+		params, err := k.getAvailableBackingParams(ctx, denom)
+		if err != nil {
+			return nil, err
+		}
+		price, err := k.oracleKeeper.GetExchangeRate(ctx, denom)
+		if err != nil {
+			return nil, err
+		}
+		_, pool, err := k.getBacking(ctx, denom)
+		if err != nil {
+			return nil, err
+		}
+
+		legs[i] = basketLeg{
+			params: params,
+			price:  price,
+			pool:   pool,
+		}
+	}
+	return legs, nil
+}
+
+// allocateByTargetWeights splits value among the basket pools, so that each pool
+// moves towards its target weight within the basket after adding (or removing)
+// the value. Pools farther from their target get a larger share. The share of
+// a pool never exceeds its cap; a nil caps means no limit.
+//
+// Target weights are normalized among the basket pools. If none of them has a
+// positive target weight, all are weighted equally.
+func allocateByTargetWeights(legs []basketLeg, caps []sdk.Dec, value sdk.Dec, increase bool) ([]sdk.Dec, error) {
+	weights := make([]sdk.Dec, len(legs))
+	totalWeight := sdk.ZeroDec()
+	currents := make([]sdk.Dec, len(legs))
+	totalCurrent := sdk.ZeroDec()
+	for i, leg := range legs {
+		weights[i] = targetWeight(&leg.params)
+		totalWeight = totalWeight.Add(weights[i])
+		currents[i] = leg.price.MulInt(leg.pool.Backing.Amount)
+		totalCurrent = totalCurrent.Add(currents[i])
+	}
+	for i := range weights {
+		if totalWeight.IsPositive() {
+			weights[i] = weights[i].Quo(totalWeight)
+		} else {
+			weights[i] = sdk.OneDec().QuoInt64(int64(len(weights)))
+		}
+	}
+
+	totalAfter := totalCurrent.Add(value)
+	if !increase {
+		totalAfter = sdk.MaxDec(totalCurrent.Sub(value), sdk.ZeroDec())
+	}
+
+	// distance of each pool from its target value after the allocation
+	gaps := make([]sdk.Dec, len(legs))
+	totalGap := sdk.ZeroDec()
+	for i := range legs {
+		gap := weights[i].Mul(totalAfter).Sub(currents[i])
+		if !increase {
+			gap = gap.Neg()
+		}
+		gaps[i] = sdk.MaxDec(gap, sdk.ZeroDec())
+		totalGap = totalGap.Add(gaps[i])
+	}
+
+	shares := make([]sdk.Dec, len(legs))
+	remaining := value
+	for i := range legs {
+		shares[i] = sdk.ZeroDec()
+		if totalGap.IsPositive() {
+			shares[i] = value.Mul(gaps[i]).Quo(totalGap)
+		}
+		if caps != nil {
+			shares[i] = sdk.MinDec(shares[i], caps[i])
+		}
+		remaining = remaining.Sub(shares[i])
+	}
+
+	// fill the remaining value up to caps, e.g., due to capped shares or rounding
+	for i := range legs {
+		if !remaining.IsPositive() {
+			break
+		}
+		room := remaining
+		if caps != nil {
+			room = sdk.MinDec(remaining, caps[i].Sub(shares[i]))
+		}
+		if room.IsPositive() {
+			shares[i] = shares[i].Add(room)
+			remaining = remaining.Sub(room)
+		}
+	}
+	if remaining.IsPositive() {
+		return nil, sdkerrors.Wrap(types.ErrBackingCoinInsufficient, "basket backing insufficient")
+	}
+
+	return shares, nil
+}
+
+// splitByShares splits amount proportionally to shares, with the last nonzero
+// share taking the rounding remainder, so that the parts sum up to amount.
+func splitByShares(amount sdk.Int, shares []sdk.Dec) []sdk.Int {
+	parts := make([]sdk.Int, len(shares))
+	totalShare := sdk.ZeroDec()
+	last := -1
+	for i, share := range shares {
+		parts[i] = sdk.ZeroInt()
+		totalShare = totalShare.Add(share)
+		if share.IsPositive() {
+			last = i
+		}
+	}
+	if last < 0 {
+		if len(parts) > 0 {
+			parts[0] = amount
+		}
+		return parts
+	}
+
+	remaining := amount
+	for i, share := range shares {
+		if i == last {
+			parts[i] = remaining
+			break
+		}
+		parts[i] = amount.ToDec().Mul(share).Quo(totalShare).TruncateInt()
+		remaining = remaining.Sub(parts[i])
+	}
+	return parts
+}
+
+func targetWeight(params *types.BackingRiskParams) sdk.Dec {
+	if params.TargetWeight == nil {
+		return sdk.ZeroDec()
+	}
+	return *params.TargetWeight
+}
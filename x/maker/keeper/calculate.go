@@ -653,6 +653,18 @@ func (k Keeper) checkBurnPriceUpperBound(ctx sdk.Context) error {
 	return nil
 }
 
+// checkExchangeRatesFresh checks that none of the exchange rates of denoms is
+// held by the oracle circuit breaker, since a held rate can lag far behind the
+// market during an extreme move.
+func (k Keeper) checkExchangeRatesFresh(ctx sdk.Context, denoms ...string) error {
+	for _, denom := range denoms {
+		if k.oracleKeeper.IsExchangeRateHeld(ctx, denom) {
+			return sdkerrors.Wrapf(types.ErrExchangeRateHeld, "exchange rate of %s", denom)
+		}
+	}
+	return nil
+}
+
 func (k Keeper) getAvailableBackingParams(ctx sdk.Context, backingDenom string) (backingParams types.BackingRiskParams, err error) {
 	backingParams, found := k.GetBackingRiskParams(ctx, backingDenom)
 	if !found {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	warmage "github.com/petri-labs/warmage/types"
 	"github.com/petri-labs/warmage/x/maker/types"
 )
@@ -989,3 +990,245 @@ func (suite *KeeperTestSuite) setupEstimationTest() {
 		MageCollateralized: sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt()),
 	})
 }
+
+func (suite *KeeperTestSuite) TestEstimateBasketMint() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		req      *types.EstimateBasketMintRequest
+		expPass  bool
+		expErr   error
+		expRes   *types.EstimateBasketMintResponse
+	}{
+		{
+			name: "war price too low",
+			malleate: func() {
+				suite.app.OracleKeeper.SetExchangeRate(suite.ctx, warmage.MicroUSWDenom, sdk.NewDecWithPrec(989, 3))
+			},
+			req:     &types.EstimateBasketMintRequest{BackingInMax: sdk.NewCoins(sdk.NewCoin(suite.bcDenom, sdk.NewInt(1)))},
+			expPass: false,
+			expErr:  types.ErrWarPriceTooLow,
+		},
+		{
+			name:    "empty basket",
+			req:     &types.EstimateBasketMintRequest{},
+			expPass: false,
+			expErr:  types.ErrBackingCoinNotFound,
+		},
+		{
+			name:    "backing denom disabled",
+			req:     &types.EstimateBasketMintRequest{BackingInMax: sdk.NewCoins(sdk.NewCoin("eth", sdk.NewInt(1)), sdk.NewCoin(suite.bcDenom, sdk.NewInt(1)))},
+			expPass: false,
+			expErr:  types.ErrBackingCoinDisabled,
+		},
+		{
+			name: "single backing same as mint by swap",
+			req: &types.EstimateBasketMintRequest{
+				BackingInMax: sdk.NewCoins(sdk.NewCoin(suite.bcDenom, sdk.NewInt(1_000000))),
+			},
+			expPass: true,
+			expRes: &types.EstimateBasketMintResponse{
+				BackingIn: sdk.NewCoins(sdk.NewCoin(suite.bcDenom, sdk.NewInt(1_000000))),
+				MageIn:    sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt()),
+				MintOut:   sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(985050)), // 1_000000 * 0.99 * (1 - 0.005)
+				MintFee:   sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(4950)),   // 1_000000 * 0.99 * 0.005
+			},
+		},
+		{
+			name: "full backing",
+			malleate: func() {
+				suite.setupBasketTest()
+			},
+			req: &types.EstimateBasketMintRequest{
+				BackingInMax: sdk.NewCoins(sdk.NewCoin(suite.bcDenom, sdk.NewInt(1_000000)), sdk.NewCoin("ufil", sdk.NewInt(200000))),
+			},
+			expPass: true,
+			expRes: &types.EstimateBasketMintResponse{
+				BackingIn: sdk.NewCoins(sdk.NewCoin(suite.bcDenom, sdk.NewInt(1_000000)), sdk.NewCoin("ufil", sdk.NewInt(200000))),
+				MageIn:    sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt()),
+				MintOut:   sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(1_980050)), // 985050 + 200000 * 5 * (1 - 0.005)
+				MintFee:   sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(9950)),     // 4950 + 200000 * 5 * 0.005
+			},
+		},
+		{
+			name: "fractional towards target weights",
+			malleate: func() {
+				suite.setupBasketTest()
+				suite.app.MakerKeeper.SetBackingRatio(suite.ctx, sdk.NewDecWithPrec(20, 2))
+			},
+			req: &types.EstimateBasketMintRequest{
+				BackingInMax: sdk.NewCoins(sdk.NewCoin(suite.bcDenom, sdk.NewInt(1_000000)), sdk.NewCoin("ufil", sdk.NewInt(1_000000))),
+				MageInMax:    sdk.NewCoin(warmage.AttoMageDenom, sdk.NewInt(10000_000000_000000)),
+			},
+			expPass: true,
+			expRes: &types.EstimateBasketMintResponse{
+				BackingIn: sdk.NewCoins(sdk.NewCoin("ufil", sdk.NewInt(50000))), // 10**16 * 10**-10 / 0.8 * 0.2 / 5, all into the pool under its target
+				MageIn:    sdk.NewCoin(warmage.AttoMageDenom, sdk.NewInt(10000_000000_000000)),
+				MintOut:   sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(1243750)), // 10**16 * 10**-10 / 0.8 * (1 - 0.005)
+				MintFee:   sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(6250)),    // 10**16 * 10**-10 / 0.8 * 0.005
+			},
+		},
+		{
+			name: "war over ceiling",
+			req: &types.EstimateBasketMintRequest{
+				BackingInMax: sdk.NewCoins(sdk.NewCoin(suite.bcDenom, sdk.NewInt(2_500000))),
+			},
+			expPass: false,
+			expErr:  types.ErrWarCeiling,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			suite.setupEstimationTest()
+			suite.app.OracleKeeper.SetExchangeRate(suite.ctx, warmage.MicroUSWDenom, sdk.NewDecWithPrec(101, 2))
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			res, err := suite.queryClient.EstimateBasketMint(ctx, tc.req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRes, res)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEstimateBasketBurn() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		req      *types.EstimateBasketBurnRequest
+		expPass  bool
+		expErr   error
+		expRes   *types.EstimateBasketBurnResponse
+	}{
+		{
+			name: "war price too high",
+			malleate: func() {
+				suite.app.OracleKeeper.SetExchangeRate(suite.ctx, warmage.MicroUSWDenom, sdk.NewDecWithPrec(1011, 3))
+			},
+			req:     &types.EstimateBasketBurnRequest{BackingDenoms: []string{suite.bcDenom}},
+			expPass: false,
+			expErr:  types.ErrWarPriceTooHigh,
+		},
+		{
+			name:    "backing denom not found",
+			req:     &types.EstimateBasketBurnRequest{BackingDenoms: []string{suite.bcDenom, "fil"}},
+			expPass: false,
+			expErr:  types.ErrBackingCoinNotFound,
+		},
+		{
+			name: "duplicate backing denom",
+			req: &types.EstimateBasketBurnRequest{
+				BurnIn:        sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(1)),
+				BackingDenoms: []string{suite.bcDenom, suite.bcDenom},
+			},
+			expPass: false,
+			expErr:  sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "single backing same as burn by swap",
+			req: &types.EstimateBasketBurnRequest{
+				BurnIn:        sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(1_000000)),
+				BackingDenoms: []string{suite.bcDenom},
+			},
+			expPass: true,
+			expRes: &types.EstimateBasketBurnResponse{
+				BackingOut: sdk.NewCoins(sdk.NewCoin(suite.bcDenom, sdk.NewInt(1_004040))), // 1_000000 * (1-0.006) / 0.99
+				MageOut:    sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt()),
+				BurnFee:    sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(6000)), // 1_000000 * 0.006
+			},
+		},
+		{
+			name: "towards target weights",
+			malleate: func() {
+				suite.setupBasketTest()
+			},
+			req: &types.EstimateBasketBurnRequest{
+				BurnIn:        sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(4_000000)),
+				BackingDenoms: []string{suite.bcDenom, "ufil"},
+			},
+			expPass: true,
+			expRes: &types.EstimateBasketBurnResponse{
+				// pool values 8.91 and 5 move towards 4.955 each:
+				// 3_955000 * (1-0.006) / 0.99, 45000 * (1-0.006) / 5
+				BackingOut: sdk.NewCoins(sdk.NewCoin(suite.bcDenom, sdk.NewInt(3_970979)), sdk.NewCoin("ufil", sdk.NewInt(8946))),
+				MageOut:    sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt()),
+				BurnFee:    sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(24000)), // 4_000000 * 0.006
+			},
+		},
+		{
+			name: "basket backing insufficient",
+			malleate: func() {
+				suite.setupBasketTest()
+			},
+			req: &types.EstimateBasketBurnRequest{
+				BurnIn:        sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(14_000000)),
+				BackingDenoms: []string{suite.bcDenom, "ufil"},
+			},
+			expPass: false,
+			expErr:  types.ErrBackingCoinInsufficient,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			suite.setupEstimationTest()
+			suite.app.OracleKeeper.SetExchangeRate(suite.ctx, warmage.MicroUSWDenom, sdk.NewDecWithPrec(99, 2))
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			res, err := suite.queryClient.EstimateBasketBurn(ctx, tc.req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRes, res)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// setupBasketTest registers a second enabled backing pool, with both pools
+// targeting half of the basket value.
+func (suite *KeeperTestSuite) setupBasketTest() {
+	brp, _ := suite.dummyBackingRiskParams()
+	weight := sdk.NewDecWithPrec(5, 1)
+	brp.TargetWeight = &weight
+	suite.app.MakerKeeper.SetBackingRiskParams(suite.ctx, brp)
+
+	maxBacking := sdk.NewInt(10_000000)
+	maxWarMint := sdk.NewInt(100_000000)
+	fee := sdk.NewDecWithPrec(5, 3)
+	burnFee := sdk.NewDecWithPrec(6, 3)
+	suite.app.MakerKeeper.SetBackingRiskParams(suite.ctx, types.BackingRiskParams{
+		BackingDenom: "ufil",
+		Enabled:      true,
+		MaxBacking:   &maxBacking,
+		MaxWarMint:   &maxWarMint,
+		MintFee:      &fee,
+		BurnFee:      &burnFee,
+		BuybackFee:   &fee,
+		RebackFee:    &fee,
+		TargetWeight: &weight,
+	})
+	suite.app.OracleKeeper.SetExchangeRate(suite.ctx, "ufil", sdk.NewDec(5))
+
+	suite.app.MakerKeeper.SetPoolBacking(suite.ctx, types.PoolBacking{
+		WarMinted:  sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(5_000000)),
+		Backing:    sdk.NewCoin("ufil", sdk.NewInt(1_000000)),
+		MageBurned: sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt()),
+	})
+	suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin("ufil", sdk.NewInt(1_000000))))
+}
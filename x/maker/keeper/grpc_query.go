@@ -100,6 +100,22 @@ func (k Keeper) CollateralOfAccount(c context.Context, req *types.QueryCollatera
 	}, nil
 }
 
+func (k Keeper) CollateralManagers(c context.Context, req *types.QueryCollateralManagersRequest) (*types.QueryCollateralManagersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	var managers []string
+	for _, manager := range k.GetCollateralManagers(ctx, account) {
+		managers = append(managers, manager.String())
+	}
+
+	return &types.QueryCollateralManagersResponse{Managers: managers}, nil
+}
+
 func (k Keeper) TotalBacking(c context.Context, req *types.QueryTotalBackingRequest) (*types.QueryTotalBackingResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -202,6 +218,35 @@ func (k Keeper) EstimateBurnBySwapOut(c context.Context, req *types.EstimateBurn
 	}, nil
 }
 
+func (k Keeper) EstimateBasketMint(c context.Context, req *types.EstimateBasketMintRequest) (*types.EstimateBasketMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	_, backingIn, mageIn, mintOut, mintFee, err := k.calculateBasketMint(ctx, req.BackingInMax, req.MageInMax, req.FullBacking)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EstimateBasketMintResponse{
+		BackingIn: backingIn,
+		MageIn:    mageIn,
+		MintOut:   mintOut,
+		MintFee:   mintFee,
+	}, nil
+}
+
+func (k Keeper) EstimateBasketBurn(c context.Context, req *types.EstimateBasketBurnRequest) (*types.EstimateBasketBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	_, backingOut, mageOut, burnFee, err := k.calculateBasketBurn(ctx, req.BurnIn, req.BackingDenoms)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EstimateBasketBurnResponse{
+		BackingOut: backingOut,
+		MageOut:    mageOut,
+		BurnFee:    burnFee,
+	}, nil
+}
+
 func (k Keeper) EstimateBuyBackingIn(c context.Context, req *types.EstimateBuyBackingInRequest) (*types.EstimateBuyBackingInResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	mageOut, buybackFee, err := k.calculateBuyBackingIn(ctx, req.BackingOut)
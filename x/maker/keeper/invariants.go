@@ -0,0 +1,213 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/petri-labs/warmage/x/maker/types"
+)
+
+// RegisterInvariants registers the maker module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "backing-balance", BackingBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "account-collateral", AccountCollateralInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "total-collateral", TotalCollateralInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "nonnegative", NonnegativeInvariant(k))
+}
+
+// AllInvariants runs all invariants of the maker module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			BackingBalanceInvariant(k),
+			AccountCollateralInvariant(k),
+			TotalCollateralInvariant(k),
+			NonnegativeInvariant(k),
+		} {
+			res, stop := inv(ctx)
+			if stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// BackingBalanceInvariant checks that the module balance of each backing denom
+// equals the pool backing, plus the pool collateral if the denom is also used
+// as collateral.
+func BackingBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		for _, pool := range k.GetAllPoolBacking(ctx) {
+			expected := pool.Backing
+			if poolColl, found := k.GetPoolCollateral(ctx, pool.Backing.Denom); found {
+				expected = expected.Add(poolColl.Collateral)
+			}
+
+			balance := k.bankKeeper.GetBalance(ctx, moduleAddr, pool.Backing.Denom)
+			if !balance.IsEqual(expected) {
+				count++
+				msg += fmt.Sprintf("\tmodule balance of %s is unequal to pools: %s != %s\n", pool.Backing.Denom, balance, expected)
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "backing-balance",
+			fmt.Sprintf("inconsistent backing balances found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// AccountCollateralInvariant checks that the sums of collateral, war debt and
+// collateralized mage of all accounts equal those of each collateral pool.
+func AccountCollateralInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		collaterals := make(map[string]sdk.Int)
+		warDebts := make(map[string]sdk.Int)
+		mages := make(map[string]sdk.Int)
+		k.IterateAccountCollateral(ctx, func(col types.AccountCollateral) bool {
+			denom := col.Collateral.Denom
+			if _, ok := collaterals[denom]; !ok {
+				collaterals[denom] = sdk.ZeroInt()
+				warDebts[denom] = sdk.ZeroInt()
+				mages[denom] = sdk.ZeroInt()
+			}
+			collaterals[denom] = collaterals[denom].Add(col.Collateral.Amount)
+			warDebts[denom] = warDebts[denom].Add(col.WarDebt.Amount)
+			mages[denom] = mages[denom].Add(col.MageCollateralized.Amount)
+			return false
+		})
+
+		for _, pool := range k.GetAllPoolCollateral(ctx) {
+			denom := pool.Collateral.Denom
+			sumOf := func(sums map[string]sdk.Int) sdk.Int {
+				if sum, ok := sums[denom]; ok {
+					return sum
+				}
+				return sdk.ZeroInt()
+			}
+
+			if sum := sumOf(collaterals); !sum.Equal(pool.Collateral.Amount) {
+				count++
+				msg += fmt.Sprintf("\taccount collateral of %s is unequal to pool: %s != %s\n", denom, sum, pool.Collateral.Amount)
+			}
+			if sum := sumOf(warDebts); !sum.Equal(pool.WarDebt.Amount) {
+				count++
+				msg += fmt.Sprintf("\taccount war debt of %s is unequal to pool: %s != %s\n", denom, sum, pool.WarDebt.Amount)
+			}
+			if sum := sumOf(mages); !sum.Equal(pool.MageCollateralized.Amount) {
+				count++
+				msg += fmt.Sprintf("\taccount collateralized mage of %s is unequal to pool: %s != %s\n", denom, sum, pool.MageCollateralized.Amount)
+			}
+			delete(collaterals, denom)
+		}
+
+		for denom := range collaterals {
+			count++
+			msg += fmt.Sprintf("\taccount collateral of %s has no pool\n", denom)
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "account-collateral",
+			fmt.Sprintf("inconsistent account collateral found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// TotalCollateralInvariant checks that the sums of war debt and collateralized
+// mage of all collateral pools equal the total collateral.
+func TotalCollateralInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		warDebt := sdk.ZeroInt()
+		mage := sdk.ZeroInt()
+		for _, pool := range k.GetAllPoolCollateral(ctx) {
+			warDebt = warDebt.Add(pool.WarDebt.Amount)
+			mage = mage.Add(pool.MageCollateralized.Amount)
+		}
+
+		total, found := k.GetTotalCollateral(ctx)
+		if !found {
+			total = types.TotalCollateral{
+				WarDebt:            sdk.Coin{Amount: sdk.ZeroInt()},
+				MageCollateralized: sdk.Coin{Amount: sdk.ZeroInt()},
+			}
+		}
+
+		if !warDebt.Equal(total.WarDebt.Amount) {
+			count++
+			msg += fmt.Sprintf("\tpool war debt is unequal to total: %s != %s\n", warDebt, total.WarDebt.Amount)
+		}
+		if !mage.Equal(total.MageCollateralized.Amount) {
+			count++
+			msg += fmt.Sprintf("\tpool collateralized mage is unequal to total: %s != %s\n", mage, total.MageCollateralized.Amount)
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "total-collateral",
+			fmt.Sprintf("inconsistent total collateral found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// NonnegativeInvariant checks that no backing or collateral amount is negative.
+// Minted war and burned mage of backing are allowed to be negative by design.
+func NonnegativeInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		check := func(owner string, coins ...sdk.Coin) {
+			for _, coin := range coins {
+				if coin.Amount.IsNil() || coin.IsNegative() {
+					count++
+					msg += fmt.Sprintf("\t%s has negative amount: %s\n", owner, coin)
+				}
+			}
+		}
+
+		for _, pool := range k.GetAllPoolBacking(ctx) {
+			check("backing pool "+pool.Backing.Denom, pool.Backing)
+		}
+		for _, pool := range k.GetAllPoolCollateral(ctx) {
+			check("collateral pool "+pool.Collateral.Denom, pool.Collateral, pool.WarDebt, pool.MageCollateralized)
+		}
+		if total, found := k.GetTotalCollateral(ctx); found {
+			check("total collateral", total.WarDebt, total.MageCollateralized)
+		}
+		k.IterateAccountCollateral(ctx, func(col types.AccountCollateral) bool {
+			check("account "+col.Account, col.Collateral, col.WarDebt, col.MageCollateralized, col.LastInterest)
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "nonnegative",
+			fmt.Sprintf("negative amounts found %d\n%s", count, msg),
+		), broken
+	}
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	warmage "github.com/petri-labs/warmage/types"
+	"github.com/petri-labs/warmage/x/maker/keeper"
+	"github.com/petri-labs/warmage/x/maker/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		broken   bool
+	}{
+		{
+			name:   "consistent",
+			broken: false,
+		},
+		{
+			name: "module backing balance mismatch",
+			malleate: func() {
+				poolBacking, _ := suite.app.MakerKeeper.GetPoolBacking(suite.ctx, suite.bcDenom)
+				poolBacking.Backing = poolBacking.Backing.AddAmount(sdk.NewInt(1))
+				suite.app.MakerKeeper.SetPoolBacking(suite.ctx, poolBacking)
+			},
+			broken: true,
+		},
+		{
+			name: "account collateral mismatch",
+			malleate: func() {
+				accColl, _ := suite.app.MakerKeeper.GetAccountCollateral(suite.ctx, suite.accAddress, suite.bcDenom)
+				accColl.MageCollateralized = accColl.MageCollateralized.AddAmount(sdk.NewInt(1))
+				suite.app.MakerKeeper.SetAccountCollateral(suite.ctx, suite.accAddress, accColl)
+			},
+			broken: true,
+		},
+		{
+			name: "total war debt mismatch",
+			malleate: func() {
+				totalColl, _ := suite.app.MakerKeeper.GetTotalCollateral(suite.ctx)
+				totalColl.WarDebt = totalColl.WarDebt.AddAmount(sdk.NewInt(1))
+				suite.app.MakerKeeper.SetTotalCollateral(suite.ctx, totalColl)
+			},
+			broken: true,
+		},
+		{
+			name: "negative account debt",
+			malleate: func() {
+				accColl, _ := suite.app.MakerKeeper.GetAccountCollateral(suite.ctx, suite.accAddress, suite.bcDenom)
+				accColl.LastInterest.Amount = sdk.NewInt(-1)
+				suite.app.MakerKeeper.SetAccountCollateral(suite.ctx, suite.accAddress, accColl)
+			},
+			broken: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.setupInvariantTest()
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			_, broken := keeper.AllInvariants(suite.app.MakerKeeper)(suite.ctx)
+			suite.Require().Equal(tc.broken, broken)
+		})
+	}
+}
+
+// setupInvariantTest sets up consistent backing and collateral states.
+func (suite *KeeperTestSuite) setupInvariantTest() {
+	brp, _ := suite.dummyBackingRiskParams()
+	suite.app.MakerKeeper.SetBackingRiskParams(suite.ctx, brp)
+	crp, _ := suite.dummyCollateralRiskParams()
+	suite.app.MakerKeeper.SetCollateralRiskParams(suite.ctx, crp)
+
+	// module holds backing plus collateral
+	suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(suite.bcDenom, sdk.NewInt(19_000000))))
+
+	suite.app.MakerKeeper.SetPoolBacking(suite.ctx, types.PoolBacking{
+		WarMinted:  sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(8_000000)),
+		Backing:    sdk.NewCoin(suite.bcDenom, sdk.NewInt(9_000000)),
+		MageBurned: sdk.Coin{Denom: warmage.AttoMageDenom, Amount: sdk.NewInt(-1)}, // minted mage
+	})
+	suite.app.MakerKeeper.SetTotalBacking(suite.ctx, types.TotalBacking{
+		WarMinted:  sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(8_000000)),
+		MageBurned: sdk.Coin{Denom: warmage.AttoMageDenom, Amount: sdk.NewInt(-1)}, // minted mage
+	})
+
+	suite.app.MakerKeeper.SetAccountCollateral(suite.ctx, suite.accAddress, types.AccountCollateral{
+		Account:            suite.accAddress.String(),
+		Collateral:         sdk.NewCoin(suite.bcDenom, sdk.NewInt(10_000000)),
+		WarDebt:            sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(6_000000)),
+		MageCollateralized: sdk.NewCoin(warmage.AttoMageDenom, sdk.NewInt(3e15)),
+		LastInterest:       sdk.NewCoin(warmage.MicroUSWDenom, sdk.ZeroInt()),
+	})
+	suite.app.MakerKeeper.SetPoolCollateral(suite.ctx, types.PoolCollateral{
+		Collateral:         sdk.NewCoin(suite.bcDenom, sdk.NewInt(10_000000)),
+		WarDebt:            sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(6_000000)),
+		MageCollateralized: sdk.NewCoin(warmage.AttoMageDenom, sdk.NewInt(3e15)),
+	})
+	suite.app.MakerKeeper.SetTotalCollateral(suite.ctx, types.TotalCollateral{
+		WarDebt:            sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(6_000000)),
+		MageCollateralized: sdk.NewCoin(warmage.AttoMageDenom, sdk.NewInt(3e15)),
+	})
+}
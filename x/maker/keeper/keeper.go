@@ -22,6 +22,8 @@ type (
 		accountKeeper types.AccountKeeper
 		bankKeeper    types.BankKeeper
 		oracleKeeper  types.OracleKeeper
+
+		swapRouter types.SwapRouter
 	}
 )
 
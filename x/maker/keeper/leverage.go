@@ -0,0 +1,260 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	warmage "github.com/petri-labs/warmage/types"
+	"github.com/petri-labs/warmage/x/maker/types"
+)
+
+// maxLeverageIterations bounds the loops of a single leverage or deleverage.
+const maxLeverageIterations = 10
+
+// SetSwapRouter sets the swap route used by leveraging and deleveraging.
+// Without it, the backing pools of maker are used, so only collateral which
+// is also an enabled backing coin can be levered.
+func (k *Keeper) SetSwapRouter(router types.SwapRouter) *Keeper {
+	if k.swapRouter != nil {
+		panic("cannot set maker swap router twice")
+	}
+	k.swapRouter = router
+	return k
+}
+
+func (k Keeper) getSwapRouter() types.SwapRouter {
+	if k.swapRouter != nil {
+		return k.swapRouter
+	}
+	return backingSwapRouter{keeper: k}
+}
+
+// backingSwapRouter swaps War for a backing asset by BurnBySwap, and a backing
+// asset for War by full-backing MintBySwap.
+type backingSwapRouter struct {
+	keeper Keeper
+}
+
+func (r backingSwapRouter) Swap(ctx sdk.Context, trader sdk.AccAddress, coinIn sdk.Coin, denomOut string) (sdk.Coins, error) {
+	msgServer := NewMsgServerImpl(r.keeper)
+	c := sdk.WrapSDKContext(ctx)
+
+	switch {
+	case coinIn.Denom == warmage.MicroUSWDenom:
+		res, err := msgServer.BurnBySwap(c, &types.MsgBurnBySwap{
+			Sender:        trader.String(),
+			BurnIn:        coinIn,
+			BackingOutMin: sdk.NewCoin(denomOut, sdk.ZeroInt()),
+			MageOutMin:    sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt()),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return sdk.NewCoins(res.BackingOut, res.MageOut), nil
+	case denomOut == warmage.MicroUSWDenom:
+		res, err := msgServer.MintBySwap(c, &types.MsgMintBySwap{
+			Sender:       trader.String(),
+			BackingInMax: coinIn,
+			MageInMax:    sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt()),
+			MintOutMin:   sdk.NewCoin(warmage.MicroUSWDenom, sdk.ZeroInt()),
+			FullBacking:  true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return sdk.NewCoins(res.MintOut), nil
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrBackingCoinNotFound, "no swap route from %s to %s", coinIn.Denom, denomOut)
+	}
+}
+
+func (r backingSwapRouter) HasRoute(ctx sdk.Context, denomIn, denomOut string) bool {
+	var backingDenom string
+	switch {
+	case denomIn == warmage.MicroUSWDenom:
+		backingDenom = denomOut
+	case denomOut == warmage.MicroUSWDenom:
+		backingDenom = denomIn
+	default:
+		return false
+	}
+	_, err := r.keeper.getAvailableBackingParams(ctx, backingDenom)
+	return err == nil
+}
+
+// checkSwapRoute checks that War can be swapped for the collateral and back.
+func (k Keeper) checkSwapRoute(ctx sdk.Context, collateralDenom string) error {
+	router := k.getSwapRouter()
+	if !router.HasRoute(ctx, warmage.MicroUSWDenom, collateralDenom) || !router.HasRoute(ctx, collateralDenom, warmage.MicroUSWDenom) {
+		return sdkerrors.Wrapf(types.ErrNoSwapRoute, "no swap route between %s and %s", warmage.MicroUSWDenom, collateralDenom)
+	}
+	return nil
+}
+
+// swapWithSlippage swaps through the swap route, and checks that the oracle
+// value of coins out is not less than that of coin in by more than maxSlippage.
+func (k Keeper) swapWithSlippage(ctx sdk.Context, trader sdk.AccAddress, coinIn sdk.Coin, denomOut string, maxSlippage sdk.Dec) (sdk.Coins, error) {
+	valueIn, err := k.coinsValue(ctx, sdk.NewCoins(coinIn))
+	if err != nil {
+		return nil, err
+	}
+
+	coinsOut, err := k.getSwapRouter().Swap(ctx, trader, coinIn, denomOut)
+	if err != nil {
+		return nil, err
+	}
+
+	valueOut, err := k.coinsValue(ctx, coinsOut)
+	if err != nil {
+		return nil, err
+	}
+	// tolerate the truncation of one unit of coin out
+	priceOut, err := k.oracleKeeper.GetExchangeRate(ctx, denomOut)
+	if err != nil {
+		return nil, err
+	}
+	if valueOut.Add(priceOut).LT(valueIn.Mul(sdk.OneDec().Sub(maxSlippage))) {
+		return nil, sdkerrors.Wrapf(types.ErrOverSlippage, "swap %s for %s", coinIn, coinsOut)
+	}
+	return coinsOut, nil
+}
+
+// coinsValue returns the value of coins in uUSD by oracle prices.
+func (k Keeper) coinsValue(ctx sdk.Context, coins sdk.Coins) (sdk.Dec, error) {
+	value := sdk.ZeroDec()
+	for _, coin := range coins {
+		price, err := k.oracleKeeper.GetExchangeRate(ctx, coin.Denom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		value = value.Add(coin.Amount.ToDec().Mul(price))
+	}
+	return value, nil
+}
+
+// getSettledCollateral returns the account collateral with interest settled
+// up to the current block, without persisting it.
+func (k Keeper) getSettledCollateral(ctx sdk.Context, account sdk.AccAddress, collateralParams *types.CollateralRiskParams) (pool types.PoolCollateral, acc types.AccountCollateral, err error) {
+	total, pool, acc, err := k.getCollateral(ctx, account, collateralParams.CollateralDenom)
+	if err != nil {
+		return
+	}
+	settleInterestFee(ctx, &acc, &pool, &total, *collateralParams.InterestFee)
+	return
+}
+
+// accountLTV returns the actual loan-to-value of the account collateral.
+func (k Keeper) accountLTV(ctx sdk.Context, acc *types.AccountCollateral) (sdk.Dec, error) {
+	collateralPrice, err := k.oracleKeeper.GetExchangeRate(ctx, acc.Collateral.Denom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	collateralInUSD := acc.Collateral.Amount.ToDec().Mul(collateralPrice)
+	if !collateralInUSD.IsPositive() {
+		return sdk.ZeroDec(), nil
+	}
+	return acc.WarDebt.Amount.ToDec().Mul(warmage.MicroUSWTarget).Quo(collateralInUSD), nil
+}
+
+// calculateLeverageMint computes War to mint in the next leverage loop. It is
+// the amount that would bring the position to targetLTV if all proceeds were
+// deposited back at par, capped by the currently available loan of the
+// position and the War ceiling of the collateral pool.
+func (k Keeper) calculateLeverageMint(ctx sdk.Context, account sdk.AccAddress, collateralParams *types.CollateralRiskParams, targetLTV sdk.Dec) (mintOut sdk.Coin, err error) {
+	mintOut = sdk.NewCoin(warmage.MicroUSWDenom, sdk.ZeroInt())
+
+	poolColl, accColl, err := k.getSettledCollateral(ctx, account, collateralParams)
+	if err != nil {
+		return
+	}
+	collateralPrice, err := k.oracleKeeper.GetExchangeRate(ctx, collateralParams.CollateralDenom)
+	if err != nil {
+		return
+	}
+
+	collateralInUSD := accColl.Collateral.Amount.ToDec().Mul(collateralPrice)
+	debtInUSD := accColl.WarDebt.Amount.ToDec().Mul(warmage.MicroUSWTarget)
+
+	// (debt + x) / (collateral + x) = target
+	need := targetLTV.Mul(collateralInUSD).Sub(debtInUSD).Quo(sdk.OneDec().Sub(targetLTV))
+	if !need.IsPositive() {
+		return
+	}
+
+	_, maxDebtInUSD, err := k.maxLoanToValueForAccount(ctx, &accColl, collateralParams)
+	if err != nil {
+		return
+	}
+	capacity := maxDebtInUSD.Quo(warmage.MicroUSWTarget).TruncateInt().Sub(accColl.WarDebt.Amount)
+	if collateralParams.MaxWarMint != nil {
+		capacity = sdk.MinInt(capacity, collateralParams.MaxWarMint.Sub(poolColl.WarDebt.Amount))
+	}
+	if collateralParams.MaxCollateral != nil {
+		// War minted must not buy more collateral than the pool ceiling admits
+		warPrice, err := k.oracleKeeper.GetExchangeRate(ctx, warmage.MicroUSWDenom)
+		if err != nil {
+			return mintOut, err
+		}
+		warPrice = sdk.MaxDec(warPrice, warmage.MicroUSWTarget)
+		room := collateralParams.MaxCollateral.Sub(poolColl.Collateral.Amount)
+		capacity = sdk.MinInt(capacity, room.ToDec().Mul(collateralPrice).Quo(warPrice).TruncateInt())
+	}
+	// leave room for the rounding of mint fee
+	capacity = capacity.SubRaw(1)
+	if !capacity.IsPositive() {
+		return
+	}
+
+	mintFeeRate := sdk.ZeroDec()
+	if collateralParams.MintFee != nil {
+		mintFeeRate = *collateralParams.MintFee
+	}
+	amount := sdk.MinDec(need.Quo(warmage.MicroUSWTarget), capacity.ToDec()).Quo(sdk.OneDec().Add(mintFeeRate)).TruncateInt()
+	mintOut = sdk.NewCoin(warmage.MicroUSWDenom, amount)
+	return
+}
+
+// calculateDeleverageRedeem computes collateral to redeem in the next
+// deleverage loop. It is the amount that would bring the position to
+// targetLTV if all proceeds repaid debt at par, capped by the collateral
+// which is redeemable without exceeding the available LTV.
+func (k Keeper) calculateDeleverageRedeem(ctx sdk.Context, account sdk.AccAddress, collateralParams *types.CollateralRiskParams, targetLTV sdk.Dec) (collateralOut sdk.Coin, err error) {
+	collateralOut = sdk.NewCoin(collateralParams.CollateralDenom, sdk.ZeroInt())
+
+	_, accColl, err := k.getSettledCollateral(ctx, account, collateralParams)
+	if err != nil {
+		return
+	}
+	if !accColl.WarDebt.IsPositive() {
+		return
+	}
+	collateralPrice, err := k.oracleKeeper.GetExchangeRate(ctx, collateralParams.CollateralDenom)
+	if err != nil {
+		return
+	}
+
+	collateralInUSD := accColl.Collateral.Amount.ToDec().Mul(collateralPrice)
+	debtInUSD := accColl.WarDebt.Amount.ToDec().Mul(warmage.MicroUSWTarget)
+
+	// (debt - x) / (collateral - x) = target
+	need := debtInUSD.Sub(targetLTV.Mul(collateralInUSD)).Quo(sdk.OneDec().Sub(targetLTV))
+	if !need.IsPositive() {
+		return
+	}
+
+	availableLTV, _, err := k.maxLoanToValueForAccount(ctx, &accColl, collateralParams)
+	if err != nil {
+		return
+	}
+	if !availableLTV.IsPositive() {
+		return
+	}
+	redeemable := collateralInUSD.Sub(debtInUSD.Quo(availableLTV))
+
+	amount := sdk.MinDec(need, redeemable).Quo(collateralPrice).TruncateInt()
+	// leave room for rounding
+	amount = sdk.MinInt(amount.SubRaw(1), accColl.Collateral.Amount)
+	if amount.IsPositive() {
+		collateralOut.Amount = amount
+	}
+	return
+}
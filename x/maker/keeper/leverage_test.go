@@ -0,0 +1,207 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/petri-labs/warmage/app"
+	warmage "github.com/petri-labs/warmage/types"
+	"github.com/petri-labs/warmage/x/maker/keeper"
+	"github.com/petri-labs/warmage/x/maker/types"
+)
+
+// mockSwapRouter swaps at oracle prices, less a haircut.
+type mockSwapRouter struct {
+	suite   *KeeperTestSuite
+	haircut sdk.Dec
+	swapped int
+}
+
+func (r *mockSwapRouter) Swap(ctx sdk.Context, trader sdk.AccAddress, coinIn sdk.Coin, denomOut string) (sdk.Coins, error) {
+	app := r.suite.app
+	priceIn, err := app.OracleKeeper.GetExchangeRate(ctx, coinIn.Denom)
+	if err != nil {
+		return nil, err
+	}
+	priceOut, err := app.OracleKeeper.GetExchangeRate(ctx, denomOut)
+	if err != nil {
+		return nil, err
+	}
+	amountOut := coinIn.Amount.ToDec().Mul(priceIn).Mul(sdk.OneDec().Sub(r.haircut)).Quo(priceOut).TruncateInt()
+	coinsOut := sdk.NewCoins(sdk.NewCoin(denomOut, amountOut))
+
+	if err := app.BankKeeper.SendCoinsFromAccountToModule(ctx, trader, types.ModuleName, sdk.NewCoins(coinIn)); err != nil {
+		return nil, err
+	}
+	if err := app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, trader, coinsOut); err != nil {
+		return nil, err
+	}
+	r.swapped++
+	return coinsOut, nil
+}
+
+func (r *mockSwapRouter) HasRoute(ctx sdk.Context, denomIn, denomOut string) bool {
+	return true
+}
+
+func (suite *KeeperTestSuite) setupLeverageTest(haircut sdk.Dec) (types.MsgServer, *mockSwapRouter) {
+	suite.SetupTest()
+
+	// minting deploys erc20 contracts, which needs a proposing validator
+	valConsPk := simapp.CreateTestPubKeys(1)[0]
+	valAddr := sdk.AccAddress(valConsPk.Address())
+	app.FundTestAddrs(suite.app, suite.ctx, []sdk.AccAddress{valAddr}, sdk.NewInt(1000))
+	header := suite.ctx.BlockHeader()
+	header.ProposerAddress = valConsPk.Address()
+	suite.ctx = suite.ctx.WithBlockHeader(header)
+	tstaking := teststaking.NewHelper(suite.T(), suite.ctx, suite.app.StakingKeeper.Keeper)
+	tstaking.Denom = warmage.AttoMageDenom
+	tstaking.CreateValidator(sdk.ValAddress(valConsPk.Address()), valConsPk, sdk.NewInt(100), true)
+
+	suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: suite.bcDenom, Exponent: 0},
+			{Denom: "DAI", Exponent: 6},
+		},
+		Base:    suite.bcDenom,
+		Display: "DAI",
+		Name:    "DAI",
+		Symbol:  "DAI",
+	})
+	suite.setupEstimationTest()
+
+	// maker module pays out both sides of the mock swaps
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(1000_000000)))))
+
+	router := &mockSwapRouter{suite: suite, haircut: haircut}
+	k := suite.app.MakerKeeper
+	k.SetSwapRouter(router)
+	return keeper.NewMsgServerImpl(k), router
+}
+
+func (suite *KeeperTestSuite) accountLTV() sdk.Dec {
+	acc, found := suite.app.MakerKeeper.GetAccountCollateral(suite.ctx, suite.accAddress, suite.bcDenom)
+	suite.Require().True(found)
+	price, err := suite.app.OracleKeeper.GetExchangeRate(suite.ctx, suite.bcDenom)
+	suite.Require().NoError(err)
+	return acc.WarDebt.Amount.ToDec().Quo(acc.Collateral.Amount.ToDec().Mul(price))
+}
+
+func (suite *KeeperTestSuite) TestLeverage() {
+	msgServer, router := suite.setupLeverageTest(sdk.ZeroDec())
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	targetLTV := sdk.NewDecWithPrec(65, 2)
+
+	before := suite.accountLTV()
+	res, err := msgServer.Leverage(ctx, &types.MsgLeverage{
+		Sender:          suite.accAddress.String(),
+		CollateralDenom: suite.bcDenom,
+		TargetLtv:       targetLTV,
+		MaxSlippage:     sdk.NewDecWithPrec(1, 2),
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(router.swapped > 0)
+	suite.Require().True(res.MintOut.IsPositive())
+	suite.Require().True(res.CollateralIn.IsPositive())
+
+	after := suite.accountLTV()
+	suite.Require().Equal(after, res.Ltv)
+	suite.Require().True(after.GT(before))
+	suite.Require().True(after.LTE(targetLTV))
+	suite.Require().True(targetLTV.Sub(after).LT(sdk.NewDecWithPrec(1, 2)))
+
+	// already at target
+	_, err = msgServer.Leverage(ctx, &types.MsgLeverage{
+		Sender:          suite.accAddress.String(),
+		CollateralDenom: suite.bcDenom,
+		TargetLtv:       targetLTV,
+		MaxSlippage:     sdk.NewDecWithPrec(1, 2),
+	})
+	suite.Require().ErrorIs(err, types.ErrLTVOutOfRange)
+
+	// target not below max LTV
+	_, err = msgServer.Leverage(ctx, &types.MsgLeverage{
+		Sender:          suite.accAddress.String(),
+		CollateralDenom: suite.bcDenom,
+		TargetLtv:       sdk.NewDecWithPrec(80, 2),
+		MaxSlippage:     sdk.NewDecWithPrec(1, 2),
+	})
+	suite.Require().ErrorIs(err, types.ErrLTVOutOfRange)
+}
+
+func (suite *KeeperTestSuite) TestLeverageOverSlippage() {
+	msgServer, _ := suite.setupLeverageTest(sdk.NewDecWithPrec(5, 2))
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := msgServer.Leverage(ctx, &types.MsgLeverage{
+		Sender:          suite.accAddress.String(),
+		CollateralDenom: suite.bcDenom,
+		TargetLtv:       sdk.NewDecWithPrec(65, 2),
+		MaxSlippage:     sdk.NewDecWithPrec(1, 2),
+	})
+	suite.Require().ErrorIs(err, types.ErrOverSlippage)
+}
+
+func (suite *KeeperTestSuite) TestDeleverage() {
+	msgServer, router := suite.setupLeverageTest(sdk.ZeroDec())
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	targetLTV := sdk.NewDecWithPrec(30, 2)
+
+	before := suite.accountLTV()
+	res, err := msgServer.Deleverage(ctx, &types.MsgDeleverage{
+		Sender:          suite.accAddress.String(),
+		CollateralDenom: suite.bcDenom,
+		TargetLtv:       targetLTV,
+		MaxSlippage:     sdk.NewDecWithPrec(1, 2),
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(router.swapped > 0)
+	suite.Require().True(res.CollateralOut.IsPositive())
+	suite.Require().True(res.RepayIn.IsPositive())
+
+	after := suite.accountLTV()
+	suite.Require().Equal(after, res.Ltv)
+	suite.Require().True(after.LT(before))
+	suite.Require().True(after.GTE(targetLTV))
+
+	// unwind the position entirely
+	_, err = msgServer.Deleverage(ctx, &types.MsgDeleverage{
+		Sender:          suite.accAddress.String(),
+		CollateralDenom: suite.bcDenom,
+		TargetLtv:       sdk.ZeroDec(),
+		MaxSlippage:     sdk.NewDecWithPrec(1, 2),
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(suite.accountLTV().LT(after))
+}
+
+func (suite *KeeperTestSuite) TestLeverageNoSwapRoute() {
+	suite.SetupTest()
+	suite.setupEstimationTest()
+
+	// collateral which is not a backing coin; the app sets no DEX swap router,
+	// so only the backing pools can route swaps
+	_, crp2 := suite.dummyCollateralRiskParams()
+	crp2.CollateralDenom = "fil"
+	crp2.Enabled = true
+	suite.app.MakerKeeper.SetCollateralRiskParams(suite.ctx, crp2)
+	msgServer := keeper.NewMsgServerImpl(suite.app.MakerKeeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := msgServer.Leverage(ctx, &types.MsgLeverage{
+		Sender:          suite.accAddress.String(),
+		CollateralDenom: "fil",
+		TargetLtv:       sdk.NewDecWithPrec(50, 2),
+		MaxSlippage:     sdk.NewDecWithPrec(1, 2),
+	})
+	suite.Require().ErrorIs(err, types.ErrNoSwapRoute)
+
+	_, err = msgServer.Deleverage(ctx, &types.MsgDeleverage{
+		Sender:          suite.accAddress.String(),
+		CollateralDenom: "fil",
+		TargetLtv:       sdk.ZeroDec(),
+		MaxSlippage:     sdk.NewDecWithPrec(1, 2),
+	})
+	suite.Require().ErrorIs(err, types.ErrNoSwapRoute)
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/petri-labs/warmage/x/maker/types"
+)
+
+// SetCollateralManager authorizes manager to manage collateral positions of owner.
+func (k Keeper) SetCollateralManager(ctx sdk.Context, owner, manager sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCollateralManager)
+	store.Set(keyByOwnerManager(owner, manager), []byte{1})
+}
+
+// DeleteCollateralManager revokes the authorization of manager for owner.
+func (k Keeper) DeleteCollateralManager(ctx sdk.Context, owner, manager sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCollateralManager)
+	store.Delete(keyByOwnerManager(owner, manager))
+}
+
+// IsCollateralManager returns whether manager is authorized to manage
+// collateral positions of owner.
+func (k Keeper) IsCollateralManager(ctx sdk.Context, owner, manager sdk.AccAddress) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCollateralManager)
+	return store.Has(keyByOwnerManager(owner, manager))
+}
+
+// GetCollateralManagers returns all authorized managers of owner.
+func (k Keeper) GetCollateralManagers(ctx sdk.Context, owner sdk.AccAddress) []sdk.AccAddress {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCollateralManager)
+	iterator := sdk.KVStorePrefixIterator(store, address.MustLengthPrefix(owner))
+	defer iterator.Close()
+
+	var managers []sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		managers = append(managers, iterator.Key()[1+len(owner):])
+	}
+
+	return managers
+}
+
+// IterateCollateralManagers iterates over all owner-manager authorizations,
+// and calls cb until it returns true.
+func (k Keeper) IterateCollateralManagers(ctx sdk.Context, cb func(owner, manager sdk.AccAddress) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCollateralManager)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		ownerLen := int(key[0])
+		owner := sdk.AccAddress(key[1 : 1+ownerLen])
+		manager := sdk.AccAddress(key[1+ownerLen:])
+		if cb(owner, manager) {
+			break
+		}
+	}
+}
+
+// getCollateralOwner returns the owner of the collateral account operated by
+// sender. An empty owner means sender itself; otherwise sender must be an
+// authorized manager of the owner.
+func (k Keeper) getCollateralOwner(ctx sdk.Context, sender sdk.AccAddress, ownerStr string) (sdk.AccAddress, error) {
+	if len(ownerStr) == 0 {
+		return sender, nil
+	}
+	owner, err := sdk.AccAddressFromBech32(ownerStr)
+	if err != nil {
+		return nil, err
+	}
+	if !owner.Equals(sender) && !k.IsCollateralManager(ctx, owner, sender) {
+		return nil, sdkerrors.Wrapf(types.ErrNotAuthorizedManager, "%s is not a manager of %s", sender, owner)
+	}
+	return owner, nil
+}
+
+// getProceedsReceiver returns the receiver of the proceeds of an operation on
+// the owner's collateral account. Proceeds go to the owner by default, and can
+// only go to the owner if sender is a manager.
+func getProceedsReceiver(sender, owner, receiver sdk.AccAddress, to string) (sdk.AccAddress, error) {
+	if len(to) == 0 {
+		return owner, nil
+	}
+	if !owner.Equals(sender) && !receiver.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "receiver must be the owner %s when managed by sender", owner)
+	}
+	return receiver, nil
+}
+
+func keyByOwnerManager(owner, manager sdk.AccAddress) []byte {
+	return append(address.MustLengthPrefix(owner), manager...)
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	warmage "github.com/petri-labs/warmage/types"
+	"github.com/petri-labs/warmage/x/maker/keeper"
+	"github.com/petri-labs/warmage/x/maker/types"
+	"github.com/tharsis/ethermint/tests"
+)
+
+func (suite *KeeperTestSuite) TestCollateralManagers() {
+	suite.SetupTest()
+	k, ctx := suite.app.MakerKeeper, suite.ctx
+	owner := suite.accAddress
+	manager1 := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	manager2 := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	suite.Require().Empty(k.GetCollateralManagers(ctx, owner))
+
+	k.SetCollateralManager(ctx, owner, manager1)
+	k.SetCollateralManager(ctx, owner, manager2)
+	suite.Require().True(k.IsCollateralManager(ctx, owner, manager1))
+	suite.Require().False(k.IsCollateralManager(ctx, manager1, owner))
+	suite.Require().ElementsMatch([]sdk.AccAddress{manager1, manager2}, k.GetCollateralManagers(ctx, owner))
+
+	res, err := suite.queryClient.CollateralManagers(sdk.WrapSDKContext(ctx), &types.QueryCollateralManagersRequest{Account: owner.String()})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]string{manager1.String(), manager2.String()}, res.Managers)
+
+	k.DeleteCollateralManager(ctx, owner, manager1)
+	suite.Require().False(k.IsCollateralManager(ctx, owner, manager1))
+	suite.Require().Equal([]sdk.AccAddress{manager2}, k.GetCollateralManagers(ctx, owner))
+}
+
+func (suite *KeeperTestSuite) TestTransferPosition() {
+	suite.SetupTest()
+	suite.setupEstimationTest()
+	msgServer := keeper.NewMsgServerImpl(suite.app.MakerKeeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	receiver := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	before, found := suite.app.MakerKeeper.GetAccountCollateral(suite.ctx, suite.accAddress, suite.bcDenom)
+	suite.Require().True(found)
+
+	_, err := msgServer.TransferPosition(ctx, &types.MsgTransferPosition{
+		Sender:          suite.accAddress.String(),
+		To:              receiver.String(),
+		CollateralDenom: suite.bcDenom,
+	})
+	suite.Require().NoError(err)
+
+	_, found = suite.app.MakerKeeper.GetAccountCollateral(suite.ctx, suite.accAddress, suite.bcDenom)
+	suite.Require().False(found)
+	after, found := suite.app.MakerKeeper.GetAccountCollateral(suite.ctx, receiver, suite.bcDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(receiver.String(), after.Account)
+	suite.Require().Equal(before.Collateral, after.Collateral)
+	suite.Require().Equal(before.MageCollateralized, after.MageCollateralized)
+	suite.Require().True(after.WarDebt.IsGTE(before.WarDebt))
+
+	// sender has no position anymore
+	_, err = msgServer.TransferPosition(ctx, &types.MsgTransferPosition{
+		Sender:          suite.accAddress.String(),
+		To:              receiver.String(),
+		CollateralDenom: suite.bcDenom,
+	})
+	suite.Require().ErrorIs(err, types.ErrAccountNoCollateral)
+
+	// receiver already has a position
+	suite.app.MakerKeeper.SetAccountCollateral(suite.ctx, suite.accAddress, before)
+	_, err = msgServer.TransferPosition(ctx, &types.MsgTransferPosition{
+		Sender:          suite.accAddress.String(),
+		To:              receiver.String(),
+		CollateralDenom: suite.bcDenom,
+	})
+	suite.Require().ErrorIs(err, types.ErrAccountPositionExists)
+}
+
+func (suite *KeeperTestSuite) TestManagedCollateral() {
+	suite.SetupTest()
+	suite.setupEstimationTest()
+	msgServer := keeper.NewMsgServerImpl(suite.app.MakerKeeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	owner := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	manager := suite.accAddress
+
+	msg := &types.MsgBurnByCollateral{
+		Sender:          manager.String(),
+		CollateralDenom: suite.bcDenom,
+		RepayInMax:      sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(1_000000)),
+		Owner:           owner.String(),
+	}
+	_, err := msgServer.BurnByCollateral(ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrNotAuthorizedManager)
+
+	_, err = msgServer.AuthorizeManager(ctx, &types.MsgAuthorizeManager{Sender: owner.String(), Manager: manager.String()})
+	suite.Require().NoError(err)
+
+	// manager operates on the owner's position rather than its own
+	_, err = msgServer.BurnByCollateral(ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrAccountNoCollateral)
+
+	// proceeds of a manager can only go to the owner
+	_, err = msgServer.MintByCollateral(ctx, &types.MsgMintByCollateral{
+		Sender:          manager.String(),
+		To:              manager.String(),
+		CollateralDenom: suite.bcDenom,
+		MintOut:         sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(1_000000)),
+		Owner:           owner.String(),
+	})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidAddress)
+	_, err = msgServer.RedeemCollateral(ctx, &types.MsgRedeemCollateral{
+		Sender:        manager.String(),
+		To:            manager.String(),
+		CollateralOut: sdk.NewCoin(suite.bcDenom, sdk.NewInt(1_000000)),
+		Owner:         owner.String(),
+	})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidAddress)
+	_, err = msgServer.RedeemCollateral(ctx, &types.MsgRedeemCollateral{
+		Sender:        manager.String(),
+		To:            owner.String(),
+		CollateralOut: sdk.NewCoin(suite.bcDenom, sdk.NewInt(1_000000)),
+		Owner:         owner.String(),
+	})
+	suite.Require().ErrorIs(err, types.ErrAccountNoCollateral)
+
+	_, err = msgServer.RevokeManager(ctx, &types.MsgRevokeManager{Sender: owner.String(), Manager: manager.String()})
+	suite.Require().NoError(err)
+	_, err = msgServer.BurnByCollateral(ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrNotAuthorizedManager)
+
+	_, err = msgServer.RevokeManager(ctx, &types.MsgRevokeManager{Sender: owner.String(), Manager: manager.String()})
+	suite.Require().ErrorIs(err, types.ErrNotAuthorizedManager)
+}
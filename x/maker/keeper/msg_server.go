@@ -7,7 +7,6 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	warmage "github.com/petri-labs/warmage/types"
 	"github.com/petri-labs/warmage/x/maker/types"
-	oracletypes "github.com/petri-labs/warmage/x/oracle/types"
 )
 
 type msgServer struct {
@@ -29,6 +28,10 @@ func (m msgServer) MintBySwap(c context.Context, msg *types.MsgMintBySwap) (*typ
 		return nil, err
 	}
 
+	if err := m.Keeper.checkExchangeRatesFresh(ctx, msg.BackingInMax.Denom, warmage.AttoMageDenom, warmage.MicroUSWDenom); err != nil {
+		return nil, err
+	}
+
 	backingIn, mageOut, mintOut, mintFee, err := m.Keeper.calculateMintBySwapOut(ctx, msg.BackingInMax, msg.MageInMax, msg.FullBacking)
 	if err != nil {
 		return nil, err
@@ -77,9 +80,9 @@ func (m msgServer) MintBySwap(c context.Context, msg *types.MsgMintBySwap) (*typ
 	if err != nil {
 		return nil, err
 	}
-	// send war fee to oracle
+	// allocate war fee via oracle
 	if mintFee.IsPositive() {
-		err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(mintFee))
+		err = m.Keeper.oracleKeeper.AllocateMakerFees(ctx, types.ModuleName, sdk.NewCoins(mintFee))
 		if err != nil {
 			return nil, err
 		}
@@ -112,6 +115,10 @@ func (m msgServer) BurnBySwap(c context.Context, msg *types.MsgBurnBySwap) (*typ
 		return nil, err
 	}
 
+	if err := m.Keeper.checkExchangeRatesFresh(ctx, msg.BackingOutMin.Denom, warmage.AttoMageDenom, warmage.MicroUSWDenom); err != nil {
+		return nil, err
+	}
+
 	backingOut, mageOut, burnFee, err := m.Keeper.calculateBurnBySwapOut(ctx, msg.BurnIn, msg.BackingOutMin.Denom)
 	if err != nil {
 		return nil, err
@@ -152,8 +159,8 @@ func (m msgServer) BurnBySwap(c context.Context, msg *types.MsgBurnBySwap) (*typ
 	if err != nil {
 		return nil, err
 	}
-	// send war fee to oracle
-	err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(burnFee))
+	// allocate war fee via oracle
+	err = m.Keeper.oracleKeeper.AllocateMakerFees(ctx, types.ModuleName, sdk.NewCoins(burnFee))
 	if err != nil {
 		return nil, err
 	}
@@ -188,6 +195,195 @@ func (m msgServer) BurnBySwap(c context.Context, msg *types.MsgBurnBySwap) (*typ
 	}, nil
 }
 
+func (m msgServer) BasketMint(c context.Context, msg *types.MsgBasketMint) (*types.MsgBasketMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, receiver, err := getSenderReceiver(msg.Sender, msg.To)
+	if err != nil {
+		return nil, err
+	}
+
+	denoms := []string{warmage.AttoMageDenom, warmage.MicroUSWDenom}
+	for _, coin := range msg.BackingInMax {
+		denoms = append(denoms, coin.Denom)
+	}
+	if err := m.Keeper.checkExchangeRatesFresh(ctx, denoms...); err != nil {
+		return nil, err
+	}
+
+	legs, backingIn, mageIn, mintOut, mintFee, err := m.Keeper.calculateBasketMint(ctx, msg.BackingInMax, msg.MageInMax, msg.FullBacking)
+	if err != nil {
+		return nil, err
+	}
+	mintTotal := mintOut.Add(mintFee)
+
+	if mintOut.IsLT(msg.MintOutMin) {
+		return nil, sdkerrors.Wrapf(types.ErrOverSlippage, "mint out: %s", mintOut)
+	}
+
+	totalBacking, found := m.Keeper.GetTotalBacking(ctx)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrBackingCoinNotFound, "total backing not found")
+	}
+
+	for _, leg := range legs {
+		poolBacking := leg.pool
+		poolBacking.WarMinted = poolBacking.WarMinted.Add(leg.war)
+		poolBacking.Backing = poolBacking.Backing.Add(leg.backing)
+		poolBacking.MageBurned = poolBacking.MageBurned.Add(leg.mage)
+		m.Keeper.SetPoolBacking(ctx, poolBacking)
+	}
+
+	totalBacking.WarMinted = totalBacking.WarMinted.Add(mintTotal)
+	totalBacking.MageBurned = totalBacking.MageBurned.Add(mageIn)
+	m.Keeper.SetTotalBacking(ctx, totalBacking)
+
+	// take backing and mage coin
+	err = m.Keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, backingIn.Add(mageIn))
+	if err != nil {
+		return nil, err
+	}
+	// burn mage
+	if mageIn.IsPositive() {
+		err = m.Keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(mageIn))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// mint war stablecoin
+	err = m.Keeper.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(mintTotal))
+	if err != nil {
+		return nil, err
+	}
+	// send war to receiver
+	err = m.Keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(mintOut))
+	if err != nil {
+		return nil, err
+	}
+	// allocate war fee via oracle
+	if mintFee.IsPositive() {
+		err = m.Keeper.oracleKeeper.AllocateMakerFees(ctx, types.ModuleName, sdk.NewCoins(mintFee))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeBasketMint,
+			sdk.NewAttribute(types.AttributeKeyCoinIn, backingIn.Add(mageIn).String()),
+			sdk.NewAttribute(types.AttributeKeyCoinOut, mintOut.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, mintFee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgBasketMintResponse{
+		BackingIn: backingIn,
+		MageIn:    mageIn,
+		MintOut:   mintOut,
+		MintFee:   mintFee,
+	}, nil
+}
+
+func (m msgServer) BasketBurn(c context.Context, msg *types.MsgBasketBurn) (*types.MsgBasketBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, receiver, err := getSenderReceiver(msg.Sender, msg.To)
+	if err != nil {
+		return nil, err
+	}
+
+	backingDenoms := make([]string, len(msg.BackingOutMin))
+	for i, coin := range msg.BackingOutMin {
+		backingDenoms[i] = coin.Denom
+	}
+	if err := m.Keeper.checkExchangeRatesFresh(ctx, append([]string{warmage.AttoMageDenom, warmage.MicroUSWDenom}, backingDenoms...)...); err != nil {
+		return nil, err
+	}
+
+	legs, backingOut, mageOut, burnFee, err := m.Keeper.calculateBasketBurn(ctx, msg.BurnIn, backingDenoms)
+	if err != nil {
+		return nil, err
+	}
+	burnActual := msg.BurnIn.Sub(burnFee)
+
+	for _, coin := range msg.BackingOutMin {
+		if backingOut.AmountOf(coin.Denom).LT(coin.Amount) {
+			return nil, sdkerrors.Wrapf(types.ErrOverSlippage, "backing out: %s", sdk.NewCoin(coin.Denom, backingOut.AmountOf(coin.Denom)))
+		}
+	}
+	if mageOut.IsLT(msg.MageOutMin) {
+		return nil, sdkerrors.Wrapf(types.ErrOverSlippage, "mage out: %s", mageOut)
+	}
+
+	totalBacking, found := m.Keeper.GetTotalBacking(ctx)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrBackingCoinNotFound, "total backing not found")
+	}
+
+	for _, leg := range legs {
+		poolBacking := leg.pool
+		poolBacking.Backing = poolBacking.Backing.Sub(leg.backing)
+		// allow MageBurned to be negative which means minted mage
+		// here use Int.Sub() to bypass Coin.Sub() negativeness check
+		poolBacking.MageBurned.Amount = poolBacking.MageBurned.Amount.Sub(leg.mage.Amount)
+		// allow WarMinted to be negative which means burned war
+		poolBacking.WarMinted.Amount = poolBacking.WarMinted.Amount.Sub(leg.war.Amount)
+		m.Keeper.SetPoolBacking(ctx, poolBacking)
+	}
+
+	totalBacking.MageBurned.Amount = totalBacking.MageBurned.Amount.Sub(mageOut.Amount)
+	totalBacking.WarMinted.Amount = totalBacking.WarMinted.Amount.Sub(burnActual.Amount)
+	m.Keeper.SetTotalBacking(ctx, totalBacking)
+
+	// take war stablecoin
+	err = m.Keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(msg.BurnIn))
+	if err != nil {
+		return nil, err
+	}
+	// burn war
+	err = m.Keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(burnActual))
+	if err != nil {
+		return nil, err
+	}
+	// allocate war fee via oracle
+	err = m.Keeper.oracleKeeper.AllocateMakerFees(ctx, types.ModuleName, sdk.NewCoins(burnFee))
+	if err != nil {
+		return nil, err
+	}
+
+	// mint mage
+	err = m.Keeper.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(mageOut))
+	if err != nil {
+		return nil, err
+	}
+	// send backing and mage to receiver
+	err = m.Keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, backingOut.Add(mageOut))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeBasketBurn,
+			sdk.NewAttribute(types.AttributeKeyCoinIn, msg.BurnIn.String()),
+			sdk.NewAttribute(types.AttributeKeyCoinOut, backingOut.Add(mageOut).String()),
+			sdk.NewAttribute(types.AttributeKeyFee, burnFee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgBasketBurnResponse{
+		BackingOut: backingOut,
+		MageOut:    mageOut,
+		BurnFee:    burnFee,
+	}, nil
+}
+
 func (m msgServer) BuyBacking(c context.Context, msg *types.MsgBuyBacking) (*types.MsgBuyBackingResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	sender, receiver, err := getSenderReceiver(msg.Sender, msg.To)
@@ -195,6 +391,10 @@ func (m msgServer) BuyBacking(c context.Context, msg *types.MsgBuyBacking) (*typ
 		return nil, err
 	}
 
+	if err := m.Keeper.checkExchangeRatesFresh(ctx, msg.BackingOutMin.Denom, warmage.AttoMageDenom); err != nil {
+		return nil, err
+	}
+
 	backingOut, buybackFee, err := m.Keeper.calculateBuyBackingOut(ctx, msg.MageIn, msg.BackingOutMin.Denom)
 	if err != nil {
 		return nil, err
@@ -232,8 +432,8 @@ func (m msgServer) BuyBacking(c context.Context, msg *types.MsgBuyBacking) (*typ
 	if err != nil {
 		return nil, err
 	}
-	// send fee to oracle
-	err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(buybackFee))
+	// allocate fee via oracle
+	err = m.Keeper.oracleKeeper.AllocateMakerFees(ctx, types.ModuleName, sdk.NewCoins(buybackFee))
 	if err != nil {
 		return nil, err
 	}
@@ -263,6 +463,10 @@ func (m msgServer) SellBacking(c context.Context, msg *types.MsgSellBacking) (*t
 		return nil, err
 	}
 
+	if err := m.Keeper.checkExchangeRatesFresh(ctx, msg.BackingIn.Denom, warmage.AttoMageDenom); err != nil {
+		return nil, err
+	}
+
 	mageOut, rebackFee, err := m.Keeper.calculateSellBackingOut(ctx, msg.BackingIn)
 	if err != nil {
 		return nil, err
@@ -304,8 +508,8 @@ func (m msgServer) SellBacking(c context.Context, msg *types.MsgSellBacking) (*t
 	if err != nil {
 		return nil, err
 	}
-	// send fee to oracle
-	err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(rebackFee))
+	// allocate fee via oracle
+	err = m.Keeper.oracleKeeper.AllocateMakerFees(ctx, types.ModuleName, sdk.NewCoins(rebackFee))
 	if err != nil {
 		return nil, err
 	}
@@ -335,13 +539,26 @@ func (m msgServer) MintByCollateral(c context.Context, msg *types.MsgMintByColla
 		return nil, err
 	}
 
-	mintFee, totalColl, poolColl, accColl, err := m.Keeper.calculateMintByCollateral(ctx, sender, msg.CollateralDenom, msg.MintOut)
+	if err := m.Keeper.checkExchangeRatesFresh(ctx, msg.CollateralDenom, warmage.AttoMageDenom, warmage.MicroUSWDenom); err != nil {
+		return nil, err
+	}
+
+	owner, err := m.Keeper.getCollateralOwner(ctx, sender, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	receiver, err = getProceedsReceiver(sender, owner, receiver, msg.To)
+	if err != nil {
+		return nil, err
+	}
+
+	mintFee, totalColl, poolColl, accColl, err := m.Keeper.calculateMintByCollateral(ctx, owner, msg.CollateralDenom, msg.MintOut)
 	if err != nil {
 		return nil, err
 	}
 	mintTotal := msg.MintOut.Add(mintFee)
 
-	m.Keeper.SetAccountCollateral(ctx, sender, accColl)
+	m.Keeper.SetAccountCollateral(ctx, owner, accColl)
 	m.Keeper.SetPoolCollateral(ctx, poolColl)
 	m.Keeper.SetTotalCollateral(ctx, totalColl)
 
@@ -355,9 +572,9 @@ func (m msgServer) MintByCollateral(c context.Context, msg *types.MsgMintByColla
 	if err != nil {
 		return nil, err
 	}
-	// send mint fee to oracle
+	// allocate mint fee via oracle
 	if mintFee.IsPositive() {
-		err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(mintFee))
+		err = m.Keeper.oracleKeeper.AllocateMakerFees(ctx, types.ModuleName, sdk.NewCoins(mintFee))
 		if err != nil {
 			return nil, err
 		}
@@ -386,6 +603,11 @@ func (m msgServer) BurnByCollateral(c context.Context, msg *types.MsgBurnByColla
 		return nil, err
 	}
 
+	owner, err := m.Keeper.getCollateralOwner(ctx, sender, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
 	collateralDenom := msg.CollateralDenom
 
 	collateralParams, err := m.Keeper.getAvailableCollateralParams(ctx, collateralDenom)
@@ -393,7 +615,7 @@ func (m msgServer) BurnByCollateral(c context.Context, msg *types.MsgBurnByColla
 		return nil, err
 	}
 
-	totalColl, poolColl, accColl, err := m.Keeper.getCollateral(ctx, sender, collateralDenom)
+	totalColl, poolColl, accColl, err := m.Keeper.getCollateral(ctx, owner, collateralDenom)
 	if err != nil {
 		return nil, err
 	}
@@ -415,7 +637,7 @@ func (m msgServer) BurnByCollateral(c context.Context, msg *types.MsgBurnByColla
 	totalColl.WarDebt = totalColl.WarDebt.Sub(repayIn)
 
 	// eventually update collateral
-	m.Keeper.SetAccountCollateral(ctx, sender, accColl)
+	m.Keeper.SetAccountCollateral(ctx, owner, accColl)
 	m.Keeper.SetPoolCollateral(ctx, poolColl)
 	m.Keeper.SetTotalCollateral(ctx, totalColl)
 
@@ -431,9 +653,9 @@ func (m msgServer) BurnByCollateral(c context.Context, msg *types.MsgBurnByColla
 			return nil, err
 		}
 	}
-	// send fee to oracle
+	// allocate fee via oracle
 	if repayInterest.IsPositive() {
-		err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(repayInterest))
+		err = m.Keeper.oracleKeeper.AllocateMakerFees(ctx, types.ModuleName, sdk.NewCoins(repayInterest))
 		if err != nil {
 			return nil, err
 		}
@@ -520,12 +742,25 @@ func (m msgServer) RedeemCollateral(c context.Context, msg *types.MsgRedeemColla
 		return nil, err
 	}
 
+	if err := m.Keeper.checkExchangeRatesFresh(ctx, msg.CollateralOut.Denom, warmage.AttoMageDenom); err != nil {
+		return nil, err
+	}
+
+	owner, err := m.Keeper.getCollateralOwner(ctx, sender, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	receiver, err = getProceedsReceiver(sender, owner, receiver, msg.To)
+	if err != nil {
+		return nil, err
+	}
+
 	collateralParams, err := m.Keeper.getAvailableCollateralParams(ctx, collateralDenom)
 	if err != nil {
 		return nil, err
 	}
 
-	totalColl, poolColl, accColl, err := m.Keeper.getCollateral(ctx, sender, collateralDenom)
+	totalColl, poolColl, accColl, err := m.Keeper.getCollateral(ctx, owner, collateralDenom)
 	if err != nil {
 		return nil, err
 	}
@@ -549,7 +784,7 @@ func (m msgServer) RedeemCollateral(c context.Context, msg *types.MsgRedeemColla
 	}
 
 	// eventually persist collateral
-	m.Keeper.SetAccountCollateral(ctx, sender, accColl)
+	m.Keeper.SetAccountCollateral(ctx, owner, accColl)
 	m.Keeper.SetPoolCollateral(ctx, poolColl)
 	m.Keeper.SetTotalCollateral(ctx, totalColl)
 
@@ -586,6 +821,10 @@ func (m msgServer) LiquidateCollateral(c context.Context, msg *types.MsgLiquidat
 		return nil, err
 	}
 
+	if err := m.Keeper.checkExchangeRatesFresh(ctx, msg.Collateral.Denom, warmage.AttoMageDenom, warmage.MicroUSWDenom); err != nil {
+		return nil, err
+	}
+
 	collateralParams, err := m.Keeper.getAvailableCollateralParams(ctx, collateralDenom)
 	if err != nil {
 		return nil, err
@@ -664,8 +903,8 @@ func (m msgServer) LiquidateCollateral(c context.Context, msg *types.MsgLiquidat
 	if err != nil {
 		return nil, err
 	}
-	// send liquidation commission fee to oracle
-	err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(commissionFee))
+	// allocate liquidation commission fee via oracle
+	err = m.Keeper.oracleKeeper.AllocateMakerFees(ctx, types.ModuleName, sdk.NewCoins(commissionFee))
 	if err != nil {
 		return nil, err
 	}
@@ -688,6 +927,287 @@ func (m msgServer) LiquidateCollateral(c context.Context, msg *types.MsgLiquidat
 	}, nil
 }
 
+func (m msgServer) TransferPosition(c context.Context, msg *types.MsgTransferPosition) (*types.MsgTransferPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, receiver, err := getSenderReceiver(msg.Sender, msg.To)
+	if err != nil {
+		return nil, err
+	}
+
+	collateralDenom := msg.CollateralDenom
+
+	collateralParams, found := m.Keeper.GetCollateralRiskParams(ctx, collateralDenom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCollateralCoinNotFound, "collateral coin denomination not found: %s", collateralDenom)
+	}
+
+	totalColl, poolColl, accColl, err := m.Keeper.getCollateral(ctx, sender, collateralDenom)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := m.Keeper.GetAccountCollateral(ctx, receiver, collateralDenom); found {
+		return nil, sdkerrors.Wrapf(types.ErrAccountPositionExists, "account %s already has %s collateral", receiver, collateralDenom)
+	}
+
+	// settle interest before the position changes hands
+	settleInterestFee(ctx, &accColl, &poolColl, &totalColl, *collateralParams.InterestFee)
+	accColl.Account = receiver.String()
+
+	m.Keeper.DeleteAccountCollateral(ctx, sender, collateralDenom)
+	m.Keeper.SetAccountCollateral(ctx, receiver, accColl)
+	m.Keeper.SetPoolCollateral(ctx, poolColl)
+	m.Keeper.SetTotalCollateral(ctx, totalColl)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeTransferPosition,
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, collateralDenom),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgTransferPositionResponse{}, nil
+}
+
+func (m msgServer) AuthorizeManager(c context.Context, msg *types.MsgAuthorizeManager) (*types.MsgAuthorizeManagerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	owner, manager, err := getSenderReceiver(msg.Sender, msg.Manager)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Keeper.SetCollateralManager(ctx, owner, manager)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeAuthorizeManager,
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyManager, manager.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgAuthorizeManagerResponse{}, nil
+}
+
+func (m msgServer) RevokeManager(c context.Context, msg *types.MsgRevokeManager) (*types.MsgRevokeManagerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	owner, manager, err := getSenderReceiver(msg.Sender, msg.Manager)
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.Keeper.IsCollateralManager(ctx, owner, manager) {
+		return nil, sdkerrors.Wrapf(types.ErrNotAuthorizedManager, "%s is not a manager of %s", manager, owner)
+	}
+	m.Keeper.DeleteCollateralManager(ctx, owner, manager)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeRevokeManager,
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyManager, manager.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgRevokeManagerResponse{}, nil
+}
+
+func (m msgServer) Leverage(c context.Context, msg *types.MsgLeverage) (*types.MsgLeverageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, _, err := getSenderReceiver(msg.Sender, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.checkExchangeRatesFresh(ctx, msg.CollateralDenom, warmage.AttoMageDenom, warmage.MicroUSWDenom); err != nil {
+		return nil, err
+	}
+
+	collateralParams, err := m.Keeper.getAvailableCollateralParams(ctx, msg.CollateralDenom)
+	if err != nil {
+		return nil, err
+	}
+	if msg.TargetLtv.GTE(*collateralParams.LoanToValue) {
+		return nil, sdkerrors.Wrapf(types.ErrLTVOutOfRange, "target LTV %s must be below max LTV %s", msg.TargetLtv, collateralParams.LoanToValue)
+	}
+	if err := m.Keeper.checkSwapRoute(ctx, msg.CollateralDenom); err != nil {
+		return nil, err
+	}
+
+	mintOut := sdk.NewCoin(warmage.MicroUSWDenom, sdk.ZeroInt())
+	collateralIn := sdk.NewCoin(msg.CollateralDenom, sdk.ZeroInt())
+	mageIn := sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt())
+	for i := 0; i < maxLeverageIterations; i++ {
+		mint, err := m.Keeper.calculateLeverageMint(ctx, sender, &collateralParams, msg.TargetLtv)
+		if err != nil {
+			return nil, err
+		}
+		if !mint.IsPositive() {
+			break
+		}
+
+		_, err = m.MintByCollateral(c, &types.MsgMintByCollateral{
+			Sender:          msg.Sender,
+			CollateralDenom: msg.CollateralDenom,
+			MintOut:         mint,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		coinsOut, err := m.Keeper.swapWithSlippage(ctx, sender, mint, msg.CollateralDenom, msg.MaxSlippage)
+		if err != nil {
+			return nil, err
+		}
+		deposit := sdk.NewCoin(msg.CollateralDenom, coinsOut.AmountOf(msg.CollateralDenom))
+		mage := sdk.NewCoin(warmage.AttoMageDenom, coinsOut.AmountOf(warmage.AttoMageDenom))
+
+		_, err = m.DepositCollateral(c, &types.MsgDepositCollateral{
+			Sender:       msg.Sender,
+			CollateralIn: deposit,
+			MageIn:       mage,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		mintOut = mintOut.Add(mint)
+		collateralIn = collateralIn.Add(deposit)
+		mageIn = mageIn.Add(mage)
+	}
+
+	if !mintOut.IsPositive() {
+		return nil, sdkerrors.Wrapf(types.ErrLTVOutOfRange, "cannot lever up towards target LTV %s", msg.TargetLtv)
+	}
+
+	accColl, _ := m.Keeper.GetAccountCollateral(ctx, sender, msg.CollateralDenom)
+	ltv, err := m.Keeper.accountLTV(ctx, &accColl)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeLeverage,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.CollateralDenom),
+			sdk.NewAttribute(types.AttributeKeyCoinIn, sdk.NewCoins(collateralIn, mageIn).String()),
+			sdk.NewAttribute(types.AttributeKeyCoinOut, mintOut.String()),
+			sdk.NewAttribute(types.AttributeKeyLTV, ltv.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgLeverageResponse{
+		MintOut:      mintOut,
+		CollateralIn: collateralIn,
+		MageIn:       mageIn,
+		Ltv:          ltv,
+	}, nil
+}
+
+func (m msgServer) Deleverage(c context.Context, msg *types.MsgDeleverage) (*types.MsgDeleverageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, _, err := getSenderReceiver(msg.Sender, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.checkExchangeRatesFresh(ctx, msg.CollateralDenom, warmage.AttoMageDenom, warmage.MicroUSWDenom); err != nil {
+		return nil, err
+	}
+
+	collateralParams, err := m.Keeper.getAvailableCollateralParams(ctx, msg.CollateralDenom)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.Keeper.checkSwapRoute(ctx, msg.CollateralDenom); err != nil {
+		return nil, err
+	}
+
+	collateralOut := sdk.NewCoin(msg.CollateralDenom, sdk.ZeroInt())
+	repayIn := sdk.NewCoin(warmage.MicroUSWDenom, sdk.ZeroInt())
+	for i := 0; i < maxLeverageIterations; i++ {
+		redeem, err := m.Keeper.calculateDeleverageRedeem(ctx, sender, &collateralParams, msg.TargetLtv)
+		if err != nil {
+			return nil, err
+		}
+		if !redeem.IsPositive() {
+			break
+		}
+
+		_, err = m.RedeemCollateral(c, &types.MsgRedeemCollateral{
+			Sender:        msg.Sender,
+			CollateralOut: redeem,
+			MageOut:       sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt()),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		coinsOut, err := m.Keeper.swapWithSlippage(ctx, sender, redeem, warmage.MicroUSWDenom, msg.MaxSlippage)
+		if err != nil {
+			return nil, err
+		}
+		repay := sdk.NewCoin(warmage.MicroUSWDenom, coinsOut.AmountOf(warmage.MicroUSWDenom))
+		if !repay.IsPositive() {
+			break
+		}
+
+		res, err := m.BurnByCollateral(c, &types.MsgBurnByCollateral{
+			Sender:          msg.Sender,
+			CollateralDenom: msg.CollateralDenom,
+			RepayInMax:      repay,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		collateralOut = collateralOut.Add(redeem)
+		repayIn = repayIn.Add(res.RepayIn)
+	}
+
+	if !collateralOut.IsPositive() {
+		return nil, sdkerrors.Wrapf(types.ErrLTVOutOfRange, "cannot lever down towards target LTV %s", msg.TargetLtv)
+	}
+
+	accColl, _ := m.Keeper.GetAccountCollateral(ctx, sender, msg.CollateralDenom)
+	ltv, err := m.Keeper.accountLTV(ctx, &accColl)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeDeleverage,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.CollateralDenom),
+			sdk.NewAttribute(types.AttributeKeyCoinIn, repayIn.String()),
+			sdk.NewAttribute(types.AttributeKeyCoinOut, collateralOut.String()),
+			sdk.NewAttribute(types.AttributeKeyLTV, ltv.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgDeleverageResponse{
+		CollateralOut: collateralOut,
+		RepayIn:       repayIn,
+		Ltv:           ltv,
+	}, nil
+}
+
 func (k Keeper) getBacking(ctx sdk.Context, denom string) (total types.TotalBacking, pool types.PoolBacking, err error) {
 	total, found := k.GetTotalBacking(ctx)
 	if !found {
@@ -6,11 +6,65 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	keepertest "github.com/petri-labs/warmage/testutil/keeper"
+	warmage "github.com/petri-labs/warmage/types"
 	"github.com/petri-labs/warmage/x/maker/keeper"
 	"github.com/petri-labs/warmage/x/maker/types"
+	oracletypes "github.com/petri-labs/warmage/x/oracle/types"
 )
 
 func setupMsgServer(t testing.TB) (types.MsgServer, context.Context) {
 	k, ctx := keepertest.MakerKeeper(t)
 	return keeper.NewMsgServerImpl(*k), sdk.WrapSDKContext(ctx)
 }
+
+func (suite *KeeperTestSuite) TestExchangeRateHeld() {
+	suite.SetupTest()
+	suite.setupEstimationTest()
+	msgServer := keeper.NewMsgServerImpl(suite.app.MakerKeeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	mintBySwap := &types.MsgMintBySwap{
+		Sender:       suite.accAddress.String(),
+		BackingInMax: sdk.NewCoin(suite.bcDenom, sdk.NewInt(1_000000)),
+		MageInMax:    sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt()),
+		MintOutMin:   sdk.NewCoin(warmage.MicroUSWDenom, sdk.ZeroInt()),
+		FullBacking:  true,
+	}
+	liquidate := &types.MsgLiquidateCollateral{
+		Sender:     suite.accAddress.String(),
+		Debtor:     suite.accAddress.String(),
+		Collateral: sdk.NewCoin(suite.bcDenom, sdk.NewInt(1_000000)),
+		RepayInMax: sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(1_000000)),
+	}
+
+	// the oracle circuit breaker holds the rate of backing and collateral
+	status := oracletypes.ExchangeRateStatus{
+		Denom:        suite.bcDenom,
+		ExchangeRate: sdk.NewDecWithPrec(99, 2),
+		HeldRounds:   1,
+	}
+	suite.app.OracleKeeper.SetExchangeRateStatus(suite.ctx, status)
+	_, err := msgServer.MintBySwap(ctx, mintBySwap)
+	suite.Require().ErrorIs(err, types.ErrExchangeRateHeld)
+	_, err = msgServer.LiquidateCollateral(ctx, liquidate)
+	suite.Require().ErrorIs(err, types.ErrExchangeRateHeld)
+
+	// a held rate of mage also stops operations
+	suite.app.OracleKeeper.SetExchangeRateStatus(suite.ctx, oracletypes.ExchangeRateStatus{
+		Denom:        warmage.AttoMageDenom,
+		ExchangeRate: sdk.NewDecWithPrec(100, 12),
+		HeldRounds:   1,
+	})
+	status.HeldRounds = 0
+	suite.app.OracleKeeper.SetExchangeRateStatus(suite.ctx, status)
+	_, err = msgServer.MintBySwap(ctx, mintBySwap)
+	suite.Require().ErrorIs(err, types.ErrExchangeRateHeld)
+
+	// fresh rates
+	suite.app.OracleKeeper.SetExchangeRateStatus(suite.ctx, oracletypes.ExchangeRateStatus{
+		Denom:        warmage.AttoMageDenom,
+		ExchangeRate: sdk.NewDecWithPrec(100, 12),
+	})
+	_, err = msgServer.LiquidateCollateral(ctx, liquidate)
+	suite.Require().NotErrorIs(err, types.ErrExchangeRateHeld)
+}
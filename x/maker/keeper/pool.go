@@ -124,6 +124,27 @@ func (k Keeper) GetAccountCollateral(ctx sdk.Context, addr sdk.AccAddress, denom
 	return collateral, true
 }
 
+// IterateAccountCollateral iterates over all account collateral, and calls cb
+// until it returns true.
+func (k Keeper) IterateAccountCollateral(ctx sdk.Context, cb func(col types.AccountCollateral) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCollateralAccount)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var col types.AccountCollateral
+		k.cdc.MustUnmarshal(iterator.Value(), &col)
+		if cb(col) {
+			break
+		}
+	}
+}
+
+func (k Keeper) DeleteAccountCollateral(ctx sdk.Context, addr sdk.AccAddress, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCollateralAccount)
+	store.Delete(keyByAddrDenom(types.KeyPrefixCollateralAccount, addr, denom))
+}
+
 func keyByAddrDenom(prefix []byte, addr sdk.AccAddress, denom string) (key []byte) {
 	key = append(prefix, address.MustLengthPrefix(addr)...)
 	return append(key, []byte(denom)...)
@@ -37,6 +37,10 @@ func HandleRegisterBackingProposal(ctx sdk.Context, k Keeper, p *types.RegisterB
 		dec := sdk.ZeroDec()
 		params.RebackFee = &dec
 	}
+	if params.TargetWeight == nil {
+		dec := sdk.ZeroDec()
+		params.TargetWeight = &dec
+	}
 
 	if err := validateBackingRiskParams(ctx, k, &params); err != nil {
 		return err
@@ -184,6 +188,12 @@ func setBackingRiskParamsProposal(ctx sdk.Context, k Keeper, patch *types.Backin
 	updated |= updateDecimal(params.BurnFee, patch.BurnFee)
 	updated |= updateDecimal(params.BuybackFee, patch.BuybackFee)
 	updated |= updateDecimal(params.RebackFee, patch.RebackFee)
+	if params.TargetWeight == nil && patch.TargetWeight != nil {
+		// registered before target weight was introduced
+		dec := sdk.ZeroDec()
+		params.TargetWeight = &dec
+	}
+	updated |= updateDecimal(params.TargetWeight, patch.TargetWeight)
 
 	if updated > 0 {
 		if err := validateBackingRiskParams(ctx, k, &params); err != nil {
@@ -250,6 +260,15 @@ func validateBackingRiskParams(ctx sdk.Context, keeper Keeper, params *types.Bac
 	if params.RebackFee.GT(keeper.RebackBonus(ctx)) {
 		return sdkerrors.Wrap(types.ErrBackingParamsInvalid, "reback fee ratio should not be greater than reback bonus ratio")
 	}
+	totalWeight := targetWeight(params)
+	for _, other := range keeper.GetAllBackingRiskParams(ctx) {
+		if other.BackingDenom != params.BackingDenom {
+			totalWeight = totalWeight.Add(targetWeight(&other))
+		}
+	}
+	if totalWeight.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(types.ErrBackingParamsInvalid, "sum of backing target weights should not be greater than 1, is %s", totalWeight)
+	}
 	return nil
 }
 
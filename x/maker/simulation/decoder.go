@@ -0,0 +1,76 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/petri-labs/warmage/x/maker/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding maker type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixBackingRatio):
+			var ratioA, ratioB sdk.DecProto
+			cdc.MustUnmarshal(kvA.Value, &ratioA)
+			cdc.MustUnmarshal(kvB.Value, &ratioB)
+			return fmt.Sprintf("%v\n%v", ratioA, ratioB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixBackingRatioLastBlock):
+			return fmt.Sprintf("%v\n%v", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixBackingParams):
+			var paramsA, paramsB types.BackingRiskParams
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixCollateralParams):
+			var paramsA, paramsB types.CollateralRiskParams
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixBackingTotal):
+			var totalA, totalB types.TotalBacking
+			cdc.MustUnmarshal(kvA.Value, &totalA)
+			cdc.MustUnmarshal(kvB.Value, &totalB)
+			return fmt.Sprintf("%v\n%v", totalA, totalB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixCollateralTotal):
+			var totalA, totalB types.TotalCollateral
+			cdc.MustUnmarshal(kvA.Value, &totalA)
+			cdc.MustUnmarshal(kvB.Value, &totalB)
+			return fmt.Sprintf("%v\n%v", totalA, totalB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixBackingPool):
+			var poolA, poolB types.PoolBacking
+			cdc.MustUnmarshal(kvA.Value, &poolA)
+			cdc.MustUnmarshal(kvB.Value, &poolB)
+			return fmt.Sprintf("%v\n%v", poolA, poolB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixCollateralPool):
+			var poolA, poolB types.PoolCollateral
+			cdc.MustUnmarshal(kvA.Value, &poolA)
+			cdc.MustUnmarshal(kvB.Value, &poolB)
+			return fmt.Sprintf("%v\n%v", poolA, poolB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixCollateralAccount):
+			var colA, colB types.AccountCollateral
+			cdc.MustUnmarshal(kvA.Value, &colA)
+			cdc.MustUnmarshal(kvB.Value, &colB)
+			return fmt.Sprintf("%v\n%v", colA, colB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixCollateralManager):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
+		default:
+			panic(fmt.Sprintf("invalid maker key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
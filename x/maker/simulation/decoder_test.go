@@ -0,0 +1,67 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+
+	warmage "github.com/petri-labs/warmage/types"
+	"github.com/petri-labs/warmage/x/maker/simulation"
+	"github.com/petri-labs/warmage/x/maker/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := simulation.NewDecodeStore(cdc)
+
+	ratio := sdk.DecProto{Dec: sdk.NewDecWithPrec(95, 2)}
+	pool := types.PoolBacking{
+		WarMinted:  sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(100)),
+		Backing:    sdk.NewCoin("usimusdc", sdk.NewInt(90)),
+		MageBurned: sdk.NewCoin(warmage.AttoMageDenom, sdk.NewInt(10)),
+	}
+	col := types.AccountCollateral{
+		Account:            "account",
+		Collateral:         sdk.NewCoin("usimatom", sdk.NewInt(100)),
+		WarDebt:            sdk.NewCoin(warmage.MicroUSWDenom, sdk.NewInt(50)),
+		MageCollateralized: sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt()),
+		LastInterest:       sdk.NewCoin(warmage.MicroUSWDenom, sdk.ZeroInt()),
+	}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.KeyPrefixBackingRatio, Value: cdc.MustMarshal(&ratio)},
+			{Key: types.KeyPrefixBackingRatioLastBlock, Value: sdk.Uint64ToBigEndian(10)},
+			{Key: append(types.KeyPrefixBackingPool, []byte("usimusdc")...), Value: cdc.MustMarshal(&pool)},
+			{Key: append(types.KeyPrefixCollateralAccount, []byte("usimatom")...), Value: cdc.MustMarshal(&col)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"BackingRatio", fmt.Sprintf("%v\n%v", ratio, ratio)},
+		{"BackingRatioLastBlock", "10\n10"},
+		{"PoolBacking", fmt.Sprintf("%v\n%v", pool, pool)},
+		{"AccountCollateral", fmt.Sprintf("%v\n%v", col, col)},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
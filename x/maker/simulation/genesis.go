@@ -0,0 +1,344 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	warmage "github.com/petri-labs/warmage/types"
+	"github.com/petri-labs/warmage/x/maker/types"
+	oracletypes "github.com/petri-labs/warmage/x/oracle/types"
+)
+
+// Simulation parameter constants
+const (
+	backingRatioStep           = "backing_ratio_step"
+	backingRatioPriceBand      = "backing_ratio_price_band"
+	backingRatioCooldownPeriod = "backing_ratio_cooldown_period"
+	mintPriceBias              = "mint_price_bias"
+	burnPriceBias              = "burn_price_bias"
+	rebackBonus                = "reback_bonus"
+	liquidationCommissionFee   = "liquidation_commission_fee"
+	backingRatio               = "backing_ratio"
+)
+
+var (
+	// BackingDenoms are the backing coins registered in simulation genesis.
+	BackingDenoms = []string{"usimusdc", "usimusdt"}
+	// CollateralDenoms are the collateral coins registered in simulation genesis.
+	CollateralDenoms = []string{"usimatom", "usimosmo"}
+
+	// initial balance of mage per account
+	simMageBalance = sdk.NewIntWithDecimal(10_000, 18)
+)
+
+// GenBackingRatioStep randomized BackingRatioStep
+func GenBackingRatioStep(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100)), 4)
+}
+
+// GenBackingRatioPriceBand randomized BackingRatioPriceBand
+func GenBackingRatioPriceBand(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 50)), 3)
+}
+
+// GenBackingRatioCooldownPeriod randomized BackingRatioCooldownPeriod
+func GenBackingRatioCooldownPeriod(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 100))
+}
+
+// GenPriceBias randomized MintPriceBias and BurnPriceBias
+func GenPriceBias(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 50)), 3)
+}
+
+// GenRebackBonus randomized RebackBonus
+func GenRebackBonus(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(200)), 4)
+}
+
+// GenLiquidationCommissionFee randomized LiquidationCommissionFee
+func GenLiquidationCommissionFee(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(50)), 2)
+}
+
+// GenBackingRatio randomized BackingRatio
+func GenBackingRatio(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 50, 101)), 2)
+}
+
+// GenBackingRiskParams randomized BackingRiskParams of denom
+func GenBackingRiskParams(r *rand.Rand, denom string) types.BackingRiskParams {
+	maxBacking := simtypes.RandomAmount(r, sdk.NewInt(100_000_000000)).Add(sdk.NewInt(1_000_000000))
+	maxWarMint := simtypes.RandomAmount(r, sdk.NewInt(100_000_000000)).Add(sdk.NewInt(1_000_000000))
+	mintFee := sdk.NewDecWithPrec(int64(r.Intn(100)), 4)
+	burnFee := sdk.NewDecWithPrec(int64(r.Intn(100)), 4)
+	buybackFee := sdk.NewDecWithPrec(int64(r.Intn(100)), 4)
+	rebackFee := sdk.NewDecWithPrec(int64(r.Intn(100)), 4)
+	return types.BackingRiskParams{
+		BackingDenom: denom,
+		Enabled:      true,
+		MaxBacking:   &maxBacking,
+		MaxWarMint:   &maxWarMint,
+		MintFee:      &mintFee,
+		BurnFee:      &burnFee,
+		BuybackFee:   &buybackFee,
+		RebackFee:    &rebackFee,
+	}
+}
+
+// GenCollateralRiskParams randomized CollateralRiskParams of denom
+func GenCollateralRiskParams(r *rand.Rand, denom string) types.CollateralRiskParams {
+	maxCollateral := simtypes.RandomAmount(r, sdk.NewInt(100_000_000000)).Add(sdk.NewInt(1_000_000000))
+	maxWarMint := simtypes.RandomAmount(r, sdk.NewInt(100_000_000000)).Add(sdk.NewInt(1_000_000000))
+	liquidationThreshold := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 70, 95)), 2)
+	loanToValue := liquidationThreshold.Sub(sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 20)), 2))
+	basicLoanToValue := loanToValue.Sub(sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 30)), 2))
+	catalyticMageRatio := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 20)), 2)
+	liquidationFee := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 20)), 2)
+	mintFee := sdk.NewDecWithPrec(int64(r.Intn(100)), 4)
+	interestFee := sdk.NewDecWithPrec(int64(r.Intn(20)), 2)
+	return types.CollateralRiskParams{
+		CollateralDenom:      denom,
+		Enabled:              true,
+		MaxCollateral:        &maxCollateral,
+		MaxWarMint:           &maxWarMint,
+		LiquidationThreshold: &liquidationThreshold,
+		LoanToValue:          &loanToValue,
+		BasicLoanToValue:     &basicLoanToValue,
+		CatalyticMageRatio:   &catalyticMageRatio,
+		LiquidationFee:       &liquidationFee,
+		MintFee:              &mintFee,
+		InterestFee:          &interestFee,
+	}
+}
+
+// GenPrice randomized exchange rate of a simulated coin denominated in uUSD
+func GenPrice(r *rand.Rand, denom string) sdk.Dec {
+	switch {
+	case denom == warmage.MicroUSWDenom:
+		// around the target price, so that both minting and burning happen
+		return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 970, 1030)), 3)
+	case denom == warmage.AttoMageDenom:
+		// 1 - 1000 USD per mage, i.e., 1e-12 - 1e-9 uUSD per amage
+		return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 1000)), 12)
+	case strings.HasSuffix(denom, "usdc") || strings.HasSuffix(denom, "usdt"):
+		return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 990, 1010)), 3)
+	default:
+		// 1 - 100 USD per coin
+		return sdk.NewDec(int64(simtypes.RandIntBetween(r, 1, 100)))
+	}
+}
+
+// RandomizedGenState generates a random GenesisState for maker. Since maker
+// relies on the simulated coins and on their prices, it also funds the
+// simulation accounts with mage, registers the coin metadata in the bank
+// genesis and seeds the oracle genesis with targets quoted by the genesis
+// validators.
+func RandomizedGenState(simState *module.SimulationState) {
+	var params types.Params
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, backingRatioStep, &params.BackingRatioStep, simState.Rand,
+		func(r *rand.Rand) { params.BackingRatioStep = GenBackingRatioStep(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, backingRatioPriceBand, &params.BackingRatioPriceBand, simState.Rand,
+		func(r *rand.Rand) { params.BackingRatioPriceBand = GenBackingRatioPriceBand(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, backingRatioCooldownPeriod, &params.BackingRatioCooldownPeriod, simState.Rand,
+		func(r *rand.Rand) { params.BackingRatioCooldownPeriod = GenBackingRatioCooldownPeriod(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, mintPriceBias, &params.MintPriceBias, simState.Rand,
+		func(r *rand.Rand) { params.MintPriceBias = GenPriceBias(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, burnPriceBias, &params.BurnPriceBias, simState.Rand,
+		func(r *rand.Rand) { params.BurnPriceBias = GenPriceBias(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, rebackBonus, &params.RebackBonus, simState.Rand,
+		func(r *rand.Rand) { params.RebackBonus = GenRebackBonus(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, liquidationCommissionFee, &params.LiquidationCommissionFee, simState.Rand,
+		func(r *rand.Rand) { params.LiquidationCommissionFee = GenLiquidationCommissionFee(r) },
+	)
+
+	var ratio sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, backingRatio, &ratio, simState.Rand,
+		func(r *rand.Rand) { ratio = GenBackingRatio(r) },
+	)
+
+	makerGenesis := types.GenesisState{
+		Params:       params,
+		BackingRatio: ratio,
+		TotalBacking: &types.TotalBacking{
+			BackingValue: sdk.ZeroInt(),
+			WarMinted:    sdk.NewCoin(warmage.MicroUSWDenom, sdk.ZeroInt()),
+			MageBurned:   sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt()),
+		},
+		TotalCollateral: &types.TotalCollateral{
+			WarDebt:            sdk.NewCoin(warmage.MicroUSWDenom, sdk.ZeroInt()),
+			MageCollateralized: sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt()),
+		},
+	}
+	for _, denom := range BackingDenoms {
+		makerGenesis.BackingRiskParams = append(makerGenesis.BackingRiskParams, GenBackingRiskParams(simState.Rand, denom))
+		makerGenesis.BackingPools = append(makerGenesis.BackingPools, types.PoolBacking{
+			WarMinted:  sdk.NewCoin(warmage.MicroUSWDenom, sdk.ZeroInt()),
+			Backing:    sdk.NewCoin(denom, sdk.ZeroInt()),
+			MageBurned: sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt()),
+		})
+	}
+	for _, denom := range CollateralDenoms {
+		makerGenesis.CollateralRiskParams = append(makerGenesis.CollateralRiskParams, GenCollateralRiskParams(simState.Rand, denom))
+		makerGenesis.CollateralPools = append(makerGenesis.CollateralPools, types.PoolCollateral{
+			Collateral:         sdk.NewCoin(denom, sdk.ZeroInt()),
+			WarDebt:            sdk.NewCoin(warmage.MicroUSWDenom, sdk.ZeroInt()),
+			MageCollateralized: sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt()),
+		})
+	}
+
+	bz, err := json.MarshalIndent(&makerGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated maker parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&makerGenesis)
+
+	randomizedBankGenState(simState)
+	randomizedOracleGenState(simState)
+}
+
+// randomizedBankGenState funds the simulation accounts with mage and registers
+// the metadata of the simulated coins and of the bond denom. The bank genesis
+// registers every coin held to erc20 by calling the EVM, which is not set up
+// yet, so the simulated backing and collateral coins are only minted to the
+// accounts by the operations.
+func randomizedBankGenState(simState *module.SimulationState) {
+	bankGenesis := banktypes.DefaultGenesisState()
+	if bz, ok := simState.GenState[banktypes.ModuleName]; ok {
+		simState.Cdc.MustUnmarshalJSON(bz, bankGenesis)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(warmage.AttoMageDenom, simMageBalance))
+	for _, denom := range append(BackingDenoms, CollateralDenoms...) {
+		bankGenesis.DenomMetadata = append(bankGenesis.DenomMetadata, simDenomMetadata(denom))
+	}
+
+	// the bank simulation funds accounts with the bond denom of staking
+	bondDenom := sdk.DefaultBondDenom
+	if bz, ok := simState.GenState[stakingtypes.ModuleName]; ok {
+		var stakingGenesis stakingtypes.GenesisState
+		simState.Cdc.MustUnmarshalJSON(bz, &stakingGenesis)
+		bondDenom = stakingGenesis.Params.BondDenom
+	}
+	if !hasDenomMetadata(bankGenesis.DenomMetadata, warmage.MicroUSWDenom) {
+		bankGenesis.DenomMetadata = append(bankGenesis.DenomMetadata, simDenomMetadata(warmage.MicroUSWDenom))
+	}
+	if !strings.Contains(bondDenom, warmage.DisplayDenom) && !hasDenomMetadata(bankGenesis.DenomMetadata, bondDenom) {
+		bankGenesis.DenomMetadata = append(bankGenesis.DenomMetadata, banktypes.Metadata{
+			Description: fmt.Sprintf("The simulated %s coin.", bondDenom),
+			DenomUnits:  []*banktypes.DenomUnit{{Denom: bondDenom, Exponent: 0}},
+			Base:        bondDenom,
+			Display:     bondDenom,
+			Name:        strings.ToUpper(bondDenom),
+			Symbol:      strings.ToUpper(bondDenom),
+		})
+	}
+
+	// only fund the simulation accounts, never module accounts
+	indexes := make(map[string]int)
+	for i, balance := range bankGenesis.Balances {
+		indexes[balance.Address] = i
+	}
+	for _, acc := range simState.Accounts {
+		address := acc.Address.String()
+		if i, ok := indexes[address]; ok {
+			bankGenesis.Balances[i].Coins = bankGenesis.Balances[i].Coins.Add(coins...)
+		} else {
+			bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{
+				Address: address,
+				Coins:   coins,
+			})
+		}
+		bankGenesis.Supply = bankGenesis.Supply.Add(coins...)
+	}
+
+	simState.GenState[banktypes.ModuleName] = simState.Cdc.MustMarshalJSON(bankGenesis)
+}
+
+// randomizedOracleGenState registers all coins used by maker as oracle targets
+// quoted by validators, with random prices. The oracle clears the exchange
+// rates of genesis at the end of the first vote period, so each genesis
+// validator also votes the prices, which are tallied then. The last accepted
+// prices are held by the circuit breaker while no votes follow.
+func randomizedOracleGenState(simState *module.SimulationState) {
+	oracleGenesis := oracletypes.DefaultGenesis()
+	if bz, ok := simState.GenState[oracletypes.ModuleName]; ok {
+		simState.Cdc.MustUnmarshalJSON(bz, oracleGenesis)
+	}
+
+	denoms := append([]string{warmage.MicroUSWDenom, warmage.AttoMageDenom}, BackingDenoms...)
+	denoms = append(denoms, CollateralDenoms...)
+	var rates oracletypes.ExchangeRateTuples
+	for _, denom := range denoms {
+		rate := GenPrice(simState.Rand, denom)
+		rates = append(rates, oracletypes.NewExchangeRateTuple(denom, rate))
+		oracleGenesis.Targets = append(oracleGenesis.Targets, oracletypes.TargetParams{
+			Denom:  denom,
+			Source: oracletypes.TARGET_SOURCE_VALIDATORS,
+		})
+		oracleGenesis.ExchangeRateStatuses = append(oracleGenesis.ExchangeRateStatuses, oracletypes.ExchangeRateStatus{
+			Denom:        denom,
+			ExchangeRate: rate,
+			Time:         simState.GenTimestamp,
+		})
+	}
+	oracleGenesis.ExchangeRates = append(oracleGenesis.ExchangeRates, rates...)
+
+	var stakingGenesis stakingtypes.GenesisState
+	if bz, ok := simState.GenState[stakingtypes.ModuleName]; ok {
+		simState.Cdc.MustUnmarshalJSON(bz, &stakingGenesis)
+	}
+	// only the votes of the validators bonded at genesis are tallied
+	for _, validator := range stakingGenesis.Validators {
+		oracleGenesis.AggregateExchangeRateVotes = append(oracleGenesis.AggregateExchangeRateVotes,
+			oracletypes.NewAggregateExchangeRateVote(rates, validator.GetOperator()))
+	}
+
+	simState.GenState[oracletypes.ModuleName] = simState.Cdc.MustMarshalJSON(oracleGenesis)
+}
+
+func simDenomMetadata(base string) banktypes.Metadata {
+	display := base[1:] // e.g., simusdc
+	return banktypes.Metadata{
+		Description: fmt.Sprintf("The simulated %s coin.", display),
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: base, Exponent: 0},
+			{Denom: display, Exponent: 6},
+		},
+		Base:    base,
+		Display: display,
+		Name:    strings.ToUpper(display),
+		Symbol:  strings.ToUpper(display),
+	}
+}
+
+func hasDenomMetadata(metadata []banktypes.Metadata, base string) bool {
+	for _, m := range metadata {
+		if m.Base == base {
+			return true
+		}
+	}
+	return false
+}
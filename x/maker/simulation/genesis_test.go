@@ -0,0 +1,111 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingsim "github.com/cosmos/cosmos-sdk/x/staking/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	warmage "github.com/petri-labs/warmage/types"
+	"github.com/petri-labs/warmage/x/maker/simulation"
+	"github.com/petri-labs/warmage/x/maker/types"
+	oracletypes "github.com/petri-labs/warmage/x/oracle/types"
+)
+
+// TestRandomizedGenState tests the normal scenario of applying RandomizedGenState.
+// Abnormal scenarios are not tested here.
+func TestRandomizedGenState(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(r, 3),
+		InitialStake: 1000,
+		UnbondTime:   time.Hour,
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	stakingsim.RandomizedGenState(&simState)
+	// a module account funded before, which must not be funded by maker
+	notBondedPool := banktypes.Balance{
+		Address: authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))),
+	}
+	simState.GenState[banktypes.ModuleName] = simState.Cdc.MustMarshalJSON(&banktypes.GenesisState{
+		Balances: []banktypes.Balance{notBondedPool},
+		Supply:   notBondedPool.Coins,
+	})
+	simulation.RandomizedGenState(&simState)
+
+	var makerGenesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &makerGenesis)
+	require.NoError(t, makerGenesis.Validate())
+	require.Len(t, makerGenesis.BackingRiskParams, len(simulation.BackingDenoms))
+	require.Len(t, makerGenesis.CollateralRiskParams, len(simulation.CollateralDenoms))
+	require.Len(t, makerGenesis.BackingPools, len(simulation.BackingDenoms))
+	require.Len(t, makerGenesis.CollateralPools, len(simulation.CollateralDenoms))
+
+	var bankGenesis banktypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[banktypes.ModuleName], &bankGenesis)
+	require.NoError(t, bankGenesis.Validate())
+	require.Len(t, bankGenesis.Balances, 4)
+	mage := sdk.ZeroInt()
+	for _, balance := range bankGenesis.Balances {
+		if balance.Address == notBondedPool.Address {
+			require.Equal(t, notBondedPool.Coins, balance.Coins)
+			continue
+		}
+		// only mage can be held at genesis
+		require.Len(t, balance.Coins, 1)
+		require.True(t, balance.Coins.AmountOf(warmage.AttoMageDenom).IsPositive())
+		mage = mage.Add(balance.Coins.AmountOf(warmage.AttoMageDenom))
+	}
+	require.Equal(t, mage, bankGenesis.Supply.AmountOf(warmage.AttoMageDenom))
+	require.Equal(t, notBondedPool.Coins.AmountOf(sdk.DefaultBondDenom), bankGenesis.Supply.AmountOf(sdk.DefaultBondDenom))
+	metadata := make(map[string]bool)
+	for _, m := range bankGenesis.DenomMetadata {
+		metadata[m.Base] = true
+	}
+	for _, denom := range append(simulation.BackingDenoms, simulation.CollateralDenoms...) {
+		require.True(t, metadata[denom], denom)
+	}
+	require.True(t, metadata[warmage.MicroUSWDenom])
+	require.True(t, metadata[sdk.DefaultBondDenom])
+
+	var oracleGenesis oracletypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[oracletypes.ModuleName], &oracleGenesis)
+	denoms := 2 + len(simulation.BackingDenoms) + len(simulation.CollateralDenoms)
+	require.Len(t, oracleGenesis.ExchangeRates, denoms)
+	for _, rate := range oracleGenesis.ExchangeRates {
+		require.True(t, rate.ExchangeRate.IsPositive(), rate.Denom)
+	}
+	require.Len(t, oracleGenesis.Targets, denoms)
+	for _, target := range oracleGenesis.Targets {
+		require.Equal(t, oracletypes.TARGET_SOURCE_VALIDATORS, target.Source)
+	}
+	require.Len(t, oracleGenesis.ExchangeRateStatuses, denoms)
+	// every genesis validator votes the genesis prices
+	require.Len(t, oracleGenesis.AggregateExchangeRateVotes, 3)
+	for _, vote := range oracleGenesis.AggregateExchangeRateVotes {
+		require.Equal(t, oracleGenesis.ExchangeRates, vote.ExchangeRateTuples)
+	}
+}
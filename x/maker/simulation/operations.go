@@ -0,0 +1,620 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	warmage "github.com/petri-labs/warmage/types"
+	"github.com/petri-labs/warmage/x/maker/keeper"
+	"github.com/petri-labs/warmage/x/maker/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgMintBySwap          = "op_weight_msg_mint_by_swap"
+	OpWeightMsgBurnBySwap          = "op_weight_msg_burn_by_swap"
+	OpWeightMsgBasketMint          = "op_weight_msg_basket_mint"
+	OpWeightMsgBasketBurn          = "op_weight_msg_basket_burn"
+	OpWeightMsgBuyBacking          = "op_weight_msg_buy_backing"
+	OpWeightMsgSellBacking         = "op_weight_msg_sell_backing"
+	OpWeightMsgDepositCollateral   = "op_weight_msg_deposit_collateral"
+	OpWeightMsgMintByCollateral    = "op_weight_msg_mint_by_collateral"
+	OpWeightMsgBurnByCollateral    = "op_weight_msg_burn_by_collateral"
+	OpWeightMsgRedeemCollateral    = "op_weight_msg_redeem_collateral"
+	OpWeightMsgLiquidateCollateral = "op_weight_msg_liquidate_collateral"
+	OpWeightMsgTransferPosition    = "op_weight_msg_transfer_position"
+	OpWeightMsgAuthorizeManager    = "op_weight_msg_authorize_manager"
+	OpWeightMsgRevokeManager       = "op_weight_msg_revoke_manager"
+
+	DefaultWeightMsgMintBySwap          = 100
+	DefaultWeightMsgBurnBySwap          = 80
+	DefaultWeightMsgBasketMint          = 50
+	DefaultWeightMsgBasketBurn          = 40
+	DefaultWeightMsgBuyBacking          = 30
+	DefaultWeightMsgSellBacking         = 30
+	DefaultWeightMsgDepositCollateral   = 100
+	DefaultWeightMsgMintByCollateral    = 80
+	DefaultWeightMsgBurnByCollateral    = 60
+	DefaultWeightMsgRedeemCollateral    = 40
+	DefaultWeightMsgLiquidateCollateral = 30
+	DefaultWeightMsgTransferPosition    = 10
+	DefaultWeightMsgAuthorizeManager    = 10
+	DefaultWeightMsgRevokeManager       = 5
+)
+
+var (
+	// maximum amount of a simulated coin used in a single operation
+	maxCoinIn = sdk.NewInt(10_000_000000)
+	// maximum amount of mage used in a single operation
+	maxMageIn = sdk.NewIntWithDecimal(100, 18)
+	// amount of each simulated coin minted to an account holding none
+	simCoinBalance = sdk.NewInt(1_000_000_000000)
+)
+
+// OracleKeeper defines the expected oracle keeper used to shock prices in
+// simulation.
+type OracleKeeper interface {
+	GetExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, error)
+	SetExchangeRate(ctx sdk.Context, denom string, exchangeRate sdk.Dec)
+}
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec,
+	ak types.AccountKeeper, bk types.BankKeeper, ok OracleKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	weight := func(key string, defaultWeight int) int {
+		var w int
+		appParams.GetOrGenerate(cdc, key, &w, nil, func(_ *rand.Rand) { w = defaultWeight })
+		return w
+	}
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgMintBySwap, DefaultWeightMsgMintBySwap),
+			SimulateMsgMintBySwap(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgBurnBySwap, DefaultWeightMsgBurnBySwap),
+			SimulateMsgBurnBySwap(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgBasketMint, DefaultWeightMsgBasketMint),
+			SimulateMsgBasketMint(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgBasketBurn, DefaultWeightMsgBasketBurn),
+			SimulateMsgBasketBurn(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgBuyBacking, DefaultWeightMsgBuyBacking),
+			SimulateMsgBuyBacking(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgSellBacking, DefaultWeightMsgSellBacking),
+			SimulateMsgSellBacking(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgDepositCollateral, DefaultWeightMsgDepositCollateral),
+			SimulateMsgDepositCollateral(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgMintByCollateral, DefaultWeightMsgMintByCollateral),
+			SimulateMsgMintByCollateral(ak, bk, ok, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgBurnByCollateral, DefaultWeightMsgBurnByCollateral),
+			SimulateMsgBurnByCollateral(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgRedeemCollateral, DefaultWeightMsgRedeemCollateral),
+			SimulateMsgRedeemCollateral(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgLiquidateCollateral, DefaultWeightMsgLiquidateCollateral),
+			SimulateMsgLiquidateCollateral(ak, bk, ok, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgTransferPosition, DefaultWeightMsgTransferPosition),
+			SimulateMsgTransferPosition(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgAuthorizeManager, DefaultWeightMsgAuthorizeManager),
+			SimulateMsgAuthorizeManager(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgRevokeManager, DefaultWeightMsgRevokeManager),
+			SimulateMsgRevokeManager(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgMintBySwap generates a MsgMintBySwap with random values.
+func SimulateMsgMintBySwap(ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable, err := fundSimCoins(ctx, bk, simAccount.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMintBySwap, "unable to mint simulated coins"), nil, err
+		}
+
+		backingIn := randomCoin(r, spendable, randomDenom(r, BackingDenoms), maxCoinIn)
+		if !backingIn.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMintBySwap, "no backing coin to swap"), nil, nil
+		}
+		fullBacking := r.Intn(2) == 0
+		mageIn := sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt())
+		if !fullBacking {
+			mageIn = randomCoin(r, spendable, warmage.AttoMageDenom, maxMageIn)
+		}
+
+		msg := &types.MsgMintBySwap{
+			Sender:       simAccount.Address.String(),
+			BackingInMax: backingIn,
+			MageInMax:    mageIn,
+			MintOutMin:   sdk.NewCoin(warmage.MicroUSWDenom, sdk.OneInt()),
+			FullBacking:  fullBacking,
+		}
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(backingIn, mageIn))
+	}
+}
+
+// SimulateMsgBurnBySwap generates a MsgBurnBySwap with random values.
+func SimulateMsgBurnBySwap(ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+
+		burnIn := randomCoin(r, spendable, warmage.MicroUSWDenom, maxCoinIn)
+		if !burnIn.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBurnBySwap, "no war to burn"), nil, nil
+		}
+
+		msg := &types.MsgBurnBySwap{
+			Sender:        simAccount.Address.String(),
+			BurnIn:        burnIn,
+			BackingOutMin: sdk.NewCoin(randomDenom(r, BackingDenoms), sdk.ZeroInt()),
+			MageOutMin:    sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt()),
+		}
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(burnIn))
+	}
+}
+
+// SimulateMsgBasketMint generates a MsgBasketMint with random values.
+func SimulateMsgBasketMint(ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable, err := fundSimCoins(ctx, bk, simAccount.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBasketMint, "unable to mint simulated coins"), nil, err
+		}
+
+		var backingIn sdk.Coins
+		for _, denom := range BackingDenoms {
+			backingIn = backingIn.Add(randomCoin(r, spendable, denom, maxCoinIn))
+		}
+		if backingIn.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBasketMint, "no backing coin to swap"), nil, nil
+		}
+		fullBacking := r.Intn(2) == 0
+		mageIn := sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt())
+		if !fullBacking {
+			mageIn = randomCoin(r, spendable, warmage.AttoMageDenom, maxMageIn)
+		}
+
+		msg := &types.MsgBasketMint{
+			Sender:       simAccount.Address.String(),
+			BackingInMax: backingIn,
+			MageInMax:    mageIn,
+			MintOutMin:   sdk.NewCoin(warmage.MicroUSWDenom, sdk.OneInt()),
+			FullBacking:  fullBacking,
+		}
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, backingIn.Add(mageIn))
+	}
+}
+
+// SimulateMsgBasketBurn generates a MsgBasketBurn with random values.
+func SimulateMsgBasketBurn(ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+
+		burnIn := randomCoin(r, spendable, warmage.MicroUSWDenom, maxCoinIn)
+		if !burnIn.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBasketBurn, "no war to burn"), nil, nil
+		}
+
+		backingOutMin := make(sdk.Coins, 0, len(BackingDenoms))
+		for _, denom := range BackingDenoms {
+			backingOutMin = append(backingOutMin, sdk.NewCoin(denom, sdk.ZeroInt()))
+		}
+
+		msg := &types.MsgBasketBurn{
+			Sender:        simAccount.Address.String(),
+			BurnIn:        burnIn,
+			BackingOutMin: backingOutMin,
+			MageOutMin:    sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt()),
+		}
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(burnIn))
+	}
+}
+
+// SimulateMsgBuyBacking generates a MsgBuyBacking with random values.
+func SimulateMsgBuyBacking(ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+
+		mageIn := randomCoin(r, spendable, warmage.AttoMageDenom, maxMageIn)
+		if !mageIn.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBuyBacking, "no mage to swap"), nil, nil
+		}
+
+		msg := &types.MsgBuyBacking{
+			Sender:        simAccount.Address.String(),
+			MageIn:        mageIn,
+			BackingOutMin: sdk.NewCoin(randomDenom(r, BackingDenoms), sdk.ZeroInt()),
+		}
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(mageIn))
+	}
+}
+
+// SimulateMsgSellBacking generates a MsgSellBacking with random values.
+func SimulateMsgSellBacking(ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable, err := fundSimCoins(ctx, bk, simAccount.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSellBacking, "unable to mint simulated coins"), nil, err
+		}
+
+		backingIn := randomCoin(r, spendable, randomDenom(r, BackingDenoms), maxCoinIn)
+		if !backingIn.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSellBacking, "no backing coin to swap"), nil, nil
+		}
+
+		msg := &types.MsgSellBacking{
+			Sender:     simAccount.Address.String(),
+			BackingIn:  backingIn,
+			MageOutMin: sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt()),
+		}
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(backingIn))
+	}
+}
+
+// SimulateMsgDepositCollateral generates a MsgDepositCollateral with random values.
+func SimulateMsgDepositCollateral(ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable, err := fundSimCoins(ctx, bk, simAccount.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDepositCollateral, "unable to mint simulated coins"), nil, err
+		}
+
+		collateralIn := randomCoin(r, spendable, randomDenom(r, CollateralDenoms), maxCoinIn)
+		if !collateralIn.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDepositCollateral, "no collateral coin to deposit"), nil, nil
+		}
+		mageIn := sdk.NewCoin(warmage.AttoMageDenom, sdk.ZeroInt())
+		if r.Intn(2) == 0 {
+			mageIn = randomCoin(r, spendable, warmage.AttoMageDenom, maxMageIn)
+		}
+
+		msg := &types.MsgDepositCollateral{
+			Sender:       simAccount.Address.String(),
+			CollateralIn: collateralIn,
+			MageIn:       mageIn,
+		}
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(collateralIn, mageIn))
+	}
+}
+
+// SimulateMsgMintByCollateral generates a MsgMintByCollateral with random
+// values, borrowing at most up to the basic loan-to-value of the position.
+func SimulateMsgMintByCollateral(ak types.AccountKeeper, bk types.BankKeeper, ok OracleKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		denom := randomDenom(r, CollateralDenoms)
+
+		accColl, found := k.GetAccountCollateral(ctx, simAccount.Address, denom)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMintByCollateral, "account has no collateral"), nil, nil
+		}
+		params, found := k.GetCollateralRiskParams(ctx, denom)
+		if !found || params.BasicLoanToValue == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMintByCollateral, "collateral not registered"), nil, nil
+		}
+		price, err := ok.GetExchangeRate(ctx, denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMintByCollateral, "no collateral price"), nil, nil
+		}
+
+		maxDebt := accColl.Collateral.Amount.ToDec().Mul(price).Mul(*params.BasicLoanToValue).Quo(warmage.MicroUSWTarget).TruncateInt()
+		available := maxDebt.Sub(accColl.WarDebt.Amount)
+		if !available.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMintByCollateral, "no available loan"), nil, nil
+		}
+		mintOut := sdk.NewCoin(warmage.MicroUSWDenom, simtypes.RandomAmount(r, available))
+		if !mintOut.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMintByCollateral, "zero mint amount"), nil, nil
+		}
+
+		msg := &types.MsgMintByCollateral{
+			Sender:          simAccount.Address.String(),
+			CollateralDenom: denom,
+			MintOut:         mintOut,
+		}
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, nil)
+	}
+}
+
+// SimulateMsgBurnByCollateral generates a MsgBurnByCollateral with random values.
+func SimulateMsgBurnByCollateral(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		denom := randomDenom(r, CollateralDenoms)
+
+		accColl, found := k.GetAccountCollateral(ctx, simAccount.Address, denom)
+		if !found || !accColl.WarDebt.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBurnByCollateral, "account has no debt"), nil, nil
+		}
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		repayIn := randomCoin(r, spendable, warmage.MicroUSWDenom, accColl.WarDebt.Amount)
+		if !repayIn.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBurnByCollateral, "no war to repay"), nil, nil
+		}
+
+		msg := &types.MsgBurnByCollateral{
+			Sender:          simAccount.Address.String(),
+			CollateralDenom: denom,
+			RepayInMax:      repayIn,
+		}
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(repayIn))
+	}
+}
+
+// SimulateMsgRedeemCollateral generates a MsgRedeemCollateral with random values.
+func SimulateMsgRedeemCollateral(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		denom := randomDenom(r, CollateralDenoms)
+
+		accColl, found := k.GetAccountCollateral(ctx, simAccount.Address, denom)
+		if !found || !accColl.Collateral.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRedeemCollateral, "account has no collateral"), nil, nil
+		}
+
+		msg := &types.MsgRedeemCollateral{
+			Sender:        simAccount.Address.String(),
+			CollateralOut: sdk.NewCoin(denom, simtypes.RandomAmount(r, accColl.Collateral.Amount)),
+			MageOut:       sdk.NewCoin(warmage.AttoMageDenom, simtypes.RandomAmount(r, accColl.MageCollateralized.Amount)),
+		}
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, nil)
+	}
+}
+
+// SimulateMsgLiquidateCollateral shocks the price of a random collateral with
+// debt, and then generates a MsgLiquidateCollateral against the debtor.
+func SimulateMsgLiquidateCollateral(ak types.AccountKeeper, bk types.BankKeeper, ok OracleKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var debts []types.AccountCollateral
+		k.IterateAccountCollateral(ctx, func(col types.AccountCollateral) bool {
+			if col.WarDebt.IsPositive() && col.Collateral.IsPositive() {
+				debts = append(debts, col)
+			}
+			return false
+		})
+		if len(debts) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgLiquidateCollateral, "no debt to liquidate"), nil, nil
+		}
+		debt := debts[r.Intn(len(debts))]
+
+		// random price drop of up to 50%
+		price, err := ok.GetExchangeRate(ctx, debt.Collateral.Denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgLiquidateCollateral, "no collateral price"), nil, nil
+		}
+		shock := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 50, 100)), 2)
+		ok.SetExchangeRate(ctx, debt.Collateral.Denom, price.Mul(shock))
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		if simAccount.Address.String() == debt.Account {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgLiquidateCollateral, "liquidator is the debtor"), nil, nil
+		}
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		repayInMax := sdk.NewCoin(warmage.MicroUSWDenom, spendable.AmountOf(warmage.MicroUSWDenom))
+		if !repayInMax.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgLiquidateCollateral, "no war to repay"), nil, nil
+		}
+
+		msg := &types.MsgLiquidateCollateral{
+			Sender:     simAccount.Address.String(),
+			Debtor:     debt.Account,
+			Collateral: sdk.NewCoin(debt.Collateral.Denom, simtypes.RandomAmount(r, debt.Collateral.Amount)),
+			RepayInMax: repayInMax,
+		}
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(repayInMax))
+	}
+}
+
+// SimulateMsgTransferPosition generates a MsgTransferPosition to a random account.
+func SimulateMsgTransferPosition(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		denom := randomDenom(r, CollateralDenoms)
+
+		if _, found := k.GetAccountCollateral(ctx, simAccount.Address, denom); !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferPosition, "account has no position"), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+		if to.Address.Equals(simAccount.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferPosition, "transfer to self"), nil, nil
+		}
+
+		msg := &types.MsgTransferPosition{
+			Sender:          simAccount.Address.String(),
+			To:              to.Address.String(),
+			CollateralDenom: denom,
+		}
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, nil)
+	}
+}
+
+// SimulateMsgAuthorizeManager generates a MsgAuthorizeManager for a random manager.
+func SimulateMsgAuthorizeManager(ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		manager, _ := simtypes.RandomAcc(r, accs)
+		if manager.Address.Equals(simAccount.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAuthorizeManager, "manager is the owner"), nil, nil
+		}
+
+		msg := &types.MsgAuthorizeManager{
+			Sender:  simAccount.Address.String(),
+			Manager: manager.Address.String(),
+		}
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, nil)
+	}
+}
+
+// SimulateMsgRevokeManager generates a MsgRevokeManager for a random existing manager.
+func SimulateMsgRevokeManager(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		managers := k.GetCollateralManagers(ctx, simAccount.Address)
+		if len(managers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeManager, "account has no manager"), nil, nil
+		}
+
+		msg := &types.MsgRevokeManager{
+			Sender:  simAccount.Address.String(),
+			Manager: managers[r.Intn(len(managers))].String(),
+		}
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, nil)
+	}
+}
+
+// deliver executes msg against a cached context first, so that the many
+// messages rejected by the maker rules end up as no-ops instead of failing
+// the simulation, and then delivers it in a transaction with random fees and
+// the gas used by the cached execution.
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+	ak types.AccountKeeper, bk types.BankKeeper,
+	simAccount simtypes.Account, msg legacytx.LegacyMsg, coinsSpent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	handler := app.MsgServiceRouter().Handler(msg)
+	if handler == nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no handler"), nil, nil
+	}
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	if _, err := handler(cacheCtx, msg); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+	}
+	// transfers of the simulated coins call their erc20 contracts, which may
+	// take far more than the default gas of the SDK
+	gas := cacheCtx.GasMeter().GasConsumed() + helpers.DefaultGenTxGas
+
+	account := ak.GetAccount(ctx, simAccount.Address)
+	spendable, hasNeg := bk.SpendableCoins(ctx, simAccount.Address).SafeSub(coinsSpent)
+	if hasNeg {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "message doesn't leave room for fees"), nil, nil
+	}
+	// fees are paid in mage, whose transfers call no erc20 contract
+	fees, err := simtypes.RandomFees(r, ctx, sdk.NewCoins(sdk.NewCoin(warmage.AttoMageDenom, spendable.AmountOf(warmage.AttoMageDenom))))
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		gas,
+		ctx.ChainID(),
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+	}
+
+	if _, _, err := app.Deliver(txGen.TxEncoder(), tx); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+	}
+	return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
+}
+
+// fundSimCoins mints the simulated backing and collateral coins to addr if it
+// holds none of them, and returns its spendable coins. The simulated coins
+// cannot be held at genesis, see randomizedBankGenState.
+func fundSimCoins(ctx sdk.Context, bk types.BankKeeper, addr sdk.AccAddress) (sdk.Coins, error) {
+	spendable := bk.SpendableCoins(ctx, addr)
+	var coins sdk.Coins
+	for _, denom := range append(BackingDenoms, CollateralDenoms...) {
+		if !bk.GetBalance(ctx, addr, denom).IsZero() {
+			return spendable, nil
+		}
+		coins = coins.Add(sdk.NewCoin(denom, simCoinBalance))
+	}
+
+	if err := bk.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+	if err := bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+		return nil, err
+	}
+	return bk.SpendableCoins(ctx, addr), nil
+}
+
+// randomCoin returns a coin of denom with a random amount not greater than
+// both the spendable balance and max.
+func randomCoin(r *rand.Rand, spendable sdk.Coins, denom string, max sdk.Int) sdk.Coin {
+	amount := sdk.MinInt(spendable.AmountOf(denom), max)
+	if !amount.IsPositive() {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+	return sdk.NewCoin(denom, simtypes.RandomAmount(r, amount))
+}
+
+func randomDenom(r *rand.Rand, denoms []string) string {
+	return denoms[r.Intn(len(denoms))]
+}
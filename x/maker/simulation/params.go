@@ -0,0 +1,42 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/petri-labs/warmage/x/maker/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBackingRatioStep),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenBackingRatioStep(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBackingRatioPriceBand),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenBackingRatioPriceBand(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMintPriceBias),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenPriceBias(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBurnPriceBias),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenPriceBias(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRebackBonus),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenRebackBonus(r))
+			},
+		),
+	}
+}
@@ -12,6 +12,8 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgMintBySwap{}, "warmage/MsgMintBySwap", nil)
 	cdc.RegisterConcrete(&MsgBurnBySwap{}, "warmage/MsgBurnBySwap", nil)
+	cdc.RegisterConcrete(&MsgBasketMint{}, "warmage/MsgBasketMint", nil)
+	cdc.RegisterConcrete(&MsgBasketBurn{}, "warmage/MsgBasketBurn", nil)
 	cdc.RegisterConcrete(&MsgBuyBacking{}, "warmage/MsgBuyBacking", nil)
 	cdc.RegisterConcrete(&MsgSellBacking{}, "warmage/MsgSellBacking", nil)
 	cdc.RegisterConcrete(&MsgMintByCollateral{}, "warmage/MsgMintByCollateral", nil)
@@ -19,6 +21,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDepositCollateral{}, "warmage/MsgDepositCollateral", nil)
 	cdc.RegisterConcrete(&MsgRedeemCollateral{}, "warmage/MsgRedeemCollateral", nil)
 	cdc.RegisterConcrete(&MsgLiquidateCollateral{}, "warmage/MsgLiquidateCollateral", nil)
+	cdc.RegisterConcrete(&MsgTransferPosition{}, "warmage/MsgTransferPosition", nil)
+	cdc.RegisterConcrete(&MsgAuthorizeManager{}, "warmage/MsgAuthorizeManager", nil)
+	cdc.RegisterConcrete(&MsgRevokeManager{}, "warmage/MsgRevokeManager", nil)
+	cdc.RegisterConcrete(&MsgLeverage{}, "warmage/MsgLeverage", nil)
+	cdc.RegisterConcrete(&MsgDeleverage{}, "warmage/MsgDeleverage", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
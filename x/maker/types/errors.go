@@ -41,4 +41,10 @@ var (
 
 	ErrLTVOutOfRange = sdkerrors.Register(ModuleName, 25, "LTV is out of range")
 	ErrOverSlippage  = sdkerrors.Register(ModuleName, 26, "over slippage")
+
+	ErrAccountPositionExists = sdkerrors.Register(ModuleName, 27, "account position already exists")
+	ErrNotAuthorizedManager  = sdkerrors.Register(ModuleName, 28, "not an authorized manager")
+
+	ErrNoSwapRoute      = sdkerrors.Register(ModuleName, 29, "no swap route")
+	ErrExchangeRateHeld = sdkerrors.Register(ModuleName, 30, "exchange rate held by oracle circuit breaker")
 )
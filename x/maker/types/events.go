@@ -4,6 +4,8 @@ package types
 const (
 	EventTypeMintBySwap          = "mint_by_swap"
 	EventTypeBurnBySwap          = "burn_by_swap"
+	EventTypeBasketMint          = "basket_mint"
+	EventTypeBasketBurn          = "basket_burn"
 	EventTypeBuyBacking          = "buy_backing"
 	EventTypeSellBacking         = "sell_backing"
 	EventTypeMintByCollateral    = "mint_by_collateral"
@@ -11,12 +13,21 @@ const (
 	EventTypeDepositCollateral   = "deposit_collateral"
 	EventTypeRedeemCollateral    = "redeem_collateral"
 	EventTypeLiquidateCollateral = "liquidate_collateral"
+	EventTypeTransferPosition    = "transfer_position"
+	EventTypeAuthorizeManager    = "authorize_manager"
+	EventTypeRevokeManager       = "revoke_manager"
+	EventTypeLeverage            = "leverage"
+	EventTypeDeleverage          = "deleverage"
 
 	AttributeKeySender   = "sender"
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyCoinIn   = "coin_in"
 	AttributeKeyCoinOut  = "coin_out"
 	AttributeKeyFee      = "fee"
+	AttributeKeyOwner    = "owner"
+	AttributeKeyManager  = "manager"
+	AttributeKeyDenom    = "denom"
+	AttributeKeyLTV      = "ltv"
 
 	EventTypeRegisterBacking         = "register_backing"
 	EventTypeRegisterCollateral      = "register_collateral"
@@ -8,10 +8,22 @@ import (
 // OracleKeeper defines the expected oracle keeper
 type OracleKeeper interface {
 	GetExchangeRate(ctx sdk.Context, denom string) (price sdk.Dec, err error)
+	IsExchangeRateHeld(ctx sdk.Context, denom string) bool
 	IsTarget(ctx sdk.Context, denom string) bool
+	AllocateMakerFees(ctx sdk.Context, senderModule string, fees sdk.Coins) error
 	// Methods imported from oracle should be defined here
 }
 
+// SwapRouter defines the expected swap route used by leveraging and
+// deleveraging, e.g., an on-chain DEX.
+type SwapRouter interface {
+	// Swap swaps coinIn of trader for coins of denomOut, and returns all the
+	// coins received by trader.
+	Swap(ctx sdk.Context, trader sdk.AccAddress, coinIn sdk.Coin, denomOut string) (coinsOut sdk.Coins, err error)
+	// HasRoute returns whether coins of denomIn can be swapped for denomOut.
+	HasRoute(ctx sdk.Context, denomIn, denomOut string) bool
+}
+
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
 type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) types.AccountI
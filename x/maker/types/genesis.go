@@ -1,6 +1,10 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
@@ -13,5 +17,70 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if gs.BackingRatio.IsNil() || gs.BackingRatio.IsNegative() || gs.BackingRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("backing ratio must be in [0, 1]: %s", gs.BackingRatio)
+	}
+
+	backingDenoms := make(map[string]bool)
+	for _, params := range gs.BackingRiskParams {
+		if err := sdk.ValidateDenom(params.BackingDenom); err != nil {
+			return err
+		}
+		if backingDenoms[params.BackingDenom] {
+			return fmt.Errorf("duplicate backing risk params for %s", params.BackingDenom)
+		}
+		backingDenoms[params.BackingDenom] = true
+		if err := validateBackingRiskParams(&params); err != nil {
+			return err
+		}
+	}
+
+	collateralDenoms := make(map[string]bool)
+	for _, params := range gs.CollateralRiskParams {
+		if err := sdk.ValidateDenom(params.CollateralDenom); err != nil {
+			return err
+		}
+		if collateralDenoms[params.CollateralDenom] {
+			return fmt.Errorf("duplicate collateral risk params for %s", params.CollateralDenom)
+		}
+		collateralDenoms[params.CollateralDenom] = true
+		if err := validateCollateralRiskParams(&params); err != nil {
+			return err
+		}
+	}
+
+	for _, pool := range gs.BackingPools {
+		if !backingDenoms[pool.Backing.Denom] {
+			return fmt.Errorf("backing pool of unregistered denom %s", pool.Backing.Denom)
+		}
+	}
+	for _, pool := range gs.CollateralPools {
+		if !collateralDenoms[pool.Collateral.Denom] {
+			return fmt.Errorf("collateral pool of unregistered denom %s", pool.Collateral.Denom)
+		}
+	}
+
+	for _, col := range gs.AccountCollaterals {
+		if _, err := sdk.AccAddressFromBech32(col.Account); err != nil {
+			return err
+		}
+		if !collateralDenoms[col.Collateral.Denom] {
+			return fmt.Errorf("account collateral of unregistered denom %s", col.Collateral.Denom)
+		}
+	}
+
+	for _, m := range gs.CollateralManagers {
+		if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(m.Manager); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
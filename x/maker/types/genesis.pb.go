@@ -26,15 +26,23 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the maker module's genesis state.
 type GenesisState struct {
-	Params       Params                                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	BackingRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=backing_ratio,json=backingRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"backing_ratio" yaml:"backing_ratio"`
+	Params               Params                                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	BackingRatio         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=backing_ratio,json=backingRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"backing_ratio" yaml:"backing_ratio"`
+	BackingRiskParams    []BackingRiskParams                    `protobuf:"bytes,3,rep,name=backing_risk_params,json=backingRiskParams,proto3" json:"backing_risk_params"`
+	CollateralRiskParams []CollateralRiskParams                 `protobuf:"bytes,4,rep,name=collateral_risk_params,json=collateralRiskParams,proto3" json:"collateral_risk_params"`
+	BackingPools         []PoolBacking                          `protobuf:"bytes,5,rep,name=backing_pools,json=backingPools,proto3" json:"backing_pools"`
+	CollateralPools      []PoolCollateral                       `protobuf:"bytes,6,rep,name=collateral_pools,json=collateralPools,proto3" json:"collateral_pools"`
+	TotalBacking         *TotalBacking                          `protobuf:"bytes,7,opt,name=total_backing,json=totalBacking,proto3" json:"total_backing,omitempty"`
+	TotalCollateral      *TotalCollateral                       `protobuf:"bytes,8,opt,name=total_collateral,json=totalCollateral,proto3" json:"total_collateral,omitempty"`
+	AccountCollaterals   []AccountCollateral                    `protobuf:"bytes,9,rep,name=account_collaterals,json=accountCollaterals,proto3" json:"account_collaterals"`
+	CollateralManagers   []CollateralManager                    `protobuf:"bytes,10,rep,name=collateral_managers,json=collateralManagers,proto3" json:"collateral_managers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60c1f3d62222051a, []int{0}
+	return fileDescriptor_ed4ea104ac4f22bc, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -70,6 +78,116 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetBackingRiskParams() []BackingRiskParams {
+	if m != nil {
+		return m.BackingRiskParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCollateralRiskParams() []CollateralRiskParams {
+	if m != nil {
+		return m.CollateralRiskParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBackingPools() []PoolBacking {
+	if m != nil {
+		return m.BackingPools
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCollateralPools() []PoolCollateral {
+	if m != nil {
+		return m.CollateralPools
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTotalBacking() *TotalBacking {
+	if m != nil {
+		return m.TotalBacking
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTotalCollateral() *TotalCollateral {
+	if m != nil {
+		return m.TotalCollateral
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccountCollaterals() []AccountCollateral {
+	if m != nil {
+		return m.AccountCollaterals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCollateralManagers() []CollateralManager {
+	if m != nil {
+		return m.CollateralManagers
+	}
+	return nil
+}
+
+// CollateralManager defines an authorized manager of an owner's collateral
+// positions, used in the maker module's genesis state.
+type CollateralManager struct {
+	Owner   string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Manager string `protobuf:"bytes,2,opt,name=manager,proto3" json:"manager,omitempty"`
+}
+
+func (m *CollateralManager) Reset()         { *m = CollateralManager{} }
+func (m *CollateralManager) String() string { return proto.CompactTextString(m) }
+func (*CollateralManager) ProtoMessage()    {}
+func (*CollateralManager) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4ea104ac4f22bc, []int{1}
+}
+func (m *CollateralManager) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollateralManager) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollateralManager.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CollateralManager) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollateralManager.Merge(m, src)
+}
+func (m *CollateralManager) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollateralManager) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollateralManager.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollateralManager proto.InternalMessageInfo
+
+func (m *CollateralManager) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *CollateralManager) GetManager() string {
+	if m != nil {
+		return m.Manager
+	}
+	return ""
+}
+
 // Params defines the parameters for the maker module.
 type Params struct {
 	// step of adjusting backing ratio
@@ -91,7 +209,7 @@ type Params struct {
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60c1f3d62222051a, []int{1}
+	return fileDescriptor_ed4ea104ac4f22bc, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -129,46 +247,62 @@ func (m *Params) GetBackingRatioCooldownPeriod() int64 {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "warmage.maker.v1.GenesisState")
+	proto.RegisterType((*CollateralManager)(nil), "warmage.maker.v1.CollateralManager")
 	proto.RegisterType((*Params)(nil), "warmage.maker.v1.Params")
 }
 
-func init() { proto.RegisterFile("warmage/maker/v1/genesis.proto", fileDescriptor_60c1f3d62222051a) }
-
-var fileDescriptor_60c1f3d62222051a = []byte{
-	// 525 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xb1, 0x6e, 0xd3, 0x40,
-	0x18, 0xc7, 0x73, 0xb4, 0x04, 0x7a, 0x4d, 0x44, 0x65, 0x0a, 0x32, 0x91, 0xb0, 0xc3, 0x09, 0xa1,
-	0x2c, 0xb1, 0x55, 0x90, 0x18, 0x3a, 0xba, 0xd0, 0x22, 0xb1, 0x84, 0xcb, 0xc6, 0x62, 0x9d, 0xed,
-	0x23, 0x3d, 0x39, 0xbe, 0x33, 0x3e, 0xa7, 0x10, 0x1e, 0x81, 0x09, 0x36, 0xc6, 0xbe, 0x03, 0x2f,
-	0xd1, 0xb1, 0x23, 0x62, 0x88, 0x50, 0xb2, 0xc0, 0xda, 0x27, 0x40, 0x77, 0x76, 0xa9, 0x13, 0x85,
-	0xc1, 0xea, 0x64, 0x7f, 0xf7, 0xff, 0xee, 0xff, 0xfb, 0x7f, 0x37, 0x7c, 0xd0, 0x4a, 0x68, 0x36,
-	0x66, 0x82, 0xbb, 0x09, 0x89, 0x69, 0xe6, 0x9e, 0xec, 0xb9, 0x23, 0xca, 0xa9, 0x64, 0xd2, 0x49,
-	0x33, 0x91, 0x0b, 0x63, 0xa7, 0xd4, 0x1d, 0xad, 0x3b, 0x27, 0x7b, 0x9d, 0xdd, 0x91, 0x18, 0x09,
-	0x2d, 0xba, 0xea, 0xaf, 0xe8, 0x43, 0xdf, 0x01, 0x6c, 0x1d, 0x15, 0x37, 0x87, 0x39, 0xc9, 0xa9,
-	0xf1, 0x1c, 0x36, 0x53, 0x92, 0x91, 0x44, 0x9a, 0xa0, 0x0b, 0x7a, 0xdb, 0x4f, 0x4d, 0x67, 0xd5,
-	0xc9, 0x19, 0x68, 0xdd, 0xdb, 0x3c, 0x9b, 0xd9, 0x0d, 0x5c, 0x76, 0x1b, 0x31, 0x6c, 0x07, 0x24,
-	0x8c, 0x19, 0x1f, 0xf9, 0x19, 0xc9, 0x99, 0x30, 0x6f, 0x74, 0x41, 0x6f, 0xcb, 0x3b, 0x54, 0x4d,
-	0x3f, 0x67, 0xf6, 0x93, 0x11, 0xcb, 0x8f, 0x27, 0x81, 0x13, 0x8a, 0xc4, 0x0d, 0x85, 0x4c, 0x84,
-	0x2c, 0x3f, 0x7d, 0x19, 0xc5, 0x6e, 0x3e, 0x4d, 0xa9, 0x74, 0x5e, 0xd0, 0xf0, 0x62, 0x66, 0xef,
-	0x4e, 0x49, 0x32, 0xde, 0x47, 0x4b, 0x66, 0x08, 0xb7, 0xca, 0x1a, 0xeb, 0xf2, 0x4f, 0x13, 0x36,
-	0x8b, 0x14, 0xc6, 0x14, 0x1a, 0x4b, 0xad, 0xbe, 0xcc, 0x69, 0xaa, 0xb3, 0x6f, 0x79, 0xaf, 0x6b,
-	0xc3, 0x1f, 0xac, 0x81, 0x6b, 0x47, 0x84, 0x77, 0xaa, 0x09, 0x86, 0x39, 0x4d, 0x8d, 0xcf, 0x00,
-	0x9a, 0xcb, 0x9d, 0x69, 0xc6, 0x42, 0xea, 0x07, 0x84, 0x47, 0xe5, 0xf8, 0x6f, 0x6a, 0x27, 0xb0,
-	0xd7, 0x25, 0xb8, 0xf2, 0x45, 0xf8, 0x5e, 0x35, 0xc7, 0x40, 0x09, 0x1e, 0xe1, 0x91, 0x11, 0xc3,
-	0x87, 0xcb, 0x77, 0x42, 0x21, 0xc6, 0x91, 0xf8, 0xc0, 0xfd, 0x94, 0x66, 0x4c, 0x44, 0xe6, 0x46,
-	0x17, 0xf4, 0x36, 0xbc, 0xde, 0xc5, 0xcc, 0x7e, 0xbc, 0x0e, 0xb1, 0xd2, 0x8e, 0x70, 0xa7, 0xca,
-	0x39, 0x28, 0xd5, 0x81, 0x16, 0x8d, 0x14, 0xde, 0x49, 0x18, 0xcf, 0x2f, 0x73, 0x31, 0x22, 0xcd,
-	0x4d, 0x3d, 0xef, 0xab, 0xda, 0xf3, 0xde, 0x2f, 0xc2, 0xac, 0xd8, 0x21, 0xdc, 0x56, 0x27, 0xc5,
-	0x78, 0x8c, 0x48, 0x45, 0x0c, 0x26, 0x19, 0xaf, 0x12, 0x6f, 0x5e, 0x8f, 0xb8, 0x62, 0x87, 0x70,
-	0x5b, 0x9d, 0x5c, 0x11, 0x8f, 0x61, 0x2b, 0xa3, 0xea, 0x0d, 0xfc, 0x40, 0xf0, 0x89, 0x34, 0x9b,
-	0x1a, 0xf7, 0xb2, 0x36, 0xee, 0x6e, 0x81, 0xab, 0x7a, 0x21, 0xbc, 0x5d, 0x94, 0x9e, 0xaa, 0x8c,
-	0xaf, 0x00, 0x76, 0xc6, 0xec, 0xfd, 0x84, 0x45, 0xea, 0xa9, 0xb9, 0x1f, 0x8a, 0x24, 0x61, 0x52,
-	0xaa, 0xdf, 0x77, 0x94, 0x9a, 0xb7, 0x34, 0x78, 0x58, 0x1b, 0xfc, 0xa8, 0x00, 0xff, 0xdf, 0x19,
-	0x61, 0xb3, 0x22, 0x1e, 0xfc, 0xd3, 0x0e, 0x29, 0xdd, 0xbf, 0xfd, 0xed, 0xd4, 0x6e, 0xfc, 0x3e,
-	0xb5, 0x81, 0x77, 0x74, 0x36, 0xb7, 0xc0, 0xf9, 0xdc, 0x02, 0xbf, 0xe6, 0x16, 0xf8, 0xb2, 0xb0,
-	0x1a, 0xe7, 0x0b, 0xab, 0xf1, 0x63, 0x61, 0x35, 0xde, 0xf6, 0x2b, 0x51, 0xca, 0x25, 0xd1, 0xff,
-	0x24, 0x38, 0xbd, 0x2c, 0xdc, 0x8f, 0xe5, 0x76, 0xd2, 0xa9, 0x82, 0xa6, 0xde, 0x38, 0xcf, 0xfe,
-	0x06, 0x00, 0x00, 0xff, 0xff, 0x7a, 0x0a, 0x88, 0x36, 0xbb, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("warmage/maker/v1/genesis.proto", fileDescriptor_ed4ea104ac4f22bc) }
+
+var fileDescriptor_ed4ea104ac4f22bc = []byte{
+	// 758 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4f, 0x4f, 0xdb, 0x48,
+	0x18, 0xc6, 0xe3, 0x0d, 0x04, 0x32, 0x24, 0x22, 0x0c, 0x59, 0xe4, 0x8d, 0x16, 0x27, 0x78, 0x57,
+	0x28, 0xd2, 0x8a, 0x44, 0xb0, 0x52, 0x0f, 0xdc, 0xea, 0x14, 0x8a, 0xd4, 0x56, 0x02, 0xd3, 0x4b,
+	0xb9, 0x58, 0x63, 0x67, 0x1a, 0xac, 0xd8, 0x1e, 0xd7, 0x33, 0x81, 0xf2, 0x15, 0x7a, 0x6a, 0x6f,
+	0x3d, 0xf2, 0x71, 0x38, 0x72, 0xac, 0x38, 0x44, 0x15, 0x5c, 0xda, 0x2b, 0x9f, 0xa0, 0x9a, 0x3f,
+	0x21, 0xce, 0xbf, 0x43, 0xd4, 0x53, 0x3c, 0x7e, 0xde, 0xf9, 0x3d, 0xcf, 0xbc, 0xf1, 0x6b, 0x03,
+	0xe3, 0x12, 0x25, 0x21, 0xea, 0xe0, 0x66, 0x88, 0xba, 0x38, 0x69, 0x5e, 0xec, 0x36, 0x3b, 0x38,
+	0xc2, 0xd4, 0xa7, 0x8d, 0x38, 0x21, 0x8c, 0xc0, 0x92, 0xd2, 0x1b, 0x42, 0x6f, 0x5c, 0xec, 0x56,
+	0xca, 0x1d, 0xd2, 0x21, 0x42, 0x6c, 0xf2, 0x2b, 0x59, 0x57, 0xf9, 0x7b, 0x82, 0x23, 0x37, 0x08,
+	0xd5, 0xbc, 0xcb, 0x81, 0xc2, 0x4b, 0xc9, 0x3d, 0x65, 0x88, 0x61, 0xf8, 0x0c, 0xe4, 0x62, 0x94,
+	0xa0, 0x90, 0xea, 0x5a, 0x4d, 0xab, 0xaf, 0xec, 0xe9, 0x8d, 0x71, 0x9f, 0xc6, 0xb1, 0xd0, 0xad,
+	0x85, 0x9b, 0x7e, 0x35, 0x63, 0xab, 0x6a, 0xd8, 0x05, 0x45, 0x17, 0x79, 0x5d, 0x3f, 0xea, 0x38,
+	0x09, 0x62, 0x3e, 0xd1, 0xff, 0xa8, 0x69, 0xf5, 0xbc, 0x75, 0xc8, 0x8b, 0xee, 0xfa, 0xd5, 0xed,
+	0x8e, 0xcf, 0xce, 0x7b, 0x6e, 0xc3, 0x23, 0x61, 0xd3, 0x23, 0x34, 0x24, 0x54, 0xfd, 0xec, 0xd0,
+	0x76, 0xb7, 0xc9, 0xae, 0x62, 0x4c, 0x1b, 0x2f, 0xb0, 0xf7, 0xd8, 0xaf, 0x96, 0xaf, 0x50, 0x18,
+	0xec, 0x9b, 0x23, 0x30, 0xd3, 0x2e, 0xa8, 0xb5, 0xcd, 0x97, 0xf0, 0x1d, 0x58, 0x7f, 0xd2, 0x7d,
+	0xda, 0x75, 0x54, 0xe2, 0x6c, 0x2d, 0x5b, 0x5f, 0xd9, 0xfb, 0x67, 0x32, 0xb1, 0xa5, 0x36, 0xfb,
+	0xb4, 0x3b, 0x12, 0x7e, 0xcd, 0x1d, 0x17, 0xa0, 0x0b, 0x36, 0x3c, 0x12, 0x04, 0x88, 0xe1, 0x04,
+	0x05, 0x23, 0xf4, 0x05, 0x41, 0xdf, 0x9e, 0xa4, 0xb7, 0x9e, 0xea, 0x27, 0x0c, 0xca, 0xde, 0x14,
+	0x0d, 0x1e, 0x0d, 0x7b, 0x15, 0x13, 0x12, 0x50, 0x7d, 0x51, 0xa0, 0x37, 0xa7, 0xb4, 0x9a, 0x90,
+	0x40, 0x85, 0x57, 0xc4, 0x41, 0x23, 0xb8, 0x42, 0xe1, 0x09, 0x28, 0xa5, 0xd2, 0x4a, 0x58, 0x4e,
+	0xc0, 0x6a, 0xd3, 0x61, 0xc3, 0xac, 0x8a, 0xb7, 0x3a, 0xdc, 0x2f, 0x91, 0x2d, 0x50, 0x64, 0x84,
+	0xa1, 0xc0, 0x51, 0x46, 0xfa, 0x92, 0x78, 0x0e, 0x8c, 0x49, 0xde, 0x5b, 0x5e, 0x36, 0x68, 0x6d,
+	0x81, 0xa5, 0x56, 0xf0, 0x35, 0x28, 0x49, 0xc8, 0x90, 0xae, 0x2f, 0x0b, 0xce, 0xd6, 0x0c, 0x4e,
+	0xaa, 0x89, 0xab, 0x6c, 0xf4, 0x06, 0x3c, 0x03, 0xeb, 0xc8, 0xf3, 0x48, 0x2f, 0x62, 0x29, 0x1e,
+	0xd5, 0xf3, 0xb3, 0xfe, 0xee, 0xe7, 0xb2, 0x78, 0xe2, 0xac, 0x10, 0x8d, 0x0b, 0x94, 0xb3, 0x53,
+	0x1d, 0x0c, 0x51, 0x84, 0x3a, 0x38, 0xa1, 0x3a, 0x98, 0xc5, 0x1e, 0xee, 0x7d, 0x23, 0x6b, 0x07,
+	0x6c, 0x6f, 0x5c, 0xa0, 0x66, 0x0b, 0xac, 0x4d, 0x94, 0xc3, 0x32, 0x58, 0x24, 0x97, 0x11, 0x4e,
+	0xc4, 0x7c, 0xe5, 0x6d, 0xb9, 0x80, 0x3a, 0x58, 0x52, 0xde, 0x72, 0x70, 0xec, 0xc1, 0xd2, 0xfc,
+	0x99, 0x03, 0x39, 0xf5, 0xdc, 0x5c, 0x01, 0x38, 0x32, 0x16, 0x0e, 0x65, 0x38, 0x96, 0x1c, 0xeb,
+	0xd5, 0xdc, 0x83, 0xf6, 0xd7, 0x94, 0x41, 0x13, 0x44, 0xd3, 0x2e, 0xa5, 0xa7, 0xed, 0x94, 0xe1,
+	0x18, 0x7e, 0xd2, 0x80, 0x3e, 0x5a, 0x19, 0x27, 0xbe, 0x87, 0x1d, 0x17, 0x45, 0x6d, 0x35, 0xea,
+	0x27, 0x73, 0x27, 0xa8, 0x4e, 0x4b, 0x30, 0xe4, 0x9a, 0xf6, 0x9f, 0xe9, 0x1c, 0xc7, 0x5c, 0xb0,
+	0x50, 0xd4, 0x86, 0x5d, 0xb0, 0x39, 0xba, 0xc7, 0x23, 0x24, 0x68, 0x93, 0xcb, 0xc8, 0x89, 0x71,
+	0xe2, 0x93, 0xb6, 0x9e, 0xad, 0x69, 0xf5, 0xac, 0x55, 0x7f, 0xec, 0x57, 0xff, 0x9d, 0x66, 0x31,
+	0x56, 0x6e, 0xda, 0x95, 0xb4, 0x4f, 0x4b, 0xa9, 0xc7, 0x42, 0x84, 0x31, 0x58, 0x0d, 0xfd, 0x88,
+	0x0d, 0x72, 0xf9, 0x88, 0xbf, 0x09, 0xf8, 0x79, 0x8f, 0xe6, 0x3e, 0xef, 0x86, 0x0c, 0x33, 0x86,
+	0x33, 0xed, 0x22, 0xbf, 0x23, 0x8f, 0xe7, 0x23, 0xca, 0x1d, 0xdd, 0x5e, 0x12, 0xa5, 0x1d, 0x17,
+	0x7f, 0xcf, 0x71, 0x0c, 0x67, 0xda, 0x45, 0x7e, 0x67, 0xe8, 0x78, 0x0e, 0x0a, 0x09, 0xe6, 0x3d,
+	0x70, 0x5c, 0x12, 0xf5, 0xf8, 0x2b, 0x84, 0xdb, 0x1d, 0xcc, 0x6d, 0xb7, 0x2e, 0xed, 0xd2, 0x2c,
+	0xd3, 0x5e, 0x91, 0x4b, 0x8b, 0xaf, 0xe0, 0x17, 0x0d, 0x54, 0x02, 0xff, 0x43, 0xcf, 0x6f, 0xf3,
+	0x56, 0x47, 0x8e, 0x47, 0xc2, 0xd0, 0xa7, 0x94, 0x5f, 0xbe, 0xc7, 0x58, 0xbc, 0x6b, 0xf2, 0xd6,
+	0xe9, 0xdc, 0xc6, 0x5b, 0xd2, 0x78, 0x36, 0xd9, 0xb4, 0xf5, 0x94, 0xd8, 0x7a, 0xd2, 0x0e, 0x31,
+	0xde, 0x5f, 0xfe, 0x7a, 0x5d, 0xcd, 0xfc, 0xb8, 0xae, 0x6a, 0xd6, 0xc1, 0xcd, 0xbd, 0xa1, 0xdd,
+	0xde, 0x1b, 0xda, 0xf7, 0x7b, 0x43, 0xfb, 0xfc, 0x60, 0x64, 0x6e, 0x1f, 0x8c, 0xcc, 0xb7, 0x07,
+	0x23, 0x73, 0xf6, 0x5f, 0x2a, 0x4a, 0x8c, 0x59, 0xe2, 0xef, 0x04, 0xc8, 0xa5, 0xcd, 0xc1, 0xb7,
+	0xf5, 0xa3, 0xfa, 0xba, 0x8a, 0x4c, 0x6e, 0x4e, 0x7c, 0x5b, 0xff, 0xff, 0x35, 0x00, 0x57, 0x87,
+	0x87, 0xb0, 0xc3, 0x07, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -233,6 +367,114 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CollateralManagers) > 0 {
+		for iNdEx := len(m.CollateralManagers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollateralManagers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AccountCollaterals) > 0 {
+		for iNdEx := len(m.AccountCollaterals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountCollaterals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.TotalCollateral != nil {
+		{
+			size, err := m.TotalCollateral.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TotalBacking != nil {
+		{
+			size, err := m.TotalBacking.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CollateralPools) > 0 {
+		for iNdEx := len(m.CollateralPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollateralPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.BackingPools) > 0 {
+		for iNdEx := len(m.BackingPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BackingPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CollateralRiskParams) > 0 {
+		for iNdEx := len(m.CollateralRiskParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollateralRiskParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BackingRiskParams) > 0 {
+		for iNdEx := len(m.BackingRiskParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BackingRiskParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size := m.BackingRatio.Size()
 		i -= size
@@ -256,6 +498,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CollateralManager) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollateralManager) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollateralManager) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -365,6 +644,67 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.BackingRatio.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BackingRiskParams) > 0 {
+		for _, e := range m.BackingRiskParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CollateralRiskParams) > 0 {
+		for _, e := range m.CollateralRiskParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BackingPools) > 0 {
+		for _, e := range m.BackingPools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CollateralPools) > 0 {
+		for _, e := range m.CollateralPools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TotalBacking != nil {
+		l = m.TotalBacking.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TotalCollateral != nil {
+		l = m.TotalCollateral.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.AccountCollaterals) > 0 {
+		for _, e := range m.AccountCollaterals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CollateralManagers) > 0 {
+		for _, e := range m.CollateralManagers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CollateralManager) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -494,6 +834,396 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingRiskParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackingRiskParams = append(m.BackingRiskParams, BackingRiskParams{})
+			if err := m.BackingRiskParams[len(m.BackingRiskParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralRiskParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralRiskParams = append(m.CollateralRiskParams, CollateralRiskParams{})
+			if err := m.CollateralRiskParams[len(m.CollateralRiskParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackingPools = append(m.BackingPools, PoolBacking{})
+			if err := m.BackingPools[len(m.BackingPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralPools = append(m.CollateralPools, PoolCollateral{})
+			if err := m.CollateralPools[len(m.CollateralPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBacking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TotalBacking == nil {
+				m.TotalBacking = &TotalBacking{}
+			}
+			if err := m.TotalBacking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TotalCollateral == nil {
+				m.TotalCollateral = &TotalCollateral{}
+			}
+			if err := m.TotalCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountCollaterals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountCollaterals = append(m.AccountCollaterals, AccountCollateral{})
+			if err := m.AccountCollaterals[len(m.AccountCollaterals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralManagers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralManagers = append(m.CollateralManagers, CollateralManager{})
+			if err := m.CollateralManagers[len(m.CollateralManagers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollateralManager) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollateralManager: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollateralManager: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/petri-labs/warmage/x/maker/types"
 	"github.com/stretchr/testify/require"
 )
@@ -18,6 +19,37 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: types.DefaultGenesis(),
 			valid:    true,
 		},
+		{
+			desc: "duplicate backing risk params",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				BackingRatio: sdk.OneDec(),
+				BackingRiskParams: []types.BackingRiskParams{
+					{BackingDenom: "eth", Enabled: true},
+					{BackingDenom: "eth", Enabled: false},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "pool of unregistered collateral",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				BackingRatio: sdk.OneDec(),
+				CollateralPools: []types.PoolCollateral{
+					{Collateral: sdk.NewCoin("eth", sdk.ZeroInt())},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid backing ratio",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				BackingRatio: sdk.NewDec(2),
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
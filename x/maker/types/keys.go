@@ -28,6 +28,7 @@ const (
 	prefixCollateralPool
 	prefixBackingAccount
 	prefixCollateralAccount
+	prefixCollateralManager
 )
 
 var (
@@ -41,4 +42,5 @@ var (
 	KeyPrefixCollateralPool        = []byte{prefixCollateralPool}
 	KeyPrefixBackingAccount        = []byte{prefixBackingAccount}
 	KeyPrefixCollateralAccount     = []byte{prefixCollateralAccount}
+	KeyPrefixCollateralManager     = []byte{prefixCollateralManager}
 )
@@ -43,13 +43,16 @@ type BackingRiskParams struct {
 	BuybackFee *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=buyback_fee,json=buybackFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"buyback_fee,omitempty"`
 	// reback fee rate
 	RebackFee *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=reback_fee,json=rebackFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reback_fee,omitempty"`
+	// target weight of the backing pool in the total backing value, used to
+	// allocate basket minting and burning; empty means zero
+	TargetWeight *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=target_weight,json=targetWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_weight,omitempty"`
 }
 
 func (m *BackingRiskParams) Reset()         { *m = BackingRiskParams{} }
 func (m *BackingRiskParams) String() string { return proto.CompactTextString(m) }
 func (*BackingRiskParams) ProtoMessage()    {}
 func (*BackingRiskParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee82e911d469b50f, []int{0}
+	return fileDescriptor_894c2d78e9259b27, []int{0}
 }
 func (m *BackingRiskParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -126,7 +129,7 @@ func (m *CollateralRiskParams) Reset()         { *m = CollateralRiskParams{} }
 func (m *CollateralRiskParams) String() string { return proto.CompactTextString(m) }
 func (*CollateralRiskParams) ProtoMessage()    {}
 func (*CollateralRiskParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee82e911d469b50f, []int{1}
+	return fileDescriptor_894c2d78e9259b27, []int{1}
 }
 func (m *CollateralRiskParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -184,7 +187,7 @@ func (m *RegisterBackingProposal) Reset()         { *m = RegisterBackingProposal
 func (m *RegisterBackingProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterBackingProposal) ProtoMessage()    {}
 func (*RegisterBackingProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee82e911d469b50f, []int{2}
+	return fileDescriptor_894c2d78e9259b27, []int{2}
 }
 func (m *RegisterBackingProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -249,7 +252,7 @@ func (m *RegisterCollateralProposal) Reset()         { *m = RegisterCollateralPr
 func (m *RegisterCollateralProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterCollateralProposal) ProtoMessage()    {}
 func (*RegisterCollateralProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee82e911d469b50f, []int{3}
+	return fileDescriptor_894c2d78e9259b27, []int{3}
 }
 func (m *RegisterCollateralProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -314,7 +317,7 @@ func (m *SetBackingRiskParamsProposal) Reset()         { *m = SetBackingRiskPara
 func (m *SetBackingRiskParamsProposal) String() string { return proto.CompactTextString(m) }
 func (*SetBackingRiskParamsProposal) ProtoMessage()    {}
 func (*SetBackingRiskParamsProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee82e911d469b50f, []int{4}
+	return fileDescriptor_894c2d78e9259b27, []int{4}
 }
 func (m *SetBackingRiskParamsProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -379,7 +382,7 @@ func (m *SetCollateralRiskParamsProposal) Reset()         { *m = SetCollateralRi
 func (m *SetCollateralRiskParamsProposal) String() string { return proto.CompactTextString(m) }
 func (*SetCollateralRiskParamsProposal) ProtoMessage()    {}
 func (*SetCollateralRiskParamsProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee82e911d469b50f, []int{5}
+	return fileDescriptor_894c2d78e9259b27, []int{5}
 }
 func (m *SetCollateralRiskParamsProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -438,7 +441,7 @@ func (m *BatchBackingRiskParams) Reset()         { *m = BatchBackingRiskParams{}
 func (m *BatchBackingRiskParams) String() string { return proto.CompactTextString(m) }
 func (*BatchBackingRiskParams) ProtoMessage()    {}
 func (*BatchBackingRiskParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee82e911d469b50f, []int{6}
+	return fileDescriptor_894c2d78e9259b27, []int{6}
 }
 func (m *BatchBackingRiskParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -489,7 +492,7 @@ func (m *BatchSetBackingRiskParamsProposal) Reset()         { *m = BatchSetBacki
 func (m *BatchSetBackingRiskParamsProposal) String() string { return proto.CompactTextString(m) }
 func (*BatchSetBackingRiskParamsProposal) ProtoMessage()    {}
 func (*BatchSetBackingRiskParamsProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee82e911d469b50f, []int{7}
+	return fileDescriptor_894c2d78e9259b27, []int{7}
 }
 func (m *BatchSetBackingRiskParamsProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -548,7 +551,7 @@ func (m *BatchCollateralRiskParams) Reset()         { *m = BatchCollateralRiskPa
 func (m *BatchCollateralRiskParams) String() string { return proto.CompactTextString(m) }
 func (*BatchCollateralRiskParams) ProtoMessage()    {}
 func (*BatchCollateralRiskParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee82e911d469b50f, []int{8}
+	return fileDescriptor_894c2d78e9259b27, []int{8}
 }
 func (m *BatchCollateralRiskParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,7 +602,7 @@ func (m *BatchSetCollateralRiskParamsProposal) Reset()         { *m = BatchSetCo
 func (m *BatchSetCollateralRiskParamsProposal) String() string { return proto.CompactTextString(m) }
 func (*BatchSetCollateralRiskParamsProposal) ProtoMessage()    {}
 func (*BatchSetCollateralRiskParamsProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee82e911d469b50f, []int{9}
+	return fileDescriptor_894c2d78e9259b27, []int{9}
 }
 func (m *BatchSetCollateralRiskParamsProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -662,7 +665,7 @@ func (m *TotalBacking) Reset()         { *m = TotalBacking{} }
 func (m *TotalBacking) String() string { return proto.CompactTextString(m) }
 func (*TotalBacking) ProtoMessage()    {}
 func (*TotalBacking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee82e911d469b50f, []int{10}
+	return fileDescriptor_894c2d78e9259b27, []int{10}
 }
 func (m *TotalBacking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -718,7 +721,7 @@ func (m *PoolBacking) Reset()         { *m = PoolBacking{} }
 func (m *PoolBacking) String() string { return proto.CompactTextString(m) }
 func (*PoolBacking) ProtoMessage()    {}
 func (*PoolBacking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee82e911d469b50f, []int{11}
+	return fileDescriptor_894c2d78e9259b27, []int{11}
 }
 func (m *PoolBacking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,7 +778,7 @@ func (m *AccountBacking) Reset()         { *m = AccountBacking{} }
 func (m *AccountBacking) String() string { return proto.CompactTextString(m) }
 func (*AccountBacking) ProtoMessage()    {}
 func (*AccountBacking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee82e911d469b50f, []int{12}
+	return fileDescriptor_894c2d78e9259b27, []int{12}
 }
 func (m *AccountBacking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -816,7 +819,7 @@ func (m *TotalCollateral) Reset()         { *m = TotalCollateral{} }
 func (m *TotalCollateral) String() string { return proto.CompactTextString(m) }
 func (*TotalCollateral) ProtoMessage()    {}
 func (*TotalCollateral) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee82e911d469b50f, []int{13}
+	return fileDescriptor_894c2d78e9259b27, []int{13}
 }
 func (m *TotalCollateral) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -873,7 +876,7 @@ func (m *PoolCollateral) Reset()         { *m = PoolCollateral{} }
 func (m *PoolCollateral) String() string { return proto.CompactTextString(m) }
 func (*PoolCollateral) ProtoMessage()    {}
 func (*PoolCollateral) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee82e911d469b50f, []int{14}
+	return fileDescriptor_894c2d78e9259b27, []int{14}
 }
 func (m *PoolCollateral) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -942,7 +945,7 @@ func (m *AccountCollateral) Reset()         { *m = AccountCollateral{} }
 func (m *AccountCollateral) String() string { return proto.CompactTextString(m) }
 func (*AccountCollateral) ProtoMessage()    {}
 func (*AccountCollateral) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee82e911d469b50f, []int{15}
+	return fileDescriptor_894c2d78e9259b27, []int{15}
 }
 func (m *AccountCollateral) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1032,75 +1035,76 @@ func init() {
 	proto.RegisterType((*AccountCollateral)(nil), "warmage.maker.v1.AccountCollateral")
 }
 
-func init() { proto.RegisterFile("warmage/maker/v1/maker.proto", fileDescriptor_ee82e911d469b50f) }
-
-var fileDescriptor_ee82e911d469b50f = []byte{
-	// 1026 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4b, 0x6f, 0x1b, 0x55,
-	0x14, 0xf6, 0xc4, 0x6e, 0x1c, 0x9f, 0x71, 0x1e, 0x9d, 0xb8, 0xc5, 0x8d, 0x2a, 0x27, 0xb4, 0xa8,
-	0x2a, 0x48, 0x19, 0x2b, 0x61, 0x45, 0x17, 0x3c, 0x9c, 0x50, 0x14, 0x1a, 0xa3, 0x30, 0x8e, 0x90,
-	0x40, 0x48, 0xa3, 0x3b, 0xe3, 0x83, 0x73, 0xf1, 0xcc, 0x5c, 0x33, 0x73, 0x1d, 0x25, 0xfd, 0x15,
-	0x6c, 0xd9, 0x21, 0x21, 0x24, 0x58, 0xc0, 0x86, 0x3f, 0xd1, 0x65, 0x76, 0x20, 0x16, 0x15, 0x4a,
-	0x84, 0x84, 0xc4, 0x5f, 0x60, 0x81, 0xee, 0x9d, 0x3b, 0xf6, 0x38, 0x71, 0x55, 0xbf, 0x54, 0x65,
-	0x15, 0xcf, 0xb9, 0xf3, 0x7d, 0xf9, 0xce, 0xeb, 0x9e, 0x33, 0x70, 0xd7, 0xc7, 0xd0, 0xa3, 0x2c,
-	0xa8, 0xfa, 0xa4, 0x8d, 0x61, 0xf5, 0x78, 0x2b, 0xfe, 0x61, 0x76, 0x42, 0xc6, 0x99, 0xb1, 0xa2,
-	0x4e, 0xcd, 0xd8, 0x78, 0xbc, 0xb5, 0x56, 0x6a, 0xb1, 0x16, 0x93, 0x87, 0x55, 0xf1, 0x2b, 0x7e,
-	0x6f, 0xad, 0xe2, 0xb2, 0xc8, 0x67, 0x51, 0xd5, 0x21, 0x11, 0x56, 0x8f, 0xb7, 0x1c, 0xe4, 0x64,
-	0xab, 0xea, 0x32, 0x1a, 0xc4, 0xe7, 0xf7, 0xbe, 0xcb, 0xc1, 0xcd, 0x1a, 0x71, 0xdb, 0x34, 0x68,
-	0x59, 0x34, 0x6a, 0x1f, 0x90, 0x90, 0xf8, 0x91, 0x71, 0x1f, 0x16, 0x9d, 0xd8, 0x68, 0x37, 0x31,
-	0x60, 0x7e, 0x59, 0xdb, 0xd0, 0x1e, 0x16, 0xac, 0xa2, 0x32, 0xee, 0x0a, 0x9b, 0x51, 0x86, 0x3c,
-	0x06, 0xc4, 0xf1, 0xb0, 0x59, 0x9e, 0xdb, 0xd0, 0x1e, 0x2e, 0x58, 0xc9, 0xa3, 0xf1, 0x04, 0x74,
-	0x9f, 0x9c, 0xd8, 0xea, 0xed, 0x72, 0x56, 0x80, 0x6b, 0x6f, 0xfd, 0xf9, 0x7c, 0xfd, 0x41, 0x8b,
-	0xf2, 0xa3, 0xae, 0x63, 0xba, 0xcc, 0xaf, 0x2a, 0x61, 0xf1, 0x9f, 0xcd, 0xa8, 0xd9, 0xae, 0xf2,
-	0xd3, 0x0e, 0x46, 0xe6, 0x5e, 0xc0, 0x2d, 0xf0, 0xc9, 0x89, 0x52, 0x65, 0xec, 0x43, 0x51, 0x90,
-	0xf9, 0x18, 0xda, 0x3e, 0x0d, 0x78, 0x39, 0x37, 0x11, 0x5b, 0x1d, 0xc3, 0x3a, 0x0d, 0xb8, 0xf1,
-	0x21, 0x2c, 0x08, 0x16, 0xfb, 0x2b, 0xc4, 0xf2, 0x8d, 0xb1, 0x98, 0x76, 0xd1, 0xb5, 0xf2, 0x02,
-	0xfb, 0x18, 0x51, 0xd0, 0x38, 0xdd, 0x30, 0x90, 0x34, 0xf3, 0xe3, 0xd3, 0x08, 0xac, 0xa0, 0x79,
-	0x02, 0xba, 0xd3, 0x3d, 0x15, 0x71, 0x92, 0x4c, 0xf9, 0xb1, 0x99, 0x40, 0xc1, 0x05, 0xd9, 0x1e,
-	0x40, 0x88, 0x3d, 0xae, 0x85, 0xb1, 0xb9, 0x0a, 0x31, 0xfa, 0x31, 0xe2, 0xa3, 0xdc, 0x3f, 0xdf,
-	0xaf, 0x67, 0xee, 0xfd, 0x3e, 0x0f, 0xa5, 0x1d, 0xe6, 0x79, 0x84, 0x63, 0x48, 0xbc, 0x54, 0x79,
-	0xbc, 0x09, 0x2b, 0x6e, 0xcf, 0x3e, 0x50, 0x21, 0xcb, 0x7d, 0xfb, 0xcb, 0x8a, 0xe4, 0x53, 0x58,
-	0x12, 0x79, 0xed, 0x03, 0x26, 0xa8, 0x93, 0x45, 0x9f, 0x9c, 0xf4, 0x15, 0xce, 0xb8, 0x54, 0x6c,
-	0xb8, 0xe5, 0xd1, 0x6f, 0xba, 0xb4, 0x49, 0x38, 0x65, 0x81, 0xcd, 0x8f, 0x42, 0x8c, 0x8e, 0x98,
-	0xd7, 0x9c, 0xa0, 0x6e, 0x4a, 0x29, 0xa2, 0xc3, 0x84, 0xc7, 0xf8, 0x04, 0x16, 0x3d, 0x46, 0x02,
-	0x9b, 0x33, 0xfb, 0x98, 0x78, 0xdd, 0x49, 0x2a, 0x49, 0x17, 0x04, 0x87, 0xec, 0x33, 0x01, 0x37,
-	0x3e, 0x87, 0x55, 0x87, 0x44, 0xd4, 0xb5, 0x07, 0x59, 0xc7, 0xaf, 0xaa, 0x15, 0x49, 0xb3, 0x9f,
-	0xa2, 0xfe, 0x12, 0x4a, 0x2e, 0xe1, 0xc4, 0x3b, 0xe5, 0x82, 0x5e, 0x84, 0x23, 0x14, 0xce, 0x4c,
-	0x50, 0x65, 0x46, 0x8f, 0x67, 0x9f, 0xb2, 0xc0, 0x12, 0x2c, 0x46, 0x03, 0x96, 0xd3, 0x91, 0x16,
-	0xe5, 0x5b, 0x18, 0x9b, 0x78, 0x29, 0x45, 0xa1, 0x5a, 0xb4, 0xd7, 0xe9, 0x30, 0x79, 0xa7, 0xd7,
-	0xa1, 0x48, 0x03, 0x8e, 0x21, 0x46, 0x31, 0x95, 0x3e, 0x7e, 0x8e, 0x12, 0x7c, 0xbf, 0xb3, 0x7e,
-	0xd0, 0xe0, 0x35, 0x0b, 0x5b, 0x34, 0xe2, 0x18, 0xaa, 0x7b, 0xee, 0x20, 0x64, 0x1d, 0x16, 0x11,
-	0xcf, 0x28, 0xc1, 0x0d, 0x4e, 0xb9, 0x87, 0xaa, 0xa3, 0xe2, 0x07, 0x63, 0x03, 0xf4, 0x26, 0x46,
-	0x6e, 0x48, 0x3b, 0xc2, 0x3f, 0xd9, 0x4b, 0x05, 0x2b, 0x6d, 0x32, 0x3e, 0x06, 0x3d, 0xa4, 0x51,
-	0xdb, 0xee, 0xc8, 0x1e, 0x95, 0xcd, 0xa4, 0x6f, 0xdf, 0x37, 0x2f, 0xcf, 0x09, 0xf3, 0xca, 0x6d,
-	0x5f, 0xcb, 0x3d, 0x7b, 0xbe, 0x9e, 0xb1, 0x20, 0xec, 0x59, 0x94, 0xca, 0x9f, 0x35, 0x58, 0x4b,
-	0x54, 0xf6, 0xbb, 0x6c, 0x6a, 0xa1, 0xf5, 0x61, 0x42, 0x1f, 0x5c, 0x15, 0x3a, 0xec, 0xea, 0x79,
-	0xa1, 0xd6, 0x9f, 0x34, 0xb8, 0xdb, 0x40, 0x7e, 0xc5, 0xb9, 0x6b, 0x18, 0xd6, 0x5f, 0x35, 0x58,
-	0x6f, 0x20, 0x1f, 0xe6, 0xde, 0xf5, 0x8c, 0xed, 0xd7, 0x70, 0xbb, 0x46, 0xb8, 0x7b, 0x74, 0x75,
-	0x4f, 0xb8, 0x14, 0x1c, 0x6d, 0x23, 0x3b, 0x6d, 0x70, 0x7e, 0xd1, 0xe0, 0x75, 0xf9, 0xcf, 0x5e,
-	0x4d, 0x32, 0xa7, 0xd6, 0xdb, 0x81, 0x3b, 0x52, 0xee, 0xd0, 0x39, 0x59, 0x1f, 0x16, 0x9e, 0x69,
-	0xb3, 0xf1, 0x9b, 0x06, 0x6f, 0x24, 0x11, 0x7a, 0x35, 0x35, 0x34, 0x0b, 0xd5, 0xff, 0x6a, 0x50,
-	0x3c, 0x64, 0x9c, 0x78, 0xc9, 0x5a, 0xd7, 0xe8, 0xaf, 0x98, 0xf1, 0x98, 0x92, 0x2a, 0x6b, 0xa6,
-	0xc0, 0x8f, 0x31, 0xb0, 0x93, 0x95, 0x34, 0x1e, 0x53, 0xef, 0x02, 0x24, 0xc3, 0x5f, 0x2d, 0x1c,
-	0xfa, 0xf6, 0x1d, 0x33, 0x06, 0x9a, 0x62, 0x05, 0x36, 0xd5, 0x0a, 0x6c, 0xee, 0x30, 0x1a, 0x28,
-	0xb1, 0x05, 0x3f, 0x1e, 0xf8, 0xd8, 0x34, 0xde, 0x07, 0x5d, 0x0e, 0x37, 0xb1, 0x9f, 0x61, 0x53,
-	0xb5, 0xcf, 0x4b, 0x09, 0x40, 0x60, 0x6a, 0x12, 0xa2, 0xbc, 0x3d, 0xd3, 0x40, 0x3f, 0x60, 0xac,
-	0xe7, 0xec, 0xa0, 0x2e, 0x6d, 0x6c, 0x5d, 0xef, 0x40, 0x3e, 0x59, 0xa6, 0x47, 0x74, 0x2a, 0x79,
-	0x7f, 0x66, 0x2e, 0xdd, 0x86, 0xa5, 0x0f, 0x5c, 0x97, 0x75, 0x83, 0xa4, 0x2d, 0x95, 0xfd, 0x47,
-	0x0d, 0x96, 0x65, 0x62, 0x53, 0x7b, 0xd8, 0x23, 0x58, 0x10, 0xee, 0x36, 0xd1, 0xe1, 0xa3, 0x3a,
-	0x9b, 0xf7, 0x31, 0xdc, 0x45, 0x87, 0x1b, 0x07, 0xb0, 0x2a, 0xf5, 0xf6, 0xf7, 0x42, 0xfa, 0x74,
-	0xf4, 0x5c, 0x1a, 0x02, 0xbb, 0x33, 0x00, 0x55, 0x3a, 0xff, 0xd6, 0x60, 0x49, 0xa4, 0x24, 0x25,
-	0xf3, 0x3d, 0x80, 0xd4, 0xf6, 0x39, 0xa2, 0xd0, 0x14, 0x64, 0xc0, 0xcf, 0xb9, 0xd9, 0xf8, 0x99,
-	0x9d, 0xd6, 0xcf, 0xff, 0xe6, 0xe0, 0xa6, 0x4a, 0x54, 0xca, 0xd5, 0x32, 0xe4, 0x49, 0x6c, 0x54,
-	0xb7, 0x41, 0xf2, 0x78, 0x29, 0x08, 0x73, 0xd3, 0x05, 0x21, 0x3b, 0x9b, 0x20, 0xe4, 0x26, 0x0e,
-	0x82, 0xb1, 0x0b, 0x8b, 0x1e, 0x89, 0xb8, 0x9d, 0xec, 0x5c, 0x72, 0x59, 0x1f, 0x81, 0xab, 0x28,
-	0x50, 0x7b, 0x0a, 0x64, 0x6c, 0xc3, 0x2d, 0xc9, 0x12, 0x21, 0xe7, 0x1e, 0xfa, 0x18, 0x70, 0xdb,
-	0xf1, 0x98, 0xdb, 0x96, 0x1b, 0x7a, 0xd6, 0x5a, 0x15, 0x87, 0x8d, 0xde, 0x59, 0x4d, 0x1c, 0xc5,
-	0xe1, 0xaf, 0x7d, 0xf4, 0xec, 0xbc, 0xa2, 0x9d, 0x9d, 0x57, 0xb4, 0xbf, 0xce, 0x2b, 0xda, 0xb7,
-	0x17, 0x95, 0xcc, 0xd9, 0x45, 0x25, 0xf3, 0xc7, 0x45, 0x25, 0xf3, 0xc5, 0x66, 0xea, 0x46, 0x53,
-	0x77, 0xe9, 0xe6, 0x53, 0x16, 0x60, 0xf2, 0x50, 0x3d, 0x51, 0x5f, 0xfa, 0xf2, 0x72, 0x73, 0xe6,
-	0xe5, 0xf7, 0xf9, 0xdb, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x91, 0x60, 0xb2, 0x07, 0x10,
-	0x00, 0x00,
+func init() { proto.RegisterFile("warmage/maker/v1/maker.proto", fileDescriptor_894c2d78e9259b27) }
+
+var fileDescriptor_894c2d78e9259b27 = []byte{
+	// 1053 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x5d, 0x6f, 0xdb, 0xd4,
+	0x1b, 0xaf, 0x9b, 0xb6, 0x69, 0x9f, 0xa4, 0x2f, 0x73, 0xbb, 0xfd, 0xbd, 0x6a, 0x4a, 0xfb, 0xdf,
+	0xd0, 0x34, 0x40, 0x73, 0xd4, 0x72, 0xc5, 0x2e, 0x78, 0x49, 0xbb, 0x49, 0x65, 0x2b, 0x14, 0xa7,
+	0x62, 0x02, 0x21, 0x59, 0xc7, 0xce, 0x83, 0x73, 0x88, 0xed, 0x13, 0x8e, 0x4f, 0x9a, 0x96, 0x4f,
+	0xc1, 0x47, 0x40, 0x42, 0x48, 0x70, 0x01, 0x37, 0x7c, 0x89, 0x5d, 0xf6, 0x0e, 0xc4, 0xc5, 0x84,
+	0x5a, 0x21, 0x21, 0xf8, 0x0a, 0x5c, 0xa0, 0x63, 0x1f, 0x27, 0x6e, 0x1b, 0x44, 0x9c, 0x44, 0xd3,
+	0xae, 0x1a, 0x3f, 0xc7, 0xbf, 0x5f, 0x7e, 0xcf, 0x73, 0x9e, 0xb7, 0x06, 0x6e, 0x75, 0x09, 0x0f,
+	0x88, 0x87, 0xd5, 0x80, 0xb4, 0x90, 0x57, 0x8f, 0xb6, 0x92, 0x0f, 0x66, 0x9b, 0x33, 0xc1, 0xf4,
+	0x15, 0x75, 0x6a, 0x26, 0xc6, 0xa3, 0xad, 0xf5, 0x35, 0x8f, 0x79, 0x2c, 0x3e, 0xac, 0xca, 0x4f,
+	0xc9, 0x7b, 0xeb, 0x15, 0x97, 0x45, 0x01, 0x8b, 0xaa, 0x0e, 0x89, 0xb0, 0x7a, 0xb4, 0xe5, 0xa0,
+	0x20, 0x5b, 0x55, 0x97, 0xd1, 0x30, 0x39, 0xbf, 0xfd, 0xe7, 0x0c, 0x5c, 0xab, 0x11, 0xb7, 0x45,
+	0x43, 0xcf, 0xa2, 0x51, 0xeb, 0x80, 0x70, 0x12, 0x44, 0xfa, 0x1d, 0x58, 0x74, 0x12, 0xa3, 0xdd,
+	0xc0, 0x90, 0x05, 0x86, 0xb6, 0xa9, 0xdd, 0x5b, 0xb0, 0xca, 0xca, 0xb8, 0x2b, 0x6d, 0xba, 0x01,
+	0x45, 0x0c, 0x89, 0xe3, 0x63, 0xc3, 0x98, 0xde, 0xd4, 0xee, 0xcd, 0x5b, 0xe9, 0xa3, 0xfe, 0x18,
+	0x4a, 0x01, 0x39, 0xb6, 0xd5, 0xdb, 0x46, 0x41, 0x82, 0x6b, 0xaf, 0xfd, 0xfa, 0x7c, 0xe3, 0xae,
+	0x47, 0x45, 0xb3, 0xe3, 0x98, 0x2e, 0x0b, 0xaa, 0x4a, 0x58, 0xf2, 0xe7, 0x7e, 0xd4, 0x68, 0x55,
+	0xc5, 0x49, 0x1b, 0x23, 0x73, 0x2f, 0x14, 0x16, 0x04, 0xe4, 0x58, 0xa9, 0xd2, 0x9f, 0x40, 0x59,
+	0x92, 0x75, 0x09, 0xb7, 0x03, 0x1a, 0x0a, 0x63, 0x66, 0x24, 0xb6, 0xa7, 0x84, 0xef, 0xd3, 0x50,
+	0xe8, 0x0f, 0x61, 0x5e, 0xb2, 0xd8, 0x9f, 0x21, 0x1a, 0xb3, 0xb9, 0x98, 0x76, 0xd1, 0xb5, 0x8a,
+	0x12, 0xfb, 0x08, 0x51, 0xd2, 0x38, 0x1d, 0x1e, 0xc6, 0x34, 0x73, 0xf9, 0x69, 0x24, 0x56, 0xd2,
+	0x3c, 0x86, 0x92, 0xd3, 0x39, 0x91, 0x71, 0x8a, 0x99, 0x8a, 0xb9, 0x99, 0x40, 0xc1, 0x25, 0xd9,
+	0x1e, 0x00, 0xc7, 0x1e, 0xd7, 0x7c, 0x6e, 0xae, 0x05, 0x8e, 0x29, 0xd5, 0x07, 0xb0, 0x28, 0x08,
+	0xf7, 0x50, 0xd8, 0x5d, 0xa4, 0x5e, 0x53, 0x18, 0x0b, 0xb9, 0xd9, 0xca, 0x09, 0xc1, 0xd3, 0x18,
+	0xff, 0x60, 0xe6, 0x8f, 0xaf, 0x37, 0xa6, 0x6e, 0xff, 0x3c, 0x07, 0x6b, 0x3b, 0xcc, 0xf7, 0x89,
+	0x40, 0x4e, 0xfc, 0x4c, 0xbe, 0xbd, 0x0a, 0x2b, 0x6e, 0xcf, 0x7e, 0x21, 0xe5, 0x96, 0xfb, 0xf6,
+	0xff, 0xca, 0xba, 0x0f, 0x61, 0x49, 0x26, 0x4a, 0x1f, 0x30, 0x42, 0xe2, 0x2d, 0x06, 0xe4, 0xb8,
+	0xaf, 0x70, 0xc2, 0xb9, 0x67, 0xc3, 0x75, 0x9f, 0x7e, 0xd1, 0xa1, 0x0d, 0x22, 0x28, 0x0b, 0x6d,
+	0xd1, 0xe4, 0x18, 0x35, 0x99, 0xdf, 0x18, 0x21, 0x11, 0xd7, 0x32, 0x44, 0x87, 0x29, 0x8f, 0xfe,
+	0x3e, 0x2c, 0xfa, 0x8c, 0x84, 0xb6, 0x60, 0xf6, 0x11, 0xf1, 0x3b, 0xa3, 0xa4, 0x66, 0x49, 0x12,
+	0x1c, 0xb2, 0x8f, 0x24, 0x5c, 0xff, 0x18, 0x56, 0x1d, 0x12, 0x51, 0xd7, 0xbe, 0xc8, 0x9a, 0x3f,
+	0x4d, 0x57, 0x62, 0x9a, 0x27, 0x19, 0xea, 0x4f, 0x61, 0xcd, 0x25, 0x82, 0xf8, 0x27, 0x82, 0xba,
+	0xb6, 0x6c, 0x64, 0x36, 0x97, 0xce, 0x8c, 0x90, 0xb6, 0x7a, 0x8f, 0x67, 0x9f, 0x78, 0x68, 0x49,
+	0x16, 0xbd, 0x0e, 0xcb, 0xd9, 0x48, 0xcb, 0x7a, 0xc8, 0x9f, 0xc1, 0x4b, 0x19, 0x0a, 0x55, 0xf3,
+	0xbd, 0xd6, 0x01, 0xa3, 0xb7, 0x8e, 0x7d, 0x28, 0xd3, 0x50, 0x20, 0xc7, 0x28, 0xa1, 0x2a, 0xe5,
+	0xbf, 0xa3, 0x14, 0xff, 0x08, 0x51, 0x55, 0xd6, 0x37, 0x1a, 0xfc, 0xcf, 0x42, 0x8f, 0x46, 0x02,
+	0xb9, 0x6a, 0x9c, 0x07, 0x9c, 0xb5, 0x59, 0x44, 0x7c, 0x7d, 0x0d, 0x66, 0x05, 0x15, 0x3e, 0xaa,
+	0x8a, 0x4a, 0x1e, 0xf4, 0x4d, 0x28, 0x35, 0x30, 0x72, 0x39, 0x6d, 0x4b, 0xff, 0xe2, 0x5a, 0x5a,
+	0xb0, 0xb2, 0x26, 0xfd, 0x3d, 0x28, 0x71, 0x1a, 0xb5, 0xec, 0x76, 0x5c, 0xa3, 0x71, 0x31, 0x95,
+	0xb6, 0xef, 0x98, 0x97, 0x07, 0x8f, 0x79, 0x65, 0x7c, 0xd4, 0x66, 0x9e, 0x3d, 0xdf, 0x98, 0xb2,
+	0x80, 0xf7, 0x2c, 0x4a, 0xe5, 0xf7, 0x1a, 0xac, 0xa7, 0x2a, 0xfb, 0x55, 0x36, 0xb6, 0xd0, 0xfd,
+	0x41, 0x42, 0xef, 0x5e, 0x15, 0x3a, 0xa8, 0xf5, 0xfc, 0xab, 0xd6, 0xef, 0x34, 0xb8, 0x55, 0x47,
+	0x71, 0xc5, 0xb9, 0x97, 0x30, 0xac, 0x3f, 0x6a, 0xb0, 0x51, 0x47, 0x31, 0xc8, 0xbd, 0x97, 0x33,
+	0xb6, 0x9f, 0xc3, 0x8d, 0x1a, 0x11, 0x6e, 0xf3, 0xea, 0xe2, 0x71, 0x29, 0x38, 0xda, 0x66, 0x61,
+	0xdc, 0xe0, 0xfc, 0xa0, 0xc1, 0xff, 0xe3, 0x2f, 0x7b, 0x31, 0x97, 0x39, 0xb6, 0xde, 0x36, 0xdc,
+	0x8c, 0xe5, 0x0e, 0x9c, 0x93, 0xfb, 0x83, 0xc2, 0x33, 0xee, 0x6d, 0xfc, 0xa4, 0xc1, 0x2b, 0x69,
+	0x84, 0x5e, 0x4c, 0x0e, 0x4d, 0x42, 0xf5, 0x5f, 0x1a, 0x94, 0x0f, 0x99, 0x20, 0x7e, 0xba, 0x27,
+	0xd6, 0xfb, 0x3b, 0x6b, 0x32, 0xa6, 0x62, 0x95, 0x35, 0x53, 0xe2, 0x73, 0x0c, 0xec, 0x74, 0xc7,
+	0x4d, 0xc6, 0xd4, 0x5b, 0x00, 0xe9, 0xf0, 0x57, 0x0b, 0x47, 0x69, 0xfb, 0xa6, 0x99, 0x00, 0x4d,
+	0xb9, 0x53, 0x9b, 0x6a, 0xa7, 0x36, 0x77, 0x18, 0x0d, 0x95, 0xd8, 0x85, 0x6e, 0x32, 0xf0, 0xb1,
+	0xa1, 0xbf, 0x23, 0x37, 0x61, 0x0f, 0x6d, 0xb9, 0xf0, 0x61, 0xc3, 0x28, 0x0c, 0x47, 0x00, 0x12,
+	0x53, 0x8b, 0x21, 0xca, 0xdb, 0x53, 0x0d, 0x4a, 0x07, 0x8c, 0xf5, 0x9c, 0xbd, 0xa8, 0x4b, 0xcb,
+	0xad, 0xeb, 0x4d, 0x28, 0xa6, 0xdb, 0xf9, 0x90, 0x4e, 0xa5, 0xef, 0x4f, 0xcc, 0xa5, 0x1b, 0xb0,
+	0xf4, 0xae, 0xeb, 0xb2, 0x4e, 0x98, 0x96, 0xa5, 0xb2, 0x7f, 0xab, 0xc1, 0x72, 0x7c, 0xb1, 0x99,
+	0x3d, 0xec, 0x01, 0xcc, 0x4b, 0x77, 0x1b, 0xe8, 0x88, 0x61, 0x9d, 0x2d, 0x76, 0x09, 0xdf, 0x45,
+	0x47, 0xe8, 0x07, 0xb0, 0x1a, 0xeb, 0xed, 0xef, 0x85, 0xf4, 0xcb, 0xe1, 0xef, 0x52, 0x97, 0xd8,
+	0x9d, 0x0b, 0x50, 0xa5, 0xf3, 0x77, 0x0d, 0x96, 0xe4, 0x95, 0x64, 0x64, 0xbe, 0x0d, 0xd0, 0xff,
+	0x96, 0x61, 0x85, 0x82, 0x3b, 0xd8, 0xcf, 0xe9, 0xc9, 0xf8, 0x59, 0x18, 0xd7, 0xcf, 0xbf, 0xa7,
+	0xe1, 0x9a, 0xba, 0xa8, 0x8c, 0xab, 0x06, 0x14, 0x49, 0x62, 0x54, 0xdd, 0x20, 0x7d, 0xbc, 0x14,
+	0x84, 0xe9, 0xf1, 0x82, 0x50, 0x98, 0x4c, 0x10, 0x66, 0x46, 0x0e, 0x82, 0xbe, 0x0b, 0x8b, 0x3e,
+	0x89, 0x84, 0x9d, 0xee, 0x5c, 0xc6, 0xec, 0x70, 0x5c, 0x65, 0x89, 0xda, 0x53, 0x20, 0x7d, 0x1b,
+	0xae, 0xc7, 0x2c, 0x11, 0x0a, 0xe1, 0x63, 0x80, 0xa1, 0xb0, 0x1d, 0x9f, 0xb9, 0xad, 0x78, 0x43,
+	0x2f, 0x58, 0xab, 0xf2, 0xb0, 0xde, 0x3b, 0xab, 0xc9, 0xa3, 0x24, 0xfc, 0xb5, 0x87, 0xcf, 0xce,
+	0x2a, 0xda, 0xe9, 0x59, 0x45, 0xfb, 0xed, 0xac, 0xa2, 0x7d, 0x75, 0x5e, 0x99, 0x3a, 0x3d, 0xaf,
+	0x4c, 0xfd, 0x72, 0x5e, 0x99, 0xfa, 0xe4, 0xf5, 0x4c, 0x47, 0x6b, 0xa3, 0xe0, 0xf4, 0xbe, 0x4f,
+	0x9c, 0xa8, 0x9a, 0xfe, 0x6c, 0x70, 0xac, 0x7e, 0x38, 0x88, 0x5b, 0x9b, 0x33, 0x17, 0xff, 0xbb,
+	0xff, 0xc6, 0x3f, 0x03, 0x00, 0xd1, 0xbe, 0x6e, 0x28, 0x56, 0x10, 0x00, 0x00,
 }
 
 func (m *BackingRiskParams) Marshal() (dAtA []byte, err error) {
@@ -1123,6 +1127,18 @@ func (m *BackingRiskParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TargetWeight != nil {
+		{
+			size := m.TargetWeight.Size()
+			i -= size
+			if _, err := m.TargetWeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintMaker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.RebackFee != nil {
 		{
 			size := m.RebackFee.Size()
@@ -2075,6 +2091,10 @@ func (m *BackingRiskParams) Size() (n int) {
 		l = m.RebackFee.Size()
 		n += 1 + l + sovMaker(uint64(l))
 	}
+	if m.TargetWeight != nil {
+		l = m.TargetWeight.Size()
+		n += 1 + l + sovMaker(uint64(l))
+	}
 	return n
 }
 
@@ -2676,6 +2696,42 @@ func (m *BackingRiskParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TargetWeight = &v
+			if err := m.TargetWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMaker(dAtA[iNdEx:])
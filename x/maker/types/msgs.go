@@ -9,6 +9,8 @@ import (
 const (
 	TypeMsgMintBySwap          = "mint_by_swap"
 	TypeMsgBurnBySwap          = "burn_by_swap"
+	TypeMsgBasketMint          = "basket_mint"
+	TypeMsgBasketBurn          = "basket_burn"
 	TypeMsgMintByCollateral    = "mint_by_collateral"
 	TypeMsgBurnByCollateral    = "burn_by_collateral"
 	TypeMsgDepositCollateral   = "deposit_collateral"
@@ -16,11 +18,18 @@ const (
 	TypeMsgBuyBacking          = "buy_backing"
 	TypeMsgSellBacking         = "sell_backing"
 	TypeMsgLiquidateCollateral = "liquidate_collateral"
+	TypeMsgTransferPosition    = "transfer_position"
+	TypeMsgAuthorizeManager    = "authorize_manager"
+	TypeMsgRevokeManager       = "revoke_manager"
+	TypeMsgLeverage            = "leverage"
+	TypeMsgDeleverage          = "deleverage"
 )
 
 var (
 	_ sdk.Msg = &MsgMintBySwap{}
 	_ sdk.Msg = &MsgBurnBySwap{}
+	_ sdk.Msg = &MsgBasketMint{}
+	_ sdk.Msg = &MsgBasketBurn{}
 	_ sdk.Msg = &MsgMintByCollateral{}
 	_ sdk.Msg = &MsgBurnByCollateral{}
 	_ sdk.Msg = &MsgDepositCollateral{}
@@ -28,6 +37,11 @@ var (
 	_ sdk.Msg = &MsgBuyBacking{}
 	_ sdk.Msg = &MsgSellBacking{}
 	_ sdk.Msg = &MsgLiquidateCollateral{}
+	_ sdk.Msg = &MsgTransferPosition{}
+	_ sdk.Msg = &MsgAuthorizeManager{}
+	_ sdk.Msg = &MsgRevokeManager{}
+	_ sdk.Msg = &MsgLeverage{}
+	_ sdk.Msg = &MsgDeleverage{}
 )
 
 // Route implements sdk.Msg
@@ -136,6 +150,125 @@ func (m *MsgBurnBySwap) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+// Route implements sdk.Msg
+func (m *MsgBasketMint) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgBasketMint) Type() string { return TypeMsgBasketMint }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgBasketMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgBasketMint) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if len(m.To) > 0 {
+		_, err = sdk.AccAddressFromBech32(m.To)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+		}
+	}
+	if m.MintOutMin.Denom != warmage.MicroUSWDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin: %s", m.MintOutMin.Denom)
+	}
+	if m.MageInMax.Denom != warmage.AttoMageDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin: %s", m.MageInMax.Denom)
+	}
+	if !m.MintOutMin.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "mint_out_min must be positive")
+	}
+	if err = m.BackingInMax.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid backing_in_max (%s)", err)
+	}
+	if m.MageInMax.Amount.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "mage_in_max must be positive or zero")
+	}
+	if m.BackingInMax.Empty() && m.MageInMax.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "backing_in_max and mage_in_max must not be both zero")
+	}
+	if m.FullBacking && m.MageInMax.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "mage_in_max must be zero when full_backing is true")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgBasketMint) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgBasketBurn) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgBasketBurn) Type() string { return TypeMsgBasketBurn }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgBasketBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgBasketBurn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if len(m.To) > 0 {
+		_, err = sdk.AccAddressFromBech32(m.To)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+		}
+	}
+	if m.BurnIn.Denom != warmage.MicroUSWDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin: %s", m.BurnIn.Denom)
+	}
+	if m.MageOutMin.Denom != warmage.AttoMageDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin: %s", m.MageOutMin.Denom)
+	}
+	if !m.BurnIn.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.BurnIn.String())
+	}
+	if len(m.BackingOutMin) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "backing_out_min must not be empty")
+	}
+	seen := make(map[string]bool)
+	for _, coin := range m.BackingOutMin {
+		if err = sdk.ValidateDenom(coin.Denom); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+		}
+		if seen[coin.Denom] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "duplicate backing denom: %s", coin.Denom)
+		}
+		seen[coin.Denom] = true
+		if coin.Amount.IsNegative() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, coin.String())
+		}
+	}
+	if m.MageOutMin.Amount.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.MageOutMin.String())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgBasketBurn) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
 // Route implements sdk.Msg
 func (m *MsgBuyBacking) Route() string { return RouterKey }
 
@@ -247,6 +380,9 @@ func (m *MsgMintByCollateral) ValidateBasic() error {
 			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
 		}
 	}
+	if err = validateOwner(m.Sender, m.Owner, m.To); err != nil {
+		return err
+	}
 	if m.MintOut.Denom != warmage.MicroUSWDenom {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin: %s", m.MintOut.Denom)
 	}
@@ -282,6 +418,9 @@ func (m *MsgBurnByCollateral) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
 	}
+	if err = validateOwner(m.Sender, m.Owner, ""); err != nil {
+		return err
+	}
 	if m.RepayInMax.Denom != warmage.MicroUSWDenom {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin: %s", m.RepayInMax.Denom)
 	}
@@ -370,6 +509,9 @@ func (m *MsgRedeemCollateral) ValidateBasic() error {
 			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
 		}
 	}
+	if err = validateOwner(m.Sender, m.Owner, m.To); err != nil {
+		return err
+	}
 	if m.CollateralOut.Amount.IsNegative() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.CollateralOut.String())
 	}
@@ -441,3 +583,206 @@ func (m *MsgLiquidateCollateral) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// Route implements sdk.Msg
+func (m *MsgTransferPosition) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgTransferPosition) Type() string { return TypeMsgTransferPosition }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgTransferPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgTransferPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(m.To)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+	if m.To == m.Sender {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver must differ from sender")
+	}
+	if err = sdk.ValidateDenom(m.CollateralDenom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgTransferPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgAuthorizeManager) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgAuthorizeManager) Type() string { return TypeMsgAuthorizeManager }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgAuthorizeManager) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgAuthorizeManager) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(m.Manager)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid manager address (%s)", err)
+	}
+	if m.Manager == m.Sender {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "manager must differ from sender")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgAuthorizeManager) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgRevokeManager) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgRevokeManager) Type() string { return TypeMsgRevokeManager }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgRevokeManager) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgRevokeManager) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(m.Manager)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid manager address (%s)", err)
+	}
+	if m.Manager == m.Sender {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "manager must differ from sender")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgRevokeManager) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgLeverage) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgLeverage) Type() string { return TypeMsgLeverage }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgLeverage) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgLeverage) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err = sdk.ValidateDenom(m.CollateralDenom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if m.TargetLtv.IsNil() || !m.TargetLtv.IsPositive() || m.TargetLtv.GTE(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrLTVOutOfRange, "target_ltv must be in (0, 1)")
+	}
+	if m.MaxSlippage.IsNil() || m.MaxSlippage.IsNegative() || m.MaxSlippage.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrOverSlippage, "max_slippage must be in [0, 1]")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgLeverage) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgDeleverage) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgDeleverage) Type() string { return TypeMsgDeleverage }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgDeleverage) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgDeleverage) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err = sdk.ValidateDenom(m.CollateralDenom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if m.TargetLtv.IsNil() || m.TargetLtv.IsNegative() || m.TargetLtv.GTE(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrLTVOutOfRange, "target_ltv must be in [0, 1)")
+	}
+	if m.MaxSlippage.IsNil() || m.MaxSlippage.IsNegative() || m.MaxSlippage.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrOverSlippage, "max_slippage must be in [0, 1]")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgDeleverage) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// validateOwner validates the optional owner of a collateral account managed
+// by sender. If sender is a manager, proceeds can only go to the owner.
+func validateOwner(sender, owner, to string) error {
+	if len(owner) == 0 || owner == sender {
+		return nil
+	}
+	_, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	if len(to) > 0 && to != owner {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver must be the owner when managed by sender")
+	}
+	return nil
+}
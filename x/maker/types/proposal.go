@@ -141,6 +141,9 @@ func validateBackingRiskParams(params *BackingRiskParams) error {
 	if params.RebackFee != nil && (params.RebackFee.IsNegative() || params.RebackFee.GT(sdk.OneDec())) {
 		return fmt.Errorf("reback fee must be in [0, 1]")
 	}
+	if params.TargetWeight != nil && (params.TargetWeight.IsNegative() || params.TargetWeight.GT(sdk.OneDec())) {
+		return fmt.Errorf("target weight must be in [0, 1]")
+	}
 	return nil
 }
 
@@ -38,7 +38,7 @@ func (m *QueryAllBackingRiskParamsRequest) Reset()         { *m = QueryAllBackin
 func (m *QueryAllBackingRiskParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllBackingRiskParamsRequest) ProtoMessage()    {}
 func (*QueryAllBackingRiskParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{0}
+	return fileDescriptor_afc9464551747cbc, []int{0}
 }
 func (m *QueryAllBackingRiskParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -75,7 +75,7 @@ func (m *QueryAllBackingRiskParamsResponse) Reset()         { *m = QueryAllBacki
 func (m *QueryAllBackingRiskParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllBackingRiskParamsResponse) ProtoMessage()    {}
 func (*QueryAllBackingRiskParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{1}
+	return fileDescriptor_afc9464551747cbc, []int{1}
 }
 func (m *QueryAllBackingRiskParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -118,7 +118,7 @@ func (m *QueryAllCollateralRiskParamsRequest) Reset()         { *m = QueryAllCol
 func (m *QueryAllCollateralRiskParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllCollateralRiskParamsRequest) ProtoMessage()    {}
 func (*QueryAllCollateralRiskParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{2}
+	return fileDescriptor_afc9464551747cbc, []int{2}
 }
 func (m *QueryAllCollateralRiskParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -155,7 +155,7 @@ func (m *QueryAllCollateralRiskParamsResponse) Reset()         { *m = QueryAllCo
 func (m *QueryAllCollateralRiskParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllCollateralRiskParamsResponse) ProtoMessage()    {}
 func (*QueryAllCollateralRiskParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{3}
+	return fileDescriptor_afc9464551747cbc, []int{3}
 }
 func (m *QueryAllCollateralRiskParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -198,7 +198,7 @@ func (m *QueryAllBackingPoolsRequest) Reset()         { *m = QueryAllBackingPool
 func (m *QueryAllBackingPoolsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllBackingPoolsRequest) ProtoMessage()    {}
 func (*QueryAllBackingPoolsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{4}
+	return fileDescriptor_afc9464551747cbc, []int{4}
 }
 func (m *QueryAllBackingPoolsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +235,7 @@ func (m *QueryAllBackingPoolsResponse) Reset()         { *m = QueryAllBackingPoo
 func (m *QueryAllBackingPoolsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllBackingPoolsResponse) ProtoMessage()    {}
 func (*QueryAllBackingPoolsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{5}
+	return fileDescriptor_afc9464551747cbc, []int{5}
 }
 func (m *QueryAllBackingPoolsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -278,7 +278,7 @@ func (m *QueryAllCollateralPoolsRequest) Reset()         { *m = QueryAllCollater
 func (m *QueryAllCollateralPoolsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllCollateralPoolsRequest) ProtoMessage()    {}
 func (*QueryAllCollateralPoolsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{6}
+	return fileDescriptor_afc9464551747cbc, []int{6}
 }
 func (m *QueryAllCollateralPoolsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -315,7 +315,7 @@ func (m *QueryAllCollateralPoolsResponse) Reset()         { *m = QueryAllCollate
 func (m *QueryAllCollateralPoolsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllCollateralPoolsResponse) ProtoMessage()    {}
 func (*QueryAllCollateralPoolsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{7}
+	return fileDescriptor_afc9464551747cbc, []int{7}
 }
 func (m *QueryAllCollateralPoolsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,7 +359,7 @@ func (m *QueryBackingPoolRequest) Reset()         { *m = QueryBackingPoolRequest
 func (m *QueryBackingPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBackingPoolRequest) ProtoMessage()    {}
 func (*QueryBackingPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{8}
+	return fileDescriptor_afc9464551747cbc, []int{8}
 }
 func (m *QueryBackingPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +403,7 @@ func (m *QueryBackingPoolResponse) Reset()         { *m = QueryBackingPoolRespon
 func (m *QueryBackingPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBackingPoolResponse) ProtoMessage()    {}
 func (*QueryBackingPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{9}
+	return fileDescriptor_afc9464551747cbc, []int{9}
 }
 func (m *QueryBackingPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -447,7 +447,7 @@ func (m *QueryCollateralPoolRequest) Reset()         { *m = QueryCollateralPoolR
 func (m *QueryCollateralPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCollateralPoolRequest) ProtoMessage()    {}
 func (*QueryCollateralPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{10}
+	return fileDescriptor_afc9464551747cbc, []int{10}
 }
 func (m *QueryCollateralPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -491,7 +491,7 @@ func (m *QueryCollateralPoolResponse) Reset()         { *m = QueryCollateralPool
 func (m *QueryCollateralPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCollateralPoolResponse) ProtoMessage()    {}
 func (*QueryCollateralPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{11}
+	return fileDescriptor_afc9464551747cbc, []int{11}
 }
 func (m *QueryCollateralPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -536,7 +536,7 @@ func (m *QueryCollateralOfAccountRequest) Reset()         { *m = QueryCollateral
 func (m *QueryCollateralOfAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCollateralOfAccountRequest) ProtoMessage()    {}
 func (*QueryCollateralOfAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{12}
+	return fileDescriptor_afc9464551747cbc, []int{12}
 }
 func (m *QueryCollateralOfAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,7 +587,7 @@ func (m *QueryCollateralOfAccountResponse) Reset()         { *m = QueryCollatera
 func (m *QueryCollateralOfAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCollateralOfAccountResponse) ProtoMessage()    {}
 func (*QueryCollateralOfAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{13}
+	return fileDescriptor_afc9464551747cbc, []int{13}
 }
 func (m *QueryCollateralOfAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -623,6 +623,94 @@ func (m *QueryCollateralOfAccountResponse) GetAccountCollateral() AccountCollate
 	return AccountCollateral{}
 }
 
+type QueryCollateralManagersRequest struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *QueryCollateralManagersRequest) Reset()         { *m = QueryCollateralManagersRequest{} }
+func (m *QueryCollateralManagersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCollateralManagersRequest) ProtoMessage()    {}
+func (*QueryCollateralManagersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afc9464551747cbc, []int{14}
+}
+func (m *QueryCollateralManagersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCollateralManagersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollateralManagersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCollateralManagersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollateralManagersRequest.Merge(m, src)
+}
+func (m *QueryCollateralManagersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollateralManagersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollateralManagersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCollateralManagersRequest proto.InternalMessageInfo
+
+func (m *QueryCollateralManagersRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type QueryCollateralManagersResponse struct {
+	Managers []string `protobuf:"bytes,1,rep,name=managers,proto3" json:"managers,omitempty"`
+}
+
+func (m *QueryCollateralManagersResponse) Reset()         { *m = QueryCollateralManagersResponse{} }
+func (m *QueryCollateralManagersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCollateralManagersResponse) ProtoMessage()    {}
+func (*QueryCollateralManagersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afc9464551747cbc, []int{15}
+}
+func (m *QueryCollateralManagersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCollateralManagersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollateralManagersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCollateralManagersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollateralManagersResponse.Merge(m, src)
+}
+func (m *QueryCollateralManagersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollateralManagersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollateralManagersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCollateralManagersResponse proto.InternalMessageInfo
+
+func (m *QueryCollateralManagersResponse) GetManagers() []string {
+	if m != nil {
+		return m.Managers
+	}
+	return nil
+}
+
 type QueryTotalBackingRequest struct {
 }
 
@@ -630,7 +718,7 @@ func (m *QueryTotalBackingRequest) Reset()         { *m = QueryTotalBackingReque
 func (m *QueryTotalBackingRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalBackingRequest) ProtoMessage()    {}
 func (*QueryTotalBackingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{14}
+	return fileDescriptor_afc9464551747cbc, []int{16}
 }
 func (m *QueryTotalBackingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -667,7 +755,7 @@ func (m *QueryTotalBackingResponse) Reset()         { *m = QueryTotalBackingResp
 func (m *QueryTotalBackingResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalBackingResponse) ProtoMessage()    {}
 func (*QueryTotalBackingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{15}
+	return fileDescriptor_afc9464551747cbc, []int{17}
 }
 func (m *QueryTotalBackingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -710,7 +798,7 @@ func (m *QueryTotalCollateralRequest) Reset()         { *m = QueryTotalCollatera
 func (m *QueryTotalCollateralRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalCollateralRequest) ProtoMessage()    {}
 func (*QueryTotalCollateralRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{16}
+	return fileDescriptor_afc9464551747cbc, []int{18}
 }
 func (m *QueryTotalCollateralRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -747,7 +835,7 @@ func (m *QueryTotalCollateralResponse) Reset()         { *m = QueryTotalCollater
 func (m *QueryTotalCollateralResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalCollateralResponse) ProtoMessage()    {}
 func (*QueryTotalCollateralResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{17}
+	return fileDescriptor_afc9464551747cbc, []int{19}
 }
 func (m *QueryTotalCollateralResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -790,7 +878,7 @@ func (m *QueryBackingRatioRequest) Reset()         { *m = QueryBackingRatioReque
 func (m *QueryBackingRatioRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBackingRatioRequest) ProtoMessage()    {}
 func (*QueryBackingRatioRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{18}
+	return fileDescriptor_afc9464551747cbc, []int{20}
 }
 func (m *QueryBackingRatioRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,7 +916,7 @@ func (m *QueryBackingRatioResponse) Reset()         { *m = QueryBackingRatioResp
 func (m *QueryBackingRatioResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBackingRatioResponse) ProtoMessage()    {}
 func (*QueryBackingRatioResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{19}
+	return fileDescriptor_afc9464551747cbc, []int{21}
 }
 func (m *QueryBackingRatioResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -872,7 +960,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{20}
+	return fileDescriptor_afc9464551747cbc, []int{22}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -911,7 +999,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{21}
+	return fileDescriptor_afc9464551747cbc, []int{23}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -957,7 +1045,7 @@ func (m *EstimateMintBySwapInRequest) Reset()         { *m = EstimateMintBySwapI
 func (m *EstimateMintBySwapInRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateMintBySwapInRequest) ProtoMessage()    {}
 func (*EstimateMintBySwapInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{22}
+	return fileDescriptor_afc9464551747cbc, []int{24}
 }
 func (m *EstimateMintBySwapInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1009,7 +1097,7 @@ func (m *EstimateMintBySwapInRequest) GetFullBacking() bool {
 
 type EstimateMintBySwapInResponse struct {
 	BackingIn types.Coin `protobuf:"bytes,1,opt,name=backing_in,json=backingIn,proto3" json:"backing_in"`
-	MageIn    types.Coin `protobuf:"bytes,2,opt,name=mage_in,json=mageOut,proto3" json:"mage_in"`
+	MageIn    types.Coin `protobuf:"bytes,2,opt,name=mage_in,json=mageIn,proto3" json:"mage_in"`
 	MintFee   types.Coin `protobuf:"bytes,3,opt,name=mint_fee,json=mintFee,proto3" json:"mint_fee"`
 }
 
@@ -1017,7 +1105,7 @@ func (m *EstimateMintBySwapInResponse) Reset()         { *m = EstimateMintBySwap
 func (m *EstimateMintBySwapInResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateMintBySwapInResponse) ProtoMessage()    {}
 func (*EstimateMintBySwapInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{23}
+	return fileDescriptor_afc9464551747cbc, []int{25}
 }
 func (m *EstimateMintBySwapInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1077,7 +1165,7 @@ func (m *EstimateMintBySwapOutRequest) Reset()         { *m = EstimateMintBySwap
 func (m *EstimateMintBySwapOutRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateMintBySwapOutRequest) ProtoMessage()    {}
 func (*EstimateMintBySwapOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{24}
+	return fileDescriptor_afc9464551747cbc, []int{26}
 }
 func (m *EstimateMintBySwapOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1129,7 +1217,7 @@ func (m *EstimateMintBySwapOutRequest) GetFullBacking() bool {
 
 type EstimateMintBySwapOutResponse struct {
 	BackingIn types.Coin `protobuf:"bytes,1,opt,name=backing_in,json=backingIn,proto3" json:"backing_in"`
-	MageIn    types.Coin `protobuf:"bytes,2,opt,name=mage_in,json=mageOut,proto3" json:"mage_in"`
+	MageIn    types.Coin `protobuf:"bytes,2,opt,name=mage_in,json=mageIn,proto3" json:"mage_in"`
 	MintOut   types.Coin `protobuf:"bytes,3,opt,name=mint_out,json=mintOut,proto3" json:"mint_out"`
 	MintFee   types.Coin `protobuf:"bytes,4,opt,name=mint_fee,json=mintFee,proto3" json:"mint_fee"`
 }
@@ -1138,7 +1226,7 @@ func (m *EstimateMintBySwapOutResponse) Reset()         { *m = EstimateMintBySwa
 func (m *EstimateMintBySwapOutResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateMintBySwapOutResponse) ProtoMessage()    {}
 func (*EstimateMintBySwapOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{25}
+	return fileDescriptor_afc9464551747cbc, []int{27}
 }
 func (m *EstimateMintBySwapOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1204,7 +1292,7 @@ func (m *EstimateBurnBySwapInRequest) Reset()         { *m = EstimateBurnBySwapI
 func (m *EstimateBurnBySwapInRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateBurnBySwapInRequest) ProtoMessage()    {}
 func (*EstimateBurnBySwapInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{26}
+	return fileDescriptor_afc9464551747cbc, []int{28}
 }
 func (m *EstimateBurnBySwapInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1258,7 +1346,7 @@ func (m *EstimateBurnBySwapInResponse) Reset()         { *m = EstimateBurnBySwap
 func (m *EstimateBurnBySwapInResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateBurnBySwapInResponse) ProtoMessage()    {}
 func (*EstimateBurnBySwapInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{27}
+	return fileDescriptor_afc9464551747cbc, []int{29}
 }
 func (m *EstimateBurnBySwapInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1324,7 +1412,7 @@ func (m *EstimateBurnBySwapOutRequest) Reset()         { *m = EstimateBurnBySwap
 func (m *EstimateBurnBySwapOutRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateBurnBySwapOutRequest) ProtoMessage()    {}
 func (*EstimateBurnBySwapOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{28}
+	return fileDescriptor_afc9464551747cbc, []int{30}
 }
 func (m *EstimateBurnBySwapOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1377,7 +1465,7 @@ func (m *EstimateBurnBySwapOutResponse) Reset()         { *m = EstimateBurnBySwa
 func (m *EstimateBurnBySwapOutResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateBurnBySwapOutResponse) ProtoMessage()    {}
 func (*EstimateBurnBySwapOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{29}
+	return fileDescriptor_afc9464551747cbc, []int{31}
 }
 func (m *EstimateBurnBySwapOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1427,6 +1515,246 @@ func (m *EstimateBurnBySwapOutResponse) GetBurnFee() types.Coin {
 	return types.Coin{}
 }
 
+type EstimateBasketMintRequest struct {
+	BackingInMax github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=backing_in_max,json=backingInMax,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"backing_in_max"`
+	MageInMax    types.Coin                               `protobuf:"bytes,2,opt,name=mage_in_max,json=mageInMax,proto3" json:"mage_in_max"`
+	FullBacking  bool                                     `protobuf:"varint,3,opt,name=full_backing,json=fullBacking,proto3" json:"full_backing,omitempty"`
+}
+
+func (m *EstimateBasketMintRequest) Reset()         { *m = EstimateBasketMintRequest{} }
+func (m *EstimateBasketMintRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateBasketMintRequest) ProtoMessage()    {}
+func (*EstimateBasketMintRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afc9464551747cbc, []int{32}
+}
+func (m *EstimateBasketMintRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateBasketMintRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateBasketMintRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateBasketMintRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateBasketMintRequest.Merge(m, src)
+}
+func (m *EstimateBasketMintRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateBasketMintRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateBasketMintRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateBasketMintRequest proto.InternalMessageInfo
+
+func (m *EstimateBasketMintRequest) GetBackingInMax() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BackingInMax
+	}
+	return nil
+}
+
+func (m *EstimateBasketMintRequest) GetMageInMax() types.Coin {
+	if m != nil {
+		return m.MageInMax
+	}
+	return types.Coin{}
+}
+
+func (m *EstimateBasketMintRequest) GetFullBacking() bool {
+	if m != nil {
+		return m.FullBacking
+	}
+	return false
+}
+
+type EstimateBasketMintResponse struct {
+	BackingIn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=backing_in,json=backingIn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"backing_in"`
+	MageIn    types.Coin                               `protobuf:"bytes,2,opt,name=mage_in,json=mageIn,proto3" json:"mage_in"`
+	MintOut   types.Coin                               `protobuf:"bytes,3,opt,name=mint_out,json=mintOut,proto3" json:"mint_out"`
+	MintFee   types.Coin                               `protobuf:"bytes,4,opt,name=mint_fee,json=mintFee,proto3" json:"mint_fee"`
+}
+
+func (m *EstimateBasketMintResponse) Reset()         { *m = EstimateBasketMintResponse{} }
+func (m *EstimateBasketMintResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateBasketMintResponse) ProtoMessage()    {}
+func (*EstimateBasketMintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afc9464551747cbc, []int{33}
+}
+func (m *EstimateBasketMintResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateBasketMintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateBasketMintResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateBasketMintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateBasketMintResponse.Merge(m, src)
+}
+func (m *EstimateBasketMintResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateBasketMintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateBasketMintResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateBasketMintResponse proto.InternalMessageInfo
+
+func (m *EstimateBasketMintResponse) GetBackingIn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BackingIn
+	}
+	return nil
+}
+
+func (m *EstimateBasketMintResponse) GetMageIn() types.Coin {
+	if m != nil {
+		return m.MageIn
+	}
+	return types.Coin{}
+}
+
+func (m *EstimateBasketMintResponse) GetMintOut() types.Coin {
+	if m != nil {
+		return m.MintOut
+	}
+	return types.Coin{}
+}
+
+func (m *EstimateBasketMintResponse) GetMintFee() types.Coin {
+	if m != nil {
+		return m.MintFee
+	}
+	return types.Coin{}
+}
+
+type EstimateBasketBurnRequest struct {
+	BurnIn        types.Coin `protobuf:"bytes,1,opt,name=burn_in,json=burnIn,proto3" json:"burn_in"`
+	BackingDenoms []string   `protobuf:"bytes,2,rep,name=backing_denoms,json=backingDenoms,proto3" json:"backing_denoms,omitempty"`
+}
+
+func (m *EstimateBasketBurnRequest) Reset()         { *m = EstimateBasketBurnRequest{} }
+func (m *EstimateBasketBurnRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateBasketBurnRequest) ProtoMessage()    {}
+func (*EstimateBasketBurnRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afc9464551747cbc, []int{34}
+}
+func (m *EstimateBasketBurnRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateBasketBurnRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateBasketBurnRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateBasketBurnRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateBasketBurnRequest.Merge(m, src)
+}
+func (m *EstimateBasketBurnRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateBasketBurnRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateBasketBurnRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateBasketBurnRequest proto.InternalMessageInfo
+
+func (m *EstimateBasketBurnRequest) GetBurnIn() types.Coin {
+	if m != nil {
+		return m.BurnIn
+	}
+	return types.Coin{}
+}
+
+func (m *EstimateBasketBurnRequest) GetBackingDenoms() []string {
+	if m != nil {
+		return m.BackingDenoms
+	}
+	return nil
+}
+
+type EstimateBasketBurnResponse struct {
+	BackingOut github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=backing_out,json=backingOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"backing_out"`
+	MageOut    types.Coin                               `protobuf:"bytes,2,opt,name=mage_out,json=mageOut,proto3" json:"mage_out"`
+	BurnFee    types.Coin                               `protobuf:"bytes,3,opt,name=burn_fee,json=burnFee,proto3" json:"burn_fee"`
+}
+
+func (m *EstimateBasketBurnResponse) Reset()         { *m = EstimateBasketBurnResponse{} }
+func (m *EstimateBasketBurnResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateBasketBurnResponse) ProtoMessage()    {}
+func (*EstimateBasketBurnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afc9464551747cbc, []int{35}
+}
+func (m *EstimateBasketBurnResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateBasketBurnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateBasketBurnResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateBasketBurnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateBasketBurnResponse.Merge(m, src)
+}
+func (m *EstimateBasketBurnResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateBasketBurnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateBasketBurnResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateBasketBurnResponse proto.InternalMessageInfo
+
+func (m *EstimateBasketBurnResponse) GetBackingOut() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BackingOut
+	}
+	return nil
+}
+
+func (m *EstimateBasketBurnResponse) GetMageOut() types.Coin {
+	if m != nil {
+		return m.MageOut
+	}
+	return types.Coin{}
+}
+
+func (m *EstimateBasketBurnResponse) GetBurnFee() types.Coin {
+	if m != nil {
+		return m.BurnFee
+	}
+	return types.Coin{}
+}
+
 type EstimateBuyBackingInRequest struct {
 	BackingOut types.Coin `protobuf:"bytes,1,opt,name=backing_out,json=backingOut,proto3" json:"backing_out"`
 }
@@ -1435,7 +1763,7 @@ func (m *EstimateBuyBackingInRequest) Reset()         { *m = EstimateBuyBackingI
 func (m *EstimateBuyBackingInRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateBuyBackingInRequest) ProtoMessage()    {}
 func (*EstimateBuyBackingInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{30}
+	return fileDescriptor_afc9464551747cbc, []int{36}
 }
 func (m *EstimateBuyBackingInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1472,7 +1800,7 @@ func (m *EstimateBuyBackingInRequest) GetBackingOut() types.Coin {
 }
 
 type EstimateBuyBackingInResponse struct {
-	MageIn     types.Coin `protobuf:"bytes,1,opt,name=mage_in,json=mageOut,proto3" json:"mage_in"`
+	MageIn     types.Coin `protobuf:"bytes,1,opt,name=mage_in,json=mageIn,proto3" json:"mage_in"`
 	BuybackFee types.Coin `protobuf:"bytes,2,opt,name=buyback_fee,json=buybackFee,proto3" json:"buyback_fee"`
 }
 
@@ -1480,7 +1808,7 @@ func (m *EstimateBuyBackingInResponse) Reset()         { *m = EstimateBuyBacking
 func (m *EstimateBuyBackingInResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateBuyBackingInResponse) ProtoMessage()    {}
 func (*EstimateBuyBackingInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{31}
+	return fileDescriptor_afc9464551747cbc, []int{37}
 }
 func (m *EstimateBuyBackingInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1524,7 +1852,7 @@ func (m *EstimateBuyBackingInResponse) GetBuybackFee() types.Coin {
 }
 
 type EstimateBuyBackingOutRequest struct {
-	MageIn       types.Coin `protobuf:"bytes,1,opt,name=mage_in,json=mageOut,proto3" json:"mage_in"`
+	MageIn       types.Coin `protobuf:"bytes,1,opt,name=mage_in,json=mageIn,proto3" json:"mage_in"`
 	BackingDenom string     `protobuf:"bytes,2,opt,name=backing_denom,json=backingDenom,proto3" json:"backing_denom,omitempty"`
 }
 
@@ -1532,7 +1860,7 @@ func (m *EstimateBuyBackingOutRequest) Reset()         { *m = EstimateBuyBacking
 func (m *EstimateBuyBackingOutRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateBuyBackingOutRequest) ProtoMessage()    {}
 func (*EstimateBuyBackingOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{32}
+	return fileDescriptor_afc9464551747cbc, []int{38}
 }
 func (m *EstimateBuyBackingOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1584,7 +1912,7 @@ func (m *EstimateBuyBackingOutResponse) Reset()         { *m = EstimateBuyBackin
 func (m *EstimateBuyBackingOutResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateBuyBackingOutResponse) ProtoMessage()    {}
 func (*EstimateBuyBackingOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{33}
+	return fileDescriptor_afc9464551747cbc, []int{39}
 }
 func (m *EstimateBuyBackingOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1636,7 +1964,7 @@ func (m *EstimateSellBackingInRequest) Reset()         { *m = EstimateSellBackin
 func (m *EstimateSellBackingInRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateSellBackingInRequest) ProtoMessage()    {}
 func (*EstimateSellBackingInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{34}
+	return fileDescriptor_afc9464551747cbc, []int{40}
 }
 func (m *EstimateSellBackingInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1688,7 +2016,7 @@ func (m *EstimateSellBackingInResponse) Reset()         { *m = EstimateSellBacki
 func (m *EstimateSellBackingInResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateSellBackingInResponse) ProtoMessage()    {}
 func (*EstimateSellBackingInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{35}
+	return fileDescriptor_afc9464551747cbc, []int{41}
 }
 func (m *EstimateSellBackingInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1739,7 +2067,7 @@ func (m *EstimateSellBackingOutRequest) Reset()         { *m = EstimateSellBacki
 func (m *EstimateSellBackingOutRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateSellBackingOutRequest) ProtoMessage()    {}
 func (*EstimateSellBackingOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{36}
+	return fileDescriptor_afc9464551747cbc, []int{42}
 }
 func (m *EstimateSellBackingOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1784,7 +2112,7 @@ func (m *EstimateSellBackingOutResponse) Reset()         { *m = EstimateSellBack
 func (m *EstimateSellBackingOutResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateSellBackingOutResponse) ProtoMessage()    {}
 func (*EstimateSellBackingOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6c4552b535aace, []int{37}
+	return fileDescriptor_afc9464551747cbc, []int{43}
 }
 func (m *EstimateSellBackingOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1842,6 +2170,8 @@ func init() {
 	proto.RegisterType((*QueryCollateralPoolResponse)(nil), "warmage.maker.v1.QueryCollateralPoolResponse")
 	proto.RegisterType((*QueryCollateralOfAccountRequest)(nil), "warmage.maker.v1.QueryCollateralOfAccountRequest")
 	proto.RegisterType((*QueryCollateralOfAccountResponse)(nil), "warmage.maker.v1.QueryCollateralOfAccountResponse")
+	proto.RegisterType((*QueryCollateralManagersRequest)(nil), "warmage.maker.v1.QueryCollateralManagersRequest")
+	proto.RegisterType((*QueryCollateralManagersResponse)(nil), "warmage.maker.v1.QueryCollateralManagersResponse")
 	proto.RegisterType((*QueryTotalBackingRequest)(nil), "warmage.maker.v1.QueryTotalBackingRequest")
 	proto.RegisterType((*QueryTotalBackingResponse)(nil), "warmage.maker.v1.QueryTotalBackingResponse")
 	proto.RegisterType((*QueryTotalCollateralRequest)(nil), "warmage.maker.v1.QueryTotalCollateralRequest")
@@ -1858,6 +2188,10 @@ func init() {
 	proto.RegisterType((*EstimateBurnBySwapInResponse)(nil), "warmage.maker.v1.EstimateBurnBySwapInResponse")
 	proto.RegisterType((*EstimateBurnBySwapOutRequest)(nil), "warmage.maker.v1.EstimateBurnBySwapOutRequest")
 	proto.RegisterType((*EstimateBurnBySwapOutResponse)(nil), "warmage.maker.v1.EstimateBurnBySwapOutResponse")
+	proto.RegisterType((*EstimateBasketMintRequest)(nil), "warmage.maker.v1.EstimateBasketMintRequest")
+	proto.RegisterType((*EstimateBasketMintResponse)(nil), "warmage.maker.v1.EstimateBasketMintResponse")
+	proto.RegisterType((*EstimateBasketBurnRequest)(nil), "warmage.maker.v1.EstimateBasketBurnRequest")
+	proto.RegisterType((*EstimateBasketBurnResponse)(nil), "warmage.maker.v1.EstimateBasketBurnResponse")
 	proto.RegisterType((*EstimateBuyBackingInRequest)(nil), "warmage.maker.v1.EstimateBuyBackingInRequest")
 	proto.RegisterType((*EstimateBuyBackingInResponse)(nil), "warmage.maker.v1.EstimateBuyBackingInResponse")
 	proto.RegisterType((*EstimateBuyBackingOutRequest)(nil), "warmage.maker.v1.EstimateBuyBackingOutRequest")
@@ -1868,117 +2202,130 @@ func init() {
 	proto.RegisterType((*EstimateSellBackingOutResponse)(nil), "warmage.maker.v1.EstimateSellBackingOutResponse")
 }
 
-func init() { proto.RegisterFile("warmage/maker/v1/query.proto", fileDescriptor_0c6c4552b535aace) }
-
-var fileDescriptor_0c6c4552b535aace = []byte{
-	// 1710 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xcf, 0x6f, 0xdc, 0x44,
-	0x1b, 0xc7, 0xe3, 0xa4, 0x6f, 0xd2, 0x3e, 0x9b, 0x36, 0xed, 0x34, 0xef, 0xdb, 0x74, 0x9b, 0x6e,
-	0x36, 0x4e, 0x9a, 0x37, 0x3f, 0xbd, 0x4d, 0x0a, 0x55, 0xc5, 0xa1, 0xd0, 0xed, 0x2f, 0x82, 0x14,
-	0xa5, 0x4d, 0x41, 0x42, 0x5c, 0x2c, 0xef, 0xd6, 0x49, 0xad, 0xec, 0xda, 0xdb, 0xb5, 0xdd, 0x36,
-	0x08, 0x84, 0xc4, 0x99, 0x43, 0x81, 0x0b, 0x42, 0x45, 0x02, 0x71, 0xa1, 0x08, 0x24, 0xc4, 0x1f,
-	0xc0, 0xb9, 0xdc, 0x2a, 0xe0, 0x00, 0x1c, 0x2a, 0xd4, 0xf2, 0x87, 0xa0, 0x19, 0x3f, 0xb6, 0xc7,
-	0xeb, 0xb1, 0x33, 0x6e, 0x7a, 0xe0, 0x04, 0x9d, 0x99, 0x67, 0x9e, 0xcf, 0xf3, 0x7d, 0x9e, 0x99,
-	0xf5, 0x3c, 0x81, 0xf1, 0xb6, 0xd9, 0x6d, 0x59, 0x8e, 0x5d, 0x6b, 0x1b, 0xdb, 0x66, 0xb7, 0x76,
-	0x67, 0xb9, 0x76, 0xdb, 0x37, 0xbb, 0x3b, 0x5a, 0xa7, 0xeb, 0x78, 0x0e, 0x39, 0x8c, 0xb3, 0x1a,
-	0x9b, 0xd5, 0xee, 0x2c, 0x97, 0x47, 0xb7, 0x9c, 0x2d, 0x87, 0x4d, 0xd6, 0xe8, 0xff, 0x05, 0xeb,
-	0xca, 0xe3, 0x5b, 0x8e, 0xb3, 0xd5, 0x32, 0x6b, 0x46, 0xc7, 0xaa, 0x19, 0xb6, 0xed, 0x78, 0x86,
-	0x67, 0x39, 0xb6, 0x8b, 0xb3, 0x95, 0x94, 0x8f, 0x2d, 0xd3, 0x36, 0x5d, 0x2b, 0x9c, 0x4f, 0x33,
-	0x04, 0xee, 0xd0, 0xba, 0xe9, 0xb8, 0x6d, 0xc7, 0xad, 0x35, 0x0c, 0xd7, 0xac, 0xdd, 0x59, 0x6e,
-	0x98, 0x9e, 0xb1, 0x5c, 0x6b, 0x3a, 0x96, 0x1d, 0xcc, 0xab, 0x2a, 0x54, 0xaf, 0x53, 0xe4, 0x0b,
-	0xad, 0x56, 0xdd, 0x68, 0x6e, 0x5b, 0xf6, 0xd6, 0x86, 0xe5, 0x6e, 0x5f, 0x33, 0xba, 0x46, 0xdb,
-	0xdd, 0x30, 0x6f, 0xfb, 0xa6, 0xeb, 0xa9, 0x0e, 0x4c, 0xe6, 0xac, 0x71, 0x3b, 0x8e, 0xed, 0x9a,
-	0xe4, 0x0d, 0x28, 0x75, 0x2d, 0x77, 0x5b, 0xef, 0xb0, 0xe1, 0x31, 0xa5, 0x3a, 0x30, 0x5b, 0x5a,
-	0x99, 0xd2, 0x7a, 0x25, 0xd0, 0x52, 0x3b, 0xd4, 0xf7, 0x3d, 0x7a, 0x32, 0xd1, 0xb7, 0x01, 0xdd,
-	0x68, 0x44, 0x3d, 0x05, 0x53, 0xa1, 0xc3, 0x8b, 0x4e, 0xab, 0x65, 0x78, 0x66, 0xd7, 0x68, 0xa5,
-	0xb9, 0x7c, 0x98, 0xce, 0x5f, 0x86, 0x68, 0x6b, 0x22, 0xb4, 0x99, 0x34, 0x9a, 0x68, 0x13, 0x01,
-	0xdd, 0x49, 0x38, 0xd1, 0x23, 0xc7, 0x35, 0xc7, 0x69, 0x45, 0x54, 0xb7, 0x60, 0x5c, 0x3c, 0x8d,
-	0x34, 0xaf, 0xc3, 0xc1, 0x46, 0x30, 0xae, 0x77, 0xe8, 0x04, 0xf2, 0x9c, 0x4c, 0xf3, 0x50, 0x3b,
-	0xdc, 0x02, 0x31, 0x86, 0x1b, 0xdc, 0x8e, 0x6a, 0x15, 0x2a, 0xe9, 0xf8, 0x13, 0x2c, 0x1e, 0x4c,
-	0x64, 0xae, 0x40, 0x9c, 0xeb, 0x70, 0xb8, 0x19, 0x4d, 0x25, 0x88, 0xaa, 0x62, 0xa2, 0x78, 0x23,
-	0x84, 0x1a, 0x69, 0x26, 0xb7, 0x56, 0xcf, 0xc3, 0x31, 0xe6, 0x95, 0x0b, 0x1f, 0x81, 0xc8, 0x54,
-	0x1c, 0xfc, 0x4d, 0xd3, 0x76, 0xda, 0x63, 0x4a, 0x55, 0x99, 0x3d, 0x10, 0xc5, 0x75, 0x89, 0x8e,
-	0xa9, 0x0d, 0x18, 0x4b, 0xdb, 0x23, 0xee, 0x15, 0x18, 0xe6, 0xd5, 0x63, 0xf6, 0x92, 0xe2, 0x95,
-	0x38, 0xf1, 0xd4, 0xab, 0x50, 0x66, 0x3e, 0x92, 0xb2, 0x84, 0x98, 0x73, 0x09, 0x51, 0x78, 0x52,
-	0x2e, 0xd8, 0x00, 0xd6, 0xc6, 0x6a, 0xe8, 0xdd, 0x08, 0x79, 0xd7, 0x61, 0xa4, 0x47, 0x5e, 0x44,
-	0x96, 0x55, 0xf7, 0x50, 0x52, 0x5d, 0x75, 0x13, 0x53, 0x1a, 0x2f, 0x5c, 0xdf, 0xbc, 0xd0, 0x6c,
-	0x3a, 0xbe, 0xed, 0x85, 0xf4, 0x63, 0x30, 0x64, 0x04, 0x23, 0x08, 0x1d, 0xfe, 0x53, 0x18, 0x57,
-	0xbf, 0x38, 0xae, 0xf7, 0xf0, 0x62, 0x10, 0xfa, 0xc1, 0xe0, 0xde, 0x06, 0x82, 0x3b, 0xeb, 0xb1,
-	0x39, 0xc6, 0x27, 0x38, 0xfa, 0x68, 0x9e, 0x0a, 0xf1, 0x88, 0xd1, 0x3b, 0xa1, 0x96, 0xb1, 0x04,
-	0xde, 0x74, 0x3c, 0x23, 0xba, 0x74, 0xb0, 0xa8, 0x37, 0xe1, 0xb8, 0x60, 0x0e, 0x91, 0x56, 0xe1,
-	0xa0, 0x47, 0xc7, 0x75, 0x4c, 0x36, 0xd2, 0x54, 0xd2, 0x34, 0xbc, 0x79, 0x78, 0xbc, 0x3c, 0x6e,
-	0x2c, 0x3a, 0xe7, 0x6c, 0x21, 0x77, 0x37, 0x20, 0x46, 0x17, 0xcf, 0x79, 0x6a, 0x1a, 0x49, 0x36,
-	0xe0, 0x70, 0x40, 0x92, 0x92, 0x66, 0x32, 0x03, 0x26, 0x7d, 0xb2, 0xbc, 0xe4, 0x70, 0x24, 0x4b,
-	0x18, 0x35, 0xfd, 0xa1, 0x08, 0x79, 0x1e, 0x28, 0xa8, 0x4b, 0x72, 0x12, 0x69, 0x6e, 0xc4, 0x07,
-	0xaf, 0x4b, 0x27, 0x82, 0xca, 0xa8, 0x6b, 0xd4, 0xcf, 0x9f, 0x4f, 0x26, 0x66, 0xb6, 0x2c, 0xef,
-	0x96, 0xdf, 0xd0, 0x9a, 0x4e, 0xbb, 0x86, 0xbf, 0x18, 0xc1, 0x7f, 0x96, 0xdc, 0x9b, 0xdb, 0x35,
-	0x6f, 0xa7, 0x63, 0xba, 0xda, 0x25, 0xb3, 0x19, 0x1d, 0x54, 0xb6, 0x39, 0x99, 0x87, 0x23, 0x2d,
-	0xc3, 0xf5, 0x74, 0xbf, 0x73, 0xd3, 0xf0, 0x4c, 0xbd, 0xd1, 0x72, 0x9a, 0xdb, 0xac, 0x9e, 0x06,
-	0x36, 0x46, 0xe8, 0xc4, 0x5b, 0x6c, 0xbc, 0x4e, 0x87, 0xd5, 0x51, 0x20, 0x8c, 0x2e, 0x79, 0x85,
-	0xaf, 0xc1, 0xd1, 0xc4, 0x28, 0xd2, 0x9e, 0x85, 0xc1, 0xe8, 0xb2, 0xa6, 0x8a, 0x8d, 0x09, 0x0e,
-	0x0b, 0x7f, 0x3d, 0xe3, 0x6a, 0xf5, 0x2b, 0x05, 0x4e, 0x5c, 0x76, 0x3d, 0xab, 0x6d, 0x78, 0xe6,
-	0x9a, 0x65, 0x7b, 0xf5, 0x9d, 0x1b, 0x77, 0x8d, 0xce, 0xaa, 0x1d, 0x9e, 0x8c, 0x57, 0x60, 0x7f,
-	0xdb, 0xb2, 0x3d, 0xdd, 0xf1, 0x3d, 0xdc, 0xf9, 0xb8, 0x16, 0xc4, 0xa9, 0xd1, 0x1f, 0x48, 0x0d,
-	0x7f, 0x20, 0xb5, 0x8b, 0x8e, 0x65, 0xe3, 0xd6, 0x43, 0xd4, 0x60, 0xdd, 0x17, 0x5c, 0x5d, 0xfd,
-	0xe9, 0xab, 0x8b, 0x4c, 0xc2, 0xf0, 0xa6, 0xdf, 0x8a, 0xab, 0x6f, 0xa0, 0xaa, 0xcc, 0xee, 0xdf,
-	0x28, 0xd1, 0xb1, 0xb0, 0xac, 0x7e, 0x53, 0x60, 0x5c, 0xcc, 0x88, 0xc1, 0x9f, 0x07, 0x08, 0x1d,
-	0x59, 0xb6, 0x2c, 0xe6, 0x01, 0x34, 0x59, 0xb5, 0xc9, 0x39, 0x18, 0xa2, 0x52, 0x51, 0xe3, 0x7e,
-	0x39, 0xe3, 0x41, 0xba, 0x7e, 0xd5, 0x8e, 0xe4, 0xd9, 0x34, 0x4d, 0x46, 0x2e, 0x2b, 0xcf, 0x15,
-	0xd3, 0x54, 0x7f, 0x16, 0x86, 0xb5, 0xee, 0x47, 0xb7, 0xd2, 0x65, 0x38, 0x14, 0x87, 0xa5, 0xb7,
-	0x8d, 0x7b, 0xb2, 0xa1, 0x0d, 0x47, 0xa1, 0xad, 0x19, 0xf7, 0xc8, 0xab, 0x50, 0xc2, 0xe8, 0xd8,
-	0x1e, 0x92, 0x11, 0x1e, 0x08, 0x22, 0xa4, 0x1b, 0x48, 0xa4, 0xe8, 0xe3, 0x7e, 0x38, 0x99, 0x11,
-	0xcb, 0xbf, 0x26, 0x47, 0xb4, 0x84, 0x07, 0x0a, 0x96, 0x30, 0x9f, 0xdf, 0x7d, 0x05, 0xf3, 0xfb,
-	0x90, 0x3b, 0x5a, 0x75, 0xbf, 0x6b, 0xf7, 0x1e, 0xad, 0xab, 0x30, 0x12, 0x2a, 0xe2, 0xf8, 0x5e,
-	0x91, 0xfc, 0x86, 0xc7, 0x6a, 0xdd, 0xf7, 0x68, 0x7e, 0x2e, 0xc0, 0x30, 0x93, 0x26, 0xdc, 0x45,
-	0x52, 0x1f, 0xa0, 0x46, 0xc1, 0x16, 0xea, 0x27, 0xfd, 0x71, 0x2d, 0x26, 0x59, 0x31, 0x7d, 0xe7,
-	0x60, 0xa8, 0xe1, 0x77, 0xed, 0x02, 0xb9, 0x1b, 0xa4, 0xeb, 0x57, 0x6d, 0xf2, 0x1a, 0x94, 0xb8,
-	0x30, 0xa5, 0xe1, 0xe2, 0x10, 0x69, 0x12, 0xc2, 0xf8, 0xa4, 0x13, 0x88, 0xb1, 0x51, 0x5b, 0xc6,
-	0x5d, 0x24, 0x81, 0xd4, 0x80, 0x26, 0xf0, 0x7d, 0x91, 0x26, 0xdc, 0xf9, 0x7c, 0x7e, 0x4d, 0x64,
-	0x6e, 0x46, 0xf5, 0x0f, 0x25, 0x3e, 0x53, 0x3d, 0xfe, 0x31, 0x29, 0x3d, 0xd2, 0x2a, 0x7b, 0x93,
-	0xb6, 0x7f, 0x0f, 0xd2, 0x0e, 0x14, 0x94, 0x56, 0xe7, 0x8f, 0x46, 0xf8, 0xfb, 0x1b, 0x1f, 0x8d,
-	0x3d, 0x07, 0xa6, 0x7e, 0xae, 0xf0, 0xc9, 0xe3, 0x3d, 0xc4, 0x05, 0x1d, 0xde, 0x27, 0x4a, 0xb1,
-	0xfb, 0x84, 0xc2, 0xf9, 0x3b, 0xd4, 0x17, 0x0b, 0x5d, 0xba, 0xa0, 0x03, 0x9b, 0x54, 0x61, 0x85,
-	0x6c, 0xc9, 0xc2, 0x7a, 0x4e, 0x36, 0xa9, 0xc2, 0xfa, 0x3a, 0x51, 0x58, 0x09, 0xff, 0x2f, 0xac,
-	0xb0, 0xf6, 0x2e, 0xd2, 0x07, 0xb1, 0x48, 0x37, 0xcc, 0xe8, 0x97, 0x26, 0xf1, 0x65, 0x12, 0x95,
-	0xae, 0x52, 0xb0, 0x74, 0x0b, 0xcb, 0xd4, 0x43, 0xf0, 0x82, 0x7e, 0xd3, 0xea, 0x30, 0xec, 0x9a,
-	0xad, 0x56, 0x51, 0x95, 0x4a, 0xa1, 0x51, 0x70, 0x92, 0x44, 0x90, 0x5c, 0x31, 0xed, 0x11, 0x52,
-	0xfd, 0x52, 0x81, 0x4a, 0x96, 0x07, 0xd4, 0x61, 0x2f, 0xa9, 0x78, 0x01, 0x1a, 0xac, 0xfc, 0x32,
-	0x06, 0xff, 0x61, 0x1f, 0xc5, 0xe4, 0x47, 0x05, 0x46, 0x45, 0x4d, 0x17, 0xb2, 0x92, 0xfe, 0x1e,
-	0xde, 0xad, 0x8b, 0x53, 0x3e, 0x53, 0xc8, 0x26, 0xd0, 0x42, 0x5d, 0xfe, 0xf0, 0xd7, 0xbf, 0x3f,
-	0xed, 0x5f, 0x20, 0x73, 0xb5, 0x54, 0x97, 0xc9, 0x88, 0xbf, 0xa1, 0x74, 0xae, 0xbd, 0x42, 0x7e,
-	0x52, 0xe0, 0x58, 0x46, 0x47, 0x86, 0xbc, 0x9c, 0xcd, 0x90, 0xd3, 0xe8, 0x29, 0x9f, 0x2d, 0x6a,
-	0x86, 0xf4, 0x2f, 0x31, 0x7a, 0x8d, 0x2c, 0x8a, 0xe9, 0xb9, 0xa7, 0x30, 0x1f, 0xc0, 0x17, 0x0a,
-	0x8c, 0xf4, 0x34, 0x6f, 0xc8, 0xd2, 0xae, 0xe2, 0xf1, 0x7d, 0x97, 0xb2, 0x26, 0xbb, 0x1c, 0x41,
-	0x17, 0x18, 0xe8, 0x29, 0x32, 0x95, 0x2f, 0x33, 0xeb, 0xce, 0x90, 0x87, 0x0a, 0x90, 0x74, 0x43,
-	0x87, 0x9c, 0x96, 0x11, 0x29, 0x41, 0xb9, 0x5c, 0xc0, 0x02, 0x41, 0x35, 0x06, 0x3a, 0x4b, 0x66,
-	0x76, 0x55, 0x34, 0x60, 0xfd, 0x48, 0x81, 0x12, 0x17, 0x31, 0x99, 0xcb, 0x70, 0x99, 0x6e, 0x15,
-	0x95, 0xe7, 0x65, 0x96, 0x22, 0xd6, 0x0c, 0xc3, 0xaa, 0x92, 0x4a, 0x1a, 0x8b, 0xd7, 0x8e, 0x7c,
-	0xa6, 0xc0, 0xa1, 0x64, 0x68, 0x64, 0x31, 0xc3, 0x8d, 0xb0, 0x31, 0x54, 0x5e, 0x92, 0x5c, 0x8d,
-	0x5c, 0x73, 0x8c, 0x6b, 0x8a, 0x4c, 0xa6, 0xb9, 0x7a, 0xa4, 0x22, 0xdf, 0x2a, 0x70, 0x54, 0xd0,
-	0x6b, 0x21, 0xcb, 0xbb, 0x7a, 0xec, 0xed, 0xff, 0x94, 0x57, 0x8a, 0x98, 0x20, 0xe9, 0x22, 0x23,
-	0x9d, 0x21, 0xd3, 0xb9, 0xa4, 0x61, 0x1f, 0xe9, 0xbe, 0x02, 0xc3, 0x7c, 0xff, 0x84, 0x64, 0x25,
-	0x4b, 0xd0, 0xbf, 0x29, 0x2f, 0x48, 0xad, 0x45, 0xae, 0xff, 0x33, 0xae, 0x49, 0x32, 0x91, 0xe6,
-	0x4a, 0xf4, 0x79, 0xc8, 0x03, 0x05, 0x46, 0x7a, 0xba, 0x28, 0x99, 0xa7, 0x56, 0xdc, 0xd1, 0xc9,
-	0x3c, 0xb5, 0x19, 0x1d, 0x1e, 0x75, 0x9e, 0xb1, 0x4d, 0x13, 0x35, 0x8b, 0x2d, 0x56, 0x8e, 0x29,
-	0x56, 0x4f, 0xf4, 0x4e, 0xf2, 0xcb, 0x9b, 0x6f, 0xed, 0x64, 0x2a, 0x26, 0xea, 0xf4, 0xe4, 0x29,
-	0x96, 0xe8, 0x00, 0x91, 0xbb, 0x30, 0x88, 0xd7, 0xf2, 0x74, 0xc6, 0xfe, 0xc9, 0x5b, 0xf8, 0xd4,
-	0x2e, 0xab, 0xd0, 0x7f, 0x95, 0xf9, 0x2f, 0x93, 0xb1, 0xb4, 0x7f, 0xbc, 0x60, 0x1f, 0x2a, 0x30,
-	0x2a, 0xea, 0x80, 0x88, 0xf2, 0x95, 0xd3, 0xcd, 0x11, 0xe5, 0x2b, 0xaf, 0xb1, 0xa2, 0xae, 0x30,
-	0xb2, 0x45, 0x32, 0x9f, 0x26, 0x33, 0xd1, 0x4e, 0x67, 0xef, 0xe3, 0xc6, 0x8e, 0xee, 0xde, 0x35,
-	0x3a, 0xba, 0x65, 0x93, 0xef, 0x15, 0xf8, 0xaf, 0xb0, 0x15, 0x40, 0xa4, 0xbc, 0xc7, 0x5f, 0x2e,
-	0xe5, 0x9a, 0xf4, 0x7a, 0xc4, 0x3d, 0xc3, 0x70, 0x97, 0xc8, 0x82, 0x2c, 0xae, 0xe3, 0x7b, 0x09,
-	0x6d, 0xf9, 0xa7, 0x6f, 0x9e, 0xb6, 0x82, 0xe7, 0x7c, 0x9e, 0xb6, 0xa2, 0x17, 0xb5, 0x94, 0xb6,
-	0xec, 0x7d, 0x95, 0xa1, 0x6d, 0xe2, 0x49, 0x48, 0xa4, 0xbc, 0xcb, 0x69, 0x2b, 0x7c, 0x6b, 0x4a,
-	0x69, 0x9b, 0xc0, 0xa5, 0xda, 0x7e, 0x93, 0xd0, 0x36, 0x7e, 0x85, 0xe5, 0x6b, 0x9b, 0x7a, 0x0f,
-	0xe6, 0x6b, 0x9b, 0x7e, 0xdc, 0xe5, 0x7d, 0x84, 0x71, 0xb0, 0x3b, 0x7a, 0xfc, 0x61, 0x4c, 0xbe,
-	0x4b, 0x48, 0xcb, 0x3d, 0x8a, 0x88, 0x94, 0x73, 0x59, 0x69, 0x05, 0xaf, 0x2d, 0xc9, 0x4a, 0x88,
-	0x69, 0xa9, 0xb2, 0x3c, 0x6e, 0xe2, 0x71, 0x92, 0x87, 0x2b, 0x7a, 0x47, 0xe5, 0xe1, 0x0a, 0x5f,
-	0x3d, 0x52, 0xb8, 0xf4, 0xeb, 0x9c, 0x57, 0xf7, 0x07, 0x05, 0xfe, 0x27, 0x7e, 0x44, 0x10, 0x39,
-	0xff, 0x9c, 0xbe, 0xa7, 0xe5, 0x0d, 0x0a, 0xd4, 0x6e, 0x82, 0xd8, 0xf1, 0xbd, 0xfa, 0xd5, 0x47,
-	0x4f, 0x2b, 0xca, 0xe3, 0xa7, 0x15, 0xe5, 0xaf, 0xa7, 0x15, 0xe5, 0xfe, 0xb3, 0x4a, 0xdf, 0xe3,
-	0x67, 0x95, 0xbe, 0xdf, 0x9f, 0x55, 0xfa, 0xde, 0x59, 0xe2, 0x5a, 0xff, 0xb8, 0xe1, 0xd2, 0xbb,
-	0x8e, 0x6d, 0x46, 0xbb, 0xdf, 0xc3, 0xfd, 0xd9, 0x5f, 0x01, 0x1a, 0x83, 0xec, 0xef, 0xc6, 0x67,
-	0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x9e, 0xdd, 0x30, 0x7b, 0xfb, 0x1e, 0x00, 0x00,
+func init() { proto.RegisterFile("warmage/maker/v1/query.proto", fileDescriptor_afc9464551747cbc) }
+
+var fileDescriptor_afc9464551747cbc = []byte{
+	// 1916 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x9a, 0xcf, 0x6f, 0xdb, 0x46,
+	0x16, 0xc7, 0x4d, 0x39, 0x6b, 0xc7, 0x4f, 0x8e, 0x9d, 0x4c, 0xbc, 0x1b, 0x99, 0x91, 0x65, 0x99,
+	0xfe, 0x11, 0xff, 0xa4, 0x22, 0x67, 0x37, 0x08, 0x02, 0x6c, 0x76, 0xa3, 0xfc, 0x5a, 0x2f, 0x60,
+	0x38, 0x71, 0x76, 0x81, 0xa2, 0x17, 0x81, 0x52, 0x68, 0x45, 0x35, 0x45, 0x2a, 0x22, 0x19, 0xc7,
+	0x40, 0x8a, 0x02, 0x39, 0xf7, 0x90, 0xb6, 0x97, 0xa2, 0x48, 0xd1, 0x16, 0xbd, 0x24, 0x41, 0x0b,
+	0x14, 0x3d, 0xf6, 0x50, 0xa0, 0xb7, 0xf4, 0x16, 0xa0, 0x3d, 0xb4, 0x3d, 0xa4, 0x45, 0xd2, 0xbf,
+	0xa0, 0x7f, 0x41, 0x31, 0xc3, 0xa1, 0x38, 0x14, 0x87, 0xd2, 0x30, 0x36, 0xd0, 0xf4, 0x94, 0x68,
+	0x66, 0xde, 0xbc, 0xcf, 0xfb, 0xce, 0x9b, 0x19, 0xce, 0x83, 0x21, 0xbb, 0xa3, 0xb5, 0x1a, 0x5a,
+	0x4d, 0x2f, 0x34, 0xb4, 0x6d, 0xbd, 0x55, 0xb8, 0x5d, 0x2c, 0xdc, 0x72, 0xf5, 0xd6, 0xae, 0xda,
+	0x6c, 0x59, 0x8e, 0x85, 0x0e, 0xd3, 0x5e, 0x95, 0xf4, 0xaa, 0xb7, 0x8b, 0xf2, 0x58, 0xcd, 0xaa,
+	0x59, 0xa4, 0xb3, 0x80, 0xff, 0xe7, 0x8d, 0x93, 0xb3, 0x35, 0xcb, 0xaa, 0x19, 0x7a, 0x41, 0x6b,
+	0xd6, 0x0b, 0x9a, 0x69, 0x5a, 0x8e, 0xe6, 0xd4, 0x2d, 0xd3, 0xa6, 0xbd, 0xb9, 0x88, 0x8f, 0x9a,
+	0x6e, 0xea, 0x76, 0xdd, 0xef, 0x8f, 0x32, 0x78, 0xee, 0xa8, 0x75, 0xd5, 0xb2, 0x1b, 0x96, 0x5d,
+	0xa8, 0x68, 0xb6, 0x5e, 0xb8, 0x5d, 0xac, 0xe8, 0x8e, 0x56, 0x2c, 0x54, 0xad, 0xba, 0xe9, 0xf5,
+	0x2b, 0x0a, 0xe4, 0xaf, 0x61, 0xe4, 0xf3, 0x86, 0x51, 0xd2, 0xaa, 0xdb, 0x75, 0xb3, 0xb6, 0x59,
+	0xb7, 0xb7, 0xaf, 0x6a, 0x2d, 0xad, 0x61, 0x6f, 0xea, 0xb7, 0x5c, 0xdd, 0x76, 0x14, 0x0b, 0xa6,
+	0xba, 0x8c, 0xb1, 0x9b, 0x96, 0x69, 0xeb, 0xe8, 0xbf, 0x90, 0x6e, 0xd5, 0xed, 0xed, 0x72, 0x93,
+	0x34, 0x67, 0xa4, 0x7c, 0xff, 0x7c, 0x7a, 0x75, 0x5a, 0xed, 0x94, 0x40, 0x8d, 0xcc, 0x50, 0x3a,
+	0xf0, 0xe4, 0xd9, 0x64, 0xdf, 0x26, 0xb4, 0xda, 0x2d, 0xca, 0x2c, 0x4c, 0xfb, 0x0e, 0x2f, 0x58,
+	0x86, 0xa1, 0x39, 0x7a, 0x4b, 0x33, 0xa2, 0x5c, 0x2e, 0xcc, 0x74, 0x1f, 0x46, 0xd1, 0xd6, 0x79,
+	0x68, 0x73, 0x51, 0x34, 0xde, 0x24, 0x1c, 0xba, 0x09, 0x38, 0xde, 0x21, 0xc7, 0x55, 0xcb, 0x32,
+	0xda, 0x54, 0x37, 0x21, 0xcb, 0xef, 0xa6, 0x34, 0xff, 0x81, 0x43, 0x15, 0xaf, 0xbd, 0xdc, 0xc4,
+	0x1d, 0x94, 0x67, 0x22, 0xca, 0x83, 0xed, 0xe8, 0x14, 0x14, 0x63, 0xb8, 0xc2, 0xcc, 0xa8, 0xe4,
+	0x21, 0x17, 0x8d, 0x3f, 0xc4, 0xe2, 0xc0, 0x64, 0xec, 0x08, 0x8a, 0x73, 0x0d, 0x0e, 0x57, 0xdb,
+	0x5d, 0x21, 0xa2, 0x3c, 0x9f, 0x28, 0x98, 0x88, 0x42, 0x8d, 0x56, 0xc3, 0x53, 0x2b, 0xe7, 0xe0,
+	0x18, 0xf1, 0xca, 0x84, 0x4f, 0x81, 0xd0, 0x74, 0x10, 0xfc, 0x0d, 0xdd, 0xb4, 0x1a, 0x19, 0x29,
+	0x2f, 0xcd, 0x0f, 0xb5, 0xe3, 0xba, 0x88, 0xdb, 0x94, 0x0a, 0x64, 0xa2, 0xf6, 0x14, 0xf7, 0x32,
+	0x0c, 0xb3, 0xea, 0x11, 0x7b, 0x41, 0xf1, 0xd2, 0x8c, 0x78, 0xca, 0x15, 0x90, 0x89, 0x8f, 0xb0,
+	0x2c, 0x3e, 0xe6, 0x42, 0x48, 0x14, 0x96, 0x94, 0x09, 0xd6, 0x83, 0x35, 0xe1, 0x38, 0x77, 0x22,
+	0xca, 0xbb, 0x01, 0xa3, 0x1d, 0xf2, 0x52, 0x64, 0x51, 0x75, 0x47, 0xc2, 0xea, 0x2a, 0x5b, 0x74,
+	0x49, 0x83, 0x81, 0x1b, 0x5b, 0xe7, 0xab, 0x55, 0xcb, 0x35, 0x1d, 0x9f, 0x3e, 0x03, 0x83, 0x9a,
+	0xd7, 0x42, 0xa1, 0xfd, 0x9f, 0xdc, 0xb8, 0x52, 0xfc, 0xb8, 0xee, 0x42, 0x3e, 0xde, 0x0f, 0x0d,
+	0xee, 0x35, 0x40, 0x74, 0xe6, 0x72, 0x60, 0x4e, 0xe3, 0xe3, 0x6c, 0x7d, 0x6a, 0x1e, 0x09, 0xf1,
+	0x88, 0xd6, 0xd9, 0xa1, 0x9c, 0xa5, 0xa9, 0x1d, 0x34, 0xad, 0x6b, 0xa6, 0x56, 0xd3, 0x5b, 0x76,
+	0xcf, 0x20, 0x95, 0x7f, 0xc2, 0x64, 0xac, 0x2d, 0x05, 0x97, 0xe1, 0x60, 0x83, 0xb6, 0x91, 0x64,
+	0x1f, 0xda, 0x6c, 0xff, 0x56, 0x64, 0x9a, 0x7d, 0xff, 0xb3, 0x1c, 0xad, 0x7d, 0xde, 0xd1, 0xfd,
+	0xb4, 0x05, 0xe3, 0x9c, 0x3e, 0x3a, 0xe9, 0x1a, 0x1c, 0x72, 0x70, 0x7b, 0x99, 0xe6, 0x19, 0x15,
+	0x22, 0x17, 0x15, 0x82, 0x35, 0xf7, 0x77, 0xb6, 0xc3, 0xb4, 0xb5, 0x8f, 0x18, 0x32, 0x90, 0x39,
+	0x96, 0x28, 0x46, 0x0b, 0xb2, 0xfc, 0x6e, 0x4a, 0xb2, 0x09, 0x87, 0x3d, 0x92, 0xc8, 0xaa, 0x4c,
+	0xc5, 0xc0, 0x44, 0x37, 0xb5, 0x13, 0x6e, 0x6e, 0xcb, 0xe2, 0x47, 0x8d, 0xef, 0x28, 0x9f, 0xe7,
+	0x81, 0x04, 0xe3, 0x9c, 0x4e, 0x4a, 0x73, 0x3d, 0xd8, 0xf3, 0x2d, 0xdc, 0xe1, 0xad, 0x57, 0x49,
+	0xc5, 0x7e, 0x7e, 0x7a, 0x36, 0x39, 0x57, 0xab, 0x3b, 0x37, 0xdd, 0x8a, 0x5a, 0xb5, 0x1a, 0x05,
+	0x7a, 0x59, 0x79, 0xff, 0xac, 0xd8, 0x37, 0xb6, 0x0b, 0xce, 0x6e, 0x53, 0xb7, 0xd5, 0x8b, 0x7a,
+	0xb5, 0x7d, 0x46, 0x90, 0xc9, 0xd1, 0x22, 0x1c, 0x31, 0x34, 0xdb, 0x29, 0xbb, 0xcd, 0x1b, 0x9a,
+	0xa3, 0x97, 0x2b, 0x86, 0x55, 0xdd, 0x26, 0xa9, 0xdc, 0xbf, 0x39, 0x8a, 0x3b, 0xfe, 0x4f, 0xda,
+	0x4b, 0xb8, 0x59, 0x19, 0x03, 0x44, 0xe8, 0xc2, 0xb7, 0xc7, 0x3a, 0x1c, 0x0d, 0xb5, 0x52, 0xda,
+	0xd3, 0x30, 0xd0, 0xbe, 0x27, 0xb0, 0x62, 0x19, 0xce, 0x3e, 0x65, 0x6f, 0x06, 0x3a, 0x5a, 0xf9,
+	0x44, 0x82, 0xe3, 0x97, 0x6c, 0xa7, 0xde, 0xd0, 0x1c, 0x7d, 0xbd, 0x6e, 0x3a, 0xa5, 0xdd, 0xeb,
+	0x3b, 0x5a, 0x73, 0xcd, 0xf4, 0xf3, 0xf5, 0x2c, 0x1c, 0x6c, 0xd4, 0x4d, 0xa7, 0x6c, 0xb9, 0x0e,
+	0x9d, 0x79, 0x5c, 0xf5, 0xe2, 0x54, 0xf1, 0xdd, 0xac, 0xd2, 0xbb, 0x59, 0xbd, 0x60, 0xd5, 0x4d,
+	0x3a, 0xf5, 0x20, 0x36, 0xd8, 0x70, 0x39, 0xa7, 0x66, 0x2a, 0x7a, 0x6a, 0xa2, 0x29, 0x18, 0xde,
+	0x72, 0x8d, 0x20, 0xfb, 0xfa, 0xf3, 0xd2, 0xfc, 0xc1, 0xcd, 0x34, 0x6e, 0xf3, 0xd3, 0xea, 0x7b,
+	0x09, 0xb2, 0x7c, 0x46, 0x1a, 0xfc, 0x39, 0x00, 0xdf, 0x51, 0xdd, 0x14, 0xc5, 0x1c, 0xa2, 0x26,
+	0x6b, 0x26, 0x3a, 0x03, 0x83, 0x58, 0x2a, 0x6c, 0x9c, 0x12, 0x33, 0x1e, 0xc0, 0xe3, 0xd7, 0xcc,
+	0xb6, 0x3c, 0x5b, 0xba, 0x9e, 0xe9, 0x17, 0x33, 0x25, 0xf2, 0x5c, 0xd6, 0x75, 0xe5, 0x5b, 0x6e,
+	0x58, 0x1b, 0x6e, 0xfb, 0x40, 0xbc, 0x04, 0x23, 0x41, 0x58, 0xe5, 0x86, 0x76, 0x47, 0x34, 0xb4,
+	0xe1, 0x76, 0x68, 0xeb, 0xda, 0x1d, 0xf4, 0x2f, 0x48, 0xd3, 0xe8, 0xc8, 0x1c, 0x82, 0x11, 0x0e,
+	0x79, 0x11, 0xe2, 0x09, 0x04, 0x96, 0xe8, 0x9d, 0x14, 0x4c, 0xc4, 0xc4, 0xf2, 0xca, 0xac, 0x11,
+	0x4e, 0xe1, 0xfe, 0x84, 0x29, 0xcc, 0xae, 0xef, 0x81, 0x84, 0xeb, 0xfb, 0x88, 0xd9, 0x5a, 0x25,
+	0xb7, 0x65, 0x76, 0x6e, 0xad, 0x2b, 0x30, 0xea, 0x2b, 0x62, 0xb9, 0x4e, 0x92, 0xf5, 0xf5, 0xb7,
+	0xd5, 0x86, 0xeb, 0xe0, 0xf5, 0x39, 0x0f, 0xc3, 0x44, 0x1a, 0x7f, 0x16, 0x41, 0x7d, 0x00, 0x1b,
+	0x79, 0x53, 0x28, 0xef, 0xa6, 0x20, 0xcb, 0x67, 0xa5, 0xcb, 0x77, 0x06, 0x06, 0x2b, 0x6e, 0xcb,
+	0x4c, 0xb0, 0x76, 0x03, 0x78, 0xfc, 0x9a, 0x89, 0xfe, 0x0d, 0x69, 0x26, 0x4c, 0x61, 0xb8, 0x20,
+	0x44, 0xb2, 0x08, 0x34, 0x3e, 0xf1, 0x05, 0xf4, 0x62, 0xc3, 0xb6, 0x84, 0x3b, 0xc9, 0x02, 0x62,
+	0x03, 0xbc, 0x80, 0x6f, 0xf2, 0x34, 0x61, 0xf6, 0xe7, 0xcb, 0x6b, 0x22, 0x72, 0x32, 0x2a, 0x3f,
+	0x4a, 0x30, 0x11, 0xe3, 0x9f, 0x2e, 0x4a, 0x87, 0xb4, 0xd2, 0xde, 0xa4, 0x4d, 0xed, 0x41, 0xda,
+	0xfe, 0x84, 0xd2, 0xfe, 0x26, 0xc1, 0x78, 0x3b, 0x36, 0xcd, 0xde, 0xd6, 0x1d, 0x7c, 0x6a, 0xf8,
+	0xc2, 0xde, 0xe2, 0x1c, 0x7c, 0xfd, 0xdd, 0xe7, 0x3f, 0x89, 0xe7, 0x7f, 0xfc, 0xf3, 0xe4, 0xbc,
+	0xc0, 0xb5, 0x8c, 0x0d, 0xec, 0x3f, 0xe0, 0x90, 0xfc, 0x2a, 0x05, 0x32, 0x2f, 0x68, 0xba, 0x9a,
+	0x6f, 0x74, 0x9c, 0x90, 0xfb, 0x1e, 0xf1, 0x9f, 0xfa, 0x34, 0xbd, 0xdb, 0x99, 0x30, 0x78, 0x4b,
+	0xec, 0x7d, 0x27, 0xce, 0xc2, 0x48, 0x68, 0x27, 0xda, 0x99, 0x14, 0xf9, 0xb0, 0x3e, 0xc4, 0x6e,
+	0x45, 0x5b, 0xb9, 0x17, 0x59, 0x3a, 0xcf, 0x3d, 0x5d, 0x3a, 0xa3, 0x73, 0x23, 0xee, 0xfb, 0xda,
+	0xbd, 0x0a, 0x9b, 0xb6, 0xcc, 0xde, 0x67, 0xfe, 0x47, 0x73, 0x70, 0x9f, 0xed, 0xf9, 0x34, 0x52,
+	0x3e, 0x90, 0x20, 0xcb, 0xf7, 0x10, 0xdc, 0x42, 0x7e, 0xda, 0x4a, 0xc9, 0xd2, 0x16, 0xc3, 0xb9,
+	0xbb, 0xd8, 0x17, 0x09, 0x5d, 0xf8, 0x16, 0xf2, 0x6c, 0x22, 0xb7, 0x81, 0xcf, 0x16, 0xbe, 0x0d,
+	0x5e, 0x92, 0x4d, 0xe8, 0x36, 0xf8, 0x34, 0x74, 0x1b, 0x84, 0xfc, 0xef, 0xdb, 0x6d, 0xb0, 0x77,
+	0x91, 0xde, 0x0a, 0x44, 0xba, 0xae, 0x1b, 0x06, 0xb3, 0x82, 0xc1, 0x73, 0xc2, 0x4f, 0x5d, 0x29,
+	0x61, 0xea, 0x26, 0x96, 0xa9, 0x83, 0x60, 0x9f, 0x3e, 0x44, 0x4b, 0x30, 0x6c, 0xeb, 0x86, 0x91,
+	0x54, 0xa5, 0xb4, 0x6f, 0xe4, 0xed, 0x24, 0x1e, 0x24, 0x93, 0x4c, 0x7b, 0x84, 0x54, 0x3e, 0x96,
+	0x20, 0x17, 0xe7, 0x81, 0xea, 0xb0, 0x97, 0xa5, 0xd8, 0x07, 0x0d, 0x56, 0xbf, 0xc9, 0xc2, 0x5f,
+	0xc8, 0x4b, 0x16, 0x7d, 0x29, 0xc1, 0x18, 0xaf, 0x48, 0x8b, 0x56, 0xa3, 0x8f, 0xd8, 0x5e, 0x55,
+	0x5f, 0xf9, 0x54, 0x22, 0x1b, 0x4f, 0x0b, 0xa5, 0x78, 0xef, 0xbb, 0x5f, 0xdf, 0x4b, 0x2d, 0xa1,
+	0x85, 0x42, 0xa4, 0x2a, 0xad, 0x05, 0x77, 0x7a, 0x99, 0x29, 0xc7, 0xa2, 0xaf, 0x25, 0x38, 0x16,
+	0x53, 0xc1, 0x45, 0xff, 0x88, 0x67, 0xe8, 0x52, 0x18, 0x96, 0x4f, 0x27, 0x35, 0xa3, 0xf4, 0x7f,
+	0x27, 0xf4, 0x2a, 0x5a, 0xe6, 0xd3, 0x33, 0xa5, 0x33, 0x36, 0x80, 0x0f, 0x25, 0x18, 0xed, 0x28,
+	0xf6, 0xa2, 0x95, 0x9e, 0xe2, 0xb1, 0x75, 0x5a, 0x59, 0x15, 0x1d, 0x4e, 0x41, 0x97, 0x08, 0xe8,
+	0x2c, 0x9a, 0xee, 0x2e, 0x33, 0xa9, 0xe6, 0xa2, 0x47, 0x12, 0xa0, 0x68, 0x01, 0x18, 0x9d, 0x14,
+	0x11, 0x29, 0x44, 0x59, 0x4c, 0x60, 0x41, 0x41, 0x55, 0x02, 0x3a, 0x8f, 0xe6, 0x7a, 0x2a, 0xea,
+	0xb1, 0xbe, 0x2d, 0x41, 0x9a, 0x89, 0x18, 0x2d, 0xc4, 0xb8, 0x8c, 0x96, 0x96, 0xe5, 0x45, 0x91,
+	0xa1, 0x14, 0x6b, 0x8e, 0x60, 0xe5, 0x51, 0x2e, 0x8a, 0xc5, 0x6a, 0x87, 0xde, 0x97, 0x60, 0x24,
+	0x1c, 0x1a, 0x5a, 0x8e, 0x71, 0xc3, 0x2d, 0x24, 0xcb, 0x2b, 0x82, 0xa3, 0x29, 0xd7, 0x02, 0xe1,
+	0x9a, 0x46, 0x53, 0x51, 0xae, 0x0e, 0xa9, 0xd0, 0x63, 0x09, 0x8e, 0x72, 0x6a, 0xb3, 0xa8, 0xd8,
+	0xd3, 0x63, 0x67, 0xbd, 0x58, 0x5e, 0x4d, 0x62, 0x42, 0x49, 0x97, 0x09, 0xe9, 0x1c, 0x9a, 0xe9,
+	0x4a, 0xea, 0xd7, 0x9d, 0x1f, 0x4a, 0x80, 0xa2, 0xe5, 0xd8, 0xd8, 0x14, 0x8c, 0xad, 0xfa, 0xca,
+	0xc5, 0x04, 0x16, 0x94, 0x74, 0x85, 0x90, 0x9e, 0x40, 0xb3, 0x5d, 0x49, 0xfd, 0xf2, 0x2f, 0xba,
+	0x2f, 0xc1, 0x30, 0x5b, 0x9f, 0x45, 0x71, 0x79, 0xc5, 0xa9, 0x0f, 0xcb, 0x4b, 0x42, 0x63, 0x29,
+	0xd8, 0x09, 0x02, 0x36, 0x85, 0x26, 0xa3, 0x60, 0xa1, 0x3a, 0x32, 0x7a, 0x20, 0xc1, 0x68, 0x47,
+	0x95, 0x36, 0xf6, 0x80, 0xe1, 0x57, 0x8c, 0x65, 0x55, 0x74, 0x38, 0x65, 0x5b, 0x24, 0x6c, 0x33,
+	0x48, 0x89, 0x63, 0x0b, 0xa4, 0x23, 0x8a, 0x95, 0x42, 0xb5, 0xd9, 0xee, 0x3b, 0x91, 0x2d, 0x1d,
+	0xcb, 0x4b, 0x42, 0x63, 0x7b, 0x2b, 0x16, 0xaa, 0x30, 0xa3, 0x1d, 0x18, 0xa0, 0x37, 0xc8, 0x4c,
+	0xcc, 0xfc, 0xe1, 0x0b, 0x63, 0xb6, 0xc7, 0x28, 0xea, 0x3f, 0x4f, 0xfc, 0xcb, 0x28, 0x13, 0xf5,
+	0x4f, 0xef, 0x82, 0x47, 0x12, 0x8c, 0xf1, 0x2a, 0xac, 0xbc, 0xf5, 0xea, 0x52, 0x2d, 0x96, 0x55,
+	0xd1, 0xe1, 0x94, 0x6c, 0x95, 0x90, 0x2d, 0xa3, 0xc5, 0x28, 0x99, 0x4e, 0xed, 0xca, 0xe4, 0xc5,
+	0x58, 0xd9, 0x2d, 0xdb, 0x3b, 0x5a, 0xb3, 0x5c, 0x37, 0xd1, 0xe7, 0x12, 0xfc, 0x95, 0x5b, 0x6a,
+	0x44, 0x42, 0xde, 0x83, 0x8f, 0x2c, 0xb9, 0x20, 0x3c, 0x9e, 0xe2, 0x9e, 0x22, 0xb8, 0x2b, 0x68,
+	0x49, 0x14, 0xd7, 0x72, 0x9d, 0x90, 0xb6, 0x6c, 0x69, 0xad, 0x9b, 0xb6, 0x9c, 0x72, 0xa1, 0xac,
+	0x8a, 0x0e, 0x4f, 0xa0, 0x2d, 0x79, 0x0a, 0xc6, 0x68, 0x1b, 0x2a, 0x39, 0x21, 0x21, 0xef, 0x62,
+	0xda, 0x72, 0x6b, 0x59, 0x42, 0xda, 0x86, 0x70, 0xb1, 0xb6, 0x1f, 0x49, 0x80, 0xa2, 0x15, 0x15,
+	0xb4, 0xd4, 0xc5, 0x79, 0x67, 0xb1, 0x49, 0x5e, 0x16, 0x1b, 0xdc, 0xfb, 0xcb, 0x20, 0xc0, 0x24,
+	0x66, 0x24, 0x13, 0x38, 0x84, 0x38, 0xfc, 0xde, 0x84, 0x4c, 0x75, 0x43, 0x5e, 0x16, 0x1b, 0x9c,
+	0x9c, 0x10, 0xeb, 0x89, 0x1e, 0x86, 0xf2, 0x33, 0x78, 0x74, 0x77, 0xcf, 0xcf, 0xc8, 0xf3, 0x5f,
+	0x56, 0x45, 0x87, 0xf7, 0xfe, 0xe6, 0x66, 0x16, 0x7c, 0xb7, 0x1c, 0xbc, 0x83, 0xd0, 0x67, 0xa1,
+	0xf4, 0x64, 0xde, 0xc0, 0x48, 0xc8, 0xb9, 0x68, 0x7a, 0x72, 0x1e, 0xd7, 0x82, 0xbb, 0x29, 0xa0,
+	0xc5, 0xd9, 0xc9, 0xe2, 0x86, 0xde, 0xa2, 0xdd, 0x70, 0x79, 0xcf, 0x66, 0xb9, 0x20, 0x3c, 0x3e,
+	0x01, 0x2e, 0x7e, 0x8c, 0xb1, 0xea, 0x7e, 0x21, 0xc1, 0xdf, 0xf8, 0x6f, 0x46, 0x24, 0xe6, 0x9f,
+	0xd1, 0xf7, 0xa4, 0xb8, 0x41, 0x82, 0xfd, 0x1f, 0x22, 0xb6, 0x5c, 0xa7, 0x74, 0xe9, 0xc9, 0xf3,
+	0x9c, 0xf4, 0xf4, 0x79, 0x4e, 0xfa, 0xe5, 0x79, 0x4e, 0xba, 0xff, 0x22, 0xd7, 0xf7, 0xf4, 0x45,
+	0xae, 0xef, 0x87, 0x17, 0xb9, 0xbe, 0xd7, 0x97, 0x98, 0xca, 0x5a, 0x53, 0x77, 0x5a, 0xf5, 0x15,
+	0x43, 0xab, 0xd8, 0xed, 0xb9, 0xef, 0xd0, 0xd9, 0x49, 0x89, 0xad, 0x32, 0x40, 0xfe, 0xa8, 0xe8,
+	0xd4, 0xef, 0x03, 0x00, 0x35, 0xfb, 0x2c, 0x39, 0x18, 0x25, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2007,6 +2354,9 @@ type QueryClient interface {
 	CollateralPool(ctx context.Context, in *QueryCollateralPoolRequest, opts ...grpc.CallOption) (*QueryCollateralPoolResponse, error)
 	// CollateralOfAccount queries the collateral of an account.
 	CollateralOfAccount(ctx context.Context, in *QueryCollateralOfAccountRequest, opts ...grpc.CallOption) (*QueryCollateralOfAccountResponse, error)
+	// CollateralManagers queries the authorized collateral position managers of
+	// an account.
+	CollateralManagers(ctx context.Context, in *QueryCollateralManagersRequest, opts ...grpc.CallOption) (*QueryCollateralManagersResponse, error)
 	// TotalBacking queries the total backing.
 	TotalBacking(ctx context.Context, in *QueryTotalBackingRequest, opts ...grpc.CallOption) (*QueryTotalBackingResponse, error)
 	// TotalCollateral queries the total collateral.
@@ -2023,6 +2373,12 @@ type QueryClient interface {
 	EstimateBurnBySwapIn(ctx context.Context, in *EstimateBurnBySwapInRequest, opts ...grpc.CallOption) (*EstimateBurnBySwapInResponse, error)
 	// EstimateBurnBySwapOut estimates output of burning by swap.
 	EstimateBurnBySwapOut(ctx context.Context, in *EstimateBurnBySwapOutRequest, opts ...grpc.CallOption) (*EstimateBurnBySwapOutResponse, error)
+	// EstimateBasketMint estimates input and output of minting by swapping in a
+	// basket of backing assets.
+	EstimateBasketMint(ctx context.Context, in *EstimateBasketMintRequest, opts ...grpc.CallOption) (*EstimateBasketMintResponse, error)
+	// EstimateBasketBurn estimates output of burning by swapping out a basket of
+	// backing assets.
+	EstimateBasketBurn(ctx context.Context, in *EstimateBasketBurnRequest, opts ...grpc.CallOption) (*EstimateBasketBurnResponse, error)
 	// EstimateBuyBackingIn estimates inpput of buying backing assets.
 	EstimateBuyBackingIn(ctx context.Context, in *EstimateBuyBackingInRequest, opts ...grpc.CallOption) (*EstimateBuyBackingInResponse, error)
 	// EstimateBuyBackingOut estimates output of buying backing assets.
@@ -2104,6 +2460,15 @@ func (c *queryClient) CollateralOfAccount(ctx context.Context, in *QueryCollater
 	return out, nil
 }
 
+func (c *queryClient) CollateralManagers(ctx context.Context, in *QueryCollateralManagersRequest, opts ...grpc.CallOption) (*QueryCollateralManagersResponse, error) {
+	out := new(QueryCollateralManagersResponse)
+	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Query/CollateralManagers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TotalBacking(ctx context.Context, in *QueryTotalBackingRequest, opts ...grpc.CallOption) (*QueryTotalBackingResponse, error) {
 	out := new(QueryTotalBackingResponse)
 	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Query/TotalBacking", in, out, opts...)
@@ -2176,6 +2541,24 @@ func (c *queryClient) EstimateBurnBySwapOut(ctx context.Context, in *EstimateBur
 	return out, nil
 }
 
+func (c *queryClient) EstimateBasketMint(ctx context.Context, in *EstimateBasketMintRequest, opts ...grpc.CallOption) (*EstimateBasketMintResponse, error) {
+	out := new(EstimateBasketMintResponse)
+	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Query/EstimateBasketMint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateBasketBurn(ctx context.Context, in *EstimateBasketBurnRequest, opts ...grpc.CallOption) (*EstimateBasketBurnResponse, error) {
+	out := new(EstimateBasketBurnResponse)
+	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Query/EstimateBasketBurn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EstimateBuyBackingIn(ctx context.Context, in *EstimateBuyBackingInRequest, opts ...grpc.CallOption) (*EstimateBuyBackingInResponse, error) {
 	out := new(EstimateBuyBackingInResponse)
 	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Query/EstimateBuyBackingIn", in, out, opts...)
@@ -2228,6 +2611,9 @@ type QueryServer interface {
 	CollateralPool(context.Context, *QueryCollateralPoolRequest) (*QueryCollateralPoolResponse, error)
 	// CollateralOfAccount queries the collateral of an account.
 	CollateralOfAccount(context.Context, *QueryCollateralOfAccountRequest) (*QueryCollateralOfAccountResponse, error)
+	// CollateralManagers queries the authorized collateral position managers of
+	// an account.
+	CollateralManagers(context.Context, *QueryCollateralManagersRequest) (*QueryCollateralManagersResponse, error)
 	// TotalBacking queries the total backing.
 	TotalBacking(context.Context, *QueryTotalBackingRequest) (*QueryTotalBackingResponse, error)
 	// TotalCollateral queries the total collateral.
@@ -2244,6 +2630,12 @@ type QueryServer interface {
 	EstimateBurnBySwapIn(context.Context, *EstimateBurnBySwapInRequest) (*EstimateBurnBySwapInResponse, error)
 	// EstimateBurnBySwapOut estimates output of burning by swap.
 	EstimateBurnBySwapOut(context.Context, *EstimateBurnBySwapOutRequest) (*EstimateBurnBySwapOutResponse, error)
+	// EstimateBasketMint estimates input and output of minting by swapping in a
+	// basket of backing assets.
+	EstimateBasketMint(context.Context, *EstimateBasketMintRequest) (*EstimateBasketMintResponse, error)
+	// EstimateBasketBurn estimates output of burning by swapping out a basket of
+	// backing assets.
+	EstimateBasketBurn(context.Context, *EstimateBasketBurnRequest) (*EstimateBasketBurnResponse, error)
 	// EstimateBuyBackingIn estimates inpput of buying backing assets.
 	EstimateBuyBackingIn(context.Context, *EstimateBuyBackingInRequest) (*EstimateBuyBackingInResponse, error)
 	// EstimateBuyBackingOut estimates output of buying backing assets.
@@ -2279,6 +2671,9 @@ func (*UnimplementedQueryServer) CollateralPool(ctx context.Context, req *QueryC
 func (*UnimplementedQueryServer) CollateralOfAccount(ctx context.Context, req *QueryCollateralOfAccountRequest) (*QueryCollateralOfAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CollateralOfAccount not implemented")
 }
+func (*UnimplementedQueryServer) CollateralManagers(ctx context.Context, req *QueryCollateralManagersRequest) (*QueryCollateralManagersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollateralManagers not implemented")
+}
 func (*UnimplementedQueryServer) TotalBacking(ctx context.Context, req *QueryTotalBackingRequest) (*QueryTotalBackingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalBacking not implemented")
 }
@@ -2303,6 +2698,12 @@ func (*UnimplementedQueryServer) EstimateBurnBySwapIn(ctx context.Context, req *
 func (*UnimplementedQueryServer) EstimateBurnBySwapOut(ctx context.Context, req *EstimateBurnBySwapOutRequest) (*EstimateBurnBySwapOutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateBurnBySwapOut not implemented")
 }
+func (*UnimplementedQueryServer) EstimateBasketMint(ctx context.Context, req *EstimateBasketMintRequest) (*EstimateBasketMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateBasketMint not implemented")
+}
+func (*UnimplementedQueryServer) EstimateBasketBurn(ctx context.Context, req *EstimateBasketBurnRequest) (*EstimateBasketBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateBasketBurn not implemented")
+}
 func (*UnimplementedQueryServer) EstimateBuyBackingIn(ctx context.Context, req *EstimateBuyBackingInRequest) (*EstimateBuyBackingInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateBuyBackingIn not implemented")
 }
@@ -2446,6 +2847,24 @@ func _Query_CollateralOfAccount_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CollateralManagers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollateralManagersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CollateralManagers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.maker.v1.Query/CollateralManagers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollateralManagers(ctx, req.(*QueryCollateralManagersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TotalBacking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTotalBackingRequest)
 	if err := dec(in); err != nil {
@@ -2590,44 +3009,80 @@ func _Query_EstimateBurnBySwapOut_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_EstimateBuyBackingIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateBuyBackingInRequest)
+func _Query_EstimateBasketMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateBasketMintRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).EstimateBuyBackingIn(ctx, in)
+		return srv.(QueryServer).EstimateBasketMint(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/warmage.maker.v1.Query/EstimateBuyBackingIn",
+		FullMethod: "/warmage.maker.v1.Query/EstimateBasketMint",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateBuyBackingIn(ctx, req.(*EstimateBuyBackingInRequest))
+		return srv.(QueryServer).EstimateBasketMint(ctx, req.(*EstimateBasketMintRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_EstimateBuyBackingOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateBuyBackingOutRequest)
+func _Query_EstimateBasketBurn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateBasketBurnRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).EstimateBuyBackingOut(ctx, in)
+		return srv.(QueryServer).EstimateBasketBurn(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/warmage.maker.v1.Query/EstimateBuyBackingOut",
+		FullMethod: "/warmage.maker.v1.Query/EstimateBasketBurn",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateBuyBackingOut(ctx, req.(*EstimateBuyBackingOutRequest))
+		return srv.(QueryServer).EstimateBasketBurn(ctx, req.(*EstimateBasketBurnRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_EstimateSellBackingIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateSellBackingInRequest)
+func _Query_EstimateBuyBackingIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateBuyBackingInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateBuyBackingIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.maker.v1.Query/EstimateBuyBackingIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateBuyBackingIn(ctx, req.(*EstimateBuyBackingInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateBuyBackingOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateBuyBackingOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateBuyBackingOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.maker.v1.Query/EstimateBuyBackingOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateBuyBackingOut(ctx, req.(*EstimateBuyBackingOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateSellBackingIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSellBackingInRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2694,6 +3149,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CollateralOfAccount",
 			Handler:    _Query_CollateralOfAccount_Handler,
 		},
+		{
+			MethodName: "CollateralManagers",
+			Handler:    _Query_CollateralManagers_Handler,
+		},
 		{
 			MethodName: "TotalBacking",
 			Handler:    _Query_TotalBacking_Handler,
@@ -2726,6 +3185,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateBurnBySwapOut",
 			Handler:    _Query_EstimateBurnBySwapOut_Handler,
 		},
+		{
+			MethodName: "EstimateBasketMint",
+			Handler:    _Query_EstimateBasketMint_Handler,
+		},
+		{
+			MethodName: "EstimateBasketBurn",
+			Handler:    _Query_EstimateBasketBurn_Handler,
+		},
 		{
 			MethodName: "EstimateBuyBackingIn",
 			Handler:    _Query_EstimateBuyBackingIn_Handler,
@@ -3183,6 +3650,68 @@ func (m *QueryCollateralOfAccountResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCollateralManagersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollateralManagersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollateralManagersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollateralManagersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollateralManagersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollateralManagersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Managers) > 0 {
+		for iNdEx := len(m.Managers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Managers[iNdEx])
+			copy(dAtA[i:], m.Managers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Managers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryTotalBackingRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3830,7 +4359,7 @@ func (m *EstimateBurnBySwapOutResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateBuyBackingInRequest) Marshal() (dAtA []byte, err error) {
+func (m *EstimateBasketMintRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3840,18 +4369,28 @@ func (m *EstimateBuyBackingInRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EstimateBuyBackingInRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateBasketMintRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateBuyBackingInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateBasketMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.FullBacking {
+		i--
+		if m.FullBacking {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	{
-		size, err := m.BackingOut.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.MageInMax.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3859,11 +4398,25 @@ func (m *EstimateBuyBackingInRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	if len(m.BackingInMax) > 0 {
+		for iNdEx := len(m.BackingInMax) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BackingInMax[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateBuyBackingInResponse) Marshal() (dAtA []byte, err error) {
+func (m *EstimateBasketMintResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3873,18 +4426,18 @@ func (m *EstimateBuyBackingInResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EstimateBuyBackingInResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateBasketMintResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateBuyBackingInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateBasketMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.BuybackFee.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.MintFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3892,7 +4445,17 @@ func (m *EstimateBuyBackingInResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x22
+	{
+		size, err := m.MintOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.MageIn.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3902,11 +4465,25 @@ func (m *EstimateBuyBackingInResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	if len(m.BackingIn) > 0 {
+		for iNdEx := len(m.BackingIn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BackingIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateBuyBackingOutRequest) Marshal() (dAtA []byte, err error) {
+func (m *EstimateBasketBurnRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3916,25 +4493,27 @@ func (m *EstimateBuyBackingOutRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EstimateBuyBackingOutRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateBasketBurnRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateBuyBackingOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateBasketBurnRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BackingDenom) > 0 {
-		i -= len(m.BackingDenom)
-		copy(dAtA[i:], m.BackingDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BackingDenom)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.BackingDenoms) > 0 {
+		for iNdEx := len(m.BackingDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BackingDenoms[iNdEx])
+			copy(dAtA[i:], m.BackingDenoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.BackingDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
 	{
-		size, err := m.MageIn.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.BurnIn.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3946,7 +4525,7 @@ func (m *EstimateBuyBackingOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateBuyBackingOutResponse) Marshal() (dAtA []byte, err error) {
+func (m *EstimateBasketBurnResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3956,18 +4535,18 @@ func (m *EstimateBuyBackingOutResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EstimateBuyBackingOutResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateBasketBurnResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateBuyBackingOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateBasketBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.BuybackFee.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.BurnFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3975,9 +4554,9 @@ func (m *EstimateBuyBackingOutResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x1a
 	{
-		size, err := m.BackingOut.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.MageOut.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3985,11 +4564,25 @@ func (m *EstimateBuyBackingOutResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	if len(m.BackingOut) > 0 {
+		for iNdEx := len(m.BackingOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BackingOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateSellBackingInRequest) Marshal() (dAtA []byte, err error) {
+func (m *EstimateBuyBackingInRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3999,25 +4592,18 @@ func (m *EstimateSellBackingInRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EstimateSellBackingInRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateBuyBackingInRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateSellBackingInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateBuyBackingInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BackingDenom) > 0 {
-		i -= len(m.BackingDenom)
-		copy(dAtA[i:], m.BackingDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BackingDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
 	{
-		size, err := m.MageOut.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.BackingOut.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4029,7 +4615,7 @@ func (m *EstimateSellBackingInRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateSellBackingInResponse) Marshal() (dAtA []byte, err error) {
+func (m *EstimateBuyBackingInResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4039,18 +4625,18 @@ func (m *EstimateSellBackingInResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EstimateSellBackingInResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateBuyBackingInResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateSellBackingInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateBuyBackingInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.SellbackFee.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.BuybackFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4060,7 +4646,7 @@ func (m *EstimateSellBackingInResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	i--
 	dAtA[i] = 0x12
 	{
-		size, err := m.BackingIn.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.MageIn.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4072,7 +4658,7 @@ func (m *EstimateSellBackingInResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateSellBackingOutRequest) Marshal() (dAtA []byte, err error) {
+func (m *EstimateBuyBackingOutRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4082,18 +4668,25 @@ func (m *EstimateSellBackingOutRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EstimateSellBackingOutRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateBuyBackingOutRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateSellBackingOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateBuyBackingOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.BackingDenom) > 0 {
+		i -= len(m.BackingDenom)
+		copy(dAtA[i:], m.BackingDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BackingDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
-		size, err := m.BackingIn.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.MageIn.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4105,7 +4698,7 @@ func (m *EstimateSellBackingOutRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateSellBackingOutResponse) Marshal() (dAtA []byte, err error) {
+func (m *EstimateBuyBackingOutResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4115,18 +4708,18 @@ func (m *EstimateSellBackingOutResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EstimateSellBackingOutResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateBuyBackingOutResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateSellBackingOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateBuyBackingOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.SellbackFee.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.BuybackFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4136,7 +4729,7 @@ func (m *EstimateSellBackingOutResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	i--
 	dAtA[i] = 0x12
 	{
-		size, err := m.MageOut.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.BackingOut.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4148,21 +4741,180 @@ func (m *EstimateSellBackingOutResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EstimateSellBackingInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAllBackingRiskParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EstimateSellBackingInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateSellBackingInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BackingDenom) > 0 {
+		i -= len(m.BackingDenom)
+		copy(dAtA[i:], m.BackingDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BackingDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.MageOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateSellBackingInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateSellBackingInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateSellBackingInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SellbackFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BackingIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateSellBackingOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateSellBackingOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateSellBackingOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BackingIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateSellBackingOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateSellBackingOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateSellBackingOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SellbackFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.MageOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAllBackingRiskParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	return n
@@ -4331,6 +5083,34 @@ func (m *QueryCollateralOfAccountResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCollateralManagersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCollateralManagersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Managers) > 0 {
+		for _, s := range m.Managers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryTotalBackingRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4540,6 +5320,83 @@ func (m *EstimateBurnBySwapOutResponse) Size() (n int) {
 	return n
 }
 
+func (m *EstimateBasketMintRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BackingInMax) > 0 {
+		for _, e := range m.BackingInMax {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.MageInMax.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.FullBacking {
+		n += 2
+	}
+	return n
+}
+
+func (m *EstimateBasketMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BackingIn) > 0 {
+		for _, e := range m.BackingIn {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.MageIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MintOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MintFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateBasketBurnRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BurnIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.BackingDenoms) > 0 {
+		for _, s := range m.BackingDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EstimateBasketBurnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BackingOut) > 0 {
+		for _, e := range m.BackingOut {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.MageOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BurnFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *EstimateBuyBackingInRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5713,7 +6570,7 @@ func (m *QueryCollateralOfAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalBackingRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCollateralManagersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5736,13 +6593,177 @@ func (m *QueryTotalBackingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalBackingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCollateralManagersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalBackingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCollateralManagersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollateralManagersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollateralManagersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollateralManagersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Managers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Managers = append(m.Managers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalBackingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalBackingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalBackingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
@@ -7429,6 +8450,591 @@ func (m *EstimateBurnBySwapOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EstimateBasketMintRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateBasketMintRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateBasketMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingInMax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackingInMax = append(m.BackingInMax, types.Coin{})
+			if err := m.BackingInMax[len(m.BackingInMax)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MageInMax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MageInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullBacking", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FullBacking = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateBasketMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateBasketMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateBasketMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackingIn = append(m.BackingIn, types.Coin{})
+			if err := m.BackingIn[len(m.BackingIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MageIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MageIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateBasketBurnRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateBasketBurnRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateBasketBurnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackingDenoms = append(m.BackingDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateBasketBurnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateBasketBurnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateBasketBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackingOut = append(m.BackingOut, types.Coin{})
+			if err := m.BackingOut[len(m.BackingOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MageOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MageOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EstimateBuyBackingInRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
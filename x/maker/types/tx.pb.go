@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -44,7 +45,7 @@ func (m *MsgMintBySwap) Reset()         { *m = MsgMintBySwap{} }
 func (m *MsgMintBySwap) String() string { return proto.CompactTextString(m) }
 func (*MsgMintBySwap) ProtoMessage()    {}
 func (*MsgMintBySwap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2797be2e51038e24, []int{0}
+	return fileDescriptor_b95fc14305d50301, []int{0}
 }
 func (m *MsgMintBySwap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -76,7 +77,7 @@ var xxx_messageInfo_MsgMintBySwap proto.InternalMessageInfo
 // MsgMintBySwapResponse defines the Msg/MintBySwap response type.
 type MsgMintBySwapResponse struct {
 	BackingIn types.Coin `protobuf:"bytes,1,opt,name=backing_in,json=backingIn,proto3" json:"backing_in" yaml:"backing_in"`
-	MageIn    types.Coin `protobuf:"bytes,2,opt,name=mage_in,json=mageOut,proto3" json:"mage_in" yaml:"mage_in"`
+	MageIn    types.Coin `protobuf:"bytes,2,opt,name=mage_in,json=mageIn,proto3" json:"mage_in" yaml:"mage_in"`
 	MintOut   types.Coin `protobuf:"bytes,3,opt,name=mint_out,json=mintOut,proto3" json:"mint_out" yaml:"mint_out"`
 	MintFee   types.Coin `protobuf:"bytes,4,opt,name=mint_fee,json=mintFee,proto3" json:"mint_fee" yaml:"mint_fee"`
 }
@@ -85,7 +86,7 @@ func (m *MsgMintBySwapResponse) Reset()         { *m = MsgMintBySwapResponse{} }
 func (m *MsgMintBySwapResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMintBySwapResponse) ProtoMessage()    {}
 func (*MsgMintBySwapResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2797be2e51038e24, []int{1}
+	return fileDescriptor_b95fc14305d50301, []int{1}
 }
 func (m *MsgMintBySwapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -155,7 +156,7 @@ func (m *MsgBurnBySwap) Reset()         { *m = MsgBurnBySwap{} }
 func (m *MsgBurnBySwap) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnBySwap) ProtoMessage()    {}
 func (*MsgBurnBySwap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2797be2e51038e24, []int{2}
+	return fileDescriptor_b95fc14305d50301, []int{2}
 }
 func (m *MsgBurnBySwap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,7 +196,7 @@ func (m *MsgBurnBySwapResponse) Reset()         { *m = MsgBurnBySwapResponse{} }
 func (m *MsgBurnBySwapResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnBySwapResponse) ProtoMessage()    {}
 func (*MsgBurnBySwapResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2797be2e51038e24, []int{3}
+	return fileDescriptor_b95fc14305d50301, []int{3}
 }
 func (m *MsgBurnBySwapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -245,11 +246,229 @@ func (m *MsgBurnBySwapResponse) GetBurnFee() types.Coin {
 	return types.Coin{}
 }
 
+// MsgBasketMint represents a message to mint War stablecoins by swapping in a
+// basket of strong-backing assets.
+type MsgBasketMint struct {
+	Sender       string                                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender" yaml:"sender"`
+	To           string                                   `protobuf:"bytes,2,opt,name=to,proto3" json:"to" yaml:"to"`
+	BackingInMax github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=backing_in_max,json=backingInMax,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"backing_in_max" yaml:"backing_in_max"`
+	MageInMax    types.Coin                               `protobuf:"bytes,4,opt,name=mage_in_max,json=mageInMax,proto3" json:"mage_in_max" yaml:"mage_in_max"`
+	MintOutMin   types.Coin                               `protobuf:"bytes,5,opt,name=mint_out_min,json=mintOutMin,proto3" json:"mint_out_min" yaml:"mint_out_min"`
+	FullBacking  bool                                     `protobuf:"varint,6,opt,name=full_backing,json=fullBacking,proto3" json:"full_backing" yaml:"full_backing"`
+}
+
+func (m *MsgBasketMint) Reset()         { *m = MsgBasketMint{} }
+func (m *MsgBasketMint) String() string { return proto.CompactTextString(m) }
+func (*MsgBasketMint) ProtoMessage()    {}
+func (*MsgBasketMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95fc14305d50301, []int{4}
+}
+func (m *MsgBasketMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBasketMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBasketMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBasketMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBasketMint.Merge(m, src)
+}
+func (m *MsgBasketMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBasketMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBasketMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBasketMint proto.InternalMessageInfo
+
+// MsgBasketMintResponse defines the Msg/BasketMint response type.
+type MsgBasketMintResponse struct {
+	BackingIn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=backing_in,json=backingIn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"backing_in" yaml:"backing_in"`
+	MageIn    types.Coin                               `protobuf:"bytes,2,opt,name=mage_in,json=mageIn,proto3" json:"mage_in" yaml:"mage_in"`
+	MintOut   types.Coin                               `protobuf:"bytes,3,opt,name=mint_out,json=mintOut,proto3" json:"mint_out" yaml:"mint_out"`
+	MintFee   types.Coin                               `protobuf:"bytes,4,opt,name=mint_fee,json=mintFee,proto3" json:"mint_fee" yaml:"mint_fee"`
+}
+
+func (m *MsgBasketMintResponse) Reset()         { *m = MsgBasketMintResponse{} }
+func (m *MsgBasketMintResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBasketMintResponse) ProtoMessage()    {}
+func (*MsgBasketMintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95fc14305d50301, []int{5}
+}
+func (m *MsgBasketMintResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBasketMintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBasketMintResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBasketMintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBasketMintResponse.Merge(m, src)
+}
+func (m *MsgBasketMintResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBasketMintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBasketMintResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBasketMintResponse proto.InternalMessageInfo
+
+func (m *MsgBasketMintResponse) GetBackingIn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BackingIn
+	}
+	return nil
+}
+
+func (m *MsgBasketMintResponse) GetMageIn() types.Coin {
+	if m != nil {
+		return m.MageIn
+	}
+	return types.Coin{}
+}
+
+func (m *MsgBasketMintResponse) GetMintOut() types.Coin {
+	if m != nil {
+		return m.MintOut
+	}
+	return types.Coin{}
+}
+
+func (m *MsgBasketMintResponse) GetMintFee() types.Coin {
+	if m != nil {
+		return m.MintFee
+	}
+	return types.Coin{}
+}
+
+// MsgBasketBurn represents a message to burn War stablecoins by swapping out a
+// basket of strong-backing assets.
+type MsgBasketBurn struct {
+	Sender string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender" yaml:"sender"`
+	To     string     `protobuf:"bytes,2,opt,name=to,proto3" json:"to" yaml:"to"`
+	BurnIn types.Coin `protobuf:"bytes,3,opt,name=burn_in,json=burnIn,proto3" json:"burn_in" yaml:"burn_in"`
+	// backing denoms to swap out, with minimum amounts which may be zero
+	BackingOutMin github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=backing_out_min,json=backingOutMin,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"backing_out_min" yaml:"backing_out_min"`
+	MageOutMin    types.Coin                               `protobuf:"bytes,5,opt,name=mage_out_min,json=mageOutMin,proto3" json:"mage_out_min" yaml:"mage_out_min"`
+}
+
+func (m *MsgBasketBurn) Reset()         { *m = MsgBasketBurn{} }
+func (m *MsgBasketBurn) String() string { return proto.CompactTextString(m) }
+func (*MsgBasketBurn) ProtoMessage()    {}
+func (*MsgBasketBurn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95fc14305d50301, []int{6}
+}
+func (m *MsgBasketBurn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBasketBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBasketBurn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBasketBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBasketBurn.Merge(m, src)
+}
+func (m *MsgBasketBurn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBasketBurn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBasketBurn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBasketBurn proto.InternalMessageInfo
+
+// MsgBasketBurnResponse defines the Msg/BasketBurn response type.
+type MsgBasketBurnResponse struct {
+	BackingOut github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=backing_out,json=backingOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"backing_out" yaml:"backing_out"`
+	MageOut    types.Coin                               `protobuf:"bytes,2,opt,name=mage_out,json=mageOut,proto3" json:"mage_out" yaml:"mage_out"`
+	BurnFee    types.Coin                               `protobuf:"bytes,3,opt,name=burn_fee,json=burnFee,proto3" json:"burn_fee" yaml:"burn_fee"`
+}
+
+func (m *MsgBasketBurnResponse) Reset()         { *m = MsgBasketBurnResponse{} }
+func (m *MsgBasketBurnResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBasketBurnResponse) ProtoMessage()    {}
+func (*MsgBasketBurnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95fc14305d50301, []int{7}
+}
+func (m *MsgBasketBurnResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBasketBurnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBasketBurnResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBasketBurnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBasketBurnResponse.Merge(m, src)
+}
+func (m *MsgBasketBurnResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBasketBurnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBasketBurnResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBasketBurnResponse proto.InternalMessageInfo
+
+func (m *MsgBasketBurnResponse) GetBackingOut() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BackingOut
+	}
+	return nil
+}
+
+func (m *MsgBasketBurnResponse) GetMageOut() types.Coin {
+	if m != nil {
+		return m.MageOut
+	}
+	return types.Coin{}
+}
+
+func (m *MsgBasketBurnResponse) GetBurnFee() types.Coin {
+	if m != nil {
+		return m.BurnFee
+	}
+	return types.Coin{}
+}
+
 // MsgBuyBacking represents a message to buy strong-backing assets.
 type MsgBuyBacking struct {
 	Sender        string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender" yaml:"sender"`
 	To            string     `protobuf:"bytes,2,opt,name=to,proto3" json:"to" yaml:"to"`
-	MageIn        types.Coin `protobuf:"bytes,3,opt,name=mage_in,json=mageOut,proto3" json:"mage_in" yaml:"mage_in"`
+	MageIn        types.Coin `protobuf:"bytes,3,opt,name=mage_in,json=mageIn,proto3" json:"mage_in" yaml:"mage_in"`
 	BackingOutMin types.Coin `protobuf:"bytes,4,opt,name=backing_out_min,json=backingOutMin,proto3" json:"backing_out_min" yaml:"backing_out_min"`
 }
 
@@ -257,7 +476,7 @@ func (m *MsgBuyBacking) Reset()         { *m = MsgBuyBacking{} }
 func (m *MsgBuyBacking) String() string { return proto.CompactTextString(m) }
 func (*MsgBuyBacking) ProtoMessage()    {}
 func (*MsgBuyBacking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2797be2e51038e24, []int{4}
+	return fileDescriptor_b95fc14305d50301, []int{8}
 }
 func (m *MsgBuyBacking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,7 +515,7 @@ func (m *MsgBuyBackingResponse) Reset()         { *m = MsgBuyBackingResponse{} }
 func (m *MsgBuyBackingResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBuyBackingResponse) ProtoMessage()    {}
 func (*MsgBuyBackingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2797be2e51038e24, []int{5}
+	return fileDescriptor_b95fc14305d50301, []int{9}
 }
 func (m *MsgBuyBackingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -352,7 +571,7 @@ func (m *MsgSellBacking) Reset()         { *m = MsgSellBacking{} }
 func (m *MsgSellBacking) String() string { return proto.CompactTextString(m) }
 func (*MsgSellBacking) ProtoMessage()    {}
 func (*MsgSellBacking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2797be2e51038e24, []int{6}
+	return fileDescriptor_b95fc14305d50301, []int{10}
 }
 func (m *MsgSellBacking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -391,7 +610,7 @@ func (m *MsgSellBackingResponse) Reset()         { *m = MsgSellBackingResponse{}
 func (m *MsgSellBackingResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSellBackingResponse) ProtoMessage()    {}
 func (*MsgSellBackingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2797be2e51038e24, []int{7}
+	return fileDescriptor_b95fc14305d50301, []int{11}
 }
 func (m *MsgSellBackingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -441,13 +660,16 @@ type MsgMintByCollateral struct {
 	To              string     `protobuf:"bytes,2,opt,name=to,proto3" json:"to" yaml:"to"`
 	CollateralDenom string     `protobuf:"bytes,3,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom" yaml:"collateral_denom"`
 	MintOut         types.Coin `protobuf:"bytes,4,opt,name=mint_out,json=mintOut,proto3" json:"mint_out" yaml:"mint_out"`
+	// owner of the collateral account; empty means sender, otherwise sender must
+	// be an authorized manager of the owner
+	Owner string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner" yaml:"owner"`
 }
 
 func (m *MsgMintByCollateral) Reset()         { *m = MsgMintByCollateral{} }
 func (m *MsgMintByCollateral) String() string { return proto.CompactTextString(m) }
 func (*MsgMintByCollateral) ProtoMessage()    {}
 func (*MsgMintByCollateral) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2797be2e51038e24, []int{8}
+	return fileDescriptor_b95fc14305d50301, []int{12}
 }
 func (m *MsgMintByCollateral) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -485,7 +707,7 @@ func (m *MsgMintByCollateralResponse) Reset()         { *m = MsgMintByCollateral
 func (m *MsgMintByCollateralResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMintByCollateralResponse) ProtoMessage()    {}
 func (*MsgMintByCollateralResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2797be2e51038e24, []int{9}
+	return fileDescriptor_b95fc14305d50301, []int{13}
 }
 func (m *MsgMintByCollateralResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,13 +749,16 @@ type MsgBurnByCollateral struct {
 	Sender          string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender" yaml:"sender"`
 	CollateralDenom string     `protobuf:"bytes,2,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom" yaml:"collateral_denom"`
 	RepayInMax      types.Coin `protobuf:"bytes,3,opt,name=repay_in_max,json=repayInMax,proto3" json:"repay_in_max" yaml:"repay_in_max"`
+	// owner of the collateral account; empty means sender, otherwise sender must
+	// be an authorized manager of the owner
+	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner" yaml:"owner"`
 }
 
 func (m *MsgBurnByCollateral) Reset()         { *m = MsgBurnByCollateral{} }
 func (m *MsgBurnByCollateral) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnByCollateral) ProtoMessage()    {}
 func (*MsgBurnByCollateral) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2797be2e51038e24, []int{10}
+	return fileDescriptor_b95fc14305d50301, []int{14}
 }
 func (m *MsgBurnByCollateral) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +796,7 @@ func (m *MsgBurnByCollateralResponse) Reset()         { *m = MsgBurnByCollateral
 func (m *MsgBurnByCollateralResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnByCollateralResponse) ProtoMessage()    {}
 func (*MsgBurnByCollateralResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2797be2e51038e24, []int{11}
+	return fileDescriptor_b95fc14305d50301, []int{15}
 }
 func (m *MsgBurnByCollateralResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -612,14 +837,14 @@ type MsgDepositCollateral struct {
 	Sender       string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender" yaml:"sender"`
 	To           string     `protobuf:"bytes,2,opt,name=to,proto3" json:"to" yaml:"to"`
 	CollateralIn types.Coin `protobuf:"bytes,3,opt,name=collateral_in,json=collateralIn,proto3" json:"collateral_in" yaml:"collateral_in"`
-	MageIn       types.Coin `protobuf:"bytes,4,opt,name=mage_in,json=mageOut,proto3" json:"mage_in" yaml:"mage_in"`
+	MageIn       types.Coin `protobuf:"bytes,4,opt,name=mage_in,json=mageIn,proto3" json:"mage_in" yaml:"mage_in"`
 }
 
 func (m *MsgDepositCollateral) Reset()         { *m = MsgDepositCollateral{} }
 func (m *MsgDepositCollateral) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositCollateral) ProtoMessage()    {}
 func (*MsgDepositCollateral) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2797be2e51038e24, []int{12}
+	return fileDescriptor_b95fc14305d50301, []int{16}
 }
 func (m *MsgDepositCollateral) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -656,7 +881,7 @@ func (m *MsgDepositCollateralResponse) Reset()         { *m = MsgDepositCollater
 func (m *MsgDepositCollateralResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositCollateralResponse) ProtoMessage()    {}
 func (*MsgDepositCollateralResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2797be2e51038e24, []int{13}
+	return fileDescriptor_b95fc14305d50301, []int{17}
 }
 func (m *MsgDepositCollateralResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -692,13 +917,16 @@ type MsgRedeemCollateral struct {
 	To            string     `protobuf:"bytes,2,opt,name=to,proto3" json:"to" yaml:"to"`
 	CollateralOut types.Coin `protobuf:"bytes,3,opt,name=collateral_out,json=collateralOut,proto3" json:"collateral_out" yaml:"collateral_out"`
 	MageOut       types.Coin `protobuf:"bytes,4,opt,name=mage_out,json=mageOut,proto3" json:"mage_out" yaml:"mage_out"`
+	// owner of the collateral account; empty means sender, otherwise sender must
+	// be an authorized manager of the owner
+	Owner string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner" yaml:"owner"`
 }
 
 func (m *MsgRedeemCollateral) Reset()         { *m = MsgRedeemCollateral{} }
 func (m *MsgRedeemCollateral) String() string { return proto.CompactTextString(m) }
 func (*MsgRedeemCollateral) ProtoMessage()    {}
 func (*MsgRedeemCollateral) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2797be2e51038e24, []int{14}
+	return fileDescriptor_b95fc14305d50301, []int{18}
 }
 func (m *MsgRedeemCollateral) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -735,7 +963,7 @@ func (m *MsgRedeemCollateralResponse) Reset()         { *m = MsgRedeemCollateral
 func (m *MsgRedeemCollateralResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRedeemCollateralResponse) ProtoMessage()    {}
 func (*MsgRedeemCollateralResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2797be2e51038e24, []int{15}
+	return fileDescriptor_b95fc14305d50301, []int{19}
 }
 func (m *MsgRedeemCollateralResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -777,7 +1005,7 @@ func (m *MsgLiquidateCollateral) Reset()         { *m = MsgLiquidateCollateral{}
 func (m *MsgLiquidateCollateral) String() string { return proto.CompactTextString(m) }
 func (*MsgLiquidateCollateral) ProtoMessage()    {}
 func (*MsgLiquidateCollateral) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2797be2e51038e24, []int{16}
+	return fileDescriptor_b95fc14305d50301, []int{20}
 }
 func (m *MsgLiquidateCollateral) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,7 +1045,7 @@ func (m *MsgLiquidateCollateralResponse) Reset()         { *m = MsgLiquidateColl
 func (m *MsgLiquidateCollateralResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLiquidateCollateralResponse) ProtoMessage()    {}
 func (*MsgLiquidateCollateralResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2797be2e51038e24, []int{17}
+	return fileDescriptor_b95fc14305d50301, []int{21}
 }
 func (m *MsgLiquidateCollateralResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -860,1142 +1088,1285 @@ func (m *MsgLiquidateCollateralResponse) GetCollateralOut() types.Coin {
 	return types.Coin{}
 }
 
-func init() {
-	proto.RegisterType((*MsgMintBySwap)(nil), "warmage.maker.v1.MsgMintBySwap")
-	proto.RegisterType((*MsgMintBySwapResponse)(nil), "warmage.maker.v1.MsgMintBySwapResponse")
-	proto.RegisterType((*MsgBurnBySwap)(nil), "warmage.maker.v1.MsgBurnBySwap")
-	proto.RegisterType((*MsgBurnBySwapResponse)(nil), "warmage.maker.v1.MsgBurnBySwapResponse")
-	proto.RegisterType((*MsgBuyBacking)(nil), "warmage.maker.v1.MsgBuyBacking")
-	proto.RegisterType((*MsgBuyBackingResponse)(nil), "warmage.maker.v1.MsgBuyBackingResponse")
-	proto.RegisterType((*MsgSellBacking)(nil), "warmage.maker.v1.MsgSellBacking")
-	proto.RegisterType((*MsgSellBackingResponse)(nil), "warmage.maker.v1.MsgSellBackingResponse")
-	proto.RegisterType((*MsgMintByCollateral)(nil), "warmage.maker.v1.MsgMintByCollateral")
-	proto.RegisterType((*MsgMintByCollateralResponse)(nil), "warmage.maker.v1.MsgMintByCollateralResponse")
-	proto.RegisterType((*MsgBurnByCollateral)(nil), "warmage.maker.v1.MsgBurnByCollateral")
-	proto.RegisterType((*MsgBurnByCollateralResponse)(nil), "warmage.maker.v1.MsgBurnByCollateralResponse")
-	proto.RegisterType((*MsgDepositCollateral)(nil), "warmage.maker.v1.MsgDepositCollateral")
-	proto.RegisterType((*MsgDepositCollateralResponse)(nil), "warmage.maker.v1.MsgDepositCollateralResponse")
-	proto.RegisterType((*MsgRedeemCollateral)(nil), "warmage.maker.v1.MsgRedeemCollateral")
-	proto.RegisterType((*MsgRedeemCollateralResponse)(nil), "warmage.maker.v1.MsgRedeemCollateralResponse")
-	proto.RegisterType((*MsgLiquidateCollateral)(nil), "warmage.maker.v1.MsgLiquidateCollateral")
-	proto.RegisterType((*MsgLiquidateCollateralResponse)(nil), "warmage.maker.v1.MsgLiquidateCollateralResponse")
+// MsgTransferPosition represents a message to transfer an entire collateral
+// position to another account.
+type MsgTransferPosition struct {
+	Sender          string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender" yaml:"sender"`
+	To              string `protobuf:"bytes,2,opt,name=to,proto3" json:"to" yaml:"to"`
+	CollateralDenom string `protobuf:"bytes,3,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom" yaml:"collateral_denom"`
 }
 
-func init() { proto.RegisterFile("warmage/maker/v1/tx.proto", fileDescriptor_2797be2e51038e24) }
-
-var fileDescriptor_2797be2e51038e24 = []byte{
-	// 1323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xc1, 0x6f, 0xdb, 0x54,
-	0x18, 0x8f, 0x9d, 0x2e, 0x5d, 0xbe, 0xa6, 0x5d, 0xe7, 0x76, 0x5d, 0x9b, 0x76, 0x71, 0x79, 0x53,
-	0xd7, 0x54, 0xa8, 0x36, 0xdd, 0x6e, 0x3b, 0x66, 0x13, 0x68, 0x15, 0x11, 0x92, 0x0b, 0xa8, 0x9a,
-	0x10, 0x91, 0xd3, 0xbc, 0x06, 0x6b, 0x89, 0x5f, 0x88, 0x9d, 0xd2, 0x70, 0x02, 0xc4, 0x01, 0x21,
-	0x21, 0x21, 0x71, 0x01, 0x89, 0xc3, 0x04, 0xbb, 0x70, 0xe3, 0x0f, 0xe0, 0xce, 0x8e, 0x95, 0x26,
-	0x21, 0x4e, 0x11, 0x6a, 0x39, 0xa0, 0x1e, 0x7b, 0xe6, 0x80, 0xfc, 0xfc, 0x6c, 0x3f, 0x3b, 0x4e,
-	0x9d, 0x92, 0xa6, 0x37, 0xbf, 0xe7, 0xef, 0xfb, 0xf9, 0xe7, 0xdf, 0xf7, 0x7b, 0xef, 0x7b, 0x36,
-	0x2c, 0x35, 0x71, 0xbb, 0x61, 0x10, 0x53, 0x6d, 0xea, 0xcf, 0x70, 0x5b, 0x3d, 0xd8, 0x52, 0xed,
-	0x43, 0xa5, 0xd5, 0x26, 0x36, 0x91, 0x66, 0xd9, 0x2d, 0x85, 0xde, 0x52, 0x0e, 0xb6, 0xf2, 0x2b,
-	0x75, 0x42, 0xea, 0x0d, 0xac, 0xea, 0x2d, 0x43, 0xd5, 0x4d, 0x93, 0xd8, 0xba, 0x6d, 0x10, 0xd3,
-	0x72, 0xe3, 0xf3, 0xf3, 0x75, 0x52, 0x27, 0xf4, 0x52, 0x75, 0xae, 0xd8, 0x6c, 0x61, 0x8f, 0x58,
-	0x4d, 0x62, 0xa9, 0x55, 0xdd, 0xc2, 0xea, 0xc1, 0x56, 0x15, 0xdb, 0xfa, 0x96, 0xba, 0x47, 0x0c,
-	0xd3, 0xbd, 0x8f, 0x8e, 0xd2, 0x30, 0x5d, 0xb6, 0xea, 0x65, 0xc3, 0xb4, 0x4b, 0xdd, 0x9d, 0x4f,
-	0xf4, 0x96, 0xf4, 0x00, 0x32, 0x16, 0x36, 0x6b, 0xb8, 0xbd, 0x28, 0xac, 0x0a, 0xc5, 0x6c, 0x69,
-	0xf9, 0xb4, 0x27, 0xb3, 0x99, 0xb3, 0x9e, 0x3c, 0xdd, 0xd5, 0x9b, 0x8d, 0x87, 0xc8, 0x1d, 0x23,
-	0x8d, 0xdd, 0x90, 0xee, 0x82, 0x68, 0x93, 0x45, 0x91, 0x26, 0xcc, 0x9d, 0xf6, 0x64, 0xd1, 0x26,
-	0x67, 0x3d, 0x39, 0xeb, 0x06, 0xdb, 0x04, 0x69, 0xa2, 0x4d, 0xa4, 0x0f, 0x61, 0xa6, 0xaa, 0xef,
-	0x3d, 0x33, 0xcc, 0x7a, 0xc5, 0x30, 0x2b, 0x4d, 0xfd, 0x70, 0x31, 0xbd, 0x2a, 0x14, 0xa7, 0xee,
-	0x2f, 0x29, 0x2e, 0x49, 0xc5, 0x21, 0xa9, 0x30, 0x92, 0xca, 0x23, 0x62, 0x98, 0xa5, 0x3b, 0x2f,
-	0x7b, 0x72, 0xea, 0xac, 0x27, 0xdf, 0x72, 0x91, 0xc2, 0xe9, 0x48, 0xcb, 0xb1, 0x89, 0x27, 0x66,
-	0x59, 0x3f, 0x94, 0xde, 0x83, 0x29, 0x47, 0x30, 0x0f, 0x7c, 0x22, 0x09, 0x3c, 0xcf, 0xc0, 0x25,
-	0x17, 0x9c, 0xcb, 0x45, 0x5a, 0xd6, 0x19, 0xb9, 0xb0, 0xbb, 0x90, 0x6b, 0x1a, 0xa6, 0x5d, 0x21,
-	0x1d, 0xbb, 0xd2, 0x34, 0xcc, 0xc5, 0x6b, 0x49, 0xb8, 0xcb, 0x0c, 0x77, 0xce, 0xc5, 0xe5, 0x93,
-	0x91, 0x06, 0xce, 0xf0, 0x9d, 0x8e, 0x5d, 0x36, 0x4c, 0x69, 0x1b, 0x72, 0xfb, 0x9d, 0x46, 0xa3,
-	0xc2, 0xde, 0x62, 0x31, 0xb3, 0x2a, 0x14, 0xaf, 0x97, 0xd6, 0x4f, 0x7b, 0x72, 0x68, 0x3e, 0x80,
-	0xe2, 0x67, 0x91, 0x36, 0xe5, 0x0c, 0x4b, 0xee, 0xe8, 0xe1, 0xf5, 0xaf, 0x9e, 0xcb, 0xa9, 0x7f,
-	0x9e, 0xcb, 0x29, 0xf4, 0x87, 0x08, 0xb7, 0x42, 0x25, 0xd5, 0xb0, 0xd5, 0x22, 0xa6, 0x85, 0xa5,
-	0x1d, 0x80, 0x40, 0x41, 0x5a, 0xde, 0x73, 0xdf, 0x63, 0x89, 0xbd, 0xc7, 0xcd, 0xa8, 0xf8, 0x48,
-	0xcb, 0xfa, 0xc2, 0x4b, 0xdb, 0x30, 0xc9, 0x94, 0xa3, 0xf5, 0x3f, 0x17, 0x71, 0x81, 0x21, 0xce,
-	0x84, 0x14, 0x47, 0x5a, 0xc6, 0x55, 0x5b, 0x2a, 0xc3, 0x75, 0x4f, 0xad, 0x64, 0x6f, 0xdc, 0x66,
-	0x60, 0x37, 0xc2, 0x32, 0x23, 0x6d, 0x92, 0x49, 0xec, 0xc3, 0xed, 0x63, 0x9c, 0xec, 0x86, 0x38,
-	0xb8, 0x7d, 0x8c, 0x19, 0xdc, 0x9b, 0x18, 0xa3, 0x7f, 0x45, 0xba, 0x56, 0x4a, 0x9d, 0xb6, 0x39,
-	0xf6, 0xb5, 0xb2, 0x0d, 0x93, 0xd5, 0x4e, 0x9b, 0xaa, 0x9a, 0xbe, 0xa0, 0xaa, 0x2c, 0x0f, 0x69,
-	0x19, 0xe7, 0xea, 0x89, 0x29, 0xe9, 0x70, 0xc3, 0xab, 0x9d, 0xe7, 0xe1, 0x44, 0x35, 0x0a, 0x0c,
-	0x73, 0x21, 0x5c, 0x7b, 0xdf, 0xc6, 0xd3, 0x6c, 0x86, 0x39, 0x79, 0x17, 0x72, 0xb4, 0x98, 0xff,
-	0x77, 0x8d, 0xf0, 0xc9, 0x48, 0x03, 0x67, 0xe8, 0x22, 0x73, 0xbe, 0xfe, 0xc6, 0xf5, 0x75, 0x20,
-	0xbf, 0xef, 0xeb, 0xf7, 0x61, 0x8a, 0x23, 0x98, 0x6c, 0xec, 0xc8, 0xc2, 0xe7, 0x72, 0x91, 0x06,
-	0xc1, 0x8b, 0x39, 0xfe, 0xf1, 0x88, 0x25, 0x7b, 0x3b, 0xe2, 0x1f, 0x2f, 0x11, 0x69, 0x93, 0xec,
-	0x6d, 0x1c, 0x38, 0x5a, 0x1b, 0xc7, 0x8e, 0x17, 0x75, 0xb7, 0x97, 0x88, 0x34, 0xea, 0x0b, 0xc7,
-	0x8e, 0x3f, 0x79, 0x76, 0xec, 0xb2, 0x3d, 0x60, 0xbc, 0x76, 0xf4, 0x16, 0x79, 0x7a, 0xd4, 0x45,
-	0x3e, 0x7e, 0x3b, 0x72, 0xa6, 0xf9, 0x5d, 0x60, 0xa6, 0xf1, 0x44, 0x1a, 0xbb, 0x69, 0x1c, 0xdc,
-	0x4e, 0xd7, 0x99, 0xa0, 0x85, 0x16, 0x2f, 0x8a, 0x1b, 0xe4, 0x3a, 0xb8, 0xee, 0xc8, 0x29, 0xf7,
-	0xcf, 0x22, 0xcc, 0x94, 0xad, 0xfa, 0x0e, 0xf6, 0xf7, 0xfc, 0x31, 0xd6, 0x3b, 0xdc, 0x29, 0xd2,
-	0x97, 0xd3, 0x29, 0xa2, 0x9b, 0xc4, 0xc4, 0x18, 0x36, 0x89, 0xdf, 0x04, 0x58, 0x08, 0xab, 0xe4,
-	0x17, 0x9c, 0x5f, 0xcd, 0xc2, 0xe8, 0xab, 0x79, 0x07, 0xa0, 0x8d, 0x87, 0x2f, 0x73, 0x44, 0xa2,
-	0x20, 0x15, 0x69, 0x59, 0x77, 0xe0, 0x14, 0xf9, 0x85, 0x08, 0x73, 0x7e, 0xef, 0x7e, 0x44, 0x1a,
-	0x0d, 0xdd, 0xc6, 0x6d, 0xbd, 0x31, 0xc6, 0x4a, 0x3f, 0x85, 0xd9, 0x3d, 0xff, 0x39, 0x95, 0x1a,
-	0x36, 0x49, 0x93, 0xd6, 0x3b, 0x5b, 0x52, 0x4f, 0x7b, 0x72, 0xdf, 0xbd, 0xb3, 0x9e, 0x7c, 0xdb,
-	0x05, 0x88, 0xde, 0x41, 0xda, 0x8d, 0x60, 0xea, 0xb1, 0x33, 0x13, 0x6a, 0xe7, 0x13, 0x23, 0xb7,
-	0x73, 0xae, 0xca, 0x0d, 0x58, 0x8e, 0x51, 0x89, 0xaf, 0xb4, 0xdf, 0xf7, 0x85, 0xd1, 0xfb, 0xfe,
-	0xd7, 0x6e, 0x51, 0xdc, 0xc6, 0x33, 0x6a, 0x51, 0xe2, 0xf4, 0x16, 0x2f, 0x49, 0xef, 0x5d, 0xc8,
-	0xb5, 0x71, 0x4b, 0xef, 0x0e, 0x7d, 0xbc, 0x8e, 0x2c, 0x30, 0x3e, 0x19, 0x69, 0x40, 0x87, 0xf4,
-	0x0c, 0xdc, 0x27, 0x7d, 0x54, 0x0b, 0x5e, 0x7a, 0x0f, 0xe5, 0xc2, 0xd2, 0x7b, 0x89, 0x48, 0x9b,
-	0x64, 0x8f, 0x76, 0xd6, 0xc3, 0x7c, 0xd9, 0xaa, 0x3f, 0xc6, 0x2d, 0x62, 0x19, 0xf6, 0x95, 0x2c,
-	0x88, 0x0f, 0x60, 0x9a, 0x93, 0x7a, 0x98, 0xdd, 0x6f, 0x85, 0xbd, 0xc6, 0x7c, 0x5f, 0xa1, 0x9c,
-	0x77, 0xc9, 0x05, 0xe3, 0xf0, 0x69, 0x79, 0x62, 0xc4, 0x46, 0xca, 0x15, 0xa5, 0x00, 0x2b, 0x71,
-	0x2a, 0x79, 0x55, 0x41, 0xbf, 0xb8, 0x0e, 0xd6, 0x70, 0x0d, 0xe3, 0xe6, 0x95, 0xa8, 0x58, 0x81,
-	0x19, 0x4e, 0x87, 0xa1, 0xce, 0xf3, 0x91, 0x6f, 0xbd, 0x70, 0x3a, 0xd2, 0xb8, 0xaa, 0x44, 0xcf,
-	0x66, 0x13, 0x23, 0xef, 0xe6, 0x9c, 0x96, 0x77, 0xa8, 0xc1, 0xa3, 0x52, 0xf9, 0x52, 0xbe, 0x12,
-	0x69, 0x83, 0x79, 0xdb, 0xf8, 0xb8, 0x63, 0xd4, 0x74, 0x1b, 0x5f, 0x89, 0x9a, 0x6b, 0x90, 0xa9,
-	0xe1, 0xaa, 0x4d, 0xda, 0x6c, 0x6b, 0x9e, 0x0e, 0x87, 0xb0, 0x9b, 0xd2, 0xbb, 0x00, 0x81, 0x48,
-	0xc9, 0xaa, 0x44, 0x5a, 0x52, 0x90, 0x8a, 0x34, 0x0e, 0xa7, 0x6f, 0x57, 0xb9, 0x36, 0x86, 0x5d,
-	0xe5, 0x48, 0x80, 0x42, 0xbc, 0xaa, 0x63, 0xda, 0x59, 0x62, 0x0c, 0x2a, 0x5e, 0xaa, 0x41, 0xef,
-	0xff, 0x0a, 0x90, 0x2e, 0x5b, 0x75, 0xe9, 0x73, 0x01, 0x80, 0xfb, 0xbd, 0x22, 0x2b, 0xd1, 0xff,
-	0x3a, 0x4a, 0xe8, 0x63, 0x3d, 0xbf, 0x9e, 0x10, 0xe0, 0x3b, 0x71, 0xe3, 0x8b, 0x57, 0x7f, 0x7f,
-	0x27, 0xde, 0x95, 0x5e, 0x53, 0x63, 0x7e, 0x22, 0xa9, 0xb4, 0x8f, 0x55, 0xbb, 0x15, 0xcb, 0x79,
-	0xa8, 0xc3, 0x81, 0xfb, 0x6c, 0x8d, 0xe7, 0x10, 0x04, 0x0c, 0xe0, 0xd0, 0xff, 0xe5, 0x95, 0xc0,
-	0x81, 0x7e, 0xb4, 0x78, 0x1c, 0x3e, 0xa3, 0x1c, 0xfc, 0x6f, 0x95, 0x41, 0x1c, 0xbc, 0x80, 0x81,
-	0x1c, 0xa2, 0x07, 0x79, 0x54, 0xa4, 0x1c, 0x90, 0xb4, 0x3a, 0x80, 0x43, 0xd7, 0xfb, 0x63, 0x22,
-	0x7d, 0x29, 0xc0, 0x14, 0x7f, 0x7e, 0x5e, 0x8d, 0x7d, 0x04, 0x17, 0x91, 0x2f, 0x26, 0x45, 0x0c,
-	0xa9, 0x84, 0x85, 0x83, 0x1f, 0x37, 0xd2, 0x0f, 0x02, 0xcc, 0xf6, 0x9d, 0xf0, 0xd6, 0xce, 0x29,
-	0x7b, 0x10, 0x96, 0xdf, 0x1c, 0x2a, 0xcc, 0x67, 0xa5, 0x52, 0x56, 0x1b, 0xd2, 0xfa, 0xb9, 0x1e,
-	0xe1, 0x16, 0xbb, 0xc3, 0xad, 0xef, 0xa0, 0xb3, 0x76, 0x8e, 0x1d, 0x12, 0xb9, 0x0d, 0x3a, 0x2a,
-	0x24, 0x70, 0xf3, 0xbc, 0xc3, 0x71, 0xfb, 0x51, 0x80, 0x9b, 0xfd, 0x27, 0x81, 0x7b, 0xb1, 0x4f,
-	0xed, 0x8b, 0xcb, 0x2b, 0xc3, 0xc5, 0x0d, 0x49, 0xaf, 0xe6, 0xe6, 0xf1, 0xf4, 0xbe, 0x17, 0x60,
-	0xb6, 0xaf, 0xc3, 0xc6, 0x4b, 0x17, 0x0d, 0x1b, 0x20, 0xdd, 0xc0, 0x26, 0xa4, 0x50, 0x6e, 0x45,
-	0xe9, 0x5e, 0x2c, 0xb7, 0x36, 0x4d, 0xe3, 0xa9, 0xbd, 0x10, 0x60, 0x2e, 0xae, 0x63, 0xc5, 0xdb,
-	0x3b, 0x26, 0x32, 0xff, 0xc6, 0xb0, 0x91, 0x3e, 0xc7, 0x2d, 0xca, 0xf1, 0x75, 0x69, 0x23, 0x96,
-	0x63, 0xc3, 0xcb, 0xe4, 0x68, 0x96, 0xde, 0x7a, 0x79, 0x5c, 0x10, 0x8e, 0x8e, 0x0b, 0xc2, 0x5f,
-	0xc7, 0x05, 0xe1, 0xdb, 0x93, 0x42, 0xea, 0xe8, 0xa4, 0x90, 0xfa, 0xf3, 0xa4, 0x90, 0x7a, 0xba,
-	0x59, 0x37, 0xec, 0x8f, 0x3a, 0x55, 0x65, 0x8f, 0x34, 0x3d, 0xb8, 0xcd, 0x4f, 0x89, 0x89, 0x7d,
-	0xec, 0x43, 0x86, 0x6e, 0x77, 0x5b, 0xd8, 0xaa, 0x66, 0xe8, 0xcf, 0xed, 0x07, 0xff, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0x23, 0xe2, 0xb2, 0xcc, 0x5f, 0x17, 0x00, 0x00,
+func (m *MsgTransferPosition) Reset()         { *m = MsgTransferPosition{} }
+func (m *MsgTransferPosition) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferPosition) ProtoMessage()    {}
+func (*MsgTransferPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95fc14305d50301, []int{22}
+}
+func (m *MsgTransferPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferPosition.Merge(m, src)
+}
+func (m *MsgTransferPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferPosition.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgTransferPosition proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// MintBySwap mints War stablecoins by swapping in strong-backing assets and
-	// Mage coins.
-	MintBySwap(ctx context.Context, in *MsgMintBySwap, opts ...grpc.CallOption) (*MsgMintBySwapResponse, error)
-	// BurnBySwap burns War stablecoins by swapping out strong-backing assets and
-	// Mage coins.
-	BurnBySwap(ctx context.Context, in *MsgBurnBySwap, opts ...grpc.CallOption) (*MsgBurnBySwapResponse, error)
-	// BuyBacking buys strong-backing assets by spending Mage coins.
-	BuyBacking(ctx context.Context, in *MsgBuyBacking, opts ...grpc.CallOption) (*MsgBuyBackingResponse, error)
-	// SellBacking sells strong-backing assets by earning Mage
-	// coins.
-	SellBacking(ctx context.Context, in *MsgSellBacking, opts ...grpc.CallOption) (*MsgSellBackingResponse, error)
-	// MintByCollateral mints War stablecoins by locking collateral assets and
-	// spending Mage coins.
-	MintByCollateral(ctx context.Context, in *MsgMintByCollateral, opts ...grpc.CallOption) (*MsgMintByCollateralResponse, error)
-	// BurnByCollateral burns War stablecoins by unlocking collateral assets and
-	// earning Mage coins.
-	BurnByCollateral(ctx context.Context, in *MsgBurnByCollateral, opts ...grpc.CallOption) (*MsgBurnByCollateralResponse, error)
-	// DepositCollateral deposits collateral assets.
-	DepositCollateral(ctx context.Context, in *MsgDepositCollateral, opts ...grpc.CallOption) (*MsgDepositCollateralResponse, error)
-	// RedeemCollateral redeems collateral assets and collateralized Mage coins.
-	RedeemCollateral(ctx context.Context, in *MsgRedeemCollateral, opts ...grpc.CallOption) (*MsgRedeemCollateralResponse, error)
-	// LiquidateCollateral liquidates collateral assets which is
-	// undercollateralized.
-	LiquidateCollateral(ctx context.Context, in *MsgLiquidateCollateral, opts ...grpc.CallOption) (*MsgLiquidateCollateralResponse, error)
+// MsgTransferPositionResponse defines the Msg/TransferPosition response type.
+type MsgTransferPositionResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgTransferPositionResponse) Reset()         { *m = MsgTransferPositionResponse{} }
+func (m *MsgTransferPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferPositionResponse) ProtoMessage()    {}
+func (*MsgTransferPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95fc14305d50301, []int{23}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgTransferPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) MintBySwap(ctx context.Context, in *MsgMintBySwap, opts ...grpc.CallOption) (*MsgMintBySwapResponse, error) {
-	out := new(MsgMintBySwapResponse)
-	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/MintBySwap", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) BurnBySwap(ctx context.Context, in *MsgBurnBySwap, opts ...grpc.CallOption) (*MsgBurnBySwapResponse, error) {
-	out := new(MsgBurnBySwapResponse)
-	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/BurnBySwap", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgTransferPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferPositionResponse.Merge(m, src)
 }
-
-func (c *msgClient) BuyBacking(ctx context.Context, in *MsgBuyBacking, opts ...grpc.CallOption) (*MsgBuyBackingResponse, error) {
-	out := new(MsgBuyBackingResponse)
-	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/BuyBacking", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgTransferPositionResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) SellBacking(ctx context.Context, in *MsgSellBacking, opts ...grpc.CallOption) (*MsgSellBackingResponse, error) {
-	out := new(MsgSellBackingResponse)
-	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/SellBacking", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgTransferPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferPositionResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) MintByCollateral(ctx context.Context, in *MsgMintByCollateral, opts ...grpc.CallOption) (*MsgMintByCollateralResponse, error) {
-	out := new(MsgMintByCollateralResponse)
-	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/MintByCollateral", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgTransferPositionResponse proto.InternalMessageInfo
+
+// MsgAuthorizeManager represents a message to authorize an account to manage
+// collateral positions of the sender.
+type MsgAuthorizeManager struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender" yaml:"sender"`
+	Manager string `protobuf:"bytes,2,opt,name=manager,proto3" json:"manager" yaml:"manager"`
 }
 
-func (c *msgClient) BurnByCollateral(ctx context.Context, in *MsgBurnByCollateral, opts ...grpc.CallOption) (*MsgBurnByCollateralResponse, error) {
-	out := new(MsgBurnByCollateralResponse)
-	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/BurnByCollateral", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgAuthorizeManager) Reset()         { *m = MsgAuthorizeManager{} }
+func (m *MsgAuthorizeManager) String() string { return proto.CompactTextString(m) }
+func (*MsgAuthorizeManager) ProtoMessage()    {}
+func (*MsgAuthorizeManager) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95fc14305d50301, []int{24}
 }
-
-func (c *msgClient) DepositCollateral(ctx context.Context, in *MsgDepositCollateral, opts ...grpc.CallOption) (*MsgDepositCollateralResponse, error) {
-	out := new(MsgDepositCollateralResponse)
-	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/DepositCollateral", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAuthorizeManager) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAuthorizeManager) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAuthorizeManager.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAuthorizeManager) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAuthorizeManager.Merge(m, src)
+}
+func (m *MsgAuthorizeManager) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAuthorizeManager) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAuthorizeManager.DiscardUnknown(m)
 }
 
-func (c *msgClient) RedeemCollateral(ctx context.Context, in *MsgRedeemCollateral, opts ...grpc.CallOption) (*MsgRedeemCollateralResponse, error) {
-	out := new(MsgRedeemCollateralResponse)
-	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/RedeemCollateral", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgAuthorizeManager proto.InternalMessageInfo
+
+// MsgAuthorizeManagerResponse defines the Msg/AuthorizeManager response type.
+type MsgAuthorizeManagerResponse struct {
 }
 
-func (c *msgClient) LiquidateCollateral(ctx context.Context, in *MsgLiquidateCollateral, opts ...grpc.CallOption) (*MsgLiquidateCollateralResponse, error) {
-	out := new(MsgLiquidateCollateralResponse)
-	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/LiquidateCollateral", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAuthorizeManagerResponse) Reset()         { *m = MsgAuthorizeManagerResponse{} }
+func (m *MsgAuthorizeManagerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAuthorizeManagerResponse) ProtoMessage()    {}
+func (*MsgAuthorizeManagerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95fc14305d50301, []int{25}
+}
+func (m *MsgAuthorizeManagerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAuthorizeManagerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAuthorizeManagerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// MintBySwap mints War stablecoins by swapping in strong-backing assets and
-	// Mage coins.
-	MintBySwap(context.Context, *MsgMintBySwap) (*MsgMintBySwapResponse, error)
-	// BurnBySwap burns War stablecoins by swapping out strong-backing assets and
-	// Mage coins.
-	BurnBySwap(context.Context, *MsgBurnBySwap) (*MsgBurnBySwapResponse, error)
-	// BuyBacking buys strong-backing assets by spending Mage coins.
-	BuyBacking(context.Context, *MsgBuyBacking) (*MsgBuyBackingResponse, error)
-	// SellBacking sells strong-backing assets by earning Mage
-	// coins.
-	SellBacking(context.Context, *MsgSellBacking) (*MsgSellBackingResponse, error)
-	// MintByCollateral mints War stablecoins by locking collateral assets and
-	// spending Mage coins.
-	MintByCollateral(context.Context, *MsgMintByCollateral) (*MsgMintByCollateralResponse, error)
-	// BurnByCollateral burns War stablecoins by unlocking collateral assets and
-	// earning Mage coins.
-	BurnByCollateral(context.Context, *MsgBurnByCollateral) (*MsgBurnByCollateralResponse, error)
-	// DepositCollateral deposits collateral assets.
-	DepositCollateral(context.Context, *MsgDepositCollateral) (*MsgDepositCollateralResponse, error)
-	// RedeemCollateral redeems collateral assets and collateralized Mage coins.
-	RedeemCollateral(context.Context, *MsgRedeemCollateral) (*MsgRedeemCollateralResponse, error)
-	// LiquidateCollateral liquidates collateral assets which is
-	// undercollateralized.
-	LiquidateCollateral(context.Context, *MsgLiquidateCollateral) (*MsgLiquidateCollateralResponse, error)
+func (m *MsgAuthorizeManagerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAuthorizeManagerResponse.Merge(m, src)
+}
+func (m *MsgAuthorizeManagerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAuthorizeManagerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAuthorizeManagerResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgAuthorizeManagerResponse proto.InternalMessageInfo
+
+// MsgRevokeManager represents a message to revoke the authorization of a
+// collateral position manager.
+type MsgRevokeManager struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender" yaml:"sender"`
+	Manager string `protobuf:"bytes,2,opt,name=manager,proto3" json:"manager" yaml:"manager"`
 }
 
-func (*UnimplementedMsgServer) MintBySwap(ctx context.Context, req *MsgMintBySwap) (*MsgMintBySwapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MintBySwap not implemented")
+func (m *MsgRevokeManager) Reset()         { *m = MsgRevokeManager{} }
+func (m *MsgRevokeManager) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeManager) ProtoMessage()    {}
+func (*MsgRevokeManager) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95fc14305d50301, []int{26}
 }
-func (*UnimplementedMsgServer) BurnBySwap(ctx context.Context, req *MsgBurnBySwap) (*MsgBurnBySwapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BurnBySwap not implemented")
+func (m *MsgRevokeManager) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) BuyBacking(ctx context.Context, req *MsgBuyBacking) (*MsgBuyBackingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BuyBacking not implemented")
+func (m *MsgRevokeManager) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeManager.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) SellBacking(ctx context.Context, req *MsgSellBacking) (*MsgSellBackingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SellBacking not implemented")
+func (m *MsgRevokeManager) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeManager.Merge(m, src)
 }
-func (*UnimplementedMsgServer) MintByCollateral(ctx context.Context, req *MsgMintByCollateral) (*MsgMintByCollateralResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MintByCollateral not implemented")
+func (m *MsgRevokeManager) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) BurnByCollateral(ctx context.Context, req *MsgBurnByCollateral) (*MsgBurnByCollateralResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BurnByCollateral not implemented")
+func (m *MsgRevokeManager) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeManager.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) DepositCollateral(ctx context.Context, req *MsgDepositCollateral) (*MsgDepositCollateralResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DepositCollateral not implemented")
+
+var xxx_messageInfo_MsgRevokeManager proto.InternalMessageInfo
+
+// MsgRevokeManagerResponse defines the Msg/RevokeManager response type.
+type MsgRevokeManagerResponse struct {
 }
-func (*UnimplementedMsgServer) RedeemCollateral(ctx context.Context, req *MsgRedeemCollateral) (*MsgRedeemCollateralResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RedeemCollateral not implemented")
+
+func (m *MsgRevokeManagerResponse) Reset()         { *m = MsgRevokeManagerResponse{} }
+func (m *MsgRevokeManagerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeManagerResponse) ProtoMessage()    {}
+func (*MsgRevokeManagerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95fc14305d50301, []int{27}
 }
-func (*UnimplementedMsgServer) LiquidateCollateral(ctx context.Context, req *MsgLiquidateCollateral) (*MsgLiquidateCollateralResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidateCollateral not implemented")
+func (m *MsgRevokeManagerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeManagerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeManagerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeManagerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeManagerResponse.Merge(m, src)
+}
+func (m *MsgRevokeManagerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeManagerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeManagerResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgRevokeManagerResponse proto.InternalMessageInfo
+
+// MsgLeverage represents a message to lever up a collateral position.
+type MsgLeverage struct {
+	Sender          string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender" yaml:"sender"`
+	CollateralDenom string `protobuf:"bytes,2,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom" yaml:"collateral_denom"`
+	// target loan-to-value, must be below the max loan-to-value of collateral
+	TargetLtv github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=target_ltv,json=targetLtv,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_ltv" yaml:"target_ltv"`
+	// max slippage ratio of each swap, valued by oracle prices
+	MaxSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_slippage,json=maxSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage" yaml:"max_slippage"`
 }
 
-func _Msg_MintBySwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMintBySwap)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MintBySwap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.maker.v1.Msg/MintBySwap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MintBySwap(ctx, req.(*MsgMintBySwap))
+func (m *MsgLeverage) Reset()         { *m = MsgLeverage{} }
+func (m *MsgLeverage) String() string { return proto.CompactTextString(m) }
+func (*MsgLeverage) ProtoMessage()    {}
+func (*MsgLeverage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95fc14305d50301, []int{28}
+}
+func (m *MsgLeverage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLeverage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLeverage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgLeverage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLeverage.Merge(m, src)
+}
+func (m *MsgLeverage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLeverage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLeverage.DiscardUnknown(m)
 }
 
-func _Msg_BurnBySwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBurnBySwap)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BurnBySwap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.maker.v1.Msg/BurnBySwap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BurnBySwap(ctx, req.(*MsgBurnBySwap))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgLeverage proto.InternalMessageInfo
+
+// MsgLeverageResponse defines the Msg/Leverage response type.
+type MsgLeverageResponse struct {
+	MintOut      types.Coin                             `protobuf:"bytes,1,opt,name=mint_out,json=mintOut,proto3" json:"mint_out" yaml:"mint_out"`
+	CollateralIn types.Coin                             `protobuf:"bytes,2,opt,name=collateral_in,json=collateralIn,proto3" json:"collateral_in" yaml:"collateral_in"`
+	MageIn       types.Coin                             `protobuf:"bytes,3,opt,name=mage_in,json=mageIn,proto3" json:"mage_in" yaml:"mage_in"`
+	Ltv          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=ltv,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ltv" yaml:"ltv"`
 }
 
-func _Msg_BuyBacking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBuyBacking)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BuyBacking(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.maker.v1.Msg/BuyBacking",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BuyBacking(ctx, req.(*MsgBuyBacking))
+func (m *MsgLeverageResponse) Reset()         { *m = MsgLeverageResponse{} }
+func (m *MsgLeverageResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLeverageResponse) ProtoMessage()    {}
+func (*MsgLeverageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95fc14305d50301, []int{29}
+}
+func (m *MsgLeverageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLeverageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLeverageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgLeverageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLeverageResponse.Merge(m, src)
+}
+func (m *MsgLeverageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLeverageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLeverageResponse.DiscardUnknown(m)
 }
 
-func _Msg_SellBacking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSellBacking)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SellBacking(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.maker.v1.Msg/SellBacking",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SellBacking(ctx, req.(*MsgSellBacking))
+var xxx_messageInfo_MsgLeverageResponse proto.InternalMessageInfo
+
+func (m *MsgLeverageResponse) GetMintOut() types.Coin {
+	if m != nil {
+		return m.MintOut
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Msg_MintByCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMintByCollateral)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MintByCollateral(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.maker.v1.Msg/MintByCollateral",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MintByCollateral(ctx, req.(*MsgMintByCollateral))
+func (m *MsgLeverageResponse) GetCollateralIn() types.Coin {
+	if m != nil {
+		return m.CollateralIn
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Msg_BurnByCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBurnByCollateral)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BurnByCollateral(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.maker.v1.Msg/BurnByCollateral",
+func (m *MsgLeverageResponse) GetMageIn() types.Coin {
+	if m != nil {
+		return m.MageIn
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BurnByCollateral(ctx, req.(*MsgBurnByCollateral))
-	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Msg_DepositCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDepositCollateral)
-	if err := dec(in); err != nil {
-		return nil, err
+// MsgDeleverage represents a message to unwind a levered collateral position.
+type MsgDeleverage struct {
+	Sender          string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender" yaml:"sender"`
+	CollateralDenom string `protobuf:"bytes,2,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom" yaml:"collateral_denom"`
+	// target loan-to-value, zero means unwinding the whole debt
+	TargetLtv github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=target_ltv,json=targetLtv,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_ltv" yaml:"target_ltv"`
+	// max slippage ratio of each swap, valued by oracle prices
+	MaxSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_slippage,json=maxSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage" yaml:"max_slippage"`
+}
+
+func (m *MsgDeleverage) Reset()         { *m = MsgDeleverage{} }
+func (m *MsgDeleverage) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleverage) ProtoMessage()    {}
+func (*MsgDeleverage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95fc14305d50301, []int{30}
+}
+func (m *MsgDeleverage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleverage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleverage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DepositCollateral(ctx, in)
+}
+func (m *MsgDeleverage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleverage.Merge(m, src)
+}
+func (m *MsgDeleverage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleverage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleverage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleverage proto.InternalMessageInfo
+
+// MsgDeleverageResponse defines the Msg/Deleverage response type.
+type MsgDeleverageResponse struct {
+	CollateralOut types.Coin                             `protobuf:"bytes,1,opt,name=collateral_out,json=collateralOut,proto3" json:"collateral_out" yaml:"collateral_out"`
+	RepayIn       types.Coin                             `protobuf:"bytes,2,opt,name=repay_in,json=repayIn,proto3" json:"repay_in" yaml:"repay_in"`
+	Ltv           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=ltv,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ltv" yaml:"ltv"`
+}
+
+func (m *MsgDeleverageResponse) Reset()         { *m = MsgDeleverageResponse{} }
+func (m *MsgDeleverageResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleverageResponse) ProtoMessage()    {}
+func (*MsgDeleverageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b95fc14305d50301, []int{31}
+}
+func (m *MsgDeleverageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleverageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleverageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.maker.v1.Msg/DepositCollateral",
+}
+func (m *MsgDeleverageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleverageResponse.Merge(m, src)
+}
+func (m *MsgDeleverageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleverageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleverageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleverageResponse proto.InternalMessageInfo
+
+func (m *MsgDeleverageResponse) GetCollateralOut() types.Coin {
+	if m != nil {
+		return m.CollateralOut
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DepositCollateral(ctx, req.(*MsgDepositCollateral))
+	return types.Coin{}
+}
+
+func (m *MsgDeleverageResponse) GetRepayIn() types.Coin {
+	if m != nil {
+		return m.RepayIn
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Msg_RedeemCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRedeemCollateral)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*MsgMintBySwap)(nil), "warmage.maker.v1.MsgMintBySwap")
+	proto.RegisterType((*MsgMintBySwapResponse)(nil), "warmage.maker.v1.MsgMintBySwapResponse")
+	proto.RegisterType((*MsgBurnBySwap)(nil), "warmage.maker.v1.MsgBurnBySwap")
+	proto.RegisterType((*MsgBurnBySwapResponse)(nil), "warmage.maker.v1.MsgBurnBySwapResponse")
+	proto.RegisterType((*MsgBasketMint)(nil), "warmage.maker.v1.MsgBasketMint")
+	proto.RegisterType((*MsgBasketMintResponse)(nil), "warmage.maker.v1.MsgBasketMintResponse")
+	proto.RegisterType((*MsgBasketBurn)(nil), "warmage.maker.v1.MsgBasketBurn")
+	proto.RegisterType((*MsgBasketBurnResponse)(nil), "warmage.maker.v1.MsgBasketBurnResponse")
+	proto.RegisterType((*MsgBuyBacking)(nil), "warmage.maker.v1.MsgBuyBacking")
+	proto.RegisterType((*MsgBuyBackingResponse)(nil), "warmage.maker.v1.MsgBuyBackingResponse")
+	proto.RegisterType((*MsgSellBacking)(nil), "warmage.maker.v1.MsgSellBacking")
+	proto.RegisterType((*MsgSellBackingResponse)(nil), "warmage.maker.v1.MsgSellBackingResponse")
+	proto.RegisterType((*MsgMintByCollateral)(nil), "warmage.maker.v1.MsgMintByCollateral")
+	proto.RegisterType((*MsgMintByCollateralResponse)(nil), "warmage.maker.v1.MsgMintByCollateralResponse")
+	proto.RegisterType((*MsgBurnByCollateral)(nil), "warmage.maker.v1.MsgBurnByCollateral")
+	proto.RegisterType((*MsgBurnByCollateralResponse)(nil), "warmage.maker.v1.MsgBurnByCollateralResponse")
+	proto.RegisterType((*MsgDepositCollateral)(nil), "warmage.maker.v1.MsgDepositCollateral")
+	proto.RegisterType((*MsgDepositCollateralResponse)(nil), "warmage.maker.v1.MsgDepositCollateralResponse")
+	proto.RegisterType((*MsgRedeemCollateral)(nil), "warmage.maker.v1.MsgRedeemCollateral")
+	proto.RegisterType((*MsgRedeemCollateralResponse)(nil), "warmage.maker.v1.MsgRedeemCollateralResponse")
+	proto.RegisterType((*MsgLiquidateCollateral)(nil), "warmage.maker.v1.MsgLiquidateCollateral")
+	proto.RegisterType((*MsgLiquidateCollateralResponse)(nil), "warmage.maker.v1.MsgLiquidateCollateralResponse")
+	proto.RegisterType((*MsgTransferPosition)(nil), "warmage.maker.v1.MsgTransferPosition")
+	proto.RegisterType((*MsgTransferPositionResponse)(nil), "warmage.maker.v1.MsgTransferPositionResponse")
+	proto.RegisterType((*MsgAuthorizeManager)(nil), "warmage.maker.v1.MsgAuthorizeManager")
+	proto.RegisterType((*MsgAuthorizeManagerResponse)(nil), "warmage.maker.v1.MsgAuthorizeManagerResponse")
+	proto.RegisterType((*MsgRevokeManager)(nil), "warmage.maker.v1.MsgRevokeManager")
+	proto.RegisterType((*MsgRevokeManagerResponse)(nil), "warmage.maker.v1.MsgRevokeManagerResponse")
+	proto.RegisterType((*MsgLeverage)(nil), "warmage.maker.v1.MsgLeverage")
+	proto.RegisterType((*MsgLeverageResponse)(nil), "warmage.maker.v1.MsgLeverageResponse")
+	proto.RegisterType((*MsgDeleverage)(nil), "warmage.maker.v1.MsgDeleverage")
+	proto.RegisterType((*MsgDeleverageResponse)(nil), "warmage.maker.v1.MsgDeleverageResponse")
+}
+
+func init() { proto.RegisterFile("warmage/maker/v1/tx.proto", fileDescriptor_b95fc14305d50301) }
+
+var fileDescriptor_b95fc14305d50301 = []byte{
+	// 1927 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x6f, 0xdc, 0x5a,
+	0x15, 0x8f, 0x3d, 0xd3, 0x24, 0x73, 0xf3, 0xd1, 0xd4, 0x69, 0xfa, 0x26, 0x4e, 0x33, 0xce, 0x73,
+	0x95, 0x4e, 0x4a, 0x95, 0x31, 0x79, 0x6f, 0x81, 0xf4, 0xc4, 0x86, 0x69, 0xfa, 0xa4, 0x46, 0x6f,
+	0x00, 0x39, 0x0f, 0xf4, 0xf4, 0x84, 0x18, 0x79, 0x92, 0x1b, 0xd7, 0xca, 0x8c, 0x3d, 0xd8, 0x9e,
+	0x24, 0xc3, 0xa6, 0x50, 0xb1, 0x40, 0x15, 0x95, 0x90, 0x58, 0x00, 0x12, 0x8b, 0x0a, 0xba, 0x81,
+	0x3f, 0x01, 0xd8, 0xd3, 0x65, 0xa4, 0xaa, 0x08, 0x75, 0x31, 0xa0, 0x96, 0x05, 0xca, 0x32, 0x12,
+	0x88, 0x05, 0x48, 0xe8, 0x7e, 0xd8, 0xbe, 0xf6, 0x78, 0xc6, 0x9e, 0x4c, 0x26, 0xa5, 0x7a, 0x5d,
+	0x25, 0xbe, 0x3e, 0xe7, 0xdc, 0xdf, 0xfd, 0x9d, 0x8f, 0x7b, 0xee, 0xf5, 0x80, 0xc5, 0x43, 0xcd,
+	0x6e, 0x68, 0x3a, 0x54, 0x1a, 0xda, 0x3e, 0xb4, 0x95, 0x83, 0x0d, 0xc5, 0x3d, 0x2a, 0x35, 0x6d,
+	0xcb, 0xb5, 0x84, 0x39, 0xfa, 0xaa, 0x84, 0x5f, 0x95, 0x0e, 0x36, 0xc4, 0xeb, 0xba, 0x65, 0xe9,
+	0x75, 0xa8, 0x68, 0x4d, 0x43, 0xd1, 0x4c, 0xd3, 0x72, 0x35, 0xd7, 0xb0, 0x4c, 0x87, 0xc8, 0x8b,
+	0x57, 0x75, 0x4b, 0xb7, 0xf0, 0xbf, 0x0a, 0xfa, 0x8f, 0x8e, 0x16, 0x76, 0x2c, 0xa7, 0x61, 0x39,
+	0x4a, 0x4d, 0x73, 0xa0, 0x72, 0xb0, 0x51, 0x83, 0xae, 0xb6, 0xa1, 0xec, 0x58, 0x86, 0x49, 0xde,
+	0xcb, 0xc7, 0x19, 0x30, 0x53, 0x71, 0xf4, 0x8a, 0x61, 0xba, 0xe5, 0xf6, 0xf6, 0xa1, 0xd6, 0x14,
+	0x3e, 0x04, 0xe3, 0x0e, 0x34, 0x77, 0xa1, 0x9d, 0xe7, 0x56, 0xb8, 0xb5, 0x5c, 0x79, 0xe9, 0xa4,
+	0x23, 0xd1, 0x91, 0xd3, 0x8e, 0x34, 0xd3, 0xd6, 0x1a, 0xf5, 0x8f, 0x64, 0xf2, 0x2c, 0xab, 0xf4,
+	0x85, 0x70, 0x03, 0xf0, 0xae, 0x95, 0xe7, 0xb1, 0xc2, 0xfc, 0x49, 0x47, 0xe2, 0x5d, 0xeb, 0xb4,
+	0x23, 0xe5, 0x88, 0xb0, 0x6b, 0xc9, 0x2a, 0xef, 0x5a, 0xc2, 0x77, 0xc1, 0x6c, 0x4d, 0xdb, 0xd9,
+	0x37, 0x4c, 0xbd, 0x6a, 0x98, 0xd5, 0x86, 0x76, 0x94, 0xcf, 0xac, 0x70, 0x6b, 0x53, 0x1f, 0x2c,
+	0x96, 0x08, 0xc8, 0x12, 0x02, 0x59, 0xa2, 0x20, 0x4b, 0x77, 0x2c, 0xc3, 0x2c, 0x2f, 0x3f, 0xeb,
+	0x48, 0x63, 0xa7, 0x1d, 0x69, 0x81, 0x58, 0x0a, 0xab, 0xcb, 0xea, 0x34, 0x1d, 0xb8, 0x67, 0x56,
+	0xb4, 0x23, 0xe1, 0x5b, 0x60, 0x0a, 0x11, 0xe6, 0x19, 0xcf, 0x26, 0x19, 0x17, 0xa9, 0x71, 0x81,
+	0x18, 0x67, 0x74, 0x65, 0x35, 0x87, 0x9e, 0x88, 0xd9, 0xcf, 0xc0, 0x74, 0xc3, 0x30, 0xdd, 0xaa,
+	0xd5, 0x72, 0xab, 0x0d, 0xc3, 0xcc, 0x5f, 0x4a, 0xb2, 0xbb, 0x44, 0xed, 0xce, 0x53, 0xbb, 0x8c,
+	0xb2, 0xac, 0x02, 0xf4, 0xf8, 0x8d, 0x96, 0x5b, 0x31, 0x4c, 0x61, 0x0b, 0x4c, 0xef, 0xb5, 0xea,
+	0xf5, 0x2a, 0x5d, 0x45, 0x7e, 0x7c, 0x85, 0x5b, 0x9b, 0x2c, 0x17, 0x4f, 0x3a, 0x52, 0x68, 0x3c,
+	0x30, 0xc5, 0x8e, 0xca, 0xea, 0x14, 0x7a, 0x2c, 0x93, 0xa7, 0x8f, 0x26, 0x7f, 0xfc, 0x44, 0x1a,
+	0xfb, 0xc7, 0x13, 0x69, 0x4c, 0xfe, 0x33, 0x0f, 0x16, 0x42, 0x2e, 0x55, 0xa1, 0xd3, 0xb4, 0x4c,
+	0x07, 0x0a, 0xdb, 0x00, 0x04, 0x0c, 0xe6, 0xb9, 0xa4, 0x75, 0x2c, 0xd2, 0x75, 0x5c, 0x89, 0x92,
+	0x2f, 0xab, 0x39, 0x9f, 0x78, 0x61, 0x0b, 0x4c, 0x50, 0xe6, 0xf2, 0x7c, 0x92, 0xc5, 0x6b, 0xd4,
+	0xe2, 0x6c, 0x88, 0x71, 0x59, 0x1d, 0x27, 0x6c, 0x0b, 0x15, 0x30, 0xe9, 0xb1, 0x95, 0x1c, 0x1b,
+	0xef, 0x51, 0x63, 0x97, 0xc3, 0x34, 0xcb, 0xea, 0x04, 0xa5, 0xd8, 0x37, 0xb7, 0x07, 0x61, 0x3e,
+	0x7b, 0x16, 0x73, 0x7b, 0x10, 0x52, 0x73, 0x1f, 0x43, 0x28, 0xff, 0x87, 0xc7, 0xb9, 0x52, 0x6e,
+	0xd9, 0xe6, 0xc8, 0x73, 0x65, 0x0b, 0x4c, 0xd4, 0x5a, 0xb6, 0x89, 0x58, 0xcd, 0x0c, 0xc8, 0x2a,
+	0xd5, 0x93, 0xd5, 0x71, 0xf4, 0xdf, 0x3d, 0x53, 0xd0, 0xc0, 0x65, 0xcf, 0x77, 0x5e, 0x0c, 0x27,
+	0xb2, 0x51, 0xa0, 0x36, 0xaf, 0x85, 0x7d, 0xef, 0x87, 0xf1, 0x0c, 0x1d, 0xa1, 0x91, 0x8c, 0x72,
+	0x04, 0x39, 0xf3, 0xcc, 0x39, 0xc2, 0x28, 0xa3, 0x1c, 0xd1, 0x74, 0x48, 0x2c, 0x33, 0x71, 0xfd,
+	0x98, 0xc4, 0x75, 0x40, 0xbf, 0x1f, 0xd7, 0xdf, 0x06, 0x53, 0x0c, 0xc0, 0x3c, 0x37, 0x60, 0xe2,
+	0x33, 0xba, 0xb2, 0x0a, 0x82, 0x85, 0xe1, 0xf8, 0xa1, 0xc0, 0xf2, 0xfc, 0xa0, 0xf1, 0x43, 0x15,
+	0x51, 0xfc, 0x90, 0xd5, 0x20, 0x73, 0xd8, 0x37, 0x28, 0x1c, 0x07, 0x8d, 0x6e, 0x4f, 0x51, 0x56,
+	0x71, 0x5c, 0xa0, 0x70, 0xfc, 0x2f, 0x29, 0xdd, 0x65, 0xcd, 0xd9, 0x87, 0x88, 0x2a, 0x77, 0x84,
+	0xe1, 0xf8, 0x88, 0x8b, 0xa9, 0xdd, 0x99, 0xfe, 0x2b, 0xb8, 0xd7, 0xb7, 0x76, 0xff, 0xee, 0xaf,
+	0xd2, 0x9a, 0x6e, 0xb8, 0xf7, 0x5b, 0xb5, 0xd2, 0x8e, 0xd5, 0x50, 0xe8, 0x36, 0x45, 0xfe, 0xac,
+	0x3b, 0xbb, 0xfb, 0x8a, 0xdb, 0x6e, 0x42, 0x07, 0x5b, 0x72, 0xde, 0xd5, 0xf9, 0xb8, 0x3a, 0xff,
+	0x30, 0x03, 0x16, 0x42, 0xfe, 0xf7, 0xf3, 0xe1, 0x41, 0xa4, 0xce, 0x27, 0x38, 0xea, 0x6e, 0xcf,
+	0x3a, 0x3f, 0x90, 0x93, 0xbe, 0x90, 0x7b, 0xc2, 0x6f, 0xd9, 0x24, 0x44, 0xa5, 0xe9, 0x2d, 0xd9,
+	0x13, 0x1e, 0x73, 0x71, 0x9b, 0x42, 0x42, 0xa0, 0x6c, 0xf5, 0xdf, 0x14, 0x06, 0x8a, 0x96, 0x37,
+	0xb0, 0x81, 0xfc, 0x9e, 0x07, 0x0b, 0x21, 0x5f, 0xf9, 0x09, 0xf3, 0x90, 0x8b, 0xee, 0x20, 0x09,
+	0x4c, 0x7c, 0xdc, 0x7b, 0x07, 0x19, 0x88, 0x85, 0xb7, 0x67, 0xb7, 0xf9, 0xb5, 0xd7, 0xfc, 0xb4,
+	0x69, 0x25, 0x1a, 0x6d, 0xa0, 0x7b, 0xe5, 0x23, 0x33, 0x6c, 0xf9, 0x18, 0x7d, 0xf3, 0xc3, 0x44,
+	0xd8, 0x9f, 0x38, 0xb0, 0x10, 0x22, 0x69, 0xe4, 0x2d, 0x0a, 0xb2, 0xdb, 0x6a, 0xa3, 0x01, 0xec,
+	0x68, 0x7e, 0x50, 0xbb, 0x81, 0x2e, 0xb2, 0x4b, 0x9e, 0x90, 0xbb, 0x7f, 0xc3, 0x83, 0xd9, 0x8a,
+	0xa3, 0x6f, 0xc3, 0x7a, 0x7d, 0xf4, 0xfe, 0x0e, 0x9f, 0x4b, 0x32, 0xe7, 0x73, 0x2e, 0x89, 0x56,
+	0x94, 0xec, 0x08, 0x2a, 0xca, 0x1f, 0x39, 0x70, 0x2d, 0xcc, 0x92, 0xef, 0x70, 0x36, 0x9b, 0xb9,
+	0xe1, 0xb3, 0x79, 0x1b, 0x00, 0x1b, 0xa6, 0x77, 0x73, 0x84, 0xa2, 0x40, 0x55, 0x56, 0x73, 0x36,
+	0xf4, 0x9c, 0xfc, 0x82, 0x07, 0xf3, 0xfe, 0x49, 0xf1, 0x8e, 0x55, 0xaf, 0x6b, 0x2e, 0xb4, 0xb5,
+	0xfa, 0x08, 0x3d, 0xfd, 0x39, 0x98, 0xdb, 0xf1, 0xe7, 0xa9, 0xee, 0x42, 0xd3, 0x6a, 0x60, 0x7f,
+	0xe7, 0xca, 0xca, 0x49, 0x47, 0xea, 0x7a, 0x77, 0xda, 0x91, 0xde, 0x23, 0x06, 0xa2, 0x6f, 0x64,
+	0xf5, 0x72, 0x30, 0xb4, 0x89, 0x46, 0x42, 0x8d, 0x42, 0x76, 0xf8, 0x46, 0x41, 0x01, 0x97, 0xac,
+	0x43, 0x13, 0xda, 0x78, 0x2b, 0xca, 0x95, 0x17, 0x4f, 0x3a, 0x12, 0x19, 0x38, 0xed, 0x48, 0xd3,
+	0x44, 0x0b, 0x3f, 0xca, 0x2a, 0x19, 0x66, 0xc2, 0xa2, 0x0e, 0x96, 0x62, 0x68, 0x0d, 0x85, 0x86,
+	0xd7, 0x82, 0x70, 0xc3, 0xb7, 0x20, 0x7f, 0x20, 0x5e, 0x24, 0xe7, 0xa2, 0x61, 0xbd, 0x18, 0xe7,
+	0x20, 0xfe, 0x9c, 0x1c, 0xf4, 0x19, 0x98, 0xb6, 0x61, 0x53, 0x6b, 0xa7, 0xbe, 0xfd, 0x89, 0x64,
+	0x24, 0xab, 0x2c, 0xab, 0x00, 0x3f, 0x92, 0xd6, 0xdd, 0xf7, 0x55, 0xf6, 0x8c, 0xbe, 0x8a, 0x92,
+	0xc7, 0xfa, 0xca, 0x9b, 0x76, 0x60, 0x5f, 0x79, 0x8a, 0xb2, 0x3a, 0x41, 0xb1, 0xca, 0x4f, 0x79,
+	0x70, 0xb5, 0xe2, 0xe8, 0x9b, 0xb0, 0x69, 0x39, 0x86, 0x7b, 0x21, 0x29, 0xf7, 0x1d, 0x30, 0xc3,
+	0xf8, 0x26, 0x4d, 0x7d, 0xbd, 0x4e, 0x97, 0x71, 0xb5, 0xcb, 0xb3, 0x68, 0x2d, 0xd3, 0xc1, 0x73,
+	0xb8, 0xd3, 0xcf, 0x0e, 0xb9, 0x55, 0x33, 0x4e, 0x29, 0x80, 0xeb, 0x71, 0x2c, 0x79, 0x5e, 0x91,
+	0x5f, 0x92, 0x90, 0x57, 0xe1, 0x2e, 0x84, 0x8d, 0x0b, 0x61, 0xb1, 0x0a, 0x66, 0x19, 0x1e, 0x52,
+	0x9d, 0x45, 0x22, 0x77, 0x97, 0x61, 0x75, 0x59, 0x65, 0xbc, 0x12, 0xed, 0xfe, 0xb2, 0xc3, 0xef,
+	0x17, 0x43, 0x54, 0xaf, 0x65, 0xb0, 0x14, 0xc3, 0xad, 0xcf, 0xfd, 0x73, 0x1e, 0xef, 0x79, 0x9f,
+	0x18, 0xdf, 0x6b, 0x19, 0xbb, 0x9a, 0x0b, 0x2f, 0x84, 0xfe, 0x55, 0x30, 0xbe, 0x0b, 0x6b, 0xae,
+	0x65, 0xd3, 0xdd, 0x62, 0x26, 0x2c, 0x42, 0x5f, 0x0a, 0x9f, 0x02, 0x10, 0xb0, 0x9a, 0xcf, 0x0e,
+	0xb8, 0x4b, 0x06, 0xaa, 0xb2, 0xca, 0xd8, 0xe9, 0xaa, 0x5b, 0x97, 0xce, 0xab, 0x6e, 0x31, 0xa4,
+	0x1f, 0x73, 0xa0, 0x10, 0xcf, 0xea, 0x88, 0x4a, 0x51, 0x4c, 0x44, 0xf3, 0xe7, 0x1a, 0xd1, 0xf2,
+	0x0b, 0x0e, 0x27, 0xe9, 0xa7, 0xb6, 0x66, 0x3a, 0x7b, 0xd0, 0xfe, 0x26, 0xca, 0x65, 0xc3, 0x32,
+	0xdf, 0xce, 0xee, 0xa2, 0x2b, 0x3f, 0xa2, 0xcb, 0xf2, 0xf3, 0xe3, 0x31, 0x59, 0xf6, 0xd7, 0x5a,
+	0xee, 0x7d, 0xcb, 0x36, 0xbe, 0x0f, 0x2b, 0x9a, 0xa9, 0xe9, 0xd0, 0x3e, 0xdb, 0xb2, 0xbf, 0x82,
+	0xca, 0x2b, 0xd6, 0xa7, 0x6b, 0x5f, 0x3e, 0xe9, 0x48, 0xde, 0x10, 0x5b, 0x4a, 0xf1, 0x00, 0xce,
+	0x7f, 0xfc, 0x5f, 0x17, 0xdc, 0x28, 0x1c, 0x1f, 0xee, 0x23, 0x0e, 0xcc, 0xe1, 0x74, 0x3f, 0xb0,
+	0xf6, 0xdf, 0x38, 0x56, 0x11, 0xe4, 0xa3, 0x58, 0x7c, 0xa0, 0xff, 0xe2, 0xc1, 0x14, 0xca, 0x10,
+	0x78, 0x00, 0x6d, 0x4d, 0x87, 0xff, 0x7f, 0xed, 0x4d, 0x0d, 0x00, 0x57, 0xb3, 0x75, 0xe8, 0x56,
+	0xeb, 0xee, 0x01, 0x8d, 0xbb, 0x3b, 0x28, 0x63, 0x5e, 0x76, 0xa4, 0x9b, 0x29, 0x6e, 0x04, 0x36,
+	0xe1, 0x4e, 0x50, 0x8b, 0x02, 0x4b, 0xb2, 0x9a, 0x23, 0x0f, 0x9f, 0xb8, 0x07, 0xc2, 0x7d, 0x74,
+	0xa8, 0x39, 0xaa, 0x3a, 0x75, 0xa3, 0xd9, 0xd4, 0x74, 0x48, 0xfb, 0x9d, 0xbb, 0x03, 0xcf, 0xe2,
+	0x9f, 0x71, 0x02, 0x5b, 0xb2, 0x3a, 0xd5, 0xd0, 0x8e, 0xb6, 0xe9, 0x13, 0xe3, 0x94, 0x0e, 0xd9,
+	0x6c, 0x3d, 0xe2, 0xbb, 0xda, 0xd8, 0x33, 0x9d, 0x70, 0xba, 0xfb, 0xed, 0xae, 0x3e, 0x85, 0x1f,
+	0x51, 0x9f, 0x32, 0xf4, 0x95, 0xc2, 0xd7, 0x41, 0x06, 0x79, 0x98, 0x70, 0xff, 0xd5, 0x81, 0xb9,
+	0x07, 0xc4, 0x2c, 0x76, 0x2d, 0x32, 0x24, 0xff, 0x9b, 0x5c, 0xad, 0x6c, 0xc2, 0xfa, 0xbb, 0xd8,
+	0xbe, 0xe0, 0xd8, 0xfe, 0x39, 0xb9, 0x12, 0x0c, 0xa8, 0xf7, 0xa3, 0xbb, 0x7b, 0x7b, 0xe4, 0xce,
+	0xbd, 0xe1, 0xf3, 0xb7, 0x73, 0x7e, 0xf8, 0xed, 0x9c, 0x06, 0x65, 0xe6, 0x9c, 0x82, 0xf2, 0x83,
+	0x7f, 0x5e, 0x01, 0x99, 0x8a, 0xa3, 0x0b, 0x3f, 0xe4, 0x00, 0x60, 0x7e, 0x1d, 0x20, 0x95, 0xa2,
+	0x3f, 0x4b, 0x28, 0x85, 0xbe, 0x35, 0x8b, 0xc5, 0x04, 0x01, 0xbf, 0x9e, 0xdf, 0x7a, 0xf8, 0xfc,
+	0xef, 0x3f, 0xe3, 0x6f, 0x08, 0xef, 0x2b, 0x31, 0xbf, 0x81, 0x50, 0x70, 0x81, 0xa8, 0xb5, 0xab,
+	0x0e, 0x9a, 0x14, 0x61, 0x60, 0xbe, 0xba, 0xc6, 0x63, 0x08, 0x04, 0xc4, 0x62, 0x82, 0x40, 0x4a,
+	0x0c, 0xf8, 0x16, 0xd4, 0xc3, 0xf0, 0x03, 0x84, 0x21, 0xf8, 0xd4, 0xd6, 0x03, 0x83, 0x2f, 0x20,
+	0x16, 0x13, 0x04, 0x7c, 0x0c, 0x6b, 0x18, 0x83, 0x2c, 0xac, 0xc4, 0x63, 0xc0, 0x0a, 0xe8, 0x0e,
+	0xca, 0x65, 0x20, 0xe0, 0x0f, 0x0d, 0xfd, 0x20, 0x20, 0x01, 0xb1, 0x98, 0x20, 0x30, 0x18, 0x04,
+	0xc4, 0x06, 0x81, 0x10, 0x5c, 0x01, 0xf7, 0xf2, 0x84, 0x27, 0x20, 0x16, 0x13, 0x04, 0xd2, 0x42,
+	0x68, 0xb5, 0xbd, 0xcf, 0x61, 0xc2, 0x8f, 0x38, 0x30, 0xc5, 0x5e, 0x4b, 0xae, 0xc4, 0x4e, 0xc1,
+	0x48, 0x88, 0x6b, 0x49, 0x12, 0x29, 0xe3, 0xc1, 0x81, 0xc1, 0x57, 0x39, 0xe1, 0x97, 0xa8, 0x6f,
+	0x8a, 0x5e, 0x9c, 0xad, 0xf6, 0x09, 0xfe, 0x40, 0x4c, 0x5c, 0x4f, 0x25, 0xe6, 0xa3, 0x52, 0x30,
+	0xaa, 0x5b, 0x42, 0xb1, 0x6f, 0xa6, 0x30, 0x07, 0x16, 0x84, 0xad, 0xeb, 0x3a, 0x68, 0xb5, 0x4f,
+	0x52, 0x24, 0x62, 0xeb, 0x75, 0x3f, 0x92, 0x80, 0xcd, 0xcb, 0x20, 0x06, 0xdb, 0xaf, 0x38, 0x70,
+	0xa5, 0xfb, 0xfa, 0xe3, 0x66, 0xec, 0xac, 0x5d, 0x72, 0x62, 0x29, 0x9d, 0x5c, 0x4a, 0x78, 0xbb,
+	0x44, 0x8f, 0x85, 0xf7, 0x0b, 0x0e, 0xcc, 0x75, 0x5d, 0x2b, 0xc4, 0x53, 0x17, 0x15, 0x13, 0xd7,
+	0x53, 0x89, 0xf9, 0xd8, 0x4a, 0x18, 0xdb, 0x9a, 0x70, 0x33, 0x16, 0x9b, 0x8d, 0xd5, 0x58, 0x68,
+	0x4f, 0x39, 0x30, 0x1f, 0x77, 0xea, 0x8e, 0x0f, 0xef, 0x18, 0x49, 0xf1, 0xcb, 0x69, 0x25, 0x7d,
+	0x8c, 0x1b, 0x18, 0xe3, 0x6d, 0xe1, 0x56, 0x2c, 0xc6, 0xba, 0xa7, 0x19, 0x65, 0xb0, 0xeb, 0xcc,
+	0x17, 0xcf, 0x60, 0x54, 0x4c, 0x5c, 0x4f, 0x25, 0x96, 0x92, 0x41, 0x97, 0xaa, 0x55, 0x9b, 0x1e,
+	0x0a, 0x04, 0xad, 0xeb, 0x5c, 0x16, 0x0f, 0x2d, 0x2a, 0x26, 0xae, 0xa7, 0x12, 0x4b, 0x09, 0x4d,
+	0xf3, 0xd4, 0xaa, 0xf4, 0x0c, 0x24, 0xfc, 0x84, 0x03, 0x33, 0xe1, 0x33, 0x98, 0xdc, 0x23, 0x9a,
+	0x18, 0x19, 0xf1, 0x4b, 0xc9, 0x32, 0x3e, 0xa2, 0xdb, 0x18, 0xd1, 0xaa, 0x70, 0xa3, 0x47, 0xb8,
+	0x21, 0x1d, 0x1f, 0xce, 0x21, 0x98, 0xf4, 0x0f, 0x5a, 0xcb, 0xf1, 0x51, 0x43, 0x5f, 0x8b, 0xab,
+	0x7d, 0x5f, 0xfb, 0xd3, 0xaf, 0xe2, 0xe9, 0x25, 0x61, 0x39, 0x3e, 0x92, 0xbc, 0xc9, 0x1e, 0x00,
+	0xc0, 0xf4, 0xc1, 0x52, 0x8f, 0x74, 0xf7, 0x04, 0xc4, 0x62, 0x82, 0x80, 0x3f, 0x7d, 0x11, 0x4f,
+	0xff, 0xbe, 0x20, 0xf5, 0x28, 0x04, 0x9e, 0x42, 0xf9, 0xee, 0xb3, 0x57, 0x05, 0xee, 0xf8, 0x55,
+	0x81, 0xfb, 0xdb, 0xab, 0x02, 0xf7, 0xd3, 0xd7, 0x85, 0xb1, 0xe3, 0xd7, 0x85, 0xb1, 0xbf, 0xbc,
+	0x2e, 0x8c, 0x7d, 0x7e, 0x9b, 0x69, 0xa6, 0x9a, 0xd0, 0xb5, 0x8d, 0xf5, 0xba, 0x56, 0x73, 0x7c,
+	0x7b, 0x47, 0xd4, 0x22, 0xee, 0xaa, 0x6a, 0xe3, 0xf8, 0xe7, 0x95, 0x1f, 0xfe, 0x6f, 0x00, 0x2f,
+	0xee, 0x12, 0x0e, 0xe1, 0x29, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// MintBySwap mints War stablecoins by swapping in strong-backing assets and
+	// Mage coins.
+	MintBySwap(ctx context.Context, in *MsgMintBySwap, opts ...grpc.CallOption) (*MsgMintBySwapResponse, error)
+	// BurnBySwap burns War stablecoins by swapping out strong-backing assets and
+	// Mage coins.
+	BurnBySwap(ctx context.Context, in *MsgBurnBySwap, opts ...grpc.CallOption) (*MsgBurnBySwapResponse, error)
+	// BasketMint mints War stablecoins by swapping in a basket of
+	// strong-backing assets and Mage coins.
+	BasketMint(ctx context.Context, in *MsgBasketMint, opts ...grpc.CallOption) (*MsgBasketMintResponse, error)
+	// BasketBurn burns War stablecoins by swapping out a basket of
+	// strong-backing assets and Mage coins.
+	BasketBurn(ctx context.Context, in *MsgBasketBurn, opts ...grpc.CallOption) (*MsgBasketBurnResponse, error)
+	// BuyBacking buys strong-backing assets by spending Mage coins.
+	BuyBacking(ctx context.Context, in *MsgBuyBacking, opts ...grpc.CallOption) (*MsgBuyBackingResponse, error)
+	// SellBacking sells strong-backing assets by earning Mage
+	// coins.
+	SellBacking(ctx context.Context, in *MsgSellBacking, opts ...grpc.CallOption) (*MsgSellBackingResponse, error)
+	// MintByCollateral mints War stablecoins by locking collateral assets and
+	// spending Mage coins.
+	MintByCollateral(ctx context.Context, in *MsgMintByCollateral, opts ...grpc.CallOption) (*MsgMintByCollateralResponse, error)
+	// BurnByCollateral burns War stablecoins by unlocking collateral assets and
+	// earning Mage coins.
+	BurnByCollateral(ctx context.Context, in *MsgBurnByCollateral, opts ...grpc.CallOption) (*MsgBurnByCollateralResponse, error)
+	// DepositCollateral deposits collateral assets.
+	DepositCollateral(ctx context.Context, in *MsgDepositCollateral, opts ...grpc.CallOption) (*MsgDepositCollateralResponse, error)
+	// RedeemCollateral redeems collateral assets and collateralized Mage coins.
+	RedeemCollateral(ctx context.Context, in *MsgRedeemCollateral, opts ...grpc.CallOption) (*MsgRedeemCollateralResponse, error)
+	// LiquidateCollateral liquidates collateral assets which is
+	// undercollateralized.
+	LiquidateCollateral(ctx context.Context, in *MsgLiquidateCollateral, opts ...grpc.CallOption) (*MsgLiquidateCollateralResponse, error)
+	// TransferPosition transfers an entire collateral position to another
+	// account.
+	TransferPosition(ctx context.Context, in *MsgTransferPosition, opts ...grpc.CallOption) (*MsgTransferPositionResponse, error)
+	// AuthorizeManager authorizes an account to manage collateral positions of
+	// the sender.
+	AuthorizeManager(ctx context.Context, in *MsgAuthorizeManager, opts ...grpc.CallOption) (*MsgAuthorizeManagerResponse, error)
+	// RevokeManager revokes the authorization of a collateral position manager.
+	RevokeManager(ctx context.Context, in *MsgRevokeManager, opts ...grpc.CallOption) (*MsgRevokeManagerResponse, error)
+	// Leverage loops minting War stablecoins by collateral, swapping them for
+	// more collateral and depositing it, until the target LTV is reached.
+	// Swaps go through the backing pools, so the collateral must also be an
+	// enabled backing coin.
+	Leverage(ctx context.Context, in *MsgLeverage, opts ...grpc.CallOption) (*MsgLeverageResponse, error)
+	// Deleverage loops redeeming collateral, swapping it for War stablecoins
+	// and repaying debt, until the target LTV is reached. Swaps go through the
+	// backing pools, as in Leverage.
+	Deleverage(ctx context.Context, in *MsgDeleverage, opts ...grpc.CallOption) (*MsgDeleverageResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) MintBySwap(ctx context.Context, in *MsgMintBySwap, opts ...grpc.CallOption) (*MsgMintBySwapResponse, error) {
+	out := new(MsgMintBySwapResponse)
+	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/MintBySwap", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RedeemCollateral(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.maker.v1.Msg/RedeemCollateral",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RedeemCollateral(ctx, req.(*MsgRedeemCollateral))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_LiquidateCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLiquidateCollateral)
-	if err := dec(in); err != nil {
+func (c *msgClient) BurnBySwap(ctx context.Context, in *MsgBurnBySwap, opts ...grpc.CallOption) (*MsgBurnBySwapResponse, error) {
+	out := new(MsgBurnBySwapResponse)
+	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/BurnBySwap", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).LiquidateCollateral(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) BasketMint(ctx context.Context, in *MsgBasketMint, opts ...grpc.CallOption) (*MsgBasketMintResponse, error) {
+	out := new(MsgBasketMintResponse)
+	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/BasketMint", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.maker.v1.Msg/LiquidateCollateral",
+	return out, nil
+}
+
+func (c *msgClient) BasketBurn(ctx context.Context, in *MsgBasketBurn, opts ...grpc.CallOption) (*MsgBasketBurnResponse, error) {
+	out := new(MsgBasketBurnResponse)
+	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/BasketBurn", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LiquidateCollateral(ctx, req.(*MsgLiquidateCollateral))
+	return out, nil
+}
+
+func (c *msgClient) BuyBacking(ctx context.Context, in *MsgBuyBacking, opts ...grpc.CallOption) (*MsgBuyBackingResponse, error) {
+	out := new(MsgBuyBackingResponse)
+	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/BuyBacking", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "warmage.maker.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "MintBySwap",
-			Handler:    _Msg_MintBySwap_Handler,
-		},
-		{
-			MethodName: "BurnBySwap",
-			Handler:    _Msg_BurnBySwap_Handler,
-		},
-		{
-			MethodName: "BuyBacking",
-			Handler:    _Msg_BuyBacking_Handler,
-		},
-		{
-			MethodName: "SellBacking",
-			Handler:    _Msg_SellBacking_Handler,
-		},
-		{
-			MethodName: "MintByCollateral",
-			Handler:    _Msg_MintByCollateral_Handler,
-		},
-		{
-			MethodName: "BurnByCollateral",
-			Handler:    _Msg_BurnByCollateral_Handler,
-		},
-		{
-			MethodName: "DepositCollateral",
-			Handler:    _Msg_DepositCollateral_Handler,
-		},
-		{
-			MethodName: "RedeemCollateral",
-			Handler:    _Msg_RedeemCollateral_Handler,
-		},
-		{
-			MethodName: "LiquidateCollateral",
-			Handler:    _Msg_LiquidateCollateral_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "warmage/maker/v1/tx.proto",
+func (c *msgClient) SellBacking(ctx context.Context, in *MsgSellBacking, opts ...grpc.CallOption) (*MsgSellBackingResponse, error) {
+	out := new(MsgSellBackingResponse)
+	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/SellBacking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgMintBySwap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MintByCollateral(ctx context.Context, in *MsgMintByCollateral, opts ...grpc.CallOption) (*MsgMintByCollateralResponse, error) {
+	out := new(MsgMintByCollateralResponse)
+	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/MintByCollateral", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgMintBySwap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) BurnByCollateral(ctx context.Context, in *MsgBurnByCollateral, opts ...grpc.CallOption) (*MsgBurnByCollateralResponse, error) {
+	out := new(MsgBurnByCollateralResponse)
+	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/BurnByCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgMintBySwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FullBacking {
-		i--
-		if m.FullBacking {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	{
-		size, err := m.MintOutMin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.MageInMax.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.BackingInMax.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) DepositCollateral(ctx context.Context, in *MsgDepositCollateral, opts ...grpc.CallOption) (*MsgDepositCollateralResponse, error) {
+	out := new(MsgDepositCollateralResponse)
+	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/DepositCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgMintBySwapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RedeemCollateral(ctx context.Context, in *MsgRedeemCollateral, opts ...grpc.CallOption) (*MsgRedeemCollateralResponse, error) {
+	out := new(MsgRedeemCollateralResponse)
+	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/RedeemCollateral", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMintBySwapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgMintBySwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MintFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.MintOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.MageIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.BackingIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) LiquidateCollateral(ctx context.Context, in *MsgLiquidateCollateral, opts ...grpc.CallOption) (*MsgLiquidateCollateralResponse, error) {
+	out := new(MsgLiquidateCollateralResponse)
+	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/LiquidateCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgBurnBySwap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TransferPosition(ctx context.Context, in *MsgTransferPosition, opts ...grpc.CallOption) (*MsgTransferPositionResponse, error) {
+	out := new(MsgTransferPositionResponse)
+	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/TransferPosition", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgBurnBySwap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgBurnBySwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MageOutMin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.BackingOutMin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.BurnIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) AuthorizeManager(ctx context.Context, in *MsgAuthorizeManager, opts ...grpc.CallOption) (*MsgAuthorizeManagerResponse, error) {
+	out := new(MsgAuthorizeManagerResponse)
+	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/AuthorizeManager", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgBurnBySwapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RevokeManager(ctx context.Context, in *MsgRevokeManager, opts ...grpc.CallOption) (*MsgRevokeManagerResponse, error) {
+	out := new(MsgRevokeManagerResponse)
+	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/RevokeManager", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgBurnBySwapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgBurnBySwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BurnFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.MageOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.BackingOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) Leverage(ctx context.Context, in *MsgLeverage, opts ...grpc.CallOption) (*MsgLeverageResponse, error) {
+	out := new(MsgLeverageResponse)
+	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/Leverage", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgBuyBacking) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Deleverage(ctx context.Context, in *MsgDeleverage, opts ...grpc.CallOption) (*MsgDeleverageResponse, error) {
+	out := new(MsgDeleverageResponse)
+	err := c.cc.Invoke(ctx, "/warmage.maker.v1.Msg/Deleverage", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgBuyBacking) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgBuyBacking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BackingOutMin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.MageIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// MintBySwap mints War stablecoins by swapping in strong-backing assets and
+	// Mage coins.
+	MintBySwap(context.Context, *MsgMintBySwap) (*MsgMintBySwapResponse, error)
+	// BurnBySwap burns War stablecoins by swapping out strong-backing assets and
+	// Mage coins.
+	BurnBySwap(context.Context, *MsgBurnBySwap) (*MsgBurnBySwapResponse, error)
+	// BasketMint mints War stablecoins by swapping in a basket of
+	// strong-backing assets and Mage coins.
+	BasketMint(context.Context, *MsgBasketMint) (*MsgBasketMintResponse, error)
+	// BasketBurn burns War stablecoins by swapping out a basket of
+	// strong-backing assets and Mage coins.
+	BasketBurn(context.Context, *MsgBasketBurn) (*MsgBasketBurnResponse, error)
+	// BuyBacking buys strong-backing assets by spending Mage coins.
+	BuyBacking(context.Context, *MsgBuyBacking) (*MsgBuyBackingResponse, error)
+	// SellBacking sells strong-backing assets by earning Mage
+	// coins.
+	SellBacking(context.Context, *MsgSellBacking) (*MsgSellBackingResponse, error)
+	// MintByCollateral mints War stablecoins by locking collateral assets and
+	// spending Mage coins.
+	MintByCollateral(context.Context, *MsgMintByCollateral) (*MsgMintByCollateralResponse, error)
+	// BurnByCollateral burns War stablecoins by unlocking collateral assets and
+	// earning Mage coins.
+	BurnByCollateral(context.Context, *MsgBurnByCollateral) (*MsgBurnByCollateralResponse, error)
+	// DepositCollateral deposits collateral assets.
+	DepositCollateral(context.Context, *MsgDepositCollateral) (*MsgDepositCollateralResponse, error)
+	// RedeemCollateral redeems collateral assets and collateralized Mage coins.
+	RedeemCollateral(context.Context, *MsgRedeemCollateral) (*MsgRedeemCollateralResponse, error)
+	// LiquidateCollateral liquidates collateral assets which is
+	// undercollateralized.
+	LiquidateCollateral(context.Context, *MsgLiquidateCollateral) (*MsgLiquidateCollateralResponse, error)
+	// TransferPosition transfers an entire collateral position to another
+	// account.
+	TransferPosition(context.Context, *MsgTransferPosition) (*MsgTransferPositionResponse, error)
+	// AuthorizeManager authorizes an account to manage collateral positions of
+	// the sender.
+	AuthorizeManager(context.Context, *MsgAuthorizeManager) (*MsgAuthorizeManagerResponse, error)
+	// RevokeManager revokes the authorization of a collateral position manager.
+	RevokeManager(context.Context, *MsgRevokeManager) (*MsgRevokeManagerResponse, error)
+	// Leverage loops minting War stablecoins by collateral, swapping them for
+	// more collateral and depositing it, until the target LTV is reached.
+	// Swaps go through the backing pools, so the collateral must also be an
+	// enabled backing coin.
+	Leverage(context.Context, *MsgLeverage) (*MsgLeverageResponse, error)
+	// Deleverage loops redeeming collateral, swapping it for War stablecoins
+	// and repaying debt, until the target LTV is reached. Swaps go through the
+	// backing pools, as in Leverage.
+	Deleverage(context.Context, *MsgDeleverage) (*MsgDeleverageResponse, error)
 }
 
-func (m *MsgBuyBackingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgBuyBackingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) MintBySwap(ctx context.Context, req *MsgMintBySwap) (*MsgMintBySwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintBySwap not implemented")
+}
+func (*UnimplementedMsgServer) BurnBySwap(ctx context.Context, req *MsgBurnBySwap) (*MsgBurnBySwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnBySwap not implemented")
+}
+func (*UnimplementedMsgServer) BasketMint(ctx context.Context, req *MsgBasketMint) (*MsgBasketMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BasketMint not implemented")
+}
+func (*UnimplementedMsgServer) BasketBurn(ctx context.Context, req *MsgBasketBurn) (*MsgBasketBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BasketBurn not implemented")
+}
+func (*UnimplementedMsgServer) BuyBacking(ctx context.Context, req *MsgBuyBacking) (*MsgBuyBackingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyBacking not implemented")
+}
+func (*UnimplementedMsgServer) SellBacking(ctx context.Context, req *MsgSellBacking) (*MsgSellBackingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellBacking not implemented")
+}
+func (*UnimplementedMsgServer) MintByCollateral(ctx context.Context, req *MsgMintByCollateral) (*MsgMintByCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintByCollateral not implemented")
+}
+func (*UnimplementedMsgServer) BurnByCollateral(ctx context.Context, req *MsgBurnByCollateral) (*MsgBurnByCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnByCollateral not implemented")
+}
+func (*UnimplementedMsgServer) DepositCollateral(ctx context.Context, req *MsgDepositCollateral) (*MsgDepositCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositCollateral not implemented")
+}
+func (*UnimplementedMsgServer) RedeemCollateral(ctx context.Context, req *MsgRedeemCollateral) (*MsgRedeemCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemCollateral not implemented")
+}
+func (*UnimplementedMsgServer) LiquidateCollateral(ctx context.Context, req *MsgLiquidateCollateral) (*MsgLiquidateCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidateCollateral not implemented")
+}
+func (*UnimplementedMsgServer) TransferPosition(ctx context.Context, req *MsgTransferPosition) (*MsgTransferPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPosition not implemented")
+}
+func (*UnimplementedMsgServer) AuthorizeManager(ctx context.Context, req *MsgAuthorizeManager) (*MsgAuthorizeManagerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeManager not implemented")
+}
+func (*UnimplementedMsgServer) RevokeManager(ctx context.Context, req *MsgRevokeManager) (*MsgRevokeManagerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeManager not implemented")
+}
+func (*UnimplementedMsgServer) Leverage(ctx context.Context, req *MsgLeverage) (*MsgLeverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Leverage not implemented")
+}
+func (*UnimplementedMsgServer) Deleverage(ctx context.Context, req *MsgDeleverage) (*MsgDeleverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deleverage not implemented")
 }
 
-func (m *MsgBuyBackingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BuybackFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.BackingOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgSellBacking) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_MintBySwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintBySwap)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).MintBySwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.maker.v1.Msg/MintBySwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintBySwap(ctx, req.(*MsgMintBySwap))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSellBacking) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_BurnBySwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnBySwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BurnBySwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.maker.v1.Msg/BurnBySwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BurnBySwap(ctx, req.(*MsgBurnBySwap))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSellBacking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MageOutMin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func _Msg_BasketMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBasketMint)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.BackingIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).BasketMint(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.maker.v1.Msg/BasketMint",
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BasketMint(ctx, req.(*MsgBasketMint))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSellBackingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_BasketBurn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBasketBurn)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).BasketBurn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.maker.v1.Msg/BasketBurn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BasketBurn(ctx, req.(*MsgBasketBurn))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSellBackingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_BuyBacking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBuyBacking)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BuyBacking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.maker.v1.Msg/BuyBacking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BuyBacking(ctx, req.(*MsgBuyBacking))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSellBackingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.RebackFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func _Msg_SellBacking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSellBacking)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.MageOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).SellBacking(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.maker.v1.Msg/SellBacking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SellBacking(ctx, req.(*MsgSellBacking))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMintByCollateral) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_MintByCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintByCollateral)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMintByCollateral) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).MintByCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.maker.v1.Msg/MintByCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintByCollateral(ctx, req.(*MsgMintByCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMintByCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MintOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func _Msg_BurnByCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnByCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.CollateralDenom) > 0 {
-		i -= len(m.CollateralDenom)
-		copy(dAtA[i:], m.CollateralDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralDenom)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).BurnByCollateral(ctx, in)
 	}
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.maker.v1.Msg/BurnByCollateral",
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BurnByCollateral(ctx, req.(*MsgBurnByCollateral))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMintByCollateralResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DepositCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositCollateral)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMintByCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).DepositCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.maker.v1.Msg/DepositCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositCollateral(ctx, req.(*MsgDepositCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMintByCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MintFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func _Msg_RedeemCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.maker.v1.Msg/RedeemCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemCollateral(ctx, req.(*MsgRedeemCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBurnByCollateral) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_LiquidateCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidateCollateral)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidateCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.maker.v1.Msg/LiquidateCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidateCollateral(ctx, req.(*MsgLiquidateCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBurnByCollateral) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_TransferPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.maker.v1.Msg/TransferPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferPosition(ctx, req.(*MsgTransferPosition))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBurnByCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.RepayInMax.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func _Msg_AuthorizeManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAuthorizeManager)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.CollateralDenom) > 0 {
-		i -= len(m.CollateralDenom)
-		copy(dAtA[i:], m.CollateralDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralDenom)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).AuthorizeManager(ctx, in)
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.maker.v1.Msg/AuthorizeManager",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AuthorizeManager(ctx, req.(*MsgAuthorizeManager))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBurnByCollateralResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RevokeManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeManager)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeManager(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.maker.v1.Msg/RevokeManager",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeManager(ctx, req.(*MsgRevokeManager))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBurnByCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_Leverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLeverage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Leverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.maker.v1.Msg/Leverage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Leverage(ctx, req.(*MsgLeverage))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBurnByCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.RepayIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func _Msg_Deleverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleverage)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).Deleverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.maker.v1.Msg/Deleverage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Deleverage(ctx, req.(*MsgDeleverage))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDepositCollateral) Marshal() (dAtA []byte, err error) {
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "warmage.maker.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MintBySwap",
+			Handler:    _Msg_MintBySwap_Handler,
+		},
+		{
+			MethodName: "BurnBySwap",
+			Handler:    _Msg_BurnBySwap_Handler,
+		},
+		{
+			MethodName: "BasketMint",
+			Handler:    _Msg_BasketMint_Handler,
+		},
+		{
+			MethodName: "BasketBurn",
+			Handler:    _Msg_BasketBurn_Handler,
+		},
+		{
+			MethodName: "BuyBacking",
+			Handler:    _Msg_BuyBacking_Handler,
+		},
+		{
+			MethodName: "SellBacking",
+			Handler:    _Msg_SellBacking_Handler,
+		},
+		{
+			MethodName: "MintByCollateral",
+			Handler:    _Msg_MintByCollateral_Handler,
+		},
+		{
+			MethodName: "BurnByCollateral",
+			Handler:    _Msg_BurnByCollateral_Handler,
+		},
+		{
+			MethodName: "DepositCollateral",
+			Handler:    _Msg_DepositCollateral_Handler,
+		},
+		{
+			MethodName: "RedeemCollateral",
+			Handler:    _Msg_RedeemCollateral_Handler,
+		},
+		{
+			MethodName: "LiquidateCollateral",
+			Handler:    _Msg_LiquidateCollateral_Handler,
+		},
+		{
+			MethodName: "TransferPosition",
+			Handler:    _Msg_TransferPosition_Handler,
+		},
+		{
+			MethodName: "AuthorizeManager",
+			Handler:    _Msg_AuthorizeManager_Handler,
+		},
+		{
+			MethodName: "RevokeManager",
+			Handler:    _Msg_RevokeManager_Handler,
+		},
+		{
+			MethodName: "Leverage",
+			Handler:    _Msg_Leverage_Handler,
+		},
+		{
+			MethodName: "Deleverage",
+			Handler:    _Msg_Deleverage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "warmage/maker/v1/tx.proto",
+}
+
+func (m *MsgMintBySwap) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2005,18 +2376,38 @@ func (m *MsgDepositCollateral) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDepositCollateral) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgMintBySwap) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDepositCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgMintBySwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.FullBacking {
+		i--
+		if m.FullBacking {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	{
-		size, err := m.MageIn.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.MintOutMin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.MageInMax.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2026,7 +2417,7 @@ func (m *MsgDepositCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x22
 	{
-		size, err := m.CollateralIn.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.BackingInMax.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2052,7 +2443,7 @@ func (m *MsgDepositCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDepositCollateralResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgMintBySwapResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2062,20 +2453,60 @@ func (m *MsgDepositCollateralResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDepositCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgMintBySwapResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDepositCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgMintBySwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.MintFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.MintOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.MageIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BackingIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRedeemCollateral) Marshal() (dAtA []byte, err error) {
+func (m *MsgBurnBySwap) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2085,18 +2516,28 @@ func (m *MsgRedeemCollateral) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRedeemCollateral) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBurnBySwap) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRedeemCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBurnBySwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.MageOut.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.MageOutMin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.BackingOutMin.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2106,7 +2547,7 @@ func (m *MsgRedeemCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x22
 	{
-		size, err := m.CollateralOut.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.BurnIn.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2132,7 +2573,7 @@ func (m *MsgRedeemCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRedeemCollateralResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgBurnBySwapResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2142,20 +2583,50 @@ func (m *MsgRedeemCollateralResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRedeemCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBurnBySwapResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRedeemCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBurnBySwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.BurnFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.MageOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BackingOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgLiquidateCollateral) Marshal() (dAtA []byte, err error) {
+func (m *MsgBasketMint) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2165,18 +2636,28 @@ func (m *MsgLiquidateCollateral) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgLiquidateCollateral) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBasketMint) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgLiquidateCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBasketMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.FullBacking {
+		i--
+		if m.FullBacking {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	{
-		size, err := m.RepayInMax.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.MintOutMin.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2186,7 +2667,7 @@ func (m *MsgLiquidateCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	i--
 	dAtA[i] = 0x2a
 	{
-		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.MageInMax.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2195,12 +2676,19 @@ func (m *MsgLiquidateCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	}
 	i--
 	dAtA[i] = 0x22
-	if len(m.Debtor) > 0 {
-		i -= len(m.Debtor)
-		copy(dAtA[i:], m.Debtor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Debtor)))
-		i--
-		dAtA[i] = 0x1a
+	if len(m.BackingInMax) > 0 {
+		for iNdEx := len(m.BackingInMax) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BackingInMax[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if len(m.To) > 0 {
 		i -= len(m.To)
@@ -2219,7 +2707,7 @@ func (m *MsgLiquidateCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgLiquidateCollateralResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgBasketMintResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2229,18 +2717,18 @@ func (m *MsgLiquidateCollateralResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgLiquidateCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBasketMintResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgLiquidateCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBasketMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.CollateralOut.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.MintFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2248,9 +2736,9 @@ func (m *MsgLiquidateCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x22
 	{
-		size, err := m.RepayIn.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.MintOut.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2258,339 +2746,3978 @@ func (m *MsgLiquidateCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgMintBySwap) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	dAtA[i] = 0x1a
+	{
+		size, err := m.MageIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.BackingInMax.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MageInMax.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MintOutMin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.FullBacking {
-		n += 2
+	i--
+	dAtA[i] = 0x12
+	if len(m.BackingIn) > 0 {
+		for iNdEx := len(m.BackingIn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BackingIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMintBySwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBasketBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.BackingIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MageIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MintOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MintFee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgBurnBySwap) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.BurnIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.BackingOutMin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MageOutMin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgBasketBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBurnBySwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgBasketBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.BackingOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MageOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.BurnFee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgBuyBacking) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size, err := m.MageOutMin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.BackingOutMin) > 0 {
+		for iNdEx := len(m.BackingOutMin) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BackingOutMin[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.BurnIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.MageIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.BackingOutMin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBuyBackingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBasketBurnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.BackingOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.BuybackFee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSellBacking) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgBasketBurnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBasketBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.BurnFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.MageOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.BackingIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MageOutMin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.BackingOut) > 0 {
+		for iNdEx := len(m.BackingOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BackingOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSellBackingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBuyBacking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.MageOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.RebackFee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMintByCollateral) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgBuyBacking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBuyBacking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.BackingOutMin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.MageIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.CollateralDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.MintOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMintByCollateralResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBuyBackingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.MintFee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgBurnByCollateral) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgBuyBackingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBuyBackingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.BuybackFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.CollateralDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BackingOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.RepayInMax.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBurnByCollateralResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSellBacking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.RepayIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDepositCollateral) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSellBacking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSellBacking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.MageOutMin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.BackingIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.CollateralIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MageIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDepositCollateralResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSellBackingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSellBackingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSellBackingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	{
+		size, err := m.RebackFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.MageOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRedeemCollateral) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMintByCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintByCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintByCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.MintOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintByCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintByCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintByCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MintFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnByCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnByCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnByCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.RepayInMax.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnByCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnByCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnByCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RepayIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MageIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.CollateralIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.MageOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.CollateralOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidateCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidateCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidateCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RepayInMax.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Debtor) > 0 {
+		i -= len(m.Debtor)
+		copy(dAtA[i:], m.Debtor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Debtor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidateCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidateCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidateCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CollateralOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.RepayIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAuthorizeManager) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAuthorizeManager) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAuthorizeManager) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAuthorizeManagerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAuthorizeManagerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAuthorizeManagerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeManager) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeManager) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeManager) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeManagerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeManagerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeManagerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeverage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeverage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeverage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TargetLtv.Size()
+		i -= size
+		if _, err := m.TargetLtv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeverageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeverageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeverageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Ltv.Size()
+		i -= size
+		if _, err := m.Ltv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.MageIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.CollateralIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.MintOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleverage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleverage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleverage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TargetLtv.Size()
+		i -= size
+		if _, err := m.TargetLtv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleverageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleverageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleverageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Ltv.Size()
+		i -= size
+		if _, err := m.Ltv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.RepayIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CollateralOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgMintBySwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BackingInMax.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MageInMax.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MintOutMin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.FullBacking {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgMintBySwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BackingIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MageIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MintOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MintFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBurnBySwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BurnIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.BackingOutMin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MageOutMin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBurnBySwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BackingOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MageOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.BurnFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBasketMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.BackingInMax) > 0 {
+		for _, e := range m.BackingInMax {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.MageInMax.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MintOutMin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.FullBacking {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgBasketMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BackingIn) > 0 {
+		for _, e := range m.BackingIn {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.MageIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MintOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MintFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBasketBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BurnIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.BackingOutMin) > 0 {
+		for _, e := range m.BackingOutMin {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.MageOutMin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBasketBurnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BackingOut) > 0 {
+		for _, e := range m.BackingOut {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.MageOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.BurnFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBuyBacking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MageIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.BackingOutMin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBuyBackingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BackingOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.BuybackFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSellBacking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BackingIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MageOutMin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSellBackingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MageOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RebackFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMintByCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MintOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMintByCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBurnByCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RepayInMax.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBurnByCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RepayIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CollateralIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MageIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedeemCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CollateralOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MageOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRedeemCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiquidateCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Debtor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RepayInMax.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLiquidateCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RepayIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CollateralOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTransferPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAuthorizeManager) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAuthorizeManagerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeManager) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeManagerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLeverage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TargetLtv.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLeverageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CollateralIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MageIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Ltv.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleverage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TargetLtv.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleverageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CollateralOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RepayIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Ltv.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintBySwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintBySwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingInMax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BackingInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MageInMax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MageInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintOutMin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullBacking", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FullBacking = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintBySwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintBySwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintBySwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BackingIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MageIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MageIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnBySwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnBySwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnBySwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingOutMin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BackingOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MageOutMin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MageOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnBySwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnBySwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnBySwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BackingOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MageOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MageOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBasketMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBasketMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBasketMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingInMax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackingInMax = append(m.BackingInMax, types.Coin{})
+			if err := m.BackingInMax[len(m.BackingInMax)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MageInMax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MageInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintOutMin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullBacking", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FullBacking = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBasketMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBasketMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBasketMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackingIn = append(m.BackingIn, types.Coin{})
+			if err := m.BackingIn[len(m.BackingIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MageIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MageIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBasketBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBasketBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBasketBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingOutMin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackingOutMin = append(m.BackingOutMin, types.Coin{})
+			if err := m.BackingOutMin[len(m.BackingOutMin)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MageOutMin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MageOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBasketBurnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBasketBurnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBasketBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackingOut = append(m.BackingOut, types.Coin{})
+			if err := m.BackingOut[len(m.BackingOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MageOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MageOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBuyBacking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuyBacking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuyBacking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MageIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MageIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingOutMin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BackingOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBuyBackingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuyBackingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuyBackingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BackingOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuybackFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BuybackFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSellBacking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSellBacking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSellBacking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BackingIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MageOutMin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MageOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.CollateralOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MageOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgRedeemCollateralResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgLiquidateCollateral) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Debtor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSellBackingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSellBackingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSellBackingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MageOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MageOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebackFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RebackFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Collateral.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.RepayInMax.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgLiquidateCollateralResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.RepayIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.CollateralOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
+func (m *MsgMintByCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2613,10 +6740,10 @@ func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintBySwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintByCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintBySwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintByCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2685,9 +6812,9 @@ func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingInMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2697,28 +6824,27 @@ func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BackingInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MageInMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2745,15 +6871,15 @@ func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MageInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MintOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintOutMin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2763,45 +6889,24 @@ func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FullBacking", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.FullBacking = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2823,7 +6928,7 @@ func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintBySwapResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMintByCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2846,112 +6951,13 @@ func (m *MsgMintBySwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintBySwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintByCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintBySwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintByCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingIn", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BackingIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MageIn", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MageIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintOut", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MintOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
 			}
@@ -3005,7 +7011,7 @@ func (m *MsgMintBySwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnBySwap) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnByCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3028,10 +7034,10 @@ func (m *MsgBurnBySwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnBySwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnByCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnBySwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnByCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3068,7 +7074,7 @@ func (m *MsgBurnBySwap) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3096,11 +7102,11 @@ func (m *MsgBurnBySwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepayInMax", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3127,15 +7133,15 @@ func (m *MsgBurnBySwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RepayInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingOutMin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3145,28 +7151,77 @@ func (m *MsgBurnBySwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BackingOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnByCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnByCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnByCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MageOutMin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepayIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3193,7 +7248,7 @@ func (m *MsgBurnBySwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MageOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RepayIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3218,7 +7273,7 @@ func (m *MsgBurnBySwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnBySwapResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3241,17 +7296,49 @@ func (m *MsgBurnBySwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnBySwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnBySwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3261,28 +7348,27 @@ func (m *MsgBurnBySwapResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BackingOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MageOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3309,13 +7395,13 @@ func (m *MsgBurnBySwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MageOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CollateralIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MageIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3342,7 +7428,7 @@ func (m *MsgBurnBySwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MageIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3367,7 +7453,7 @@ func (m *MsgBurnBySwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBuyBacking) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3390,10 +7476,60 @@ func (m *MsgBuyBacking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBuyBacking: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBuyBacking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3462,7 +7598,7 @@ func (m *MsgBuyBacking) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MageIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3489,13 +7625,13 @@ func (m *MsgBuyBacking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MageIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CollateralOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingOutMin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MageOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3522,10 +7658,42 @@ func (m *MsgBuyBacking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BackingOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MageOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3547,7 +7715,7 @@ func (m *MsgBuyBacking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBuyBackingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3570,78 +7738,12 @@ func (m *MsgBuyBackingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBuyBackingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBuyBackingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingOut", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BackingOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuybackFee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BuybackFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3663,7 +7765,7 @@ func (m *MsgBuyBackingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSellBacking) Unmarshal(dAtA []byte) error {
+func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3686,10 +7788,10 @@ func (m *MsgSellBacking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSellBacking: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiquidateCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSellBacking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiquidateCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3758,7 +7860,39 @@ func (m *MsgSellBacking) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Debtor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Debtor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3785,13 +7919,13 @@ func (m *MsgSellBacking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BackingIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MageOutMin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepayInMax", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3818,7 +7952,7 @@ func (m *MsgSellBacking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MageOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RepayInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3843,7 +7977,7 @@ func (m *MsgSellBacking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSellBackingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLiquidateCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3866,15 +8000,15 @@ func (m *MsgSellBackingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSellBackingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiquidateCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSellBackingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiquidateCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MageOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepayIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3901,13 +8035,13 @@ func (m *MsgSellBackingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MageOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RepayIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RebackFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3934,7 +8068,7 @@ func (m *MsgSellBackingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RebackFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CollateralOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3959,7 +8093,7 @@ func (m *MsgSellBackingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintByCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3982,10 +8116,10 @@ func (m *MsgMintByCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintByCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintByCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4084,39 +8218,6 @@ func (m *MsgMintByCollateral) Unmarshal(dAtA []byte) error {
 			}
 			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintOut", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MintOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4138,7 +8239,7 @@ func (m *MsgMintByCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintByCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferPositionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4161,45 +8262,12 @@ func (m *MsgMintByCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintByCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferPositionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintByCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4221,7 +8289,7 @@ func (m *MsgMintByCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnByCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgAuthorizeManager) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4244,10 +8312,10 @@ func (m *MsgBurnByCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnByCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAuthorizeManager: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnByCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAuthorizeManager: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4284,7 +8352,7 @@ func (m *MsgBurnByCollateral) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4312,41 +8380,58 @@ func (m *MsgBurnByCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
+			m.Manager = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepayInMax", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAuthorizeManagerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RepayInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAuthorizeManagerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAuthorizeManagerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4368,7 +8453,7 @@ func (m *MsgBurnByCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnByCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeManager) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4391,17 +8476,17 @@ func (m *MsgBurnByCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnByCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeManager: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnByCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeManager: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepayIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4411,24 +8496,55 @@ func (m *MsgBurnByCollateralResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RepayIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4451,7 +8567,7 @@ func (m *MsgBurnByCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeManagerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4474,10 +8590,60 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeManagerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeManagerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLeverage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLeverage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLeverage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4514,7 +8680,7 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4542,13 +8708,13 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetLtv", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4558,30 +8724,31 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CollateralIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TargetLtv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MageIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4591,22 +8758,23 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MageIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4631,57 +8799,7 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositCollateralResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRedeemCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgLeverageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4704,17 +8822,17 @@ func (m *MsgRedeemCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeemCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLeverageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeemCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLeverageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintOut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4724,29 +8842,30 @@ func (m *MsgRedeemCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := m.MintOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralIn", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4756,27 +8875,28 @@ func (m *MsgRedeemCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			if err := m.CollateralIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MageIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4803,15 +8923,15 @@ func (m *MsgRedeemCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CollateralOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MageIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MageOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4821,22 +8941,23 @@ func (m *MsgRedeemCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MageOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Ltv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4861,57 +8982,7 @@ func (m *MsgRedeemCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedeemCollateralResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeemCollateralResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeemCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleverage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4934,10 +9005,10 @@ func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidateCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleverage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidateCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleverage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4974,7 +9045,7 @@ func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5002,11 +9073,11 @@ func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Debtor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetLtv", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5034,46 +9105,15 @@ func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Debtor = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TargetLtv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepayInMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5083,22 +9123,23 @@ func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RepayInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5123,7 +9164,7 @@ func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidateCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleverageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5146,15 +9187,15 @@ func (m *MsgLiquidateCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidateCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleverageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidateCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleverageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepayIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5181,13 +9222,13 @@ func (m *MsgLiquidateCollateralResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RepayIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CollateralOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepayIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5214,7 +9255,41 @@ func (m *MsgLiquidateCollateralResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CollateralOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RepayIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ltv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
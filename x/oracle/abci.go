@@ -77,7 +77,8 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 				}
 
 				// Get weighted median of cross exchange rates
-				exchangeRate := Tally(ctx, ballot, params.RewardBand, validatorClaimMap)
+				_, rewardBand, _ := k.GetTallyParams(ctx, denom)
+				exchangeRate := Tally(ctx, ballot, rewardBand, validatorClaimMap)
 
 				// Transform into the original form {denom}/uUSD
 				if denom != referenceWar {
@@ -89,15 +90,36 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 					exchangeRate = exchangeRateRM.Quo(exchangeRate)
 				}
 
-				// Set the exchange rate, emit ABCI event
-				k.SetExchangeRate(ctx, denom, exchangeRate)
+				// Set the exchange rate, unless the circuit breaker holds the last accepted one
+				k.ApplyTalliedExchangeRate(ctx, denom, exchangeRate)
 			}
 		}
 
+		// Hold the last accepted exchange rates of vote targets whose ballots failed
+		k.IterateVoteTargets(ctx, func(denom string) (stop bool) {
+			if _, err := k.GetExchangeRate(ctx, denom); err != nil {
+				k.HoldFailedExchangeRate(ctx, denom)
+			}
+			return false
+		})
+
+		// Quote exchange rates of DEX targets, priced by the tallied exchange rates
+		k.UpdateDexExchangeRates(ctx)
+
+		// Apply exchange rates quoted from counterparty chains, and request new ones
+		k.UpdateInterchainExchangeRates(ctx)
+		k.RequestInterchainExchangeRates(ctx)
+
+		// Publish the exchange rates to the EVM price feed contracts
+		k.SyncPriceFeeds(ctx)
+
 		// ---------------------------
 		// Do miss counting & slashing
 		voteTargetsLen := len(voteTargets)
 		for _, claim := range validatorClaimMap {
+			// Record performance in the slash window
+			k.AddValidatorPerformance(ctx, claim.Recipient, uint64(claim.WinCount))
+
 			// Skip abstain & valid voters
 			if int(claim.WinCount) == voteTargetsLen {
 				continue
@@ -112,7 +134,6 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 			ctx,
 			(int64)(params.VotePeriod),
 			(int64)(params.RewardDistributionWindow),
-			voteTargets,
 			validatorClaimMap,
 		)
 
@@ -74,6 +74,10 @@ func TestOracleThreshold(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, randomExchangeRate, rate)
 
+	snapshot, err := input.OracleKeeper.GetHistoricalRate(input.Ctx.WithBlockHeight(1), denom1, 1)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, snapshot.ExchangeRate)
+
 	// Case 3.
 	// Increase voting power of absent validator, exchange rate consensus fails
 	val, _ := input.StakingKeeper.GetValidator(input.Ctx, keeper.ValAddrs[2])
@@ -101,8 +105,11 @@ func TestOracleThreshold(t *testing.T) {
 
 	oracle.EndBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
 
-	_, err = input.OracleKeeper.GetExchangeRate(input.Ctx.WithBlockHeight(1), denom1)
-	require.Error(t, err)
+	// The last accepted exchange rate is held by the circuit breaker
+	rate, err = input.OracleKeeper.GetExchangeRate(input.Ctx.WithBlockHeight(1), denom1)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+	require.True(t, input.OracleKeeper.IsExchangeRateHeld(input.Ctx, denom1))
 }
 
 func TestOracleDrop(t *testing.T) {
@@ -294,6 +301,61 @@ func TestOracleRewardBand(t *testing.T) {
 
 }
 
+func TestOracleTallyOverrides(t *testing.T) {
+	input, h := setup(t)
+
+	// reset vote targets
+	input.OracleKeeper.ClearVoteTargets(input.Ctx)
+	minVoters := types.TargetParams{Denom: denom2, Source: types.TARGET_SOURCE_VALIDATORS, MinVoters: 3}
+	input.OracleKeeper.SetVoteTargetParams(input.Ctx, minVoters)
+
+	// Case 1.
+	// More than the threshold power but fewer than the minimum voters, exchange rate consensus fails
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: denom2, Amount: randomExchangeRate}}, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: denom2, Amount: randomExchangeRate}}, 1)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	_, err := input.OracleKeeper.GetExchangeRate(input.Ctx, denom2)
+	require.Error(t, err)
+
+	// Case 2.
+	// The minimum voters vote, exchange rate consensus succeeds
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: denom2, Amount: randomExchangeRate}}, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: denom2, Amount: randomExchangeRate}}, 1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: denom2, Amount: randomExchangeRate}}, 2)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	rate, err := input.OracleKeeper.GetExchangeRate(input.Ctx, denom2)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+
+	// Case 3.
+	// More than the VoteThreshold param but less than the overriding threshold, exchange rate consensus fails
+	voteThreshold := sdk.NewDecWithPrec(9, 1)
+	input.OracleKeeper.SetVoteTargetParams(input.Ctx, types.TargetParams{Denom: denom2, Source: types.TARGET_SOURCE_VALIDATORS, VoteThreshold: &voteThreshold})
+
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: denom2, Amount: randomExchangeRate}}, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: denom2, Amount: randomExchangeRate}}, 1)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	require.True(t, input.OracleKeeper.IsExchangeRateHeld(input.Ctx, denom2))
+
+	// Case 4.
+	// Out of the RewardBand param but within the overriding reward band, no one will miss the vote
+	rewardSpread := randomExchangeRate.Mul(input.OracleKeeper.RewardBand(input.Ctx).QuoInt64(2))
+	rewardBand := sdk.NewDecWithPrec(5, 1)
+	input.OracleKeeper.SetVoteTargetParams(input.Ctx, types.TargetParams{Denom: denom2, Source: types.TARGET_SOURCE_VALIDATORS, RewardBand: &rewardBand})
+
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: denom2, Amount: randomExchangeRate.Sub(rewardSpread.Add(sdk.OneDec()))}}, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: denom2, Amount: randomExchangeRate}}, 1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: denom2, Amount: randomExchangeRate.Add(rewardSpread)}}, 2)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[0]))
+	require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[1]))
+	require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[2]))
+}
+
 func TestOracleMultiRewardDistribution(t *testing.T) {
 	input, h := setup(t)
 
@@ -3,7 +3,9 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -26,10 +28,16 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		CmdQueryExchangeRates(),
+		CmdQueryExchangeRateStatus(),
+		CmdQueryPriceFeed(),
+		CmdQueryTWAP(),
+		CmdQueryHistoricalRate(),
 		CmdQueryActives(),
 		CmdQueryVoteTargets(),
+		CmdQueryTargetParams(),
 		CmdQueryFeederDelegation(),
 		CmdQueryMissCounter(),
+		CmdQueryValidatorOracleHistory(),
 		CmdQueryAggregatePrevote(),
 		CmdQueryAggregateVote(),
 		CmdQueryParams(),
@@ -89,6 +97,156 @@ $ maged query oracle exchange-rates uusd
 	return cmd
 }
 
+// CmdQueryExchangeRateStatus implements the query exchange rate status command.
+func CmdQueryExchangeRateStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-status [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the last accepted exchange rate of an asset w.r.t $uUSD",
+		Long: strings.TrimSpace(`
+Query the last exchange rate of an asset with an $uUSD accepted from a ballot, the block at which it was accepted,
+and the # of vote periods the price circuit breaker has been holding it for, which is zero if it is fresh.
+
+$ maged query oracle rate-status amage
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ExchangeRateStatus(
+				context.Background(),
+				&types.QueryExchangeRateStatusRequest{Denom: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryPriceFeed implements the query price feed command.
+func CmdQueryPriceFeed() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-feed [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the EVM price feed contract of an asset",
+		Long: strings.TrimSpace(`
+Query the address of the Chainlink AggregatorV3 compatible contract, through which EVM contracts read
+the exchange rate of an asset with an $uUSD.
+
+$ maged query oracle price-feed amage
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PriceFeed(
+				context.Background(),
+				&types.QueryPriceFeedRequest{Denom: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryTWAP implements the query twap command.
+func CmdQueryTWAP() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [denom] [window]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the time-weighted average exchange rate of an asset w.r.t $uUSD",
+		Long: strings.TrimSpace(`
+Query the time-weighted average exchange rate of an asset with an $uUSD over a time window
+up to the current block.
+
+$ maged query oracle twap amage 1h
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			window, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TWAP(
+				context.Background(),
+				&types.QueryTWAPRequest{Denom: args[0], Window: window},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryHistoricalRate implements the query historical rate command.
+func CmdQueryHistoricalRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "historical-rate [denom] [height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the exchange rate of an asset w.r.t $uUSD in effect at a block height",
+		Long: strings.TrimSpace(`
+Query the exchange rate of an asset with an $uUSD in effect at a block height,
+which is the latest snapshot tallied at or before the height.
+
+$ maged query oracle historical-rate amage 1000
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.HistoricalRate(
+				context.Background(),
+				&types.QueryHistoricalRateRequest{Denom: args[0], Height: height},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // CmdQueryActives implements the query actives command.
 func CmdQueryActives() *cobra.Command {
 	cmd := &cobra.Command{
@@ -149,6 +307,35 @@ func CmdQueryVoteTargets() *cobra.Command {
 	return cmd
 }
 
+// CmdQueryTargetParams implements the query target params command.
+func CmdQueryTargetParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "target-params",
+		Args:  cobra.NoArgs,
+		Short: "Query the quotation sources of the current Oracle targets",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TargetParams(
+				context.Background(),
+				&types.QueryTargetParamsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // CmdQueryFeederDelegation implements the query feeder delegation command
 func CmdQueryFeederDelegation() *cobra.Command {
 	cmd := &cobra.Command{
@@ -230,6 +417,55 @@ $ maged query oracle miss warvaloper...
 	return cmd
 }
 
+// CmdQueryValidatorOracleHistory implements the query oracle history of the validator command.
+func CmdQueryValidatorOracleHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the oracle performance history of a validator",
+		Long: strings.TrimSpace(`
+Query the # of vote periods, misses and wins of a validator in the current and past oracle slash windows,
+and whether it was slashed or jailed at the end of each past window.
+
+$ maged query oracle history warvaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorOracleHistory(
+				context.Background(),
+				&types.QueryValidatorOracleHistoryRequest{
+					ValidatorAddr: validator.String(),
+					Pagination:    pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "history")
+	return cmd
+}
+
 // CmdQueryAggregatePrevote implements the query aggregate prevote of the validator command
 func CmdQueryAggregatePrevote() *cobra.Command {
 	cmd := &cobra.Command{
@@ -256,6 +256,95 @@ The proposal details must be supplied via a JSON file.`,
 	return cmd
 }
 
+func NewUpdateTargetProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-oracle-target [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an update oracle target proposal",
+		Long: strings.TrimSpace(
+			`Submit an update oracle target proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			title, description, deposit, err := getProposalArgs(cmd)
+			if err != nil {
+				return err
+			}
+
+			var targetParams types.TargetParams
+			err = parseProposalContent(clientCtx.Codec, args[0], &targetParams)
+			if err != nil {
+				return err
+			}
+
+			content := &types.UpdateTargetProposal{
+				Title:        title,
+				Description:  description,
+				TargetParams: targetParams,
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewDeregisterTargetProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deregister-oracle-target [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a deregister oracle target proposal",
+		Long: strings.TrimSpace(
+			`Submit a deregister oracle target proposal along with an initial deposit.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			title, description, deposit, err := getProposalArgs(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := &types.DeregisterTargetProposal{
+				Title:       title,
+				Description: description,
+				Denom:       args[0],
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func parseProposalContent(cdc codec.JSONCodec, proposalFile string, proposal proto.Message) error {
 	content, err := ioutil.ReadFile(proposalFile)
 	if err != nil {
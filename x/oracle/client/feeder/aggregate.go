@@ -0,0 +1,35 @@
+package feeder
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MedianPrices aggregates the exchange rates reported by multiple providers
+// into their median per denom. Denoms reported by no provider are omitted.
+func MedianPrices(reports []map[string]sdk.Dec) map[string]sdk.Dec {
+	all := make(map[string][]sdk.Dec)
+	for _, report := range reports {
+		for denom, price := range report {
+			all[denom] = append(all[denom], price)
+		}
+	}
+
+	medians := make(map[string]sdk.Dec, len(all))
+	for denom, prices := range all {
+		medians[denom] = median(prices)
+	}
+	return medians
+}
+
+func median(prices []sdk.Dec) sdk.Dec {
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i].LT(prices[j])
+	})
+	mid := len(prices) / 2
+	if len(prices)%2 == 1 {
+		return prices[mid]
+	}
+	return prices[mid-1].Add(prices[mid]).QuoInt64(2)
+}
@@ -0,0 +1,119 @@
+package feeder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	FlagValidator       = "validator"
+	FlagProviders       = "providers"
+	FlagProviderTimeout = "provider-timeout"
+	FlagMaxRetries      = "max-retries"
+	FlagRetryInterval   = "retry-interval"
+)
+
+// NewPriceFeederCmd returns the command running the reference price feeder.
+func NewPriceFeederCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-feeder",
+		Args:  cobra.NoArgs,
+		Short: "Run the price feeder which submits oracle exchange rate votes every vote period",
+		Long: strings.TrimSpace(`
+Run the price feeder which submits oracle aggregate prevotes and votes every vote period,
+signed by the key given by --from.
+
+The key is either the validator operator account itself, or the feeder delegated by the
+validator through "maged tx oracle set-feeder".
+
+Exchange rates in $uUSD are fetched from every provider and aggregated by median.
+A provider is either a JSON file or an HTTP endpoint serving JSON of the form
+{"amage": "0.000000001234", "uusw": "0.99"}:
+
+$ maged price-feeder --from feeder --validator warvaloper1... \
+	--providers file:/path/to/prices.json,https://example.com/prices --gas auto
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.GetFromAddress().Empty() {
+				return fmt.Errorf("--%s must be specified", flags.FlagFrom)
+			}
+
+			cfg, err := configFromFlags(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			logger := log.NewTMLogger(log.NewSyncWriter(cmd.OutOrStdout()))
+
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			return NewFeeder(clientCtx, txf, cfg, logger).Run(ctx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagValidator, "", "Validator operator address to vote on behalf of (defaults to the operator address of --from)")
+	cmd.Flags().StringSlice(FlagProviders, nil, "Price providers, each of the form file:<path> or http(s)://<url>")
+	cmd.Flags().Duration(FlagProviderTimeout, 5*time.Second, "Timeout of fetching prices from a provider")
+	cmd.Flags().Int(FlagMaxRetries, 3, "Max retries of a failed broadcast")
+	cmd.Flags().Duration(FlagRetryInterval, time.Second, "Interval between retries of a failed broadcast")
+	if err := cmd.MarkFlagRequired(FlagProviders); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+func configFromFlags(cmd *cobra.Command, clientCtx client.Context) (cfg Config, err error) {
+	validatorStr, err := cmd.Flags().GetString(FlagValidator)
+	if err != nil {
+		return
+	}
+	if len(validatorStr) > 0 {
+		cfg.Validator, err = sdk.ValAddressFromBech32(validatorStr)
+		if err != nil {
+			return
+		}
+	} else {
+		cfg.Validator = sdk.ValAddress(clientCtx.GetFromAddress())
+	}
+
+	providerSpecs, err := cmd.Flags().GetStringSlice(FlagProviders)
+	if err != nil {
+		return
+	}
+	for _, spec := range providerSpecs {
+		provider, err := NewProvider(spec)
+		if err != nil {
+			return cfg, err
+		}
+		cfg.Providers = append(cfg.Providers, provider)
+	}
+
+	if cfg.ProviderTimeout, err = cmd.Flags().GetDuration(FlagProviderTimeout); err != nil {
+		return
+	}
+	if cfg.MaxRetries, err = cmd.Flags().GetInt(FlagMaxRetries); err != nil {
+		return
+	}
+	cfg.RetryInterval, err = cmd.Flags().GetDuration(FlagRetryInterval)
+	return
+}
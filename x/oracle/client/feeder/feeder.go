@@ -0,0 +1,304 @@
+package feeder
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/petri-labs/warmage/x/oracle/types"
+)
+
+const subscriber = "price-feeder"
+
+// Config configures a Feeder.
+type Config struct {
+	// Validator is the validator to vote on behalf of.
+	Validator sdk.ValAddress
+	// Providers are the sources of exchange rates, aggregated by median.
+	Providers []Provider
+	// ProviderTimeout bounds the time spent fetching from each provider.
+	ProviderTimeout time.Duration
+	// MaxRetries is the number of retries of a failed broadcast.
+	MaxRetries int
+	// RetryInterval is the time to wait between retries.
+	RetryInterval time.Duration
+}
+
+// pendingVote is the exchange rates committed by the prevote of a vote period,
+// to be revealed in the next vote period.
+type pendingVote struct {
+	period        uint64
+	salt          string
+	exchangeRates string
+}
+
+// Feeder submits the aggregate prevote and vote of a validator every vote
+// period. It signs as the feeder, which is either the validator operator
+// account itself or the account delegated by MsgDelegateFeedConsent.
+type Feeder struct {
+	clientCtx   client.Context
+	txf         tx.Factory
+	queryClient types.QueryClient
+	cfg         Config
+	logger      log.Logger
+
+	votePeriod uint64
+	lastPeriod uint64
+	pending    *pendingVote
+}
+
+// NewFeeder creates a new Feeder.
+func NewFeeder(clientCtx client.Context, txf tx.Factory, cfg Config, logger log.Logger) *Feeder {
+	return &Feeder{
+		clientCtx:   clientCtx,
+		txf:         txf,
+		queryClient: types.NewQueryClient(clientCtx),
+		cfg:         cfg,
+		logger:      logger,
+	}
+}
+
+// Run feeds exchange rates on new blocks until ctx is done.
+func (f *Feeder) Run(ctx context.Context) error {
+	if err := f.checkFeeder(ctx); err != nil {
+		return err
+	}
+
+	node, err := f.clientCtx.GetNode()
+	if err != nil {
+		return err
+	}
+	if !node.IsRunning() {
+		if err := node.Start(); err != nil {
+			return err
+		}
+		defer node.Stop() //nolint:errcheck
+	}
+
+	query := tmtypes.QueryForEvent(tmtypes.EventNewBlock).String()
+	events, err := node.Subscribe(ctx, subscriber, query)
+	if err != nil {
+		return err
+	}
+	defer node.UnsubscribeAll(context.Background(), subscriber) //nolint:errcheck
+
+	f.logger.Info("price feeder started", "validator", f.cfg.Validator.String(), "feeder", f.clientCtx.GetFromAddress().String())
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return fmt.Errorf("new block subscription closed")
+			}
+			data, ok := event.Data.(tmtypes.EventDataNewBlock)
+			if !ok {
+				continue
+			}
+			if err := f.onBlock(ctx, data.Block.Height); err != nil {
+				f.logger.Error("failed to feed exchange rates", "height", data.Block.Height, "err", err)
+			}
+		}
+	}
+}
+
+// checkFeeder checks that the signer is allowed to vote for the validator.
+func (f *Feeder) checkFeeder(ctx context.Context) error {
+	feeder := f.clientCtx.GetFromAddress()
+	if sdk.ValAddress(feeder).Equals(f.cfg.Validator) {
+		return nil
+	}
+
+	res, err := f.queryClient.FeederDelegation(ctx, &types.QueryFeederDelegationRequest{ValidatorAddr: f.cfg.Validator.String()})
+	if err != nil {
+		return err
+	}
+	if res.FeederAddr != feeder.String() {
+		return sdkerrors.Wrapf(types.ErrNoVotingPermission, "%s is not the feeder of validator %s", feeder, f.cfg.Validator)
+	}
+	return nil
+}
+
+// onBlock submits the prevote of the vote period to which the next block
+// belongs, together with the vote revealing the prevote of the last period.
+func (f *Feeder) onBlock(ctx context.Context, height int64) error {
+	if f.votePeriod == 0 || uint64(height)%f.votePeriod == 0 {
+		res, err := f.queryClient.Params(ctx, &types.QueryParamsRequest{})
+		if err != nil {
+			return err
+		}
+		f.votePeriod = res.Params.VotePeriod
+	}
+
+	// the tx is expected to be included in the next block; avoid the last
+	// block of a period, since the tx may spill over into the next period
+	next := uint64(height) + 1
+	period := next / f.votePeriod
+	if period <= f.lastPeriod || (f.votePeriod > 1 && next%f.votePeriod == f.votePeriod-1) {
+		return nil
+	}
+
+	prices, err := f.fetchPrices(ctx)
+	if err != nil {
+		return err
+	}
+	if len(prices) == 0 {
+		return fmt.Errorf("no exchange rates of vote targets available")
+	}
+
+	msgs, pending, err := f.buildMsgs(period, prices)
+	if err != nil {
+		return err
+	}
+	f.lastPeriod = period
+	if err := f.broadcast(ctx, msgs); err != nil {
+		f.pending = nil
+		return err
+	}
+	f.pending = pending
+	f.logger.Info("submitted exchange rates", "height", height, "period", period, "exchange_rates", pending.exchangeRates)
+	return nil
+}
+
+// fetchPrices fetches the median exchange rates of the vote targets.
+func (f *Feeder) fetchPrices(ctx context.Context) (map[string]sdk.Dec, error) {
+	res, err := f.queryClient.VoteTargets(ctx, &types.QueryVoteTargetsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	var reports []map[string]sdk.Dec
+	for _, provider := range f.cfg.Providers {
+		pctx, cancel := context.WithTimeout(ctx, f.cfg.ProviderTimeout)
+		prices, err := provider.GetPrices(pctx, res.VoteTargets)
+		cancel()
+		if err != nil {
+			f.logger.Error("failed to get prices", "provider", provider.Name(), "err", err)
+			continue
+		}
+		reports = append(reports, prices)
+	}
+	return MedianPrices(reports), nil
+}
+
+// buildMsgs builds the vote revealing the pending prevote if it was submitted
+// in the last period, and the prevote of the given prices.
+func (f *Feeder) buildMsgs(period uint64, prices map[string]sdk.Dec) ([]sdk.Msg, *pendingVote, error) {
+	feeder := f.clientCtx.GetFromAddress()
+
+	var msgs []sdk.Msg
+	if f.pending != nil && f.pending.period+1 == period {
+		msgs = append(msgs, types.NewMsgAggregateExchangeRateVote(f.pending.salt, f.pending.exchangeRates, feeder, f.cfg.Validator))
+	}
+
+	salt, err := generateSalt()
+	if err != nil {
+		return nil, nil, err
+	}
+	pending := &pendingVote{
+		period:        period,
+		salt:          salt,
+		exchangeRates: FormatExchangeRates(prices),
+	}
+	hash := types.GetAggregateVoteHash(pending.salt, pending.exchangeRates, f.cfg.Validator)
+	msgs = append(msgs, types.NewMsgAggregateExchangeRatePrevote(hash, feeder, f.cfg.Validator))
+
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, nil, err
+		}
+	}
+	return msgs, pending, nil
+}
+
+// broadcast signs and broadcasts msgs, retrying on failure.
+func (f *Feeder) broadcast(ctx context.Context, msgs []sdk.Msg) (err error) {
+	for i := 0; i <= f.cfg.MaxRetries; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(f.cfg.RetryInterval):
+			}
+		}
+		if err = f.broadcastOnce(msgs); err == nil {
+			return nil
+		}
+		f.logger.Error("failed to broadcast tx", "attempt", i+1, "err", err)
+	}
+	return err
+}
+
+func (f *Feeder) broadcastOnce(msgs []sdk.Msg) error {
+	// always refresh the account sequence, as it may be stale after failures
+	num, seq, err := f.txf.AccountRetriever().GetAccountNumberSequence(f.clientCtx, f.clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+	txf := f.txf.WithAccountNumber(num).WithSequence(seq)
+
+	if txf.SimulateAndExecute() {
+		_, gas, err := tx.CalculateGas(f.clientCtx, txf, msgs...)
+		if err != nil {
+			return err
+		}
+		txf = txf.WithGas(gas)
+	}
+
+	txb, err := tx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return err
+	}
+	if err := tx.Sign(txf, f.clientCtx.GetFromName(), txb, true); err != nil {
+		return err
+	}
+	txBytes, err := f.clientCtx.TxConfig.TxEncoder()(txb.GetTx())
+	if err != nil {
+		return err
+	}
+
+	res, err := f.clientCtx.BroadcastTxSync(txBytes)
+	if err != nil {
+		return err
+	}
+	if res.Code != 0 {
+		return fmt.Errorf("tx %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+	return nil
+}
+
+// FormatExchangeRates formats exchange rates as "{denom}:{exchange rate},...",
+// sorted by denom.
+func FormatExchangeRates(prices map[string]sdk.Dec) string {
+	denoms := make([]string, 0, len(prices))
+	for denom := range prices {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	tuples := make([]string, len(denoms))
+	for i, denom := range denoms {
+		tuples[i] = fmt.Sprintf("%s:%s", denom, prices[denom])
+	}
+	return strings.Join(tuples, ",")
+}
+
+// generateSalt returns a random salt of 4 hex characters.
+func generateSalt() (string, error) {
+	bz := make([]byte, 2)
+	if _, err := rand.Read(bz); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bz), nil
+}
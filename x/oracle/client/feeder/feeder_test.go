@@ -0,0 +1,104 @@
+package feeder
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/petri-labs/warmage/x/oracle/types"
+)
+
+const pricesJSON = `{"amage": "0.000000001234", "uusw": "0.99", "uatom": "10"}`
+
+func TestFileProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(pricesJSON), 0o600))
+
+	provider, err := NewProvider("file:" + path)
+	require.NoError(t, err)
+	prices, err := provider.GetPrices(context.Background(), []string{"amage", "uusw", "ueth"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]sdk.Dec{
+		"amage": sdk.MustNewDecFromStr("0.000000001234"),
+		"uusw":  sdk.MustNewDecFromStr("0.99"),
+	}, prices)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"uusw": "-1"}`), 0o600))
+	_, err = provider.GetPrices(context.Background(), []string{"uusw"})
+	require.Error(t, err)
+}
+
+func TestHTTPProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, pricesJSON)
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(server.URL)
+	require.NoError(t, err)
+	prices, err := provider.GetPrices(context.Background(), []string{"uatom"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]sdk.Dec{"uatom": sdk.NewDec(10)}, prices)
+
+	_, err = NewProvider("ftp://example.com")
+	require.Error(t, err)
+	_, err = NewProvider("file:")
+	require.Error(t, err)
+}
+
+func TestMedianPrices(t *testing.T) {
+	medians := MedianPrices([]map[string]sdk.Dec{
+		{"uusw": sdk.NewDecWithPrec(99, 2), "uatom": sdk.NewDec(10)},
+		{"uusw": sdk.NewDecWithPrec(101, 2), "uatom": sdk.NewDec(12)},
+		{"uusw": sdk.NewDecWithPrec(100, 2)},
+	})
+	require.Equal(t, map[string]sdk.Dec{
+		"uusw":  sdk.NewDecWithPrec(100, 2),
+		"uatom": sdk.NewDec(11),
+	}, medians)
+	require.Empty(t, MedianPrices(nil))
+}
+
+func TestBuildMsgs(t *testing.T) {
+	feeder := sdk.AccAddress([]byte("feeder______________"))
+	validator := sdk.ValAddress([]byte("validator___________"))
+	f := &Feeder{
+		clientCtx: client.Context{}.WithFromAddress(feeder),
+		cfg:       Config{Validator: validator},
+	}
+	prices := map[string]sdk.Dec{"uusw": sdk.NewDecWithPrec(99, 2), "amage": sdk.NewDecWithPrec(1234, 12)}
+
+	// first period only prevotes
+	msgs, pending, err := f.buildMsgs(5, prices)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	prevote := msgs[0].(*types.MsgAggregateExchangeRatePrevote)
+	require.Equal(t, uint64(5), pending.period)
+	require.Equal(t, "amage:0.000000001234000000,uusw:0.990000000000000000", pending.exchangeRates)
+	require.Equal(t, types.GetAggregateVoteHash(pending.salt, pending.exchangeRates, validator).String(), prevote.Hash)
+	f.pending = pending
+
+	// next period reveals the prevote
+	msgs, next, err := f.buildMsgs(6, prices)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	vote := msgs[0].(*types.MsgAggregateExchangeRateVote)
+	require.Equal(t, pending.salt, vote.Salt)
+	require.Equal(t, pending.exchangeRates, vote.ExchangeRates)
+	require.Equal(t, feeder.String(), vote.Feeder)
+	require.Equal(t, validator.String(), vote.Validator)
+	f.pending = next
+
+	// a skipped period has nothing to reveal
+	msgs, _, err = f.buildMsgs(8, prices)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+}
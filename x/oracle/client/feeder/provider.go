@@ -0,0 +1,111 @@
+package feeder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Provider fetches exchange rates of denoms in uUSD.
+type Provider interface {
+	// Name returns the name of the provider, used for logging.
+	Name() string
+	// GetPrices returns the exchange rates of those denoms known to the provider.
+	GetPrices(ctx context.Context, denoms []string) (map[string]sdk.Dec, error)
+}
+
+// NewProvider creates a provider from a spec, which is either "file:<path>" or
+// an http(s) URL.
+func NewProvider(spec string) (Provider, error) {
+	splits := strings.SplitN(spec, ":", 2)
+	if len(splits) != 2 || len(splits[1]) == 0 {
+		return nil, fmt.Errorf("invalid price provider: %s", spec)
+	}
+	switch splits[0] {
+	case "file":
+		return FileProvider{Path: splits[1]}, nil
+	case "http", "https":
+		return HTTPProvider{URL: spec, Client: http.DefaultClient}, nil
+	default:
+		return nil, fmt.Errorf("unknown price provider type: %s", splits[0])
+	}
+}
+
+// FileProvider reads exchange rates from a JSON file of the form
+// {"<denom>": "<exchange rate>", ...}. It's mainly useful for testing.
+type FileProvider struct {
+	Path string
+}
+
+func (p FileProvider) Name() string {
+	return "file:" + p.Path
+}
+
+func (p FileProvider) GetPrices(_ context.Context, denoms []string) (map[string]sdk.Dec, error) {
+	bz, err := ioutil.ReadFile(p.Path)
+	if err != nil {
+		return nil, err
+	}
+	return parsePrices(bz, denoms)
+}
+
+// HTTPProvider fetches exchange rates by GET from a URL which responds JSON in
+// the same form as FileProvider.
+type HTTPProvider struct {
+	URL    string
+	Client *http.Client
+}
+
+func (p HTTPProvider) Name() string {
+	return p.URL
+}
+
+func (p HTTPProvider) GetPrices(ctx context.Context, denoms []string) (map[string]sdk.Dec, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status from %s: %s", p.URL, resp.Status)
+	}
+	bz, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return parsePrices(bz, denoms)
+}
+
+func parsePrices(bz []byte, denoms []string) (map[string]sdk.Dec, error) {
+	var raw map[string]string
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return nil, err
+	}
+
+	prices := make(map[string]sdk.Dec)
+	for _, denom := range denoms {
+		priceStr, ok := raw[denom]
+		if !ok {
+			continue
+		}
+		price, err := sdk.NewDecFromStr(priceStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid price of %s: %w", denom, err)
+		}
+		if !price.IsPositive() {
+			return nil, fmt.Errorf("price of %s must be positive: %s", denom, price)
+		}
+		prices[denom] = price
+	}
+	return prices, nil
+}
@@ -7,5 +7,7 @@ import (
 )
 
 var (
-	RegisterTargetProposalHandler = govclient.NewProposalHandler(cli.NewRegisterTargetProposalCmd, rest.RegisterTargetProposalRESTHandler)
+	RegisterTargetProposalHandler   = govclient.NewProposalHandler(cli.NewRegisterTargetProposalCmd, rest.RegisterTargetProposalRESTHandler)
+	UpdateTargetProposalHandler     = govclient.NewProposalHandler(cli.NewUpdateTargetProposalCmd, rest.UpdateTargetProposalRESTHandler)
+	DeregisterTargetProposalHandler = govclient.NewProposalHandler(cli.NewDeregisterTargetProposalCmd, rest.DeregisterTargetProposalRESTHandler)
 )
@@ -56,8 +56,46 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetAggregateExchangeRateVote(ctx, valAddr, av)
 	}
 
+	for _, snapshot := range genState.HistoricalExchangeRates {
+		k.SetHistoricalRate(ctx, snapshot)
+	}
+
+	for _, performance := range genState.ValidatorPerformances {
+		k.SetValidatorPerformance(ctx, performance)
+	}
+
+	for _, stats := range genState.ValidatorOracleHistory {
+		k.SetValidatorOracleStats(ctx, stats)
+	}
+
+	for _, status := range genState.ExchangeRateStatuses {
+		k.SetExchangeRateStatus(ctx, status)
+	}
+
+	for _, priceFeed := range genState.PriceFeeds {
+		k.SetPriceFeed(ctx, priceFeed)
+	}
+
+	for _, observation := range genState.DexObservations {
+		k.SetDexObservation(ctx, observation)
+	}
+
+	for _, params := range genState.Targets {
+		k.SetTargetParams(ctx, params)
+	}
+
 	k.SetParams(ctx, genState.Params)
 
+	// Only try to bind to port if it is not already bound, since we may already own
+	// port capability from capability InitGenesis
+	if !k.IsBound(ctx, types.PortID) {
+		// module binds to the port on InitChain
+		// and claims the returned capability
+		if err := k.BindPort(ctx, types.PortID); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+
 	// check if the module account exists
 	moduleAcc := k.GetOracleAccount(ctx)
 	if moduleAcc == nil {
@@ -104,10 +142,58 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	historicalExchangeRates := []types.ExchangeRateSnapshot{}
+	k.IterateHistoricalRates(ctx, func(snapshot types.ExchangeRateSnapshot) (stop bool) {
+		historicalExchangeRates = append(historicalExchangeRates, snapshot)
+		return false
+	})
+
+	validatorPerformances := []types.ValidatorPerformance{}
+	k.IterateValidatorPerformances(ctx, func(performance types.ValidatorPerformance) (stop bool) {
+		validatorPerformances = append(validatorPerformances, performance)
+		return false
+	})
+
+	validatorOracleHistory := []types.ValidatorOracleStats{}
+	k.IterateValidatorOracleHistory(ctx, func(stats types.ValidatorOracleStats) (stop bool) {
+		validatorOracleHistory = append(validatorOracleHistory, stats)
+		return false
+	})
+
+	exchangeRateStatuses := []types.ExchangeRateStatus{}
+	k.IterateExchangeRateStatuses(ctx, func(status types.ExchangeRateStatus) (stop bool) {
+		exchangeRateStatuses = append(exchangeRateStatuses, status)
+		return false
+	})
+
+	priceFeeds := []types.PriceFeed{}
+	k.IteratePriceFeeds(ctx, func(priceFeed types.PriceFeed) (stop bool) {
+		priceFeeds = append(priceFeeds, priceFeed)
+		return false
+	})
+
+	dexObservations := []types.DexObservation{}
+	k.IterateDexObservations(ctx, func(observation types.DexObservation) (stop bool) {
+		dexObservations = append(dexObservations, observation)
+		return false
+	})
+
+	targets := k.GetAllTargetParams(ctx)
+	if targets == nil {
+		targets = []types.TargetParams{}
+	}
+
 	return types.NewGenesis(params,
 		exchangeRates,
 		feederDelegations,
 		missCounters,
 		aggregateExchangeRatePrevotes,
-		aggregateExchangeRateVotes)
+		aggregateExchangeRateVotes,
+		historicalExchangeRates,
+		validatorPerformances,
+		validatorOracleHistory,
+		exchangeRateStatuses,
+		priceFeeds,
+		dexObservations,
+		targets)
 }
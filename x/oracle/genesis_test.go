@@ -18,6 +18,12 @@ func TestExportInitGenesis(t *testing.T) {
 	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, keeper.ValAddrs[0], types.NewAggregateExchangeRatePrevote(types.AggregateVoteHash{123}, keeper.ValAddrs[0], uint64(2)))
 	input.OracleKeeper.SetAggregateExchangeRateVote(input.Ctx, keeper.ValAddrs[0], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{{Denom: "foo", ExchangeRate: sdk.NewDec(123)}}, keeper.ValAddrs[0]))
 	input.OracleKeeper.SetMissCounter(input.Ctx, keeper.ValAddrs[0], 10)
+	input.OracleKeeper.AddValidatorPerformance(input.Ctx, keeper.ValAddrs[0], 3)
+	input.OracleKeeper.SetValidatorOracleStats(input.Ctx, types.ValidatorOracleStats{ValidatorAddress: keeper.ValAddrs[0].String(), WindowEndHeight: 99, VotePeriods: 20, Misses: 2, Wins: 18})
+	input.OracleKeeper.SetPriceFeed(input.Ctx, types.PriceFeed{Denom: "denom", Contract: "0x5FbDB2315678afecb367f032d93F642f64180aa3"})
+	input.OracleKeeper.SetDexObservation(input.Ctx, types.DexObservation{Denom: "denom", CumulativePrice: sdk.NewInt(123), Timestamp: 1000})
+	input.OracleKeeper.SetTargetParams(input.Ctx, types.TargetParams{Denom: "dex", Source: types.TARGET_SOURCE_DEX, SourceDexContract: "0x5FbDB2315678afecb367f032d93F642f64180aa3"})
+	input.OracleKeeper.SetExchangeRateStatus(input.Ctx, types.ExchangeRateStatus{Denom: "denom", ExchangeRate: sdk.NewDec(123), Height: 1, HeldRounds: 2})
 	genesis := oracle.ExportGenesis(input.Ctx, input.OracleKeeper)
 
 	newInput := keeper.CreateTestInput(t)
@@ -39,6 +39,10 @@ func NewOracleProposalHandler(k keeper.Keeper) govtypes.Handler {
 		switch c := content.(type) {
 		case *types.RegisterTargetProposal:
 			return keeper.HandleRegisterTargetProposal(ctx, k, c)
+		case *types.UpdateTargetProposal:
+			return keeper.HandleUpdateTargetProposal(ctx, k, c)
+		case *types.DeregisterTargetProposal:
+			return keeper.HandleDeregisterTargetProposal(ctx, k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
 		}
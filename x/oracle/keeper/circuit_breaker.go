@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/petri-labs/warmage/x/oracle/types"
+)
+
+// GetExchangeRateStatus returns the last exchange rate of denom accepted from a ballot.
+func (k Keeper) GetExchangeRateStatus(ctx sdk.Context, denom string) (types.ExchangeRateStatus, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetExchangeRateStatusKey(denom))
+	if bz == nil {
+		return types.ExchangeRateStatus{}, false
+	}
+
+	var status types.ExchangeRateStatus
+	k.cdc.MustUnmarshal(bz, &status)
+	return status, true
+}
+
+// SetExchangeRateStatus sets the last exchange rate of a denom accepted from a ballot.
+func (k Keeper) SetExchangeRateStatus(ctx sdk.Context, status types.ExchangeRateStatus) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&status)
+	store.Set(types.GetExchangeRateStatusKey(status.Denom), bz)
+}
+
+// DeleteExchangeRateStatus deletes the last exchange rate of denom accepted from a ballot.
+func (k Keeper) DeleteExchangeRateStatus(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetExchangeRateStatusKey(denom))
+}
+
+// IterateExchangeRateStatuses iterates over the last exchange rates of all denoms accepted from ballots.
+func (k Keeper) IterateExchangeRateStatuses(ctx sdk.Context, handler func(status types.ExchangeRateStatus) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ExchangeRateStatusKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var status types.ExchangeRateStatus
+		k.cdc.MustUnmarshal(iter.Value(), &status)
+		if handler(status) {
+			break
+		}
+	}
+}
+
+// IsExchangeRateHeld returns whether the exchange rate of denom is the last
+// accepted one held by the circuit breaker, rather than a fresh one.
+func (k Keeper) IsExchangeRateHeld(ctx sdk.Context, denom string) bool {
+	status, found := k.GetExchangeRateStatus(ctx, denom)
+	return found && status.HeldRounds > 0
+}
+
+// ApplyTalliedExchangeRate sets the exchange rate of denom tallied from its
+// ballot. If the rate moves beyond MaxPriceChange from the last accepted one,
+// the circuit breaker holds the last accepted rate instead, for up to
+// MaxHeldRounds consecutive vote periods; a move persisting longer is
+// accepted.
+func (k Keeper) ApplyTalliedExchangeRate(ctx sdk.Context, denom string, exchangeRate sdk.Dec) {
+	if status, found := k.GetExchangeRateStatus(ctx, denom); found && status.ExchangeRate.IsPositive() {
+		change := exchangeRate.Sub(status.ExchangeRate).Abs().Quo(status.ExchangeRate)
+		if change.GT(k.MaxPriceChange(ctx)) &&
+			k.holdExchangeRate(ctx, status, types.AttributeValuePriceMoveLimit,
+				sdk.NewAttribute(types.AttributeKeyExchangeRate, exchangeRate.String())) {
+			return
+		}
+	}
+
+	k.SetExchangeRate(ctx, denom, exchangeRate)
+	k.AddHistoricalRate(ctx, denom, exchangeRate)
+	k.SetExchangeRateStatus(ctx, types.ExchangeRateStatus{
+		Denom:        denom,
+		ExchangeRate: exchangeRate,
+		Time:         ctx.BlockTime(),
+		Height:       ctx.BlockHeight(),
+	})
+}
+
+// HoldFailedExchangeRate holds the last accepted exchange rate of denom whose
+// ballot failed, for up to MaxHeldRounds consecutive vote periods. The denom
+// has no exchange rate afterwards.
+func (k Keeper) HoldFailedExchangeRate(ctx sdk.Context, denom string) {
+	status, found := k.GetExchangeRateStatus(ctx, denom)
+	if !found {
+		return
+	}
+
+	if !k.holdExchangeRate(ctx, status, types.AttributeValueBallotFailed) {
+		k.DeleteExchangeRateStatus(ctx, denom)
+	}
+}
+
+// holdExchangeRate holds the last accepted exchange rate for one more vote
+// period, unless it has been held for MaxHeldRounds already.
+func (k Keeper) holdExchangeRate(ctx sdk.Context, status types.ExchangeRateStatus, reason string, attrs ...sdk.Attribute) bool {
+	if status.HeldRounds >= k.MaxHeldRounds(ctx) {
+		return false
+	}
+
+	status.HeldRounds++
+	k.SetExchangeRateStatus(ctx, status)
+	k.SetExchangeRate(ctx, status.Denom, status.ExchangeRate)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypePriceCircuitTrip,
+			append([]sdk.Attribute{
+				sdk.NewAttribute(types.AttributeKeyDenom, status.Denom),
+				sdk.NewAttribute(types.AttributeKeyReason, reason),
+				sdk.NewAttribute(types.AttributeKeyHeldRate, status.ExchangeRate.String()),
+				sdk.NewAttribute(types.AttributeKeyHeldRounds, strconv.FormatUint(status.HeldRounds, 10)),
+			}, attrs...)...,
+		),
+	)
+	return true
+}
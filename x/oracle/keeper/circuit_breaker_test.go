@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/petri-labs/warmage/x/oracle/types"
+)
+
+func countCircuitTrips(ctx sdk.Context) (trips int) {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypePriceCircuitTrip {
+			trips++
+		}
+	}
+	return trips
+}
+
+func TestApplyTalliedExchangeRate(t *testing.T) {
+	input := CreateTestInput(t)
+	k := input.OracleKeeper
+	params := k.GetParams(input.Ctx)
+	params.MaxPriceChange = sdk.NewDecWithPrec(2, 1)
+	params.MaxHeldRounds = 2
+	k.SetParams(input.Ctx, params)
+
+	ctx := input.Ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0)).WithEventManager(sdk.NewEventManager())
+	rate := sdk.NewDec(100)
+
+	// The first tallied exchange rate is accepted
+	k.ApplyTalliedExchangeRate(ctx, fooDenom1, rate)
+	status, found := k.GetExchangeRateStatus(ctx, fooDenom1)
+	require.True(t, found)
+	require.Equal(t, rate, status.ExchangeRate)
+	require.Equal(t, int64(10), status.Height)
+	require.Equal(t, uint64(0), status.HeldRounds)
+	require.False(t, k.IsExchangeRateHeld(ctx, fooDenom1))
+
+	// A move within the bound is accepted
+	ctx = ctx.WithBlockHeight(11)
+	rate = sdk.NewDec(120)
+	k.ApplyTalliedExchangeRate(ctx, fooDenom1, rate)
+	got, err := k.GetExchangeRate(ctx, fooDenom1)
+	require.NoError(t, err)
+	require.Equal(t, rate, got)
+	require.False(t, k.IsExchangeRateHeld(ctx, fooDenom1))
+	require.Equal(t, 0, countCircuitTrips(ctx))
+
+	// A move beyond the bound trips the circuit, holding the last accepted rate
+	for i := 1; i <= 2; i++ {
+		ctx = ctx.WithBlockHeight(11 + int64(i))
+		k.ApplyTalliedExchangeRate(ctx, fooDenom1, sdk.NewDec(200))
+		got, err = k.GetExchangeRate(ctx, fooDenom1)
+		require.NoError(t, err)
+		require.Equal(t, rate, got)
+		status, _ = k.GetExchangeRateStatus(ctx, fooDenom1)
+		require.Equal(t, uint64(i), status.HeldRounds)
+		require.Equal(t, int64(11), status.Height)
+		require.True(t, k.IsExchangeRateHeld(ctx, fooDenom1))
+		require.Equal(t, i, countCircuitTrips(ctx))
+	}
+
+	// The move persisting beyond MaxHeldRounds is accepted
+	ctx = ctx.WithBlockHeight(14)
+	k.ApplyTalliedExchangeRate(ctx, fooDenom1, sdk.NewDec(200))
+	got, err = k.GetExchangeRate(ctx, fooDenom1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(200), got)
+	status, _ = k.GetExchangeRateStatus(ctx, fooDenom1)
+	require.Equal(t, int64(14), status.Height)
+	require.False(t, k.IsExchangeRateHeld(ctx, fooDenom1))
+}
+
+func TestHoldFailedExchangeRate(t *testing.T) {
+	input := CreateTestInput(t)
+	k := input.OracleKeeper
+	params := k.GetParams(input.Ctx)
+	params.MaxHeldRounds = 2
+	k.SetParams(input.Ctx, params)
+
+	ctx := input.Ctx.WithEventManager(sdk.NewEventManager())
+	rate := sdk.NewDec(100)
+
+	// Nothing to hold without an accepted exchange rate
+	k.HoldFailedExchangeRate(ctx, fooDenom1)
+	_, err := k.GetExchangeRate(ctx, fooDenom1)
+	require.Error(t, err)
+
+	k.ApplyTalliedExchangeRate(ctx, fooDenom1, rate)
+
+	// The last accepted rate is held for MaxHeldRounds
+	for i := 1; i <= 2; i++ {
+		k.DeleteExchangeRate(ctx, fooDenom1)
+		k.HoldFailedExchangeRate(ctx, fooDenom1)
+		got, err := k.GetExchangeRate(ctx, fooDenom1)
+		require.NoError(t, err)
+		require.Equal(t, rate, got)
+		require.True(t, k.IsExchangeRateHeld(ctx, fooDenom1))
+	}
+	require.Equal(t, 2, countCircuitTrips(ctx))
+
+	// Then the denom has no exchange rate
+	k.DeleteExchangeRate(ctx, fooDenom1)
+	k.HoldFailedExchangeRate(ctx, fooDenom1)
+	_, err = k.GetExchangeRate(ctx, fooDenom1)
+	require.Error(t, err)
+	_, found := k.GetExchangeRateStatus(ctx, fooDenom1)
+	require.False(t, found)
+}
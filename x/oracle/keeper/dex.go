@@ -0,0 +1,325 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/petri-labs/warmage/x/oracle/types"
+)
+
+// uint256Modulus is the modulus of the uint256 price accumulators of the DEX
+// pair contracts, which are allowed to overflow.
+var uint256Modulus = new(big.Int).Lsh(big.NewInt(1), 256)
+
+// dexReserves are the reserves of a DEX pair of a target denom.
+type dexReserves struct {
+	quoteDenom   string
+	targetAmount sdk.Int
+	quoteAmount  sdk.Int
+	// timestamp is the block timestamp modulo 2^32 at which the price
+	// accumulators of the pair were last updated
+	timestamp uint32
+	// cumulativePriceMethod reads the price accumulator of the target denom
+	cumulativePriceMethod string
+}
+
+// spotPrice returns the price of the target denom in the quote denom.
+func (r dexReserves) spotPrice() sdk.Dec {
+	return sdk.NewDecFromInt(r.quoteAmount).QuoInt(r.targetAmount)
+}
+
+// IsDexTarget returns existence of a denom in the DEX target list.
+func (k Keeper) IsDexTarget(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetDexTargetKey(denom))
+}
+
+// GetDexTarget returns the DEX pair contract quoting the denom.
+func (k Keeper) GetDexTarget(ctx sdk.Context, denom string) (common.Address, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDexTargetKey(denom))
+	if bz == nil {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(bz), true
+}
+
+// SetDexTarget sets the DEX pair contract quoting the denom.
+func (k Keeper) SetDexTarget(ctx sdk.Context, denom string, contract common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDexTargetKey(denom), contract.Bytes())
+}
+
+// DeleteDexTarget deletes the DEX pair contract quoting the denom, along with
+// its last price observation.
+func (k Keeper) DeleteDexTarget(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDexTargetKey(denom))
+	store.Delete(types.GetDexObservationKey(denom))
+}
+
+// IterateDexTargets iterates over DEX targets in the store.
+func (k Keeper) IterateDexTargets(ctx sdk.Context, handler func(denom string, contract common.Address) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DexTargetKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom := types.ExtractDenomFromDexTargetKey(iter.Key())
+		if handler(denom, common.BytesToAddress(iter.Value())) {
+			break
+		}
+	}
+}
+
+// GetDexObservation returns the last price observation of the pair contract
+// of a DEX target.
+func (k Keeper) GetDexObservation(ctx sdk.Context, denom string) (observation types.DexObservation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDexObservationKey(denom))
+	if bz == nil {
+		return observation, false
+	}
+	k.cdc.MustUnmarshal(bz, &observation)
+	return observation, true
+}
+
+// SetDexObservation sets the last price observation of the pair contract of a
+// DEX target.
+func (k Keeper) SetDexObservation(ctx sdk.Context, observation types.DexObservation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDexObservationKey(observation.Denom), k.cdc.MustMarshal(&observation))
+}
+
+// IterateDexObservations iterates over the last price observations of the
+// pair contracts of all DEX targets.
+func (k Keeper) IterateDexObservations(ctx sdk.Context, handler func(observation types.DexObservation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DexObservationKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var observation types.DexObservation
+		k.cdc.MustUnmarshal(iter.Value(), &observation)
+		if handler(observation) {
+			break
+		}
+	}
+}
+
+// GetDexExchangeRate quotes the exchange rate of the denom from the DEX pair
+// contract, as the time-weighted average price of the pair since its last
+// observation, priced by the exchange rate of the counterpart token. Only the
+// pair contracts of DEX targets are observed, at the end of each vote period.
+func (k Keeper) GetDexExchangeRate(ctx sdk.Context, denom string, contract common.Address) (sdk.Dec, error) {
+	last, found := k.GetDexObservation(ctx, denom)
+	if target, ok := k.GetDexTarget(ctx, denom); !ok || target != contract || !found {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidDexQuotation, "no price observation of DEX pair %s for %s", contract, denom)
+	}
+
+	observation, reserves, err := k.observeDex(ctx, denom, contract)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return k.quoteDexExchangeRate(ctx, contract, last, observation, reserves)
+}
+
+// UpdateDexExchangeRates sets the exchange rates of DEX targets quoted from
+// the time-weighted average prices of their pair contracts over the vote
+// period. It must be called after the exchange rates tallied from validators
+// have been set. A DEX target is first quoted in the vote period after its
+// pair contract has been observed.
+func (k Keeper) UpdateDexExchangeRates(ctx sdk.Context) {
+	var denoms []string
+	contracts := make(map[string]common.Address)
+	k.IterateDexTargets(ctx, func(denom string, contract common.Address) bool {
+		denoms = append(denoms, denom)
+		contracts[denom] = contract
+		return false
+	})
+
+	for _, denom := range denoms {
+		contract := contracts[denom]
+
+		// The pair contract is only read, so discard any state changes of the EVM calls
+		cacheCtx, _ := ctx.CacheContext()
+		observation, reserves, err := k.observeDex(cacheCtx, denom, contract)
+		if err != nil {
+			k.Logger(ctx).Error("failed to observe DEX pair", "denom", denom, "contract", contract.Hex(), "err", err)
+			continue
+		}
+
+		last, found := k.GetDexObservation(ctx, denom)
+		k.SetDexObservation(ctx, observation)
+		if !found {
+			continue
+		}
+
+		exchangeRate, err := k.quoteDexExchangeRate(ctx, contract, last, observation, reserves)
+		if err != nil {
+			k.Logger(ctx).Error("failed to quote exchange rate from DEX", "denom", denom, "contract", contract.Hex(), "err", err)
+			continue
+		}
+
+		k.setSourcedExchangeRate(ctx, denom, exchangeRate)
+	}
+}
+
+// quoteDexExchangeRate quotes the exchange rate of a DEX target from the
+// time-weighted average price of its pair contract between two observations.
+// The quotation is dropped if the spot price of the pair deviates from the
+// average price beyond DexPriceBand, as the pair is then being moved.
+func (k Keeper) quoteDexExchangeRate(ctx sdk.Context, contract common.Address, last, observation types.DexObservation, reserves dexReserves) (sdk.Dec, error) {
+	elapsed := observation.Timestamp - last.Timestamp
+	if elapsed == 0 {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidDexQuotation, "no time elapsed since the last price observation of DEX pair %s", contract)
+	}
+
+	// The accumulators are UQ112x112 numbers which may overflow
+	delta := new(big.Int).Sub(observation.CumulativePrice.BigInt(), last.CumulativePrice.BigInt())
+	delta.Mod(delta, uint256Modulus)
+	delta.Quo(delta, big.NewInt(int64(elapsed)))
+	delta.Mul(delta, sdk.NewIntWithDecimal(1, sdk.Precision).BigInt())
+	averagePrice := sdk.NewDecFromBigIntWithPrec(delta.Rsh(delta, 112), sdk.Precision)
+	if !averagePrice.IsPositive() {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidDexQuotation, "zero average price of DEX pair %s", contract)
+	}
+
+	spotPrice := reserves.spotPrice()
+	if spotPrice.Sub(averagePrice).Abs().GT(averagePrice.Mul(k.DexPriceBand(ctx))) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidDexQuotation, "spot price %s of DEX pair %s out of band of average price %s", spotPrice, contract, averagePrice)
+	}
+
+	quoteRate, err := k.GetExchangeRate(ctx, reserves.quoteDenom)
+	if err != nil {
+		return sdk.Dec{}, sdkerrors.Wrapf(err, "no exchange rate of quote denom %s", reserves.quoteDenom)
+	}
+
+	exchangeRate := quoteRate.Mul(averagePrice)
+	if !exchangeRate.IsPositive() {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidDexQuotation, "zero exchange rate of %s from DEX pair %s", observation.Denom, contract)
+	}
+	return exchangeRate, nil
+}
+
+// observeDex reads the price accumulator of the denom from the DEX pair
+// contract at the current block. The price since the last update of the
+// accumulator is accumulated as the pair would do on its next update.
+func (k Keeper) observeDex(ctx sdk.Context, denom string, contract common.Address) (types.DexObservation, dexReserves, error) {
+	reserves, err := k.queryDexReserves(ctx, denom, contract)
+	if err != nil {
+		return types.DexObservation{}, dexReserves{}, err
+	}
+	if !reserves.targetAmount.IsPositive() || !reserves.quoteAmount.IsPositive() {
+		return types.DexObservation{}, dexReserves{}, sdkerrors.Wrapf(types.ErrInvalidDexQuotation, "empty reserves of DEX pair %s", contract)
+	}
+
+	pair := types.UniswapV2PairABI
+	res, err := k.erc20Keeper.CallEVM(ctx, pair, k.moduleEVMAddress(), contract, reserves.cumulativePriceMethod)
+	if err != nil {
+		return types.DexObservation{}, dexReserves{}, err
+	}
+	unpacked, err := pair.Unpack(reserves.cumulativePriceMethod, res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return types.DexObservation{}, dexReserves{}, sdkerrors.Wrapf(types.ErrInvalidDexQuotation, "failed to unpack %s of DEX pair %s", reserves.cumulativePriceMethod, contract)
+	}
+	cumulativePrice, ok := unpacked[0].(*big.Int)
+	if !ok {
+		return types.DexObservation{}, dexReserves{}, sdkerrors.Wrapf(types.ErrInvalidDexQuotation, "failed to unpack %s of DEX pair %s", reserves.cumulativePriceMethod, contract)
+	}
+
+	timestamp := uint32(ctx.BlockTime().Unix())
+	if elapsed := timestamp - reserves.timestamp; elapsed > 0 {
+		price := new(big.Int).Lsh(reserves.quoteAmount.BigInt(), 112)
+		price.Quo(price, reserves.targetAmount.BigInt())
+		cumulativePrice = new(big.Int).Add(cumulativePrice, price.Mul(price, big.NewInt(int64(elapsed))))
+		cumulativePrice.Mod(cumulativePrice, uint256Modulus)
+	}
+
+	return types.DexObservation{
+		Denom:           denom,
+		CumulativePrice: sdk.NewIntFromBigInt(cumulativePrice),
+		Timestamp:       timestamp,
+	}, reserves, nil
+}
+
+// queryDexReserves reads the reserves of the DEX pair contract, one token of
+// which must be the denom.
+func (k Keeper) queryDexReserves(ctx sdk.Context, denom string, contract common.Address) (dexReserves, error) {
+	denom0, err := k.queryDexPairDenom(ctx, contract, "token0")
+	if err != nil {
+		return dexReserves{}, err
+	}
+	denom1, err := k.queryDexPairDenom(ctx, contract, "token1")
+	if err != nil {
+		return dexReserves{}, err
+	}
+
+	pair := types.UniswapV2PairABI
+	res, err := k.erc20Keeper.CallEVM(ctx, pair, k.moduleEVMAddress(), contract, "getReserves")
+	if err != nil {
+		return dexReserves{}, err
+	}
+	unpacked, err := pair.Unpack("getReserves", res.Ret)
+	if err != nil || len(unpacked) < 3 {
+		return dexReserves{}, sdkerrors.Wrapf(types.ErrInvalidDexQuotation, "failed to unpack reserves of DEX pair %s", contract)
+	}
+	reserve0, ok0 := unpacked[0].(*big.Int)
+	reserve1, ok1 := unpacked[1].(*big.Int)
+	timestamp, ok2 := unpacked[2].(uint32)
+	if !ok0 || !ok1 || !ok2 {
+		return dexReserves{}, sdkerrors.Wrapf(types.ErrInvalidDexQuotation, "failed to unpack reserves of DEX pair %s", contract)
+	}
+
+	switch denom {
+	case denom0:
+		return dexReserves{
+			quoteDenom:            denom1,
+			targetAmount:          sdk.NewIntFromBigInt(reserve0),
+			quoteAmount:           sdk.NewIntFromBigInt(reserve1),
+			timestamp:             timestamp,
+			cumulativePriceMethod: "price0CumulativeLast",
+		}, nil
+	case denom1:
+		return dexReserves{
+			quoteDenom:            denom0,
+			targetAmount:          sdk.NewIntFromBigInt(reserve1),
+			quoteAmount:           sdk.NewIntFromBigInt(reserve0),
+			timestamp:             timestamp,
+			cumulativePriceMethod: "price1CumulativeLast",
+		}, nil
+	default:
+		return dexReserves{}, sdkerrors.Wrapf(types.ErrInvalidDexQuotation, "DEX pair %s is not a pair of %s", contract, denom)
+	}
+}
+
+// queryDexPairDenom reads a token of the DEX pair contract, and returns the
+// denom of the token pair registered in the erc20 module.
+func (k Keeper) queryDexPairDenom(ctx sdk.Context, contract common.Address, method string) (string, error) {
+	pair := types.UniswapV2PairABI
+	res, err := k.erc20Keeper.CallEVM(ctx, pair, k.moduleEVMAddress(), contract, method)
+	if err != nil {
+		return "", err
+	}
+	unpacked, err := pair.Unpack(method, res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return "", sdkerrors.Wrapf(types.ErrInvalidDexQuotation, "failed to unpack %s of DEX pair %s", method, contract)
+	}
+	token, ok := unpacked[0].(common.Address)
+	if !ok {
+		return "", sdkerrors.Wrapf(types.ErrInvalidDexQuotation, "failed to unpack %s of DEX pair %s", method, contract)
+	}
+
+	tokenPair, found := k.erc20Keeper.GetTokenPair(ctx, k.erc20Keeper.GetTokenPairID(ctx, token.Hex()))
+	if !found {
+		return "", sdkerrors.Wrapf(types.ErrInvalidDexQuotation, "token %s of DEX pair %s is not registered", token, contract)
+	}
+	return tokenPair.Denom, nil
+}
+
+// moduleEVMAddress returns the EVM address of the oracle module account,
+// from which the DEX pair contracts are called.
+func (k Keeper) moduleEVMAddress() common.Address {
+	return common.BytesToAddress(k.accountKeeper.GetModuleAddress(types.ModuleName))
+}
@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	warmage "github.com/petri-labs/warmage/types"
+	erc20types "github.com/petri-labs/warmage/x/erc20/types"
+	"github.com/petri-labs/warmage/x/oracle/types"
+)
+
+type mockDexPair struct {
+	token0, token1                             common.Address
+	reserve0, reserve1                         *big.Int
+	timestamp                                  uint32
+	price0CumulativeLast, price1CumulativeLast *big.Int
+}
+
+// mockErc20Keeper serves token pairs and Uniswap V2 compatible pair contracts.
+type mockErc20Keeper struct {
+	denoms map[common.Address]string
+	pairs  map[common.Address]*mockDexPair
+}
+
+func (m mockErc20Keeper) CallEVM(_ sdk.Context, abi abi.ABI, _, contract common.Address, method string, _ ...interface{}) (*evmtypes.MsgEthereumTxResponse, error) {
+	pair, ok := m.pairs[contract]
+	if !ok {
+		return nil, evmtypes.ErrVMExecution
+	}
+
+	var outputs []interface{}
+	switch method {
+	case "token0":
+		outputs = []interface{}{pair.token0}
+	case "token1":
+		outputs = []interface{}{pair.token1}
+	case "getReserves":
+		outputs = []interface{}{pair.reserve0, pair.reserve1, pair.timestamp}
+	case "price0CumulativeLast":
+		outputs = []interface{}{pair.price0CumulativeLast}
+	case "price1CumulativeLast":
+		outputs = []interface{}{pair.price1CumulativeLast}
+	}
+	ret, err := abi.Methods[method].Outputs.Pack(outputs...)
+	if err != nil {
+		return nil, err
+	}
+	return &evmtypes.MsgEthereumTxResponse{Ret: ret}, nil
+}
+
+func (m mockErc20Keeper) CallEVMWithData(_ sdk.Context, _ common.Address, _ *common.Address, _ []byte) (*evmtypes.MsgEthereumTxResponse, error) {
+	return nil, evmtypes.ErrVMExecution
+}
+
+func (m mockErc20Keeper) GetTokenPairID(_ sdk.Context, token string) []byte {
+	return common.HexToAddress(token).Bytes()
+}
+
+func (m mockErc20Keeper) GetTokenPair(_ sdk.Context, id []byte) (erc20types.TokenPair, bool) {
+	token := common.BytesToAddress(id)
+	denom, ok := m.denoms[token]
+	if !ok {
+		return erc20types.TokenPair{}, false
+	}
+	return erc20types.TokenPair{Erc20Address: token.Hex(), Denom: denom}, true
+}
+
+func setupDexTest(t *testing.T) (TestInput, common.Address) {
+	input := CreateTestInput(t)
+	require.NoError(t, FundAccount(input, Addrs[0], sdk.NewCoins(sdk.NewInt64Coin(fooDenom3, 1000))))
+
+	tokenFoo := common.BytesToAddress([]byte("foo_token"))
+	tokenUSW := common.BytesToAddress([]byte("usw_token"))
+	tokenBar := common.BytesToAddress([]byte("bar_token"))
+	pairFooUSW := common.BytesToAddress([]byte("foo_usw_pair"))
+	pairBarUSW := common.BytesToAddress([]byte("bar_usw_pair"))
+
+	input.OracleKeeper.erc20Keeper = mockErc20Keeper{
+		denoms: map[common.Address]string{
+			tokenFoo: fooDenom3,
+			tokenUSW: warmage.MicroUSWDenom,
+			tokenBar: fooDenom6,
+		},
+		pairs: map[common.Address]*mockDexPair{
+			pairFooUSW: {token0: tokenUSW, token1: tokenFoo, reserve0: big.NewInt(2000), reserve1: big.NewInt(1000), price0CumulativeLast: big.NewInt(0), price1CumulativeLast: big.NewInt(0)},
+			pairBarUSW: {token0: tokenBar, token1: tokenUSW, reserve0: big.NewInt(1000), reserve1: big.NewInt(500), price0CumulativeLast: big.NewInt(0), price1CumulativeLast: big.NewInt(0)},
+		},
+	}
+	return input, pairFooUSW
+}
+
+func TestRegisterDexTarget(t *testing.T) {
+	input, pair := setupDexTest(t)
+	k := input.OracleKeeper
+
+	proposal := &types.RegisterTargetProposal{
+		TargetParams: types.TargetParams{
+			Denom:             fooDenom3,
+			Source:            types.TARGET_SOURCE_DEX,
+			SourceDexContract: common.BytesToAddress([]byte("bar_usw_pair")).Hex(),
+		},
+	}
+	require.NoError(t, proposal.ValidateBasic())
+	// not a pair of the target denom
+	require.ErrorIs(t, HandleRegisterTargetProposal(input.Ctx, k, proposal), types.ErrInvalidDexQuotation)
+	require.False(t, k.IsTarget(input.Ctx, fooDenom3))
+
+	proposal.TargetParams.SourceDexContract = "invalid"
+	require.Error(t, proposal.ValidateBasic())
+
+	proposal.TargetParams.SourceDexContract = pair.Hex()
+	require.NoError(t, HandleRegisterTargetProposal(input.Ctx, k, proposal))
+	require.True(t, k.IsTarget(input.Ctx, fooDenom3))
+	require.False(t, k.IsVoteTarget(input.Ctx, fooDenom3))
+	contract, found := k.GetDexTarget(input.Ctx, fooDenom3)
+	require.True(t, found)
+	require.Equal(t, pair, contract)
+}
+
+// uq112x112 returns the price accumulated over the seconds as a UQ112x112 number.
+func uq112x112(price, seconds int64) *big.Int {
+	return new(big.Int).Mul(new(big.Int).Lsh(big.NewInt(price), 112), big.NewInt(seconds))
+}
+
+func TestUpdateDexExchangeRates(t *testing.T) {
+	input, pair := setupDexTest(t)
+	k := input.OracleKeeper
+	k.SetDexTarget(input.Ctx, fooDenom3, pair)
+	mockPair := k.erc20Keeper.(mockErc20Keeper).pairs[pair]
+
+	// the pair is first observed
+	ctx := input.Ctx.WithBlockTime(time.Unix(1000, 0))
+	k.SetExchangeRate(ctx, warmage.MicroUSWDenom, sdk.NewDecWithPrec(99, 2))
+	k.UpdateDexExchangeRates(ctx)
+	_, err := k.GetExchangeRate(ctx, fooDenom3)
+	require.Error(t, err)
+	observation, found := k.GetDexObservation(ctx, fooDenom3)
+	require.True(t, found)
+	require.Equal(t, types.DexObservation{Denom: fooDenom3, CumulativePrice: sdk.NewIntFromBigInt(uq112x112(2, 1000)), Timestamp: 1000}, observation)
+
+	// no exchange rate of the quote denom
+	ctx = ctx.WithBlockTime(time.Unix(1006, 0))
+	k.DeleteExchangeRate(ctx, warmage.MicroUSWDenom)
+	k.UpdateDexExchangeRates(ctx)
+	_, err = k.GetExchangeRate(ctx, fooDenom3)
+	require.Error(t, err)
+
+	// 2000uusw * 0.99 / 1000bar3 on average
+	ctx = ctx.WithBlockTime(time.Unix(1012, 0))
+	k.SetExchangeRate(ctx, warmage.MicroUSWDenom, sdk.NewDecWithPrec(99, 2))
+	k.UpdateDexExchangeRates(ctx)
+	rate, err := k.GetExchangeRate(ctx, fooDenom3)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(198, 2), rate)
+	snapshot, err := k.GetHistoricalRate(ctx, fooDenom3, ctx.BlockHeight())
+	require.NoError(t, err)
+	require.Equal(t, rate, snapshot.ExchangeRate)
+
+	// the pair is moved within the block, which the average price does not follow
+	ctx = ctx.WithBlockTime(time.Unix(1018, 0))
+	k.DeleteExchangeRate(ctx, fooDenom3)
+	mockPair.reserve0 = big.NewInt(4000)
+	mockPair.timestamp = 1018
+	mockPair.price1CumulativeLast = uq112x112(2, 1018)
+	k.UpdateDexExchangeRates(ctx)
+	_, err = k.GetExchangeRate(ctx, fooDenom3)
+	require.Error(t, err)
+
+	// 4000uusw * 0.99 / 1000bar3 for 3 seconds, then 4500uusw * 0.99 / 1000bar3 for 3 seconds
+	ctx = ctx.WithBlockTime(time.Unix(1024, 0))
+	mockPair.reserve0 = big.NewInt(4500)
+	mockPair.timestamp = 1021
+	mockPair.price1CumulativeLast = new(big.Int).Add(uq112x112(2, 1018), uq112x112(4, 3))
+	k.UpdateDexExchangeRates(ctx)
+	rate, err = k.GetExchangeRate(ctx, fooDenom3)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(42075, 4), rate)
+
+	// the price accumulator overflows
+	ctx = ctx.WithBlockTime(time.Unix(1030, 0))
+	k.DeleteExchangeRate(ctx, fooDenom3)
+	mockPair.reserve0 = big.NewInt(2000)
+	mockPair.timestamp = 1030
+	mockPair.price1CumulativeLast = big.NewInt(0)
+	k.SetDexObservation(ctx, types.DexObservation{
+		Denom:           fooDenom3,
+		CumulativePrice: sdk.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), uq112x112(2, 6))),
+		Timestamp:       1024,
+	})
+	k.UpdateDexExchangeRates(ctx)
+	rate, err = k.GetExchangeRate(ctx, fooDenom3)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(198, 2), rate)
+
+	// the observation is deleted along with the target
+	k.DeleteDexTarget(ctx, fooDenom3)
+	_, found = k.GetDexObservation(ctx, fooDenom3)
+	require.False(t, found)
+}
+
+func TestGetDexExchangeRate(t *testing.T) {
+	input, pair := setupDexTest(t)
+	k := input.OracleKeeper
+	ctx := input.Ctx.WithBlockTime(time.Unix(1000, 0))
+	k.SetExchangeRate(ctx, warmage.MicroUSWDenom, sdk.NewDecWithPrec(99, 2))
+
+	// not a DEX target
+	_, err := k.GetDexExchangeRate(ctx, fooDenom3, pair)
+	require.ErrorIs(t, err, types.ErrInvalidDexQuotation)
+
+	// not observed yet
+	k.SetDexTarget(ctx, fooDenom3, pair)
+	_, err = k.GetDexExchangeRate(ctx, fooDenom3, pair)
+	require.ErrorIs(t, err, types.ErrInvalidDexQuotation)
+
+	// no time elapsed since the observation
+	k.UpdateDexExchangeRates(ctx)
+	_, err = k.GetDexExchangeRate(ctx, fooDenom3, pair)
+	require.ErrorIs(t, err, types.ErrInvalidDexQuotation)
+
+	// not the pair contract of the DEX target
+	ctx = ctx.WithBlockTime(time.Unix(1006, 0))
+	_, err = k.GetDexExchangeRate(ctx, fooDenom3, common.BytesToAddress([]byte("bar_usw_pair")))
+	require.ErrorIs(t, err, types.ErrInvalidDexQuotation)
+
+	rate, err := k.GetDexExchangeRate(ctx, fooDenom3, pair)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(198, 2), rate)
+}
@@ -3,7 +3,10 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/petri-labs/warmage/x/oracle/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -54,6 +57,42 @@ func (k Keeper) ExchangeRates(c context.Context, req *types.QueryExchangeRatesRe
 	return &types.QueryExchangeRatesResponse{ExchangeRates: exchangeRates}, nil
 }
 
+func (k Keeper) ExchangeRateStatus(c context.Context, req *types.QueryExchangeRateStatusRequest) (*types.QueryExchangeRateStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	exchangeRateStatus, found := k.GetExchangeRateStatus(ctx, req.Denom)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownDenom, req.Denom)
+	}
+
+	return &types.QueryExchangeRateStatusResponse{ExchangeRateStatus: exchangeRateStatus}, nil
+}
+
+func (k Keeper) PriceFeed(c context.Context, req *types.QueryPriceFeedRequest) (*types.QueryPriceFeedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contract, found := k.GetPriceFeed(ctx, req.Denom)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownDenom, req.Denom)
+	}
+
+	return &types.QueryPriceFeedResponse{Address: contract.Hex()}, nil
+}
+
 func (k Keeper) Actives(c context.Context, req *types.QueryActivesRequest) (*types.QueryActivesResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -88,6 +127,41 @@ func (k Keeper) Targets(c context.Context, req *types.QueryTargetsRequest) (*typ
 	return &types.QueryTargetsResponse{Targets: k.GetTargets(ctx)}, nil
 }
 
+func (k Keeper) TargetParams(c context.Context, req *types.QueryTargetParamsRequest) (*types.QueryTargetParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryTargetParamsResponse{TargetParams: k.GetAllTargetParams(ctx)}, nil
+}
+
+func (k Keeper) RewardPool(c context.Context, req *types.QueryRewardPoolRequest) (*types.QueryRewardPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var inflows sdk.Coins
+	k.IterateRewardPoolInflows(ctx, func(inflow sdk.Coin) (stop bool) {
+		inflows = inflows.Add(inflow)
+		return false
+	})
+
+	return &types.QueryRewardPoolResponse{Balances: k.GetRewardPoolBalances(ctx), Inflows: inflows}, nil
+}
+
+func (k Keeper) PeriodRewards(c context.Context, req *types.QueryPeriodRewardsRequest) (*types.QueryPeriodRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	periodRewards := k.GetPeriodRewards(ctx, int64(k.VotePeriod(ctx)), int64(k.RewardDistributionWindow(ctx)))
+	return &types.QueryPeriodRewardsResponse{PeriodRewards: periodRewards}, nil
+}
+
 func (k Keeper) FeederDelegation(c context.Context, req *types.QueryFeederDelegationRequest) (*types.QueryFeederDelegationResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -120,6 +194,39 @@ func (k Keeper) MissCounter(c context.Context, req *types.QueryMissCounterReques
 	}, nil
 }
 
+func (k Keeper) ValidatorOracleHistory(c context.Context, req *types.QueryValidatorOracleHistoryRequest) (*types.QueryValidatorOracleHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetValidatorOracleHistoryKey(valAddr))
+
+	var history []types.ValidatorOracleStats
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var stats types.ValidatorOracleStats
+		if err := k.cdc.Unmarshal(value, &stats); err != nil {
+			return err
+		}
+		history = append(history, stats)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorOracleHistoryResponse{
+		Current:    k.GetCurrentValidatorOracleStats(ctx, valAddr),
+		History:    history,
+		Pagination: pageRes,
+	}, nil
+}
+
 func (k Keeper) AggregatePrevote(c context.Context, req *types.QueryAggregatePrevoteRequest) (*types.QueryAggregatePrevoteResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -197,3 +304,43 @@ func (k Keeper) AggregateVotes(c context.Context, req *types.QueryAggregateVotes
 		AggregateVotes: votes,
 	}, nil
 }
+
+func (k Keeper) TWAP(c context.Context, req *types.QueryTWAPRequest) (*types.QueryTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	if req.Window <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "window must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	twap, err := k.GetTWAP(ctx, req.Denom, req.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTWAPResponse{Twap: twap}, nil
+}
+
+func (k Keeper) HistoricalRate(c context.Context, req *types.QueryHistoricalRateRequest) (*types.QueryHistoricalRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	snapshot, err := k.GetHistoricalRate(ctx, req.Denom, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryHistoricalRateResponse{Snapshot: snapshot}, nil
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"bytes"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	warmage "github.com/petri-labs/warmage/types"
 	"github.com/petri-labs/warmage/x/oracle/types"
 	"github.com/stretchr/testify/require"
 	"sort"
@@ -46,6 +48,23 @@ func TestQueryExchangeRate(t *testing.T) {
 	require.Equal(t, rate, res.ExchangeRate)
 }
 
+func TestQueryPriceFeed(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	_, err := querier.PriceFeed(ctx, &types.QueryPriceFeedRequest{Denom: warmage.AttoMageDenom})
+	require.Error(t, err)
+
+	input.OracleKeeper.SetExchangeRate(input.Ctx, warmage.AttoMageDenom, sdk.OneDec())
+	input.OracleKeeper.SyncPriceFeeds(input.Ctx)
+	res, err := querier.PriceFeed(ctx, &types.QueryPriceFeedRequest{Denom: warmage.AttoMageDenom})
+	require.NoError(t, err)
+	contract, found := input.OracleKeeper.GetPriceFeed(input.Ctx, warmage.AttoMageDenom)
+	require.True(t, found)
+	require.Equal(t, contract.Hex(), res.Address)
+}
+
 func TestQueryMissCounter(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx := sdk.WrapSDKContext(input.Ctx)
@@ -66,6 +85,53 @@ func TestQueryMissCounter(t *testing.T) {
 	require.Equal(t, missCounter, res.MissCounter)
 }
 
+func TestQueryValidatorOracleHistory(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	for height := int64(1); height <= 3; height++ {
+		input.OracleKeeper.SetValidatorOracleStats(input.Ctx, types.ValidatorOracleStats{
+			ValidatorAddress: ValAddrs[0].String(),
+			WindowEndHeight:  height * 100,
+			VotePeriods:      10,
+			Misses:           uint64(height),
+		})
+	}
+	input.OracleKeeper.SetValidatorOracleStats(input.Ctx, types.ValidatorOracleStats{
+		ValidatorAddress: ValAddrs[1].String(),
+		WindowEndHeight:  100,
+	})
+	input.OracleKeeper.AddValidatorPerformance(input.Ctx, ValAddrs[0], 2)
+	input.OracleKeeper.SetMissCounter(input.Ctx, ValAddrs[0], 1)
+
+	// empty request
+	_, err := querier.ValidatorOracleHistory(ctx, nil)
+	require.Error(t, err)
+
+	// Query to grpc
+	res, err := querier.ValidatorOracleHistory(ctx, &types.QueryValidatorOracleHistoryRequest{
+		ValidatorAddr: ValAddrs[0].String(),
+		Pagination:    &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Current.VotePeriods)
+	require.Equal(t, uint64(2), res.Current.Wins)
+	require.Equal(t, uint64(1), res.Current.Misses)
+	require.Len(t, res.History, 2)
+	require.Equal(t, int64(100), res.History[0].WindowEndHeight)
+	require.Equal(t, int64(200), res.History[1].WindowEndHeight)
+
+	res, err = querier.ValidatorOracleHistory(ctx, &types.QueryValidatorOracleHistoryRequest{
+		ValidatorAddr: ValAddrs[0].String(),
+		Pagination:    &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.History, 1)
+	require.Equal(t, int64(300), res.History[0].WindowEndHeight)
+	require.Equal(t, uint64(3), res.History[0].Misses)
+}
+
 func TestQueryExchangeRates(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx := sdk.WrapSDKContext(input.Ctx)
@@ -262,3 +328,20 @@ func TestQueryTargets(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, targets, res.Targets)
 }
+
+func TestQueryTargetParams(t *testing.T) {
+	input, pair := setupDexTest(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	input.OracleKeeper.SetTarget(input.Ctx, fooDenom3)
+	input.OracleKeeper.SetDexTarget(input.Ctx, fooDenom3, pair)
+	input.OracleKeeper.SetTarget(input.Ctx, warmage.MicroUSWDenom)
+
+	res, err := querier.TargetParams(ctx, &types.QueryTargetParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.TargetParams{
+		{Denom: fooDenom3, Source: types.TARGET_SOURCE_DEX, SourceDexContract: pair.Hex()},
+		{Denom: warmage.MicroUSWDenom, Source: types.TARGET_SOURCE_VALIDATORS},
+	}, res.TargetParams)
+}
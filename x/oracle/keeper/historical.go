@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/petri-labs/warmage/x/oracle/types"
+)
+
+// AddHistoricalRate records the exchange rate of denom at the current block,
+// and prunes the snapshots of denom older than the lookback.
+func (k Keeper) AddHistoricalRate(ctx sdk.Context, denom string, exchangeRate sdk.Dec) {
+	k.SetHistoricalRate(ctx, types.ExchangeRateSnapshot{
+		Denom:        denom,
+		Height:       ctx.BlockHeight(),
+		Time:         ctx.BlockTime(),
+		ExchangeRate: exchangeRate,
+	})
+
+	lookback := int64(k.HistoricalRatesLookback(ctx))
+	if ctx.BlockHeight() > lookback {
+		k.pruneHistoricalRates(ctx, denom, ctx.BlockHeight()-lookback)
+	}
+}
+
+// SetHistoricalRate sets an exchange rate snapshot to the store.
+func (k Keeper) SetHistoricalRate(ctx sdk.Context, snapshot types.ExchangeRateSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&snapshot)
+	store.Set(types.GetHistoricalRateKey(snapshot.Denom, snapshot.Height), bz)
+}
+
+// GetHistoricalRate gets the exchange rate snapshot of denom in effect at the
+// given height, i.e., the latest one at or before the height.
+func (k Keeper) GetHistoricalRate(ctx sdk.Context, denom string, height int64) (snapshot types.ExchangeRateSnapshot, err error) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.ReverseIterator(types.GetHistoricalRatesKey(denom), types.GetHistoricalRateKey(denom, height+1))
+	defer iter.Close()
+	if !iter.Valid() {
+		return snapshot, sdkerrors.Wrapf(types.ErrNoHistoricalRate, "denom %s at height %d", denom, height)
+	}
+	k.cdc.MustUnmarshal(iter.Value(), &snapshot)
+	return snapshot, nil
+}
+
+// IterateHistoricalRates iterates over the exchange rate snapshots of all
+// denoms, in ascending order of height for each denom.
+func (k Keeper) IterateHistoricalRates(ctx sdk.Context, handler func(snapshot types.ExchangeRateSnapshot) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.HistoricalRateKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var snapshot types.ExchangeRateSnapshot
+		k.cdc.MustUnmarshal(iter.Value(), &snapshot)
+		if handler(snapshot) {
+			break
+		}
+	}
+}
+
+// GetTWAP computes the time-weighted average exchange rate of denom over the
+// window up to the current block. Each snapshot is in effect until the next
+// one. If the history is shorter than the window, only the covered part of the
+// window is averaged.
+func (k Keeper) GetTWAP(ctx sdk.Context, denom string, window time.Duration) (sdk.Dec, error) {
+	start := ctx.BlockTime().Add(-window)
+	end := ctx.BlockTime()
+
+	weighted := sdk.ZeroDec()
+	var duration int64
+	var latest *sdk.Dec
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.ReverseIterator(types.GetHistoricalRatesKey(denom), types.GetHistoricalRateKey(denom, ctx.BlockHeight()+1))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var snapshot types.ExchangeRateSnapshot
+		k.cdc.MustUnmarshal(iter.Value(), &snapshot)
+		if latest == nil {
+			latest = &snapshot.ExchangeRate
+		}
+
+		segmentStart := snapshot.Time
+		if segmentStart.Before(start) {
+			segmentStart = start
+		}
+		if end.After(segmentStart) {
+			elapsed := end.Sub(segmentStart).Nanoseconds()
+			weighted = weighted.Add(snapshot.ExchangeRate.MulInt64(elapsed))
+			duration += elapsed
+			end = segmentStart
+		}
+
+		if !snapshot.Time.After(start) {
+			break
+		}
+	}
+
+	if latest == nil {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrNoHistoricalRate, "denom %s", denom)
+	}
+	if duration == 0 {
+		return *latest, nil
+	}
+	return weighted.QuoInt64(duration), nil
+}
+
+// DeleteHistoricalRates deletes all the exchange rate snapshots of denom.
+func (k Keeper) DeleteHistoricalRates(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetHistoricalRatesKey(denom))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// pruneHistoricalRates deletes the exchange rate snapshots of denom below the
+// given height.
+func (k Keeper) pruneHistoricalRates(ctx sdk.Context, denom string, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.GetHistoricalRatesKey(denom), types.GetHistoricalRateKey(denom, height))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
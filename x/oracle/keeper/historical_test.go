@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/petri-labs/warmage/x/oracle/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoricalRates(t *testing.T) {
+	input := CreateTestInput(t)
+	k := input.OracleKeeper
+	start := time.Unix(1_600_000_000, 0).UTC()
+
+	for i, rate := range []int64{1, 2, 4} {
+		ctx := input.Ctx.WithBlockHeight(int64(10 * (i + 1))).WithBlockTime(start.Add(time.Duration(i) * time.Minute))
+		k.AddHistoricalRate(ctx, fooDenom3, sdk.NewDec(rate))
+	}
+	ctx := input.Ctx.WithBlockHeight(40).WithBlockTime(start.Add(3 * time.Minute))
+
+	snapshot, err := k.GetHistoricalRate(ctx, fooDenom3, 25)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), snapshot.Height)
+	require.Equal(t, sdk.NewDec(2), snapshot.ExchangeRate)
+	require.Equal(t, start.Add(time.Minute), snapshot.Time)
+
+	snapshot, err = k.GetHistoricalRate(ctx, fooDenom3, 30)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(4), snapshot.ExchangeRate)
+
+	_, err = k.GetHistoricalRate(ctx, fooDenom3, 9)
+	require.ErrorIs(t, err, types.ErrNoHistoricalRate)
+	_, err = k.GetHistoricalRate(ctx, fooDenom6, 30)
+	require.ErrorIs(t, err, types.ErrNoHistoricalRate)
+
+	// (1*60 + 2*60 + 4*60) / 180
+	twap, err := k.GetTWAP(ctx, fooDenom3, 3*time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(7).QuoInt64(3), twap)
+
+	// (2*30 + 4*60) / 90
+	twap, err = k.GetTWAP(ctx, fooDenom3, 90*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(10).QuoInt64(3), twap)
+
+	// history shorter than the window
+	twap, err = k.GetTWAP(ctx, fooDenom3, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(7).QuoInt64(3), twap)
+
+	_, err = k.GetTWAP(ctx, fooDenom6, time.Hour)
+	require.ErrorIs(t, err, types.ErrNoHistoricalRate)
+
+	// snapshots older than the lookback are pruned
+	params := k.GetParams(input.Ctx)
+	params.HistoricalRatesLookback = 15
+	k.SetParams(input.Ctx, params)
+	k.AddHistoricalRate(ctx, fooDenom3, sdk.NewDec(8))
+
+	var heights []int64
+	k.IterateHistoricalRates(ctx, func(snapshot types.ExchangeRateSnapshot) bool {
+		heights = append(heights, snapshot.Height)
+		return false
+	})
+	require.Equal(t, []int64{30, 40}, heights)
+}
+
+func TestQueryHistoricalRates(t *testing.T) {
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.OracleKeeper)
+	start := time.Unix(1_600_000_000, 0).UTC()
+
+	input.OracleKeeper.AddHistoricalRate(input.Ctx.WithBlockHeight(10).WithBlockTime(start), fooDenom3, sdk.NewDec(1700))
+	ctx := sdk.WrapSDKContext(input.Ctx.WithBlockHeight(20).WithBlockTime(start.Add(time.Minute)))
+
+	_, err := querier.TWAP(ctx, nil)
+	require.Error(t, err)
+	_, err = querier.TWAP(ctx, &types.QueryTWAPRequest{Denom: fooDenom3})
+	require.Error(t, err)
+
+	twapRes, err := querier.TWAP(ctx, &types.QueryTWAPRequest{Denom: fooDenom3, Window: time.Minute})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1700), twapRes.Twap)
+
+	_, err = querier.HistoricalRate(ctx, nil)
+	require.Error(t, err)
+
+	rateRes, err := querier.HistoricalRate(ctx, &types.QueryHistoricalRateRequest{Denom: fooDenom3, Height: 15})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), rateRes.Snapshot.Height)
+	require.Equal(t, sdk.NewDec(1700), rateRes.Snapshot.ExchangeRate)
+}
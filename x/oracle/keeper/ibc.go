@@ -0,0 +1,270 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/petri-labs/warmage/x/oracle/types"
+)
+
+// IsBound checks if the oracle module is already bound to the desired port
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort binds to the port and claims its capability
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	cap := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, cap, host.PortPath(portID))
+}
+
+// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
+	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
+}
+
+// ClaimCapability allows the oracle module to claim a capability that IBC module
+// passes to it
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
+
+// GetInterchainTarget returns the target params of an inter-chain quoted target.
+func (k Keeper) GetInterchainTarget(ctx sdk.Context, denom string) (params types.TargetParams, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetInterchainTargetKey(denom))
+	if bz == nil {
+		return params, false
+	}
+	k.cdc.MustUnmarshal(bz, &params)
+	return params, true
+}
+
+// SetInterchainTarget sets the target params of an inter-chain quoted target.
+func (k Keeper) SetInterchainTarget(ctx sdk.Context, params types.TargetParams) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetInterchainTargetKey(params.Denom), k.cdc.MustMarshal(&params))
+}
+
+// DeleteInterchainTarget deletes the target params of an inter-chain quoted
+// target, along with its latest exchange rate responded.
+func (k Keeper) DeleteInterchainTarget(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetInterchainTargetKey(denom))
+	store.Delete(types.GetInterchainExchangeRateKey(denom))
+}
+
+// IterateInterchainTargets iterates over inter-chain quoted targets in the store.
+func (k Keeper) IterateInterchainTargets(ctx sdk.Context, handler func(params types.TargetParams) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.InterchainTargetKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var params types.TargetParams
+		k.cdc.MustUnmarshal(iter.Value(), &params)
+		if handler(params) {
+			break
+		}
+	}
+}
+
+// GetInterchainExchangeRate returns the latest exchange rate of denom quoted
+// from the counterparty chain.
+func (k Keeper) GetInterchainExchangeRate(ctx sdk.Context, denom string) (rate types.InterchainExchangeRate, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetInterchainExchangeRateKey(denom))
+	if bz == nil {
+		return rate, false
+	}
+	k.cdc.MustUnmarshal(bz, &rate)
+	return rate, true
+}
+
+// SetInterchainExchangeRate sets the latest exchange rate of denom quoted from
+// the counterparty chain.
+func (k Keeper) SetInterchainExchangeRate(ctx sdk.Context, rate types.InterchainExchangeRate) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetInterchainExchangeRateKey(rate.Denom), k.cdc.MustMarshal(&rate))
+}
+
+// RequestInterchainExchangeRates sends a request packet for the exchange rates
+// of inter-chain quoted targets over each of their channels.
+func (k Keeper) RequestInterchainExchangeRates(ctx sdk.Context) {
+	var channels []string
+	requests := make(map[string]*types.ExchangeRateRequestPacketData)
+	k.IterateInterchainTargets(ctx, func(params types.TargetParams) bool {
+		request, ok := requests[params.SourceChannel]
+		if !ok {
+			request = &types.ExchangeRateRequestPacketData{}
+			requests[params.SourceChannel] = request
+			channels = append(channels, params.SourceChannel)
+		}
+		request.Queries = append(request.Queries, interchainQuery(params))
+		return false
+	})
+
+	for _, channel := range channels {
+		if _, err := k.SendExchangeRateRequest(ctx, channel, *requests[channel]); err != nil {
+			k.Logger(ctx).Error("failed to request inter-chain exchange rates", "channel", channel, "err", err)
+		}
+	}
+}
+
+// SendExchangeRateRequest sends the request packet over the channel of the
+// oracle port. The packet times out after InterchainRateMaxAge, beyond which
+// the response would be stale.
+func (k Keeper) SendExchangeRateRequest(ctx sdk.Context, channelID string, data types.ExchangeRateRequestPacketData) (channeltypes.Packet, error) {
+	if err := data.ValidateBasic(); err != nil {
+		return channeltypes.Packet{}, err
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, types.PortID, channelID)
+	if !found {
+		return channeltypes.Packet{}, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", types.PortID, channelID)
+	}
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, types.PortID, channelID)
+	if !found {
+		return channeltypes.Packet{}, sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", types.PortID, channelID,
+		)
+	}
+
+	chanCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(types.PortID, channelID))
+	if !ok {
+		return channeltypes.Packet{}, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	packet := channeltypes.NewPacket(
+		data.GetBytes(),
+		sequence,
+		types.PortID,
+		channelID,
+		channel.Counterparty.PortId,
+		channel.Counterparty.ChannelId,
+		clienttypes.ZeroHeight(),
+		uint64(ctx.BlockTime().Add(k.InterchainRateMaxAge(ctx)).UnixNano()),
+	)
+	if err := k.channelKeeper.SendPacket(ctx, chanCap, packet); err != nil {
+		return channeltypes.Packet{}, err
+	}
+	return packet, nil
+}
+
+// OnRecvExchangeRateRequest quotes the requested exchange rates from the
+// oracle or DEX pair contracts of this chain. A DEX pair contract is quoted by
+// its time-weighted average price, so only those of DEX targets are available.
+// Those which are not available are responded as zero.
+func (k Keeper) OnRecvExchangeRateRequest(ctx sdk.Context, data types.ExchangeRateRequestPacketData) (types.ExchangeRateResponsePacketData, error) {
+	if err := data.ValidateBasic(); err != nil {
+		return types.ExchangeRateResponsePacketData{}, err
+	}
+
+	response := types.ExchangeRateResponsePacketData{
+		Time:   ctx.BlockTime(),
+		Height: ctx.BlockHeight(),
+	}
+	for _, query := range data.Queries {
+		var (
+			exchangeRate sdk.Dec
+			err          error
+		)
+		if len(query.DexContract) > 0 {
+			// The pair contract is only read, so discard any state changes of the EVM calls
+			cacheCtx, _ := ctx.CacheContext()
+			exchangeRate, err = k.GetDexExchangeRate(cacheCtx, query.Denom, common.HexToAddress(query.DexContract))
+		} else {
+			exchangeRate, err = k.GetExchangeRate(ctx, query.Denom)
+		}
+		if err != nil {
+			exchangeRate = sdk.ZeroDec()
+		}
+		response.ExchangeRates = append(response.ExchangeRates, types.NewExchangeRateTuple(query.Denom, exchangeRate))
+	}
+	return response, nil
+}
+
+// OnAcknowledgementExchangeRateRequest verifies the responded exchange rates
+// and stores them for the inter-chain quoted targets of the channel. Stale
+// exchange rates, or those older than the stored ones, are ignored.
+func (k Keeper) OnAcknowledgementExchangeRateRequest(ctx sdk.Context, packet channeltypes.Packet, data types.ExchangeRateRequestPacketData, ack channeltypes.Acknowledgement) error {
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		k.Logger(ctx).Error("inter-chain exchange rate request failed", "channel", packet.SourceChannel, "err", resp.Error)
+		return nil
+	case *channeltypes.Acknowledgement_Result:
+		var response types.ExchangeRateResponsePacketData
+		if err := types.ModuleCdc.UnmarshalJSON(resp.Result, &response); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidPacket, "cannot unmarshal exchange rate response: %s", err)
+		}
+		if err := response.ValidateBasic(data); err != nil {
+			return err
+		}
+		if response.Time.Before(ctx.BlockTime().Add(-k.InterchainRateMaxAge(ctx))) {
+			k.Logger(ctx).Error("stale inter-chain exchange rates", "channel", packet.SourceChannel, "time", response.Time)
+			return nil
+		}
+
+		k.IterateInterchainTargets(ctx, func(params types.TargetParams) bool {
+			if params.SourceChannel != packet.SourceChannel {
+				return false
+			}
+			query := interchainQuery(params)
+			for i, q := range data.Queries {
+				exchangeRate := response.ExchangeRates[i].ExchangeRate
+				if q != query || !exchangeRate.IsPositive() {
+					continue
+				}
+				if last, found := k.GetInterchainExchangeRate(ctx, params.Denom); found && !last.Time.Before(response.Time) {
+					break
+				}
+				k.SetInterchainExchangeRate(ctx, types.InterchainExchangeRate{
+					Denom:        params.Denom,
+					ExchangeRate: exchangeRate,
+					Time:         response.Time,
+					Height:       response.Height,
+				})
+				break
+			}
+			return false
+		})
+		return nil
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalidPacket, "invalid acknowledgement type %T", resp)
+	}
+}
+
+// UpdateInterchainExchangeRates sets the exchange rates of inter-chain quoted
+// targets from the latest responses which are not stale.
+func (k Keeper) UpdateInterchainExchangeRates(ctx sdk.Context) {
+	var denoms []string
+	k.IterateInterchainTargets(ctx, func(params types.TargetParams) bool {
+		denoms = append(denoms, params.Denom)
+		return false
+	})
+
+	maxAge := k.InterchainRateMaxAge(ctx)
+	for _, denom := range denoms {
+		rate, found := k.GetInterchainExchangeRate(ctx, denom)
+		if !found || rate.Time.Before(ctx.BlockTime().Add(-maxAge)) {
+			continue
+		}
+		k.setSourcedExchangeRate(ctx, denom, rate.ExchangeRate)
+	}
+}
+
+// interchainQuery returns the query of the counterparty chain for the target.
+func interchainQuery(params types.TargetParams) types.ExchangeRateQuery {
+	query := types.ExchangeRateQuery{Denom: params.SourceDenom}
+	if params.Source == types.TARGET_SOURCE_INTERCHAIN_DEX {
+		query.DexContract = params.SourceDexContract
+	}
+	return query
+}
@@ -5,7 +5,9 @@ import (
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
 	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -25,6 +27,13 @@ type (
 		bankKeeper    types.BankKeeper
 		distrKeeper   types.DistrKeeper
 		stakingKeeper types.StakingKeeper
+		veKeeper      types.VeKeeper
+		erc20Keeper   types.Erc20Keeper
+		evmKeeper     types.EVMKeeper
+		channelKeeper types.ChannelKeeper
+		portKeeper    types.PortKeeper
+		scopedKeeper  capabilitykeeper.ScopedKeeper
+		makerKeeper   types.MakerKeeper
 
 		distrName string
 	}
@@ -38,6 +47,12 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	distrKeeper types.DistrKeeper,
 	stakingKeeper types.StakingKeeper,
+	veKeeper types.VeKeeper,
+	erc20Keeper types.Erc20Keeper,
+	evmKeeper types.EVMKeeper,
+	channelKeeper types.ChannelKeeper,
+	portKeeper types.PortKeeper,
+	scopedKeeper capabilitykeeper.ScopedKeeper,
 	distrName string,
 ) *Keeper {
 	// Set KeyTable if it has not already been set
@@ -58,10 +73,27 @@ func NewKeeper(
 		bankKeeper:    bankKeeper,
 		distrKeeper:   distrKeeper,
 		stakingKeeper: stakingKeeper,
+		veKeeper:      veKeeper,
+		erc20Keeper:   erc20Keeper,
+		evmKeeper:     evmKeeper,
+		channelKeeper: channelKeeper,
+		portKeeper:    portKeeper,
+		scopedKeeper:  scopedKeeper,
 		distrName:     distrName,
 	}
 }
 
+// SetMakerKeeper sets the maker keeper, which is consulted before deregistering
+// a target. It is set after construction, since the maker keeper depends on
+// the oracle keeper.
+func (k *Keeper) SetMakerKeeper(makerKeeper types.MakerKeeper) *Keeper {
+	if k.makerKeeper != nil {
+		panic("cannot set oracle maker keeper twice")
+	}
+	k.makerKeeper = makerKeeper
+	return k
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -120,6 +152,13 @@ func (k Keeper) SetExchangeRateWithEvent(ctx sdk.Context, denom string, exchange
 	)
 }
 
+// setSourcedExchangeRate sets the exchange rate of denom quoted from a source
+// other than validators, e.g. DEX or counterparty chains, and records it.
+func (k Keeper) setSourcedExchangeRate(ctx sdk.Context, denom string, exchangeRate sdk.Dec) {
+	k.SetExchangeRateWithEvent(ctx, denom, exchangeRate)
+	k.AddHistoricalRate(ctx, denom, exchangeRate)
+}
+
 // DeleteExchangeRate deletes the consensus exchange rate of denom denominated in uUSD from the store.
 func (k Keeper) DeleteExchangeRate(ctx sdk.Context, denom string) {
 	store := ctx.KVStore(k.storeKey)
@@ -333,6 +372,55 @@ func (k Keeper) SetVoteTarget(ctx sdk.Context, denom string) {
 	store.Set(types.GetVoteTargetKey(denom), []byte(denom))
 }
 
+// DeleteVoteTarget deletes vote target for the denom.
+func (k Keeper) DeleteVoteTarget(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetVoteTargetKey(denom))
+	store.Delete(types.GetVoteTargetParamsKey(denom))
+}
+
+// SetVoteTargetParams sets vote target for the denom, together with its tally overrides.
+func (k Keeper) SetVoteTargetParams(ctx sdk.Context, params types.TargetParams) {
+	k.SetVoteTarget(ctx, params.Denom)
+
+	store := ctx.KVStore(k.storeKey)
+	if !params.HasTallyOverrides() {
+		store.Delete(types.GetVoteTargetParamsKey(params.Denom))
+		return
+	}
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(types.GetVoteTargetParamsKey(params.Denom), bz)
+}
+
+// GetVoteTargetParams returns the target params of the vote target denom, with its tally overrides if any.
+func (k Keeper) GetVoteTargetParams(ctx sdk.Context, denom string) types.TargetParams {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetVoteTargetParamsKey(denom))
+	if bz == nil {
+		return types.TargetParams{Denom: denom, Source: types.TARGET_SOURCE_VALIDATORS}
+	}
+
+	var params types.TargetParams
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// GetTallyParams returns the vote threshold, reward band and minimum number of distinct voters
+// applied to the ballot of denom, i.e., its tally overrides falling back to the module params.
+func (k Keeper) GetTallyParams(ctx sdk.Context, denom string) (voteThreshold sdk.Dec, rewardBand sdk.Dec, minVoters uint64) {
+	params := k.GetVoteTargetParams(ctx, denom)
+
+	voteThreshold = k.VoteThreshold(ctx)
+	if params.VoteThreshold != nil {
+		voteThreshold = *params.VoteThreshold
+	}
+	rewardBand = k.RewardBand(ctx)
+	if params.RewardBand != nil {
+		rewardBand = *params.RewardBand
+	}
+	return voteThreshold, rewardBand, params.MinVoters
+}
+
 // IterateVoteTargets iterates rate over vote targets in the store.
 func (k Keeper) IterateVoteTargets(ctx sdk.Context, handler func(denom string) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
@@ -360,10 +448,12 @@ func (k Keeper) GetVoteTargets(ctx sdk.Context) (voteTargets []string) {
 // ClearVoteTargets clears vote targets
 func (k Keeper) ClearVoteTargets(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
-	iter := sdk.KVStorePrefixIterator(store, types.VoteTargetKey)
-	defer iter.Close()
-	for ; iter.Valid(); iter.Next() {
-		store.Delete(iter.Key())
+	for _, keyPrefix := range [][]byte{types.VoteTargetKey, types.VoteTargetParamsKey} {
+		iter := sdk.KVStorePrefixIterator(store, keyPrefix)
+		for ; iter.Valid(); iter.Next() {
+			store.Delete(iter.Key())
+		}
+		iter.Close()
 	}
 }
 
@@ -383,6 +473,12 @@ func (k Keeper) SetTarget(ctx sdk.Context, denom string) {
 	store.Set(types.GetTargetKey(denom), []byte(denom))
 }
 
+// DeleteTarget deletes target for the denom.
+func (k Keeper) DeleteTarget(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTargetKey(denom))
+}
+
 // IterateTargets iterates rate over targets in the store.
 func (k Keeper) IterateTargets(ctx sdk.Context, handler func(denom string) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
@@ -407,6 +503,46 @@ func (k Keeper) GetTargets(ctx sdk.Context) (targets []string) {
 	return targets
 }
 
+// GetTargetParams returns the quotation source params of a target.
+func (k Keeper) GetTargetParams(ctx sdk.Context, denom string) (types.TargetParams, bool) {
+	if !k.IsTarget(ctx, denom) {
+		return types.TargetParams{}, false
+	}
+	if k.IsVoteTarget(ctx, denom) {
+		return k.GetVoteTargetParams(ctx, denom), true
+	}
+	if contract, found := k.GetDexTarget(ctx, denom); found {
+		return types.TargetParams{Denom: denom, Source: types.TARGET_SOURCE_DEX, SourceDexContract: contract.Hex()}, true
+	}
+	if params, found := k.GetInterchainTarget(ctx, denom); found {
+		return params, true
+	}
+	return types.TargetParams{Denom: denom}, true
+}
+
+// SetTargetParams sets the target denom to be quoted from the source of the
+// params. Unlike the proposals registering targets, the source is not checked.
+func (k Keeper) SetTargetParams(ctx sdk.Context, params types.TargetParams) {
+	k.SetTarget(ctx, params.Denom)
+	switch params.Source {
+	case types.TARGET_SOURCE_VALIDATORS:
+		k.SetVoteTargetParams(ctx, params)
+	case types.TARGET_SOURCE_DEX:
+		k.SetDexTarget(ctx, params.Denom, common.HexToAddress(params.SourceDexContract))
+	case types.TARGET_SOURCE_INTERCHAIN_DEX, types.TARGET_SOURCE_INTERCHAIN_ORACLE:
+		k.SetInterchainTarget(ctx, params)
+	}
+}
+
+// GetAllTargetParams returns the quotation source params of all targets.
+func (k Keeper) GetAllTargetParams(ctx sdk.Context) (targetParams []types.TargetParams) {
+	for _, denom := range k.GetTargets(ctx) {
+		params, _ := k.GetTargetParams(ctx, denom)
+		targetParams = append(targetParams, params)
+	}
+	return targetParams
+}
+
 // ValidateFeeder return the given feeder is allowed to feed the message or not.
 func (k Keeper) ValidateFeeder(ctx sdk.Context, feederAddr sdk.AccAddress, validatorAddr sdk.ValAddress) error {
 	if !feederAddr.Equals(validatorAddr) {
@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -120,6 +121,16 @@ func TestParams(t *testing.T) {
 	slashFraction := sdk.NewDecWithPrec(1, 2)
 	slashWindow := uint64(1000)
 	minValidPerWindow := sdk.NewDecWithPrec(1, 4)
+	historicalRatesLookback := uint64(100)
+	dexPriceBand := sdk.NewDecWithPrec(5, 2)
+	interchainRateMaxAge := 10 * time.Minute
+	maxPriceChange := sdk.NewDecWithPrec(3, 1)
+	maxHeldRounds := uint64(3)
+	makerFeeSplit := types.FeeSplit{
+		OracleVoters:  sdk.NewDecWithPrec(6, 1),
+		CommunityPool: sdk.NewDecWithPrec(4, 1),
+		VeHolders:     sdk.ZeroDec(),
+	}
 
 	// Should really test validateParams, but skipping because obvious
 	newParams := types.Params{
@@ -130,6 +141,12 @@ func TestParams(t *testing.T) {
 		SlashFraction:            slashFraction,
 		SlashWindow:              slashWindow,
 		MinValidPerWindow:        minValidPerWindow,
+		HistoricalRatesLookback:  historicalRatesLookback,
+		DexPriceBand:             dexPriceBand,
+		InterchainRateMaxAge:     interchainRateMaxAge,
+		MakerFeeSplit:            makerFeeSplit,
+		MaxPriceChange:           maxPriceChange,
+		MaxHeldRounds:            maxHeldRounds,
 	}
 	input.OracleKeeper.SetParams(input.Ctx, newParams)
 
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/petri-labs/warmage/x/oracle/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates from version 2 to 3, setting the params added since
+// version 2 to their default values.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	for _, pair := range []struct {
+		key   []byte
+		value interface{}
+	}{
+		{types.KeyHistoricalRatesLookback, &defaults.HistoricalRatesLookback},
+		{types.KeyDexPriceBand, &defaults.DexPriceBand},
+		{types.KeyInterchainRateMaxAge, &defaults.InterchainRateMaxAge},
+		{types.KeyMakerFeeSplit, &defaults.MakerFeeSplit},
+		{types.KeyMaxPriceChange, &defaults.MaxPriceChange},
+		{types.KeyMaxHeldRounds, &defaults.MaxHeldRounds},
+	} {
+		if !m.keeper.paramstore.Has(ctx, pair.key) {
+			m.keeper.paramstore.Set(ctx, pair.key, pair.value)
+		}
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/petri-labs/warmage/x/oracle/types"
 )
@@ -57,3 +59,39 @@ func (k Keeper) MinValidPerWindow(ctx sdk.Context) (res sdk.Dec) {
 	k.paramstore.Get(ctx, types.KeyMinValidPerWindow, &res)
 	return
 }
+
+// HistoricalRatesLookback returns the number of blocks for which exchange rate snapshots are kept.
+func (k Keeper) HistoricalRatesLookback(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyHistoricalRatesLookback, &res)
+	return
+}
+
+// DexPriceBand returns the max deviation of the spot price of a DEX pair from its time-weighted average price.
+func (k Keeper) DexPriceBand(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyDexPriceBand, &res)
+	return
+}
+
+// InterchainRateMaxAge returns the max age of an exchange rate quoted from a counterparty chain.
+func (k Keeper) InterchainRateMaxAge(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyInterchainRateMaxAge, &res)
+	return
+}
+
+// MakerFeeSplit returns the split of maker fees between oracle voters, the community pool and ve holders.
+func (k Keeper) MakerFeeSplit(ctx sdk.Context) (res types.FeeSplit) {
+	k.paramstore.Get(ctx, types.KeyMakerFeeSplit, &res)
+	return
+}
+
+// MaxPriceChange returns the maximum change of a tallied exchange rate from the last accepted one.
+func (k Keeper) MaxPriceChange(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMaxPriceChange, &res)
+	return
+}
+
+// MaxHeldRounds returns the maximum number of consecutive vote periods to hold the last accepted exchange rate for.
+func (k Keeper) MaxHeldRounds(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxHeldRounds, &res)
+	return
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/petri-labs/warmage/x/oracle/types"
+)
+
+// GetValidatorPerformance retrieves the oracle performance of the validator
+// accumulated in the current slash window.
+func (k Keeper) GetValidatorPerformance(ctx sdk.Context, operator sdk.ValAddress) types.ValidatorPerformance {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorPerformanceKey(operator))
+	if bz == nil {
+		return types.ValidatorPerformance{ValidatorAddress: operator.String()}
+	}
+
+	var performance types.ValidatorPerformance
+	k.cdc.MustUnmarshal(bz, &performance)
+	return performance
+}
+
+// SetValidatorPerformance sets the oracle performance of the validator
+// accumulated in the current slash window.
+func (k Keeper) SetValidatorPerformance(ctx sdk.Context, performance types.ValidatorPerformance) {
+	operator, err := sdk.ValAddressFromBech32(performance.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&performance)
+	store.Set(types.GetValidatorPerformanceKey(operator), bz)
+}
+
+// DeleteValidatorPerformance removes the oracle performance of the validator
+// accumulated in the current slash window.
+func (k Keeper) DeleteValidatorPerformance(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorPerformanceKey(operator))
+}
+
+// IterateValidatorPerformances iterates over the oracle performances of all
+// validators accumulated in the current slash window.
+func (k Keeper) IterateValidatorPerformances(ctx sdk.Context, handler func(performance types.ValidatorPerformance) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorPerformanceKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var performance types.ValidatorPerformance
+		k.cdc.MustUnmarshal(iter.Value(), &performance)
+		if handler(performance) {
+			break
+		}
+	}
+}
+
+// AddValidatorPerformance counts a vote period in which the validator was in
+// the active set and won the given number of ballots.
+func (k Keeper) AddValidatorPerformance(ctx sdk.Context, operator sdk.ValAddress, wins uint64) {
+	performance := k.GetValidatorPerformance(ctx, operator)
+	performance.VotePeriods++
+	performance.Wins += wins
+	k.SetValidatorPerformance(ctx, performance)
+}
+
+// SetValidatorOracleStats sets the oracle performance of a validator over a
+// past slash window.
+func (k Keeper) SetValidatorOracleStats(ctx sdk.Context, stats types.ValidatorOracleStats) {
+	operator, err := sdk.ValAddressFromBech32(stats.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&stats)
+	store.Set(types.GetValidatorOracleStatsKey(operator, stats.WindowEndHeight), bz)
+}
+
+// IterateValidatorOracleHistory iterates over the oracle performances of all
+// validators over past slash windows, in ascending order of the window end
+// height for each validator.
+func (k Keeper) IterateValidatorOracleHistory(ctx sdk.Context, handler func(stats types.ValidatorOracleStats) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorOracleStatsKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var stats types.ValidatorOracleStats
+		k.cdc.MustUnmarshal(iter.Value(), &stats)
+		if handler(stats) {
+			break
+		}
+	}
+}
+
+// GetCurrentValidatorOracleStats returns the oracle performance of the
+// validator accumulated so far in the current slash window.
+func (k Keeper) GetCurrentValidatorOracleStats(ctx sdk.Context, operator sdk.ValAddress) types.ValidatorOracleStats {
+	slashWindow := int64(k.SlashWindow(ctx))
+	performance := k.GetValidatorPerformance(ctx, operator)
+
+	jailed := false
+	if validator := k.StakingKeeper().Validator(ctx, operator); validator != nil {
+		jailed = validator.IsJailed()
+	}
+
+	return types.ValidatorOracleStats{
+		ValidatorAddress: operator.String(),
+		// Queries see the state after EndBlock, so a window ending at the current block is already closed
+		WindowEndHeight: ((ctx.BlockHeight()+1)/slashWindow+1)*slashWindow - 1,
+		VotePeriods:     performance.VotePeriods,
+		Misses:          k.GetMissCounter(ctx, operator),
+		Wins:            performance.Wins,
+		Jailed:          jailed,
+	}
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/petri-labs/warmage/contracts"
+	"github.com/petri-labs/warmage/x/oracle/types"
+)
+
+// GetPriceFeed returns the address of the price feed contract of denom, if
+// it has been deployed.
+func (k Keeper) GetPriceFeed(ctx sdk.Context, denom string) (common.Address, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPriceFeedKey(denom))
+	if bz == nil {
+		return common.Address{}, false
+	}
+
+	var priceFeed types.PriceFeed
+	k.cdc.MustUnmarshal(bz, &priceFeed)
+	return common.HexToAddress(priceFeed.Contract), true
+}
+
+// SetPriceFeed sets the address of the price feed contract of a denom.
+func (k Keeper) SetPriceFeed(ctx sdk.Context, priceFeed types.PriceFeed) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&priceFeed)
+	store.Set(types.GetPriceFeedKey(priceFeed.Denom), bz)
+}
+
+// IteratePriceFeeds iterates over the price feed contracts of all denoms.
+func (k Keeper) IteratePriceFeeds(ctx sdk.Context, handler func(priceFeed types.PriceFeed) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PriceFeedKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var priceFeed types.PriceFeed
+		k.cdc.MustUnmarshal(iter.Value(), &priceFeed)
+		if handler(priceFeed) {
+			break
+		}
+	}
+}
+
+// SyncPriceFeeds publishes the exchange rates of all denoms to their
+// Chainlink AggregatorV3 compatible price feed contracts, so that EVM
+// contracts consume the same prices as the maker. The price feed contract of
+// a denom is deployed the first time it has an exchange rate.
+func (k Keeper) SyncPriceFeeds(ctx sdk.Context) {
+	k.IterateExchangeRates(ctx, func(denom string, exchangeRate sdk.Dec) (stop bool) {
+		if err := k.syncPriceFeed(ctx, denom, exchangeRate); err != nil {
+			k.Logger(ctx).Error("failed to sync price feed", "denom", denom, "error", err)
+		}
+		return false
+	})
+}
+
+// syncPriceFeed writes the exchange rate of denom as the latest round of its
+// price feed contract. The round id is the height at which the exchange rate
+// was accepted, which is earlier than the current one if the rate is held by
+// the circuit breaker.
+func (k Keeper) syncPriceFeed(ctx sdk.Context, denom string, exchangeRate sdk.Dec) error {
+	contract, found := k.GetPriceFeed(ctx, denom)
+	if !found {
+		var err error
+		if contract, err = k.deployPriceFeed(ctx, denom); err != nil {
+			return err
+		}
+	}
+
+	roundID, updatedAt := ctx.BlockHeight(), ctx.BlockTime()
+	if status, found := k.GetExchangeRateStatus(ctx, denom); found && status.HeldRounds > 0 {
+		roundID, updatedAt = status.Height, status.Time
+	}
+
+	k.setPriceFeedState(ctx, contract, types.PriceFeedSlotRoundID, big.NewInt(roundID))
+	k.setPriceFeedState(ctx, contract, types.PriceFeedSlotAnswer, exchangeRate.BigInt())
+	k.setPriceFeedState(ctx, contract, types.PriceFeedSlotStartedAt, big.NewInt(updatedAt.Unix()))
+	k.setPriceFeedState(ctx, contract, types.PriceFeedSlotUpdatedAt, big.NewInt(updatedAt.Unix()))
+	return nil
+}
+
+// retirePriceFeed writes a zero answer as the latest round of the price feed
+// contract of denom, if any, so that EVM contracts stop consuming its last
+// exchange rate. The contract is kept for the denom, and resumes if the denom
+// is quoted again.
+func (k Keeper) retirePriceFeed(ctx sdk.Context, denom string) {
+	contract, found := k.GetPriceFeed(ctx, denom)
+	if !found {
+		return
+	}
+
+	k.setPriceFeedState(ctx, contract, types.PriceFeedSlotRoundID, big.NewInt(ctx.BlockHeight()))
+	k.setPriceFeedState(ctx, contract, types.PriceFeedSlotAnswer, big.NewInt(0))
+	k.setPriceFeedState(ctx, contract, types.PriceFeedSlotStartedAt, big.NewInt(ctx.BlockTime().Unix()))
+	k.setPriceFeedState(ctx, contract, types.PriceFeedSlotUpdatedAt, big.NewInt(ctx.BlockTime().Unix()))
+}
+
+// deployPriceFeed deploys the price feed contract of denom with the oracle
+// module account as deployer.
+func (k Keeper) deployPriceFeed(ctx sdk.Context, denom string) (common.Address, error) {
+	ctorArgs, err := types.PriceFeedABI.Pack("", uint8(types.PriceFeedDecimals), types.PriceFeedDescription(denom))
+	if err != nil {
+		return common.Address{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid price feed of %s: %s", denom, err.Error())
+	}
+
+	data := make([]byte, len(contracts.PriceFeedContract.Bin)+len(ctorArgs))
+	copy(data[:len(contracts.PriceFeedContract.Bin)], contracts.PriceFeedContract.Bin)
+	copy(data[len(contracts.PriceFeedContract.Bin):], ctorArgs)
+
+	from := k.moduleEVMAddress()
+	nonce := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName).GetSequence()
+
+	contract := crypto.CreateAddress(from, nonce)
+	if _, err := k.erc20Keeper.CallEVMWithData(ctx, from, nil, data); err != nil {
+		return common.Address{}, sdkerrors.Wrapf(err, "failed to deploy price feed for %s", denom)
+	}
+	k.SetPriceFeed(ctx, types.PriceFeed{Denom: denom, Contract: contract.Hex()})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypePriceFeedDeploy,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyContract, contract.Hex()),
+		),
+	)
+	return contract, nil
+}
+
+func (k Keeper) setPriceFeedState(ctx sdk.Context, contract common.Address, slot int64, value *big.Int) {
+	k.evmKeeper.SetState(ctx, contract, types.PriceFeedSlot(slot), common.BigToHash(value).Bytes())
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/petri-labs/warmage/x/oracle/types"
+)
+
+// callPriceFeed calls the price feed contract against the state held by the test EVM.
+func callPriceFeed(t *testing.T, input TestInput, contract common.Address, method string, args ...interface{}) []interface{} {
+	evm := input.OracleKeeper.evmKeeper.(*testEVM)
+
+	data, err := types.PriceFeedABI.Pack(method, args...)
+	require.NoError(t, err)
+	ret, _, err := runtime.Call(contract, data, &runtime.Config{State: evm.stateDB})
+	require.NoError(t, err)
+	res, err := types.PriceFeedABI.Unpack(method, ret)
+	require.NoError(t, err)
+	return res
+}
+
+func TestSyncPriceFeeds(t *testing.T) {
+	input := CreateTestInput(t)
+	k := input.OracleKeeper
+
+	ctx := input.Ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0)).WithEventManager(sdk.NewEventManager())
+	input.Ctx = ctx
+	rate := sdk.NewDecWithPrec(15, 1)
+	k.ApplyTalliedExchangeRate(ctx, fooDenom1, rate)
+
+	// No price feed before the denom has an exchange rate
+	_, found := k.GetPriceFeed(ctx, fooDenom1)
+	require.False(t, found)
+
+	// The price feed is deployed on the first sync
+	k.SyncPriceFeeds(ctx)
+	contract, found := k.GetPriceFeed(ctx, fooDenom1)
+	require.True(t, found)
+	oracleAcc := input.AccountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	require.Equal(t, crypto.CreateAddress(common.BytesToAddress(oracleAcc.GetAddress()), 0), contract)
+	require.Equal(t, uint64(1), oracleAcc.GetSequence())
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypePriceFeedDeploy, ctx.EventManager().Events()[0].Type)
+
+	require.Equal(t, uint8(types.PriceFeedDecimals), callPriceFeed(t, input, contract, "decimals")[0])
+	require.Equal(t, types.PriceFeedDescription(fooDenom1), callPriceFeed(t, input, contract, "description")[0])
+	require.Equal(t, []interface{}{big.NewInt(10), rate.BigInt(), big.NewInt(1000), big.NewInt(1000), big.NewInt(10)},
+		callPriceFeed(t, input, contract, "latestRoundData"))
+
+	// A new exchange rate starts a new round
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(time.Unix(1006, 0)).WithEventManager(sdk.NewEventManager())
+	input.Ctx = ctx
+	rate = sdk.NewDecWithPrec(16, 1)
+	k.ApplyTalliedExchangeRate(ctx, fooDenom1, rate)
+	k.SyncPriceFeeds(ctx)
+	require.Empty(t, ctx.EventManager().Events())
+	require.Equal(t, []interface{}{big.NewInt(11), rate.BigInt(), big.NewInt(1006), big.NewInt(1006), big.NewInt(11)},
+		callPriceFeed(t, input, contract, "latestRoundData"))
+
+	// A held exchange rate keeps the round in which it was accepted
+	ctx = ctx.WithBlockHeight(12).WithBlockTime(time.Unix(1012, 0))
+	input.Ctx = ctx
+	k.DeleteExchangeRate(ctx, fooDenom1)
+	k.HoldFailedExchangeRate(ctx, fooDenom1)
+	require.True(t, k.IsExchangeRateHeld(ctx, fooDenom1))
+	k.SyncPriceFeeds(ctx)
+	require.Equal(t, []interface{}{big.NewInt(11), rate.BigInt(), big.NewInt(1006), big.NewInt(1006), big.NewInt(11)},
+		callPriceFeed(t, input, contract, "getRoundData", big.NewInt(11)))
+}
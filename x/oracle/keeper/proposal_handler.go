@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
 	warmage "github.com/petri-labs/warmage/types"
 	"github.com/petri-labs/warmage/x/oracle/types"
 )
@@ -22,14 +23,88 @@ func HandleRegisterTargetProposal(ctx sdk.Context, k Keeper, p *types.RegisterTa
 		)
 	}
 
+	if err := setTargetSource(ctx, k, params); err != nil {
+		return err
+	}
+
 	k.SetTarget(ctx, params.Denom)
 
+	return nil
+}
+
+func HandleUpdateTargetProposal(ctx sdk.Context, k Keeper, p *types.UpdateTargetProposal) error {
+	params := p.TargetParams
+
+	if !k.IsTarget(ctx, params.Denom) {
+		return sdkerrors.Wrapf(types.ErrUnknownTarget, "unknown target denom '%s'", params.Denom)
+	}
+
+	clearTargetSource(ctx, k, params.Denom)
+	return setTargetSource(ctx, k, params)
+}
+
+func HandleDeregisterTargetProposal(ctx sdk.Context, k Keeper, p *types.DeregisterTargetProposal) error {
+	denom := p.Denom
+
+	if !k.IsTarget(ctx, denom) {
+		return sdkerrors.Wrapf(types.ErrUnknownTarget, "unknown target denom '%s'", denom)
+	}
+
+	// The stablecoin and the native token are always priced by the oracle
+	if denom == warmage.MicroUSWDenom || denom == warmage.AttoMageDenom {
+		return sdkerrors.Wrapf(types.ErrTargetInUse, "target denom '%s' cannot be deregistered", denom)
+	}
+
+	// Make sure the maker no longer prices its pools by the target
+	if k.makerKeeper != nil {
+		if params, found := k.makerKeeper.GetBackingRiskParams(ctx, denom); found && params.Enabled {
+			return sdkerrors.Wrapf(types.ErrTargetInUse, "target denom '%s' is enabled as maker backing", denom)
+		}
+		if params, found := k.makerKeeper.GetCollateralRiskParams(ctx, denom); found && params.Enabled {
+			return sdkerrors.Wrapf(types.ErrTargetInUse, "target denom '%s' is enabled as maker collateral", denom)
+		}
+	}
+
+	clearTargetSource(ctx, k, denom)
+	k.DeleteTarget(ctx, denom)
+	k.DeleteExchangeRate(ctx, denom)
+	k.DeleteHistoricalRates(ctx, denom)
+	k.retirePriceFeed(ctx, denom)
+
+	return nil
+}
+
+// setTargetSource sets the target denom to be quoted from the source.
+func setTargetSource(ctx sdk.Context, k Keeper, params types.TargetParams) error {
 	switch params.Source {
 	case types.TARGET_SOURCE_VALIDATORS:
-		k.SetVoteTarget(ctx, params.Denom)
+		k.SetVoteTargetParams(ctx, params)
+	case types.TARGET_SOURCE_DEX:
+		if !common.IsHexAddress(params.SourceDexContract) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid source DEX contract address '%s'", params.SourceDexContract)
+		}
+		contract := common.HexToAddress(params.SourceDexContract)
+		// Make sure the contract is a pair of the target denom
+		cacheCtx, _ := ctx.CacheContext()
+		if _, err := k.queryDexReserves(cacheCtx, params.Denom, contract); err != nil {
+			return err
+		}
+		k.SetDexTarget(ctx, params.Denom, contract)
+	case types.TARGET_SOURCE_INTERCHAIN_DEX, types.TARGET_SOURCE_INTERCHAIN_ORACLE:
+		if _, found := k.channelKeeper.GetChannel(ctx, types.PortID, params.SourceChannel); !found {
+			return sdkerrors.Wrapf(types.ErrInvalidChannel, "no channel '%s' of port '%s'", params.SourceChannel, types.PortID)
+		}
+		k.SetInterchainTarget(ctx, params)
 	default:
-		// TODO
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unsupported target source %s", params.Source)
 	}
-
 	return nil
 }
+
+// clearTargetSource clears whichever source the target denom is quoted from.
+func clearTargetSource(ctx sdk.Context, k Keeper, denom string) {
+	k.DeleteVoteTarget(ctx, denom)
+	k.DeleteDexTarget(ctx, denom)
+	k.DeleteInterchainTarget(ctx, denom)
+	k.DeleteExchangeRateStatus(ctx, denom)
+}
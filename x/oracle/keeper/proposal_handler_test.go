@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	warmage "github.com/petri-labs/warmage/types"
+	makertypes "github.com/petri-labs/warmage/x/maker/types"
+	"github.com/petri-labs/warmage/x/oracle/types"
+)
+
+// mockMakerKeeper serves the risk params of maker backing and collateral pools.
+type mockMakerKeeper struct {
+	backings    map[string]makertypes.BackingRiskParams
+	collaterals map[string]makertypes.CollateralRiskParams
+}
+
+func (m mockMakerKeeper) GetBackingRiskParams(_ sdk.Context, denom string) (makertypes.BackingRiskParams, bool) {
+	params, found := m.backings[denom]
+	return params, found
+}
+
+func (m mockMakerKeeper) GetCollateralRiskParams(_ sdk.Context, denom string) (makertypes.CollateralRiskParams, bool) {
+	params, found := m.collaterals[denom]
+	return params, found
+}
+
+func TestUpdateTargetProposal(t *testing.T) {
+	input, pair := setupDexTest(t)
+	k := input.OracleKeeper
+
+	proposal := &types.UpdateTargetProposal{
+		TargetParams: types.TargetParams{
+			Denom:             fooDenom3,
+			Source:            types.TARGET_SOURCE_DEX,
+			SourceDexContract: pair.Hex(),
+		},
+	}
+	require.NoError(t, proposal.ValidateBasic())
+	require.ErrorIs(t, HandleUpdateTargetProposal(input.Ctx, k, proposal), types.ErrUnknownTarget)
+
+	require.NoError(t, HandleRegisterTargetProposal(input.Ctx, k, &types.RegisterTargetProposal{
+		TargetParams: types.TargetParams{Denom: fooDenom3, Source: types.TARGET_SOURCE_VALIDATORS},
+	}))
+	require.True(t, k.IsVoteTarget(input.Ctx, fooDenom3))
+
+	// switch from validators to DEX
+	require.NoError(t, HandleUpdateTargetProposal(input.Ctx, k, proposal))
+	require.True(t, k.IsTarget(input.Ctx, fooDenom3))
+	require.False(t, k.IsVoteTarget(input.Ctx, fooDenom3))
+	params, found := k.GetTargetParams(input.Ctx, fooDenom3)
+	require.True(t, found)
+	require.Equal(t, proposal.TargetParams, params)
+
+	// switch back to validators
+	proposal.TargetParams = types.TargetParams{Denom: fooDenom3, Source: types.TARGET_SOURCE_VALIDATORS}
+	require.NoError(t, HandleUpdateTargetProposal(input.Ctx, k, proposal))
+	require.True(t, k.IsVoteTarget(input.Ctx, fooDenom3))
+	_, found = k.GetDexTarget(input.Ctx, fooDenom3)
+	require.False(t, found)
+
+	// override tally params
+	voteThreshold, rewardBand := sdk.NewDecWithPrec(8, 1), sdk.NewDecWithPrec(1, 1)
+	proposal.TargetParams = types.TargetParams{
+		Denom:         fooDenom3,
+		Source:        types.TARGET_SOURCE_VALIDATORS,
+		VoteThreshold: &voteThreshold,
+		RewardBand:    &rewardBand,
+		MinVoters:     4,
+	}
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, HandleUpdateTargetProposal(input.Ctx, k, proposal))
+	params, found = k.GetTargetParams(input.Ctx, fooDenom3)
+	require.True(t, found)
+	require.Equal(t, voteThreshold, *params.VoteThreshold)
+	require.Equal(t, rewardBand, *params.RewardBand)
+	require.Equal(t, uint64(4), params.MinVoters)
+	gotVoteThreshold, gotRewardBand, minVoters := k.GetTallyParams(input.Ctx, fooDenom3)
+	require.Equal(t, voteThreshold, gotVoteThreshold)
+	require.Equal(t, rewardBand, gotRewardBand)
+	require.Equal(t, uint64(4), minVoters)
+
+	// other vote targets fall back to the params
+	gotVoteThreshold, gotRewardBand, minVoters = k.GetTallyParams(input.Ctx, warmage.AttoMageDenom)
+	require.Equal(t, k.VoteThreshold(input.Ctx), gotVoteThreshold)
+	require.Equal(t, k.RewardBand(input.Ctx), gotRewardBand)
+	require.Equal(t, uint64(0), minVoters)
+
+	// tally overrides apply to validator sources only
+	proposal.TargetParams.Source = types.TARGET_SOURCE_DEX
+	proposal.TargetParams.SourceDexContract = pair.Hex()
+	require.Error(t, proposal.ValidateBasic())
+
+	// invalid overriding vote threshold
+	voteThreshold = sdk.NewDecWithPrec(2, 1)
+	proposal.TargetParams = types.TargetParams{Denom: fooDenom3, Source: types.TARGET_SOURCE_VALIDATORS, VoteThreshold: &voteThreshold}
+	require.Error(t, proposal.ValidateBasic())
+
+	// overrides are cleared on update
+	proposal.TargetParams = types.TargetParams{Denom: fooDenom3, Source: types.TARGET_SOURCE_VALIDATORS}
+	require.NoError(t, HandleUpdateTargetProposal(input.Ctx, k, proposal))
+	params, _ = k.GetTargetParams(input.Ctx, fooDenom3)
+	require.Equal(t, proposal.TargetParams, params)
+}
+
+func TestDeregisterTargetProposal(t *testing.T) {
+	input, pair := setupDexTest(t)
+	k := input.OracleKeeper
+	maker := mockMakerKeeper{
+		backings:    map[string]makertypes.BackingRiskParams{fooDenom3: {BackingDenom: fooDenom3, Enabled: true}},
+		collaterals: map[string]makertypes.CollateralRiskParams{fooDenom3: {CollateralDenom: fooDenom3, Enabled: true}},
+	}
+	k.SetMakerKeeper(maker)
+
+	proposal := &types.DeregisterTargetProposal{Denom: fooDenom3}
+	require.NoError(t, proposal.ValidateBasic())
+	require.ErrorIs(t, HandleDeregisterTargetProposal(input.Ctx, k, proposal), types.ErrUnknownTarget)
+
+	k.SetTarget(input.Ctx, fooDenom3)
+	k.SetDexTarget(input.Ctx, fooDenom3, pair)
+	k.SetExchangeRate(input.Ctx, fooDenom3, sdk.OneDec())
+
+	// enabled as maker backing and collateral
+	require.ErrorIs(t, HandleDeregisterTargetProposal(input.Ctx, k, proposal), types.ErrTargetInUse)
+	maker.backings[fooDenom3] = makertypes.BackingRiskParams{BackingDenom: fooDenom3}
+	require.ErrorIs(t, HandleDeregisterTargetProposal(input.Ctx, k, proposal), types.ErrTargetInUse)
+	maker.collaterals[fooDenom3] = makertypes.CollateralRiskParams{CollateralDenom: fooDenom3}
+
+	require.NoError(t, HandleDeregisterTargetProposal(input.Ctx, k, proposal))
+	require.False(t, k.IsTarget(input.Ctx, fooDenom3))
+	_, found := k.GetDexTarget(input.Ctx, fooDenom3)
+	require.False(t, found)
+	_, err := k.GetExchangeRate(input.Ctx, fooDenom3)
+	require.Error(t, err)
+	_, found = k.GetTargetParams(input.Ctx, fooDenom3)
+	require.False(t, found)
+}
+
+func TestDeregisterTargetProposalClearsState(t *testing.T) {
+	input := CreateTestInput(t)
+	k := input.OracleKeeper
+
+	// The stablecoin and the native token cannot be deregistered
+	for _, denom := range []string{warmage.MicroUSWDenom, warmage.AttoMageDenom} {
+		k.SetTarget(input.Ctx, denom)
+		require.ErrorIs(t, HandleDeregisterTargetProposal(input.Ctx, k, &types.DeregisterTargetProposal{Denom: denom}), types.ErrTargetInUse)
+		require.True(t, k.IsTarget(input.Ctx, denom))
+	}
+
+	ctx := input.Ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	input.Ctx = ctx
+	require.NoError(t, FundAccount(input, Addrs[0], sdk.NewCoins(sdk.NewInt64Coin(fooDenom1, 1000))))
+	require.NoError(t, HandleRegisterTargetProposal(ctx, k, &types.RegisterTargetProposal{
+		TargetParams: types.TargetParams{Denom: fooDenom1, Source: types.TARGET_SOURCE_VALIDATORS},
+	}))
+	rate := sdk.NewDecWithPrec(15, 1)
+	k.ApplyTalliedExchangeRate(ctx, fooDenom1, rate)
+	k.AddHistoricalRate(ctx, fooDenom1, rate)
+	k.SyncPriceFeeds(ctx)
+	contract, found := k.GetPriceFeed(ctx, fooDenom1)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(time.Unix(1006, 0))
+	input.Ctx = ctx
+	require.NoError(t, HandleDeregisterTargetProposal(ctx, k, &types.DeregisterTargetProposal{Denom: fooDenom1}))
+	require.False(t, k.IsVoteTarget(ctx, fooDenom1))
+	_, err := k.GetHistoricalRate(ctx, fooDenom1, ctx.BlockHeight())
+	require.ErrorIs(t, err, types.ErrNoHistoricalRate)
+	_, err = k.GetTWAP(ctx, fooDenom1, time.Hour)
+	require.ErrorIs(t, err, types.ErrNoHistoricalRate)
+
+	// The price feed is kept with a zero answer
+	got, found := k.GetPriceFeed(ctx, fooDenom1)
+	require.True(t, found)
+	require.Equal(t, contract, got)
+	round := callPriceFeed(t, input, contract, "latestRoundData")
+	require.Equal(t, big.NewInt(11), round[0])
+	require.Zero(t, round[1].(*big.Int).Sign())
+	require.Equal(t, big.NewInt(1006), round[3])
+}
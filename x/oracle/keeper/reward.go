@@ -8,23 +8,110 @@ import (
 	"github.com/petri-labs/warmage/x/oracle/types"
 )
 
+// AllocateMakerFees allocates the fees collected by the sender module
+// according to MakerFeeSplit. The share of ve holders goes into the ve
+// distribution pool, which pays MAGE as is and any other denom as an extra
+// reward denom. The share of oracle voters goes into the reward pool.
+func (k Keeper) AllocateMakerFees(ctx sdk.Context, senderModule string, fees sdk.Coins) error {
+	if fees.IsZero() {
+		return nil
+	}
+
+	split := k.MakerFeeSplit(ctx)
+
+	var communityPoolFees, veHoldersFees sdk.Coins
+	for _, fee := range fees {
+		communityPoolFees = communityPoolFees.Add(sdk.NewCoin(fee.Denom, split.CommunityPool.MulInt(fee.Amount).TruncateInt()))
+		veHoldersFees = veHoldersFees.Add(sdk.NewCoin(fee.Denom, split.VeHolders.MulInt(fee.Amount).TruncateInt()))
+	}
+	oracleVotersFees := fees.Sub(communityPoolFees).Sub(veHoldersFees)
+
+	if !communityPoolFees.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, communityPoolFees, k.accountKeeper.GetModuleAddress(senderModule)); err != nil {
+			return err
+		}
+	}
+	if !veHoldersFees.IsZero() {
+		if err := k.veKeeper.DistributeFees(ctx, senderModule, veHoldersFees); err != nil {
+			return err
+		}
+	}
+	if !oracleVotersFees.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, oracleVotersFees); err != nil {
+			return err
+		}
+		for _, fee := range oracleVotersFees {
+			k.SetRewardPoolInflow(ctx, fee.Denom, k.GetRewardPoolInflow(ctx, fee.Denom).Add(fee.Amount))
+		}
+	}
+	return nil
+}
+
+// GetRewardPoolInflow returns the fees of denom accumulated into the reward pool.
+func (k Keeper) GetRewardPoolInflow(ctx sdk.Context, denom string) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRewardPoolInflowKey(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &ip)
+	return ip.Int
+}
+
+// SetRewardPoolInflow sets the fees of denom accumulated into the reward pool.
+func (k Keeper) SetRewardPoolInflow(ctx sdk.Context, denom string, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: amount})
+	store.Set(types.GetRewardPoolInflowKey(denom), bz)
+}
+
+// IterateRewardPoolInflows iterates over the fees accumulated into the reward pool per denom.
+func (k Keeper) IterateRewardPoolInflows(ctx sdk.Context, handler func(inflow sdk.Coin) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.RewardPoolInflowKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Key()[len(types.RewardPoolInflowKey):])
+		ip := sdk.IntProto{}
+		k.cdc.MustUnmarshal(iter.Value(), &ip)
+		if handler(sdk.NewCoin(denom, ip.Int)) {
+			break
+		}
+	}
+}
+
+// GetRewardPoolBalances returns all coins held by the reward pool.
+func (k Keeper) GetRewardPoolBalances(ctx sdk.Context) sdk.Coins {
+	acc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	return k.bankKeeper.GetAllBalances(ctx, acc.GetAddress())
+}
+
+// GetPeriodRewards returns the rewards given out to ballot winners per vote
+// period, i.e., the portion VotePeriod / RewardDistributionWindow of every
+// denom held by the reward pool.
+func (k Keeper) GetPeriodRewards(ctx sdk.Context, votePeriod int64, rewardDistributionWindow int64) sdk.DecCoins {
+	distributionRatio := sdk.NewDec(votePeriod).QuoInt64(rewardDistributionWindow)
+
+	var periodRewards sdk.DecCoins
+	for _, rewardPool := range k.GetRewardPoolBalances(ctx) {
+		periodRewards = periodRewards.Add(sdk.NewDecCoinFromDec(
+			rewardPool.Denom,
+			sdk.NewDecFromInt(rewardPool.Amount).Mul(distributionRatio),
+		))
+	}
+	return periodRewards
+}
+
 // RewardBallotWinners gives out portion of seigniorage reward to the
 // oracle voters that voted faithfully, at the end of every VotePeriod.
 func (k Keeper) RewardBallotWinners(
 	ctx sdk.Context,
 	votePeriod int64,
 	rewardDistributionWindow int64,
-	voteTargets map[string]struct{},
 	ballotWinners map[string]types.Claim,
 ) {
-	rewardDenoms := make([]string, len(voteTargets))
-
-	i := 0
-	for denom := range voteTargets {
-		rewardDenoms[i] = denom
-		i++
-	}
-
 	// Sum weight of the claims
 	ballotPowerSum := int64(0)
 	for _, winner := range ballotWinners {
@@ -36,21 +123,11 @@ func (k Keeper) RewardBallotWinners(
 		return
 	}
 
-	distributionRatio := sdk.NewDec(votePeriod).QuoInt64(rewardDistributionWindow)
-
-	var periodRewards sdk.DecCoins
-	for _, denom := range rewardDenoms {
-		rewardPool := k.GetRewardPool(ctx, denom)
+	periodRewards := k.GetPeriodRewards(ctx, votePeriod, rewardDistributionWindow)
 
-		// Return if there's no rewards to give out
-		if rewardPool.IsZero() {
-			continue
-		}
-
-		periodRewards = periodRewards.Add(sdk.NewDecCoinFromDec(
-			denom,
-			sdk.NewDecFromInt(rewardPool.Amount).Mul(distributionRatio),
-		))
+	// Return if there's no rewards to give out
+	if periodRewards.IsZero() {
+		return
 	}
 
 	// Dole out rewards
@@ -46,28 +46,24 @@ func TestRewardBallotWinners(t *testing.T) {
 		addr1.String(): claim2,
 	}
 
-	// Prepare reward pool
-	givingAmt := sdk.NewCoins(sdk.NewInt64Coin(warmage.AttoMageDenom, 30000000), sdk.NewInt64Coin(warmage.MicroUSWDenom, 40000000))
+	// Prepare reward pool, including a denom which is not a vote target
+	givingAmt := sdk.NewCoins(sdk.NewInt64Coin(warmage.AttoMageDenom, 30000000), sdk.NewInt64Coin(warmage.MicroUSWDenom, 40000000), sdk.NewInt64Coin(fooDenom3, 50000000))
 	acc := input.AccountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	err = FundAccount(input, acc.GetAddress(), givingAmt)
 	require.NoError(t, err)
 
-	voteTargets := make(map[string]struct{})
-	input.OracleKeeper.IterateVoteTargets(ctx, func(denom string) bool {
-		voteTargets[denom] = struct{}{}
-		return false
-	})
-
 	votePeriodsPerWindow := sdk.NewDec((int64)(input.OracleKeeper.RewardDistributionWindow(input.Ctx))).
 		QuoInt64((int64)(input.OracleKeeper.VotePeriod(input.Ctx))).
 		TruncateInt64()
-	input.OracleKeeper.RewardBallotWinners(ctx, (int64)(input.OracleKeeper.VotePeriod(input.Ctx)), (int64)(input.OracleKeeper.RewardDistributionWindow(input.Ctx)), voteTargets, claims)
+	input.OracleKeeper.RewardBallotWinners(ctx, (int64)(input.OracleKeeper.VotePeriod(input.Ctx)), (int64)(input.OracleKeeper.RewardDistributionWindow(input.Ctx)), claims)
 	outstandingRewardsDec := input.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, addr)
 	outstandingRewards, _ := outstandingRewardsDec.TruncateDecimal()
 	require.Equal(t, sdk.NewDecFromInt(givingAmt.AmountOf(warmage.AttoMageDenom)).QuoInt64(votePeriodsPerWindow).QuoInt64(3).TruncateInt(),
 		outstandingRewards.AmountOf(warmage.AttoMageDenom))
 	require.Equal(t, sdk.NewDecFromInt(givingAmt.AmountOf(warmage.MicroUSWDenom)).QuoInt64(votePeriodsPerWindow).QuoInt64(3).TruncateInt(),
 		outstandingRewards.AmountOf(warmage.MicroUSWDenom))
+	require.Equal(t, sdk.NewDecFromInt(givingAmt.AmountOf(fooDenom3)).QuoInt64(votePeriodsPerWindow).QuoInt64(3).TruncateInt(),
+		outstandingRewards.AmountOf(fooDenom3))
 
 	outstandingRewardsDec1 := input.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, addr1)
 	outstandingRewards1, _ := outstandingRewardsDec1.TruncateDecimal()
@@ -76,3 +72,38 @@ func TestRewardBallotWinners(t *testing.T) {
 	require.Equal(t, sdk.NewDecFromInt(givingAmt.AmountOf(warmage.MicroUSWDenom)).QuoInt64(votePeriodsPerWindow).QuoInt64(3).MulInt64(2).TruncateInt(),
 		outstandingRewards1.AmountOf(warmage.MicroUSWDenom))
 }
+
+func TestAllocateMakerFees(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := input.Ctx
+	k := input.OracleKeeper
+
+	params := k.GetParams(ctx)
+	params.MakerFeeSplit = types.FeeSplit{
+		OracleVoters:  sdk.NewDecWithPrec(5, 1),
+		CommunityPool: sdk.NewDecWithPrec(3, 1),
+		VeHolders:     sdk.NewDecWithPrec(2, 1),
+	}
+	k.SetParams(ctx, params)
+
+	// the faucet module stands in for maker
+	fees := sdk.NewCoins(sdk.NewInt64Coin(warmage.AttoMageDenom, 1000), sdk.NewInt64Coin(warmage.MicroUSWDenom, 100))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, faucetAccountName, fees))
+	require.NoError(t, k.AllocateMakerFees(ctx, faucetAccountName, fees))
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(warmage.AttoMageDenom, 500), sdk.NewInt64Coin(warmage.MicroUSWDenom, 50)), k.GetRewardPoolBalances(ctx))
+	require.Equal(t, sdk.NewInt(500), k.GetRewardPoolInflow(ctx, warmage.AttoMageDenom))
+	require.Equal(t, sdk.NewInt(50), k.GetRewardPoolInflow(ctx, warmage.MicroUSWDenom))
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin(warmage.AttoMageDenom, 300), sdk.NewInt64DecCoin(warmage.MicroUSWDenom, 30)), input.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+	// every denom of the ve holders share is paid into the ve distribution pool
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(warmage.AttoMageDenom, 200), sdk.NewInt64Coin(warmage.MicroUSWDenom, 20)),
+		input.BankKeeper.GetAllBalances(ctx, input.AccountKeeper.GetModuleAddress(veDistributionPoolTestName)))
+
+	// inflows accumulate
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, faucetAccountName, fees))
+	require.NoError(t, k.AllocateMakerFees(ctx, faucetAccountName, fees))
+	require.Equal(t, sdk.NewInt(1000), k.GetRewardPoolInflow(ctx, warmage.AttoMageDenom))
+
+	periodRewards := k.GetPeriodRewards(ctx, 1, 10)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin(warmage.AttoMageDenom, 100), sdk.NewInt64DecCoin(warmage.MicroUSWDenom, 10)), periodRewards)
+}
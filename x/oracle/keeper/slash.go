@@ -1,10 +1,15 @@
 package keeper
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/petri-labs/warmage/x/oracle/types"
 )
 
 // SlashAndResetMissCounters slashes any operator who over criteria and clears all operators' miss counter to zero.
+// The performances of all operators over the window are recorded into the oracle history.
 func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 	stakingKeeper := k.StakingKeeper()
 	height := ctx.BlockHeight()
@@ -20,7 +25,10 @@ func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 	slashFraction := k.SlashFraction(ctx)
 	powerReduction := stakingKeeper.PowerReduction(ctx)
 
+	missCounters := make(map[string]uint64)
+	slashed := make(map[string]bool)
 	k.IterateMissCounters(ctx, func(operator sdk.ValAddress, missCounter uint64) bool {
+		missCounters[operator.String()] = missCounter
 
 		// Calculate valid vote rate: (SlashWindow - MissCounter) / SlashWindow
 		validVoteRate := sdk.NewDecFromInt(
@@ -36,15 +44,52 @@ func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 					panic(err)
 				}
 
+				power := validator.GetConsensusPower(powerReduction)
 				stakingKeeper.Slash(
 					ctx, consAddr,
-					distributionHeight, validator.GetConsensusPower(powerReduction), slashFraction,
+					distributionHeight, power, slashFraction,
 				)
 				stakingKeeper.Jail(ctx, consAddr)
+				slashed[operator.String()] = true
+
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(types.EventTypeSlash,
+						sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+						sdk.NewAttribute(types.AttributeKeyPower, strconv.FormatInt(power, 10)),
+						sdk.NewAttribute(types.AttributeKeyMissCounter, strconv.FormatUint(missCounter, 10)),
+						sdk.NewAttribute(types.AttributeKeyValidVoteRate, validVoteRate.String()),
+						sdk.NewAttribute(types.AttributeKeySlashFraction, slashFraction.String()),
+					),
+				)
 			}
 		}
 
 		k.DeleteMissCounter(ctx, operator)
 		return false
 	})
+
+	k.IterateValidatorPerformances(ctx, func(performance types.ValidatorPerformance) bool {
+		operator, err := sdk.ValAddressFromBech32(performance.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		jailed := false
+		if validator := stakingKeeper.Validator(ctx, operator); validator != nil {
+			jailed = validator.IsJailed()
+		}
+
+		k.SetValidatorOracleStats(ctx, types.ValidatorOracleStats{
+			ValidatorAddress: performance.ValidatorAddress,
+			WindowEndHeight:  height,
+			VotePeriods:      performance.VotePeriods,
+			Misses:           missCounters[performance.ValidatorAddress],
+			Wins:             performance.Wins,
+			Slashed:          slashed[performance.ValidatorAddress],
+			Jailed:           jailed,
+		})
+
+		k.DeleteValidatorPerformance(ctx, operator)
+		return false
+	})
 }
@@ -7,6 +7,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/petri-labs/warmage/x/oracle/types"
 )
 
 func TestSlashAndResetMissCounters(t *testing.T) {
@@ -79,3 +81,77 @@ func TestSlashAndResetMissCounters(t *testing.T) {
 	validator, _ = input.StakingKeeper.GetValidator(input.Ctx, ValAddrs[0])
 	require.Equal(t, amt, validator.Tokens)
 }
+
+func TestSlashAndResetMissCountersRecordsHistory(t *testing.T) {
+	input := CreateTestInput(t)
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := staking.NewHandler(input.StakingKeeper)
+	ctx := input.Ctx
+
+	_, err := sh(ctx, NewTestMsgCreateValidator(ValAddrs[0], ValPubKeys[0], amt))
+	require.NoError(t, err)
+	_, err = sh(ctx, NewTestMsgCreateValidator(ValAddrs[1], ValPubKeys[1], amt))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	votePeriodsPerWindow := sdk.NewDec(int64(input.OracleKeeper.SlashWindow(ctx))).QuoInt64(int64(input.OracleKeeper.VotePeriod(ctx))).TruncateInt64()
+	minValidVotes := input.OracleKeeper.MinValidPerWindow(ctx).MulInt64(votePeriodsPerWindow).TruncateInt64()
+	misses := uint64(votePeriodsPerWindow - minValidVotes + 1)
+
+	// Validator 0 misses too many vote periods, validator 1 wins every ballot
+	for i := 0; i < 3; i++ {
+		input.OracleKeeper.AddValidatorPerformance(ctx, ValAddrs[0], 0)
+		input.OracleKeeper.AddValidatorPerformance(ctx, ValAddrs[1], 2)
+	}
+	input.OracleKeeper.SetMissCounter(ctx, ValAddrs[0], misses)
+
+	ctx = ctx.WithBlockHeight(int64(input.OracleKeeper.SlashWindow(ctx)) - 1).WithEventManager(sdk.NewEventManager())
+	current := input.OracleKeeper.GetCurrentValidatorOracleStats(ctx.WithBlockHeight(ctx.BlockHeight()-1), ValAddrs[0])
+	require.Equal(t, ctx.BlockHeight(), current.WindowEndHeight)
+	require.Equal(t, uint64(3), current.VotePeriods)
+	require.Equal(t, misses, current.Misses)
+
+	input.OracleKeeper.SlashAndResetMissCounters(ctx)
+
+	var history []types.ValidatorOracleStats
+	input.OracleKeeper.IterateValidatorOracleHistory(ctx, func(stats types.ValidatorOracleStats) (stop bool) {
+		history = append(history, stats)
+		return false
+	})
+	require.Len(t, history, 2)
+	for _, stats := range history {
+		require.Equal(t, ctx.BlockHeight(), stats.WindowEndHeight)
+		require.Equal(t, uint64(3), stats.VotePeriods)
+		switch stats.ValidatorAddress {
+		case ValAddrs[0].String():
+			require.Equal(t, misses, stats.Misses)
+			require.Equal(t, uint64(0), stats.Wins)
+			require.True(t, stats.Slashed)
+			require.True(t, stats.Jailed)
+		case ValAddrs[1].String():
+			require.Equal(t, uint64(0), stats.Misses)
+			require.Equal(t, uint64(6), stats.Wins)
+			require.False(t, stats.Slashed)
+			require.False(t, stats.Jailed)
+		default:
+			t.Fatalf("unexpected validator %s", stats.ValidatorAddress)
+		}
+	}
+
+	// Slash event emitted for validator 0 only
+	var slashEvents []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSlash {
+			slashEvents = append(slashEvents, event)
+		}
+	}
+	require.Len(t, slashEvents, 1)
+	require.Equal(t, types.AttributeKeyOperator, string(slashEvents[0].Attributes[0].Key))
+	require.Equal(t, ValAddrs[0].String(), string(slashEvents[0].Attributes[0].Value))
+
+	// Performances of the next window start from scratch
+	current = input.OracleKeeper.GetCurrentValidatorOracleStats(ctx, ValAddrs[1])
+	require.Equal(t, ctx.BlockHeight()+int64(input.OracleKeeper.SlashWindow(ctx)), current.WindowEndHeight)
+	require.Equal(t, uint64(0), current.VotePeriods)
+	require.Equal(t, uint64(0), current.Wins)
+}
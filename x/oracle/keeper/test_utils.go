@@ -2,6 +2,7 @@
 package keeper
 
 import (
+	"math/big"
 	"testing"
 	"time"
 
@@ -12,7 +13,9 @@ import (
 	simparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	"github.com/cosmos/cosmos-sdk/std"
 	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
@@ -21,6 +24,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -29,6 +34,13 @@ import (
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	portkeeper "github.com/cosmos/ibc-go/v3/modules/core/05-port/keeper"
+	ibchost "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
 	warmage "github.com/petri-labs/warmage/types"
 	"github.com/petri-labs/warmage/x/oracle/types"
 	customstaking "github.com/petri-labs/warmage/x/staking"
@@ -38,9 +50,14 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+	erc20types "github.com/petri-labs/warmage/x/erc20/types"
 )
 
-const faucetAccountName = "faucet"
+const (
+	faucetAccountName          = "faucet"
+	veDistributionPoolTestName = "ve_distribution_pool"
+)
 
 // ModuleBasics nolint
 var ModuleBasics = module.NewBasicManager(
@@ -121,6 +138,7 @@ type TestInput struct {
 	OracleKeeper  Keeper
 	StakingKeeper stakingkeeper.Keeper
 	DistrKeeper   distrkeeper.Keeper
+	ParamsKey     sdk.StoreKey
 }
 
 // CreateTestInput nolint
@@ -132,6 +150,8 @@ func CreateTestInput(t *testing.T) TestInput {
 	keyOracle := sdk.NewKVStoreKey(types.StoreKey)
 	keyStaking := sdk.NewKVStoreKey(stakingtypes.StoreKey)
 	keyDistr := sdk.NewKVStoreKey(distrtypes.StoreKey)
+	keyCapability := sdk.NewKVStoreKey(capabilitytypes.StoreKey)
+	memKeyCapability := storetypes.NewMemoryStoreKey(capabilitytypes.MemStoreKey)
 
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
@@ -146,6 +166,8 @@ func CreateTestInput(t *testing.T) TestInput {
 	ms.MountStoreWithDB(keyOracle, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyDistr, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyCapability, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(memKeyCapability, sdk.StoreTypeMemory, nil)
 
 	require.NoError(t, ms.LoadLatestVersion())
 
@@ -164,6 +186,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		distrtypes.ModuleName:          nil,
 		types.ModuleName:               nil,
+		veDistributionPoolTestName:     nil,
 	}
 
 	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, keyParams, tKeyParams)
@@ -219,6 +242,13 @@ func CreateTestInput(t *testing.T) TestInput {
 		require.NoError(t, err)
 	}
 
+	capabilityKeeper := capabilitykeeper.NewKeeper(appCodec, keyCapability, memKeyCapability)
+	portKeeper := portkeeper.NewKeeper(capabilityKeeper.ScopeToModule(ibchost.ModuleName))
+	scopedOracleKeeper := capabilityKeeper.ScopeToModule(types.ModuleName)
+	capabilityKeeper.Seal()
+	capabilityKeeper.InitMemStore(ctx)
+
+	evm := newTestEVM(t, accountKeeper)
 	keeper := NewKeeper(
 		appCodec,
 		keyOracle,
@@ -227,6 +257,12 @@ func CreateTestInput(t *testing.T) TestInput {
 		bankKeeper,
 		distrKeeper,
 		stakingKeeper,
+		testVeKeeper{bankKeeper},
+		evm,
+		evm,
+		nil,
+		&portKeeper,
+		scopedOracleKeeper,
 		distrtypes.ModuleName,
 	)
 
@@ -236,7 +272,69 @@ func CreateTestInput(t *testing.T) TestInput {
 	keeper.SetVoteTarget(ctx, warmage.AttoMageDenom)
 	keeper.SetVoteTarget(ctx, warmage.MicroUSWDenom)
 
-	return TestInput{ctx, legacyAmino, accountKeeper, bankKeeper, *keeper, stakingKeeper, distrKeeper}
+	return TestInput{ctx, legacyAmino, accountKeeper, bankKeeper, *keeper, stakingKeeper, distrKeeper, keyParams}
+}
+
+// testVeKeeper pays the fees into the ve distribution pool, without
+// distributing them
+type testVeKeeper struct {
+	bankKeeper bankkeeper.Keeper
+}
+
+func (k testVeKeeper) DistributeFees(ctx sdk.Context, senderModule string, fees sdk.Coins) error {
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, veDistributionPoolTestName, fees)
+}
+
+// testEVM is an in-memory EVM serving as both the erc20 keeper, through which
+// contracts are deployed, and the EVM keeper, through which their storage is written
+type testEVM struct {
+	accountKeeper authkeeper.AccountKeeper
+	stateDB       *state.StateDB
+}
+
+func newTestEVM(t *testing.T, accountKeeper authkeeper.AccountKeeper) *testEVM {
+	stateDB, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	require.NoError(t, err)
+	return &testEVM{accountKeeper: accountKeeper, stateDB: stateDB}
+}
+
+func (e *testEVM) CallEVM(_ sdk.Context, _ abi.ABI, _, _ common.Address, _ string, _ ...interface{}) (*evmtypes.MsgEthereumTxResponse, error) {
+	return nil, evmtypes.ErrVMExecution
+}
+
+func (e *testEVM) CallEVMWithData(ctx sdk.Context, from common.Address, contract *common.Address, data []byte) (*evmtypes.MsgEthereumTxResponse, error) {
+	account := e.accountKeeper.GetAccount(ctx, from.Bytes())
+	e.stateDB.SetNonce(from, account.GetSequence())
+
+	cfg := &runtime.Config{Origin: from, State: e.stateDB, BlockNumber: big.NewInt(ctx.BlockHeight())}
+	var ret []byte
+	var err error
+	if contract == nil {
+		_, _, _, err = runtime.Create(data, cfg)
+	} else {
+		ret, _, err = runtime.Call(*contract, data, cfg)
+	}
+	if err != nil {
+		return nil, sdkerrors.Wrap(evmtypes.ErrVMExecution, err.Error())
+	}
+
+	if err := account.SetSequence(account.GetSequence() + 1); err != nil {
+		return nil, err
+	}
+	e.accountKeeper.SetAccount(ctx, account)
+	return &evmtypes.MsgEthereumTxResponse{Ret: ret}, nil
+}
+
+func (e *testEVM) GetTokenPairID(_ sdk.Context, _ string) []byte {
+	return nil
+}
+
+func (e *testEVM) GetTokenPair(_ sdk.Context, _ []byte) (erc20types.TokenPair, bool) {
+	return erc20types.TokenPair{}, false
+}
+
+func (e *testEVM) SetState(_ sdk.Context, addr common.Address, key common.Hash, value []byte) {
+	e.stateDB.SetState(addr, key, common.BytesToHash(value))
 }
 
 // NewTestMsgCreateValidator test msg creator
@@ -0,0 +1,58 @@
+package oracle_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/petri-labs/warmage/x/oracle"
+	"github.com/petri-labs/warmage/x/oracle/keeper"
+	"github.com/petri-labs/warmage/x/oracle/types"
+)
+
+func TestMigrate2to3(t *testing.T) {
+	input, h := setup(t)
+	ctx := input.Ctx.WithBlockHeight(1)
+
+	// params of version 2 lack the keys added since
+	store := prefix.NewStore(ctx.KVStore(input.ParamsKey), append([]byte(types.ModuleName), '/'))
+	for _, key := range [][]byte{
+		types.KeyHistoricalRatesLookback,
+		types.KeyDexPriceBand,
+		types.KeyInterchainRateMaxAge,
+		types.KeyMakerFeeSplit,
+		types.KeyMaxPriceChange,
+		types.KeyMaxHeldRounds,
+	} {
+		require.True(t, store.Has(key))
+		store.Delete(key)
+	}
+	cacheCtx, _ := ctx.CacheContext()
+	require.Panics(t, func() { oracle.EndBlocker(cacheCtx, input.OracleKeeper) })
+
+	require.NoError(t, keeper.NewMigrator(input.OracleKeeper).Migrate2to3(ctx))
+
+	params := input.OracleKeeper.GetParams(ctx)
+	require.NoError(t, params.Validate())
+	defaults := types.DefaultParams()
+	require.Equal(t, defaults.HistoricalRatesLookback, params.HistoricalRatesLookback)
+	require.Equal(t, defaults.DexPriceBand, params.DexPriceBand)
+	require.Equal(t, defaults.InterchainRateMaxAge, params.InterchainRateMaxAge)
+	require.Equal(t, defaults.MakerFeeSplit, params.MakerFeeSplit)
+	require.Equal(t, defaults.MaxPriceChange, params.MaxPriceChange)
+	require.Equal(t, defaults.MaxHeldRounds, params.MaxHeldRounds)
+	// params of version 2 are kept
+	require.Equal(t, uint64(1), params.VotePeriod)
+
+	// the exchange rates are tallied once migrated
+	for i := 0; i < 3; i++ {
+		makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: denom1, Amount: randomExchangeRate}}, i)
+	}
+	oracle.EndBlocker(ctx, input.OracleKeeper)
+
+	rate, err := input.OracleKeeper.GetExchangeRate(ctx, denom1)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+}
@@ -36,10 +36,9 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	for i, acc := range simState.Accounts {
 		accs[i] = acc.Address.String()
 	}
-	oracleGenesis := types.GenesisState{
-		// this line is used by starport scaffolding # simapp/module/genesisState
-	}
-	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&oracleGenesis)
+	oracleGenesis := types.DefaultGenesis()
+	// this line is used by starport scaffolding # simapp/module/genesisState
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(oracleGenesis)
 }
 
 // ProposalContents doesn't return any content functions for governance proposals
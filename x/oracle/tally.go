@@ -37,10 +37,11 @@ func Tally(ctx sdk.Context, pb types.ExchangeRateBallot, rewardBand sdk.Dec, val
 	return
 }
 
-// ballotIsPassing returns the ballot power and whether the power is passing the threshold amount of voting power.
-func ballotIsPassing(ballot types.ExchangeRateBallot, thresholdVotes sdk.Int) (sdk.Int, bool) {
+// ballotIsPassing returns the ballot power and whether the power is passing the threshold amount of voting power,
+// cast by at least the minimum number of distinct voters.
+func ballotIsPassing(ballot types.ExchangeRateBallot, thresholdVotes sdk.Int, minVoters uint64) (sdk.Int, bool) {
 	ballotPower := sdk.NewInt(ballot.Power())
-	return ballotPower, !ballotPower.IsZero() && ballotPower.GTE(thresholdVotes)
+	return ballotPower, !ballotPower.IsZero() && ballotPower.GTE(thresholdVotes) && ballot.Voters() >= minVoters
 }
 
 // PickReferenceWar chooses Reference War with the highest voter turnout.
@@ -52,8 +53,6 @@ func PickReferenceWar(ctx sdk.Context, k keeper.Keeper, voteTargets map[string]s
 
 	stakingKeeper := k.StakingKeeper()
 	totalBondedPower := sdk.TokensToConsensusPower(stakingKeeper.TotalBondedTokens(ctx), stakingKeeper.PowerReduction(ctx))
-	voteThreshold := k.VoteThreshold(ctx)
-	thresholdVotes := voteThreshold.MulInt64(totalBondedPower).RoundInt()
 
 	for denom, ballot := range voteMap {
 		// If denom is not in the voteTargets, or the ballot for it has failed, then skip
@@ -64,10 +63,12 @@ func PickReferenceWar(ctx sdk.Context, k keeper.Keeper, voteTargets map[string]s
 		}
 
 		ballotPower := int64(0)
+		voteThreshold, _, minVoters := k.GetTallyParams(ctx, denom)
+		thresholdVotes := voteThreshold.MulInt64(totalBondedPower).RoundInt()
 
 		// If the ballot is not passed, remove it from the voteTargets array
 		// to prevent slashing validators who did valid vote.
-		if power, ok := ballotIsPassing(ballot, thresholdVotes); ok {
+		if power, ok := ballotIsPassing(ballot, thresholdVotes, minVoters); ok {
 			ballotPower = power.Int64()
 		} else {
 			delete(voteTargets, denom)
@@ -68,6 +68,18 @@ func (pb ExchangeRateBallot) ToCrossRateWithSort(bases map[string]sdk.Dec) (cb E
 	return
 }
 
+// Voters returns the number of distinct voters with voting power in the ballot.
+func (pb ExchangeRateBallot) Voters() uint64 {
+	voters := make(map[string]struct{})
+	for _, vote := range pb {
+		if vote.Power > 0 {
+			voters[string(vote.Voter)] = struct{}{}
+		}
+	}
+
+	return uint64(len(voters))
+}
+
 // Power returns the total amount of voting power in the ballot.
 func (pb ExchangeRateBallot) Power() int64 {
 	totalPower := int64(0)
@@ -159,6 +159,26 @@ func TestPBPower(t *testing.T) {
 	require.Equal(t, ballotPower, pb.Power())
 }
 
+func TestPBVoters(t *testing.T) {
+	valAddrs := []sdk.ValAddress{
+		sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()),
+	}
+
+	pb := types.ExchangeRateBallot{
+		types.NewVoteForTally(sdk.OneDec(), warmage.AttoMageDenom, valAddrs[0], 10),
+		types.NewVoteForTally(sdk.OneDec(), warmage.AttoMageDenom, valAddrs[1], 10),
+		// abstain vote has no voting power
+		types.NewVoteForTally(sdk.ZeroDec(), warmage.AttoMageDenom, valAddrs[2], 0),
+	}
+	require.Equal(t, uint64(2), pb.Voters())
+
+	// duplicate voter is counted once
+	pb = append(pb, types.NewVoteForTally(sdk.OneDec(), warmage.AttoMageDenom, valAddrs[0], 10))
+	require.Equal(t, uint64(2), pb.Voters())
+}
+
 func TestPBWeightedMedian(t *testing.T) {
 	tests := []struct {
 		inputs      []int64
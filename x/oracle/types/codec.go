@@ -18,6 +18,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&RegisterTargetProposal{},
+		&UpdateTargetProposal{},
+		&DeregisterTargetProposal{},
 	)
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
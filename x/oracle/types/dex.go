@@ -0,0 +1,61 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// UniswapV2PairABI is the ABI subset of a Uniswap V2 compatible pair contract,
+// from which the exchange rates of DEX targets are quoted.
+var UniswapV2PairABI abi.ABI
+
+const uniswapV2PairJSON = `[
+  {
+    "inputs": [],
+    "name": "token0",
+    "outputs": [{"internalType": "address", "name": "", "type": "address"}],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [],
+    "name": "token1",
+    "outputs": [{"internalType": "address", "name": "", "type": "address"}],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [],
+    "name": "getReserves",
+    "outputs": [
+      {"internalType": "uint112", "name": "reserve0", "type": "uint112"},
+      {"internalType": "uint112", "name": "reserve1", "type": "uint112"},
+      {"internalType": "uint32", "name": "blockTimestampLast", "type": "uint32"}
+    ],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [],
+    "name": "price0CumulativeLast",
+    "outputs": [{"internalType": "uint256", "name": "", "type": "uint256"}],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [],
+    "name": "price1CumulativeLast",
+    "outputs": [{"internalType": "uint256", "name": "", "type": "uint256"}],
+    "stateMutability": "view",
+    "type": "function"
+  }
+]`
+
+func init() {
+	var err error
+	UniswapV2PairABI, err = abi.JSON(strings.NewReader(uniswapV2PairJSON))
+	if err != nil {
+		panic(err)
+	}
+}
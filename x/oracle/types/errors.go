@@ -25,4 +25,11 @@ var (
 	ErrNoVoteTarget          = sdkerrors.Register(ModuleName, 13, "no vote target")
 	ErrUnknownDenom          = sdkerrors.Register(ModuleName, 14, "unknown denom")
 	ErrExistingTarget        = sdkerrors.Register(ModuleName, 15, "existing denom")
+	ErrNoHistoricalRate      = sdkerrors.Register(ModuleName, 16, "no historical exchange rate")
+	ErrInvalidDexQuotation   = sdkerrors.Register(ModuleName, 17, "invalid DEX quotation")
+	ErrInvalidVersion        = sdkerrors.Register(ModuleName, 18, "invalid oracle IBC version")
+	ErrInvalidPacket         = sdkerrors.Register(ModuleName, 19, "invalid oracle packet")
+	ErrInvalidChannel        = sdkerrors.Register(ModuleName, 20, "invalid oracle channel")
+	ErrUnknownTarget         = sdkerrors.Register(ModuleName, 21, "unknown target")
+	ErrTargetInUse           = sdkerrors.Register(ModuleName, 22, "target in use")
 )
@@ -8,6 +8,9 @@ const (
 	EventTypeFeedDelegate       = "feed_delegate"
 	EventTypeAggregatePrevote   = "aggregate_prevote"
 	EventTypeAggregateVote      = "aggregate_vote"
+	EventTypeSlash              = "slash"
+	EventTypePriceCircuitTrip   = "price_circuit_tripped"
+	EventTypePriceFeedDeploy    = "price_feed_deploy"
 
 	AttributeKeyDenom         = "denom"
 	AttributeKeyVoter         = "voter"
@@ -15,6 +18,16 @@ const (
 	AttributeKeyExchangeRates = "exchange_rates"
 	AttributeKeyOperator      = "operator"
 	AttributeKeyFeeder        = "feeder"
+	AttributeKeyMissCounter   = "miss_counter"
+	AttributeKeyValidVoteRate = "valid_vote_rate"
+	AttributeKeySlashFraction = "slash_fraction"
+	AttributeKeyPower         = "power"
+	AttributeKeyHeldRate      = "held_rate"
+	AttributeKeyHeldRounds    = "held_rounds"
+	AttributeKeyReason        = "reason"
+	AttributeKeyContract      = "contract"
 
-	AttributeValueCategory = ModuleName
+	AttributeValueCategory       = ModuleName
+	AttributeValueBallotFailed   = "ballot_failed"
+	AttributeValuePriceMoveLimit = "price_move_limit"
 )
@@ -5,14 +5,29 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	erc20types "github.com/petri-labs/warmage/x/erc20/types"
+	makertypes "github.com/petri-labs/warmage/x/maker/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 )
 
 type DistrKeeper interface {
 	AllocateTokensToValidator(ctx sdk.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins)
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 	// Methods imported from distr should be defined here
 }
 
+// VeKeeper defines the expected ve keeper, into whose distribution pool the
+// ve holders share of maker fees is paid
+type VeKeeper interface {
+	DistributeFees(ctx sdk.Context, senderModule string, fees sdk.Coins) error
+}
+
 type StakingKeeper interface {
 	Validator(ctx sdk.Context, address sdk.ValAddress) stakingtypes.ValidatorI
 	TotalBondedTokens(sdk.Context) sdk.Int
@@ -39,6 +54,40 @@ type BankKeeper interface {
 	SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule string, recipientModule string, amt sdk.Coins) error
 	// Methods imported from bank should be defined here
 }
+
+// Erc20Keeper defines the expected interface needed to read DEX contracts.
+type Erc20Keeper interface {
+	CallEVM(ctx sdk.Context, abi abi.ABI, from, contract common.Address, method string, args ...interface{}) (*evmtypes.MsgEthereumTxResponse, error)
+	CallEVMWithData(ctx sdk.Context, from common.Address, contract *common.Address, data []byte) (*evmtypes.MsgEthereumTxResponse, error)
+	GetTokenPairID(ctx sdk.Context, token string) []byte
+	GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool)
+}
+
+// EVMKeeper defines the expected EVM keeper, through which the module
+// writes the rounds of the price feed contracts
+type EVMKeeper interface {
+	SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte)
+}
+
+// ChannelKeeper defines the expected IBC channel keeper
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	SendPacket(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet ibcexported.PacketI) error
+}
+
+// PortKeeper defines the expected IBC port keeper
+type PortKeeper interface {
+	BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability
+}
+
+// MakerKeeper defines the expected maker keeper, whose backing and collateral
+// pools are priced by oracle targets
+type MakerKeeper interface {
+	GetBackingRiskParams(ctx sdk.Context, denom string) (makertypes.BackingRiskParams, bool)
+	GetCollateralRiskParams(ctx sdk.Context, denom string) (makertypes.CollateralRiskParams, bool)
+}
@@ -6,6 +6,13 @@ func NewGenesis(
 	feederDelegations []FeederDelegation, missCounters []MissCounter,
 	aggregateExchangeRatePrevotes []AggregateExchangeRatePrevote,
 	aggregateExchangeRateVotes []AggregateExchangeRateVote,
+	historicalExchangeRates []ExchangeRateSnapshot,
+	validatorPerformances []ValidatorPerformance,
+	validatorOracleHistory []ValidatorOracleStats,
+	exchangeRateStatuses []ExchangeRateStatus,
+	priceFeeds []PriceFeed,
+	dexObservations []DexObservation,
+	targets []TargetParams,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                        params,
@@ -14,6 +21,13 @@ func NewGenesis(
 		MissCounters:                  missCounters,
 		AggregateExchangeRatePrevotes: aggregateExchangeRatePrevotes,
 		AggregateExchangeRateVotes:    aggregateExchangeRateVotes,
+		HistoricalExchangeRates:       historicalExchangeRates,
+		ValidatorPerformances:         validatorPerformances,
+		ValidatorOracleHistory:        validatorOracleHistory,
+		ExchangeRateStatuses:          exchangeRateStatuses,
+		PriceFeeds:                    priceFeeds,
+		DexObservations:               dexObservations,
+		Targets:                       targets,
 	}
 }
 
@@ -31,13 +31,20 @@ type GenesisState struct {
 	MissCounters                  []MissCounter                  `protobuf:"bytes,4,rep,name=miss_counters,json=missCounters,proto3" json:"miss_counters"`
 	AggregateExchangeRatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,5,rep,name=aggregate_exchange_rate_prevotes,json=aggregateExchangeRatePrevotes,proto3" json:"aggregate_exchange_rate_prevotes"`
 	AggregateExchangeRateVotes    []AggregateExchangeRateVote    `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
+	HistoricalExchangeRates       []ExchangeRateSnapshot         `protobuf:"bytes,7,rep,name=historical_exchange_rates,json=historicalExchangeRates,proto3" json:"historical_exchange_rates"`
+	ValidatorPerformances         []ValidatorPerformance         `protobuf:"bytes,8,rep,name=validator_performances,json=validatorPerformances,proto3" json:"validator_performances"`
+	ValidatorOracleHistory        []ValidatorOracleStats         `protobuf:"bytes,9,rep,name=validator_oracle_history,json=validatorOracleHistory,proto3" json:"validator_oracle_history"`
+	ExchangeRateStatuses          []ExchangeRateStatus           `protobuf:"bytes,10,rep,name=exchange_rate_statuses,json=exchangeRateStatuses,proto3" json:"exchange_rate_statuses"`
+	PriceFeeds                    []PriceFeed                    `protobuf:"bytes,11,rep,name=price_feeds,json=priceFeeds,proto3" json:"price_feeds"`
+	DexObservations               []DexObservation               `protobuf:"bytes,12,rep,name=dex_observations,json=dexObservations,proto3" json:"dex_observations"`
+	Targets                       []TargetParams                 `protobuf:"bytes,13,rep,name=targets,proto3" json:"targets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4f7ec516d500fe6, []int{0}
+	return fileDescriptor_85ff9ea6be5c4152, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -108,6 +115,55 @@ func (m *GenesisState) GetAggregateExchangeRateVotes() []AggregateExchangeRateVo
 	return nil
 }
 
+func (m *GenesisState) GetHistoricalExchangeRates() []ExchangeRateSnapshot {
+	if m != nil {
+		return m.HistoricalExchangeRates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorPerformances() []ValidatorPerformance {
+	if m != nil {
+		return m.ValidatorPerformances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorOracleHistory() []ValidatorOracleStats {
+	if m != nil {
+		return m.ValidatorOracleHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExchangeRateStatuses() []ExchangeRateStatus {
+	if m != nil {
+		return m.ExchangeRateStatuses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceFeeds() []PriceFeed {
+	if m != nil {
+		return m.PriceFeeds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDexObservations() []DexObservation {
+	if m != nil {
+		return m.DexObservations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTargets() []TargetParams {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
 // FeederDelegation is the address for where oracle feeder authority are
 // delegated to. By default this struct is only used at genesis to feed in
 // default feeder addresses.
@@ -120,7 +176,7 @@ func (m *FeederDelegation) Reset()         { *m = FeederDelegation{} }
 func (m *FeederDelegation) String() string { return proto.CompactTextString(m) }
 func (*FeederDelegation) ProtoMessage()    {}
 func (*FeederDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4f7ec516d500fe6, []int{1}
+	return fileDescriptor_85ff9ea6be5c4152, []int{1}
 }
 func (m *FeederDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -174,7 +230,7 @@ func (m *MissCounter) Reset()         { *m = MissCounter{} }
 func (m *MissCounter) String() string { return proto.CompactTextString(m) }
 func (*MissCounter) ProtoMessage()    {}
 func (*MissCounter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4f7ec516d500fe6, []int{2}
+	return fileDescriptor_85ff9ea6be5c4152, []int{2}
 }
 func (m *MissCounter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -217,46 +273,115 @@ func (m *MissCounter) GetMissCounter() uint64 {
 	return 0
 }
 
+// PriceFeed is the address of the price feed contract deployed for a denom,
+// used in oracle module's genesis state.
+type PriceFeed struct {
+	Denom    string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *PriceFeed) Reset()         { *m = PriceFeed{} }
+func (m *PriceFeed) String() string { return proto.CompactTextString(m) }
+func (*PriceFeed) ProtoMessage()    {}
+func (*PriceFeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85ff9ea6be5c4152, []int{3}
+}
+func (m *PriceFeed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceFeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceFeed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceFeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceFeed.Merge(m, src)
+}
+func (m *PriceFeed) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceFeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceFeed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceFeed proto.InternalMessageInfo
+
+func (m *PriceFeed) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PriceFeed) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "warmage.oracle.v1.GenesisState")
 	proto.RegisterType((*FeederDelegation)(nil), "warmage.oracle.v1.FeederDelegation")
 	proto.RegisterType((*MissCounter)(nil), "warmage.oracle.v1.MissCounter")
+	proto.RegisterType((*PriceFeed)(nil), "warmage.oracle.v1.PriceFeed")
 }
 
-func init() { proto.RegisterFile("warmage/oracle/v1/genesis.proto", fileDescriptor_c4f7ec516d500fe6) }
+func init() { proto.RegisterFile("warmage/oracle/v1/genesis.proto", fileDescriptor_85ff9ea6be5c4152) }
 
-var fileDescriptor_c4f7ec516d500fe6 = []byte{
-	// 468 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xe3, 0x36, 0x44, 0x62, 0x93, 0x54, 0xcd, 0x8a, 0x43, 0x88, 0x54, 0x27, 0x04, 0x90,
-	0x2a, 0x01, 0xb6, 0x5a, 0x0e, 0x9c, 0x1b, 0xfe, 0x1f, 0x90, 0x2a, 0x83, 0x10, 0x42, 0x42, 0xd6,
-	0x36, 0x9e, 0x6c, 0x2d, 0xd9, 0x5e, 0x6b, 0x67, 0x13, 0x15, 0x0e, 0x3c, 0x03, 0xcf, 0xc1, 0x93,
-	0xf4, 0xc0, 0xa1, 0x47, 0x4e, 0x80, 0x92, 0x17, 0x41, 0xde, 0xdd, 0x34, 0xa6, 0x71, 0x50, 0x6f,
-	0xc9, 0xcc, 0xef, 0xfb, 0xbe, 0x59, 0xcf, 0x2e, 0xe9, 0xa7, 0x20, 0x93, 0x58, 0x64, 0xbe, 0x90,
-	0x6c, 0x9c, 0x80, 0x3f, 0x3b, 0xf0, 0x39, 0x64, 0x80, 0x31, 0x7a, 0xb9, 0x14, 0x4a, 0xd0, 0x8e,
-	0x05, 0x3c, 0x03, 0x78, 0xb3, 0x83, 0xde, 0x2d, 0x2e, 0xb8, 0xd0, 0x5d, 0xbf, 0xf8, 0x65, 0xc0,
-	0x9e, 0xbb, 0xee, 0x64, 0x25, 0xba, 0x3f, 0xfc, 0x51, 0x27, 0xad, 0x97, 0xc6, 0xfa, 0xad, 0x62,
-	0x0a, 0xe8, 0x13, 0xd2, 0xc8, 0x99, 0x64, 0x29, 0x76, 0x9d, 0x81, 0xb3, 0xdf, 0x3c, 0xbc, 0xed,
-	0xad, 0x45, 0x79, 0xc7, 0x1a, 0x18, 0xd5, 0xcf, 0x7f, 0xf5, 0x6b, 0x81, 0xc5, 0xe9, 0x07, 0x42,
-	0x27, 0x00, 0x11, 0xc8, 0x30, 0x82, 0x04, 0x38, 0x53, 0xb1, 0xc8, 0xb0, 0xbb, 0x35, 0xd8, 0xde,
-	0x6f, 0x1e, 0xde, 0xad, 0x30, 0x79, 0xa1, 0xe1, 0x67, 0x97, 0xac, 0xb5, 0xeb, 0x4c, 0xae, 0xd4,
-	0x91, 0x72, 0xb2, 0x03, 0x67, 0xe3, 0x53, 0x96, 0x71, 0x08, 0x25, 0x53, 0x80, 0xdd, 0x6d, 0xed,
-	0x7a, 0xaf, 0xc2, 0xf5, 0xb9, 0x05, 0x03, 0xa6, 0xe0, 0xdd, 0x34, 0x4f, 0x60, 0xd4, 0x2b, 0x6c,
-	0xbf, 0xff, 0xee, 0xd3, 0xb5, 0x16, 0x06, 0x6d, 0x28, 0xd5, 0x90, 0xbe, 0x26, 0xed, 0x34, 0x46,
-	0x0c, 0xc7, 0x62, 0x9a, 0x29, 0x90, 0xd8, 0xad, 0xeb, 0x1c, 0xb7, 0x22, 0xe7, 0x4d, 0x8c, 0xf8,
-	0xd4, 0x60, 0x76, 0xf0, 0x56, 0xba, 0x2a, 0x21, 0xfd, 0x4a, 0x06, 0x8c, 0x73, 0x59, 0x9c, 0x01,
-	0xc2, 0x7f, 0xa6, 0x0f, 0x73, 0x09, 0x33, 0x51, 0x9c, 0xe2, 0x86, 0x76, 0xf7, 0x2b, 0xdc, 0x8f,
-	0x96, 0xd2, 0xf2, 0xcc, 0xc7, 0x46, 0x67, 0xe3, 0xf6, 0xd8, 0x7f, 0x18, 0xa4, 0x53, 0xb2, 0xb7,
-	0x29, 0xdf, 0x84, 0x37, 0x74, 0xf8, 0xc3, 0xeb, 0x86, 0xbf, 0x5f, 0x25, 0xf7, 0xd8, 0x26, 0x00,
-	0x87, 0x13, 0xb2, 0x7b, 0x75, 0xaf, 0xf4, 0x3e, 0xd9, 0xb1, 0x17, 0x83, 0x45, 0x91, 0x04, 0x34,
-	0x37, 0xeb, 0x66, 0xd0, 0x36, 0xd5, 0x23, 0x53, 0xa4, 0x0f, 0x48, 0x67, 0xc6, 0x92, 0x38, 0x62,
-	0x4a, 0xac, 0xc8, 0x2d, 0x4d, 0xee, 0x5e, 0x36, 0x2c, 0x3c, 0xfc, 0x44, 0x9a, 0xa5, 0x0d, 0x54,
-	0x6b, 0x9d, 0x6a, 0x2d, 0xbd, 0x43, 0x5a, 0xe5, 0x2d, 0xeb, 0x8c, 0x7a, 0xd0, 0x2c, 0xad, 0x6f,
-	0xf4, 0xea, 0x7c, 0xee, 0x3a, 0x17, 0x73, 0xd7, 0xf9, 0x33, 0x77, 0x9d, 0x6f, 0x0b, 0xb7, 0x76,
-	0xb1, 0x70, 0x6b, 0x3f, 0x17, 0x6e, 0xed, 0xa3, 0xc7, 0x63, 0x75, 0x3a, 0x3d, 0xf1, 0xc6, 0x22,
-	0xf5, 0xed, 0xa7, 0x7b, 0xf4, 0x45, 0x64, 0xb0, 0xfc, 0xe3, 0x9f, 0x2d, 0x5f, 0x9a, 0xfa, 0x9c,
-	0x03, 0x9e, 0x34, 0xf4, 0x33, 0x7b, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0x26, 0x0e, 0x41, 0x47,
-	0xd2, 0x03, 0x00, 0x00,
+var fileDescriptor_85ff9ea6be5c4152 = []byte{
+	// 693 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0x93, 0x7e, 0x67, 0x93, 0x94, 0x76, 0x55, 0x8a, 0x1b, 0x51, 0xa7, 0x0d, 0x54, 0x54,
+	0xa2, 0x24, 0x6a, 0x39, 0x70, 0x42, 0xa8, 0x9f, 0xc0, 0x01, 0xb5, 0x4a, 0xab, 0x0a, 0x21, 0x21,
+	0x6b, 0x63, 0x4f, 0x1c, 0x4b, 0x89, 0xd7, 0xda, 0xd9, 0x84, 0xf4, 0xc2, 0x33, 0xf0, 0x1c, 0xbc,
+	0x06, 0x97, 0x1e, 0x7b, 0xe4, 0x04, 0xa8, 0x7d, 0x11, 0xe4, 0xf5, 0x3a, 0x4e, 0x1a, 0x97, 0x72,
+	0x8b, 0x67, 0xfe, 0xf3, 0xfb, 0xaf, 0x3d, 0x99, 0x59, 0x52, 0xfe, 0xc2, 0x44, 0x87, 0xb9, 0x50,
+	0xe3, 0x82, 0xd9, 0x6d, 0xa8, 0xf5, 0xb6, 0x6b, 0x2e, 0xf8, 0x80, 0x1e, 0x56, 0x03, 0xc1, 0x25,
+	0xa7, 0x8b, 0x5a, 0x50, 0x8d, 0x04, 0xd5, 0xde, 0x76, 0x69, 0xc9, 0xe5, 0x2e, 0x57, 0xd9, 0x5a,
+	0xf8, 0x2b, 0x12, 0x96, 0xcc, 0x71, 0x92, 0x2e, 0x51, 0xf9, 0xca, 0x8f, 0x1c, 0x29, 0xbc, 0x8d,
+	0xd0, 0xa7, 0x92, 0x49, 0xa0, 0xaf, 0xc8, 0x4c, 0xc0, 0x04, 0xeb, 0xa0, 0x91, 0x5d, 0xcb, 0x6e,
+	0xe6, 0x77, 0x56, 0xaa, 0x63, 0x56, 0xd5, 0x13, 0x25, 0xd8, 0x9b, 0xba, 0xfc, 0x55, 0xce, 0xd4,
+	0xb5, 0x9c, 0x7e, 0x24, 0xb4, 0x09, 0xe0, 0x80, 0xb0, 0x1c, 0x68, 0x83, 0xcb, 0xa4, 0xc7, 0x7d,
+	0x34, 0x26, 0xd6, 0x26, 0x37, 0xf3, 0x3b, 0x4f, 0x52, 0x20, 0x47, 0x4a, 0x7c, 0x30, 0xd0, 0x6a,
+	0xdc, 0x62, 0xf3, 0x56, 0x1c, 0xa9, 0x4b, 0xe6, 0xa1, 0x6f, 0xb7, 0x98, 0xef, 0x82, 0x25, 0x98,
+	0x04, 0x34, 0x26, 0x15, 0xf5, 0x69, 0x0a, 0xf5, 0x50, 0x0b, 0xeb, 0x4c, 0xc2, 0x59, 0x37, 0x68,
+	0xc3, 0x5e, 0x29, 0xc4, 0x7e, 0xff, 0x5d, 0xa6, 0x63, 0x29, 0xac, 0x17, 0x61, 0x28, 0x86, 0xf4,
+	0x3d, 0x29, 0x76, 0x3c, 0x44, 0xcb, 0xe6, 0x5d, 0x5f, 0x82, 0x40, 0x63, 0x4a, 0xf9, 0x98, 0x29,
+	0x3e, 0x1f, 0x3c, 0xc4, 0xfd, 0x48, 0xa6, 0x0f, 0x5e, 0xe8, 0x24, 0x21, 0xa4, 0x5f, 0xc9, 0x1a,
+	0x73, 0x5d, 0x11, 0xbe, 0x03, 0x58, 0x23, 0xa7, 0xb7, 0x02, 0x01, 0x3d, 0x1e, 0xbe, 0xc5, 0xb4,
+	0xa2, 0xd7, 0x52, 0xe8, 0xbb, 0x71, 0xe9, 0xf0, 0x99, 0x4f, 0xa2, 0x3a, 0x6d, 0xb7, 0xca, 0xfe,
+	0xa1, 0x41, 0xda, 0x25, 0xab, 0x77, 0xf9, 0x47, 0xe6, 0x33, 0xca, 0x7c, 0xeb, 0x7f, 0xcd, 0xcf,
+	0x13, 0xe7, 0x12, 0xbb, 0x4b, 0x80, 0xd4, 0x23, 0x2b, 0x2d, 0x0f, 0x25, 0x17, 0x9e, 0xcd, 0xda,
+	0xd6, 0xad, 0xae, 0xcd, 0x2a, 0xcb, 0x67, 0xf7, 0x74, 0xed, 0xd4, 0x67, 0x01, 0xb6, 0xb8, 0xd4,
+	0x6e, 0x8f, 0x12, 0xde, 0xe1, 0x48, 0xb3, 0x1c, 0xb2, 0xdc, 0x63, 0x6d, 0xcf, 0x61, 0x92, 0x0b,
+	0x2b, 0x00, 0xd1, 0xe4, 0xa2, 0xc3, 0x7c, 0x1b, 0xd0, 0x98, 0xbb, 0xd3, 0xe7, 0x3c, 0x2e, 0x38,
+	0x49, 0xf4, 0xda, 0xe7, 0x61, 0x2f, 0x25, 0x17, 0xfe, 0xf7, 0x8c, 0xc4, 0x25, 0x02, 0x59, 0xd1,
+	0x89, 0x2e, 0x8c, 0xdc, 0xfd, 0x3e, 0xc7, 0x2a, 0x14, 0x4e, 0x56, 0x3c, 0x2e, 0xcb, 0xbd, 0xd1,
+	0xdc, 0xbb, 0x08, 0x46, 0x19, 0x59, 0x1e, 0x6d, 0x13, 0x4a, 0x26, 0xbb, 0x08, 0x68, 0x10, 0x65,
+	0xb3, 0x71, 0xdf, 0x67, 0x53, 0x72, 0x6d, 0xb2, 0x04, 0x63, 0x19, 0x40, 0xba, 0x4f, 0xf2, 0x81,
+	0xf0, 0x6c, 0xb0, 0xc2, 0x11, 0x43, 0x23, 0xaf, 0xb8, 0x8f, 0xd3, 0xe6, 0x3b, 0x54, 0x85, 0xf3,
+	0xa9, 0x71, 0x24, 0x88, 0x03, 0x48, 0xeb, 0x64, 0xc1, 0x81, 0xbe, 0xc5, 0x1b, 0x08, 0xa2, 0xa7,
+	0x87, 0xbc, 0xa0, 0x48, 0xeb, 0x29, 0xa4, 0x03, 0xe8, 0x1f, 0x27, 0x4a, 0x8d, 0x7b, 0xe0, 0x8c,
+	0x44, 0x91, 0xbe, 0x21, 0xb3, 0x92, 0x09, 0x17, 0x24, 0x1a, 0x45, 0x85, 0x2a, 0xa7, 0xa0, 0xce,
+	0x94, 0x62, 0x64, 0xf5, 0xc4, 0x55, 0x95, 0x26, 0x59, 0xb8, 0xbd, 0x4e, 0xe8, 0x06, 0x99, 0xd7,
+	0xfb, 0x88, 0x39, 0x8e, 0x00, 0x8c, 0x16, 0x5a, 0xae, 0x5e, 0x8c, 0xa2, 0xbb, 0x51, 0x90, 0x3e,
+	0x27, 0x8b, 0x49, 0x83, 0x63, 0xe5, 0x84, 0x52, 0x2e, 0x0c, 0x12, 0x5a, 0x5c, 0xf9, 0x4c, 0xf2,
+	0x43, 0x83, 0x9f, 0x5e, 0x9b, 0x4d, 0xaf, 0xa5, 0xeb, 0xa4, 0x30, 0xbc, 0x5c, 0x94, 0xc7, 0x54,
+	0x3d, 0x3f, 0xb4, 0x35, 0x2a, 0xaf, 0x49, 0x6e, 0xf0, 0xe9, 0xe9, 0x12, 0x99, 0x76, 0xc0, 0xe7,
+	0x1d, 0x0d, 0x8c, 0x1e, 0x68, 0x89, 0xcc, 0xd9, 0xdc, 0x97, 0x82, 0xd9, 0x52, 0x9f, 0x72, 0xf0,
+	0xbc, 0x77, 0x74, 0x79, 0x6d, 0x66, 0xaf, 0xae, 0xcd, 0xec, 0x9f, 0x6b, 0x33, 0xfb, 0xed, 0xc6,
+	0xcc, 0x5c, 0xdd, 0x98, 0x99, 0x9f, 0x37, 0x66, 0xe6, 0xd3, 0x96, 0xeb, 0xc9, 0x56, 0xb7, 0x51,
+	0xb5, 0x79, 0xa7, 0x16, 0x80, 0x14, 0xde, 0x8b, 0x36, 0x6b, 0x60, 0x2d, 0xbe, 0x1b, 0xfa, 0xf1,
+	0xed, 0x20, 0x2f, 0x02, 0xc0, 0xc6, 0x8c, 0xba, 0x1a, 0x5e, 0xfe, 0x1d, 0x00, 0x89, 0x4c, 0x70,
+	0x3c, 0x86, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -279,6 +404,104 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Targets) > 0 {
+		for iNdEx := len(m.Targets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Targets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.DexObservations) > 0 {
+		for iNdEx := len(m.DexObservations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DexObservations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PriceFeeds) > 0 {
+		for iNdEx := len(m.PriceFeeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceFeeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ExchangeRateStatuses) > 0 {
+		for iNdEx := len(m.ExchangeRateStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRateStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ValidatorOracleHistory) > 0 {
+		for iNdEx := len(m.ValidatorOracleHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorOracleHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ValidatorPerformances) > 0 {
+		for iNdEx := len(m.ValidatorPerformances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPerformances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.HistoricalExchangeRates) > 0 {
+		for iNdEx := len(m.HistoricalExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoricalExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.AggregateExchangeRateVotes) > 0 {
 		for iNdEx := len(m.AggregateExchangeRateVotes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -434,6 +657,43 @@ func (m *MissCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PriceFeed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceFeed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceFeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -483,6 +743,48 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.HistoricalExchangeRates) > 0 {
+		for _, e := range m.HistoricalExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorPerformances) > 0 {
+		for _, e := range m.ValidatorPerformances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorOracleHistory) > 0 {
+		for _, e := range m.ValidatorOracleHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExchangeRateStatuses) > 0 {
+		for _, e := range m.ExchangeRateStatuses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceFeeds) > 0 {
+		for _, e := range m.PriceFeeds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DexObservations) > 0 {
+		for _, e := range m.DexObservations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Targets) > 0 {
+		for _, e := range m.Targets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -519,6 +821,23 @@ func (m *MissCounter) Size() (n int) {
 	return n
 }
 
+func (m *PriceFeed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -757,39 +1076,277 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalExchangeRates", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FeederDelegation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			m.HistoricalExchangeRates = append(m.HistoricalExchangeRates, ExchangeRateSnapshot{})
+			if err := m.HistoricalExchangeRates[len(m.HistoricalExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPerformances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPerformances = append(m.ValidatorPerformances, ValidatorPerformance{})
+			if err := m.ValidatorPerformances[len(m.ValidatorPerformances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorOracleHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorOracleHistory = append(m.ValidatorOracleHistory, ValidatorOracleStats{})
+			if err := m.ValidatorOracleHistory[len(m.ValidatorOracleHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateStatuses = append(m.ExchangeRateStatuses, ExchangeRateStatus{})
+			if err := m.ExchangeRateStatuses[len(m.ExchangeRateStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceFeeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceFeeds = append(m.PriceFeeds, PriceFeed{})
+			if err := m.PriceFeeds[len(m.PriceFeeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DexObservations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DexObservations = append(m.DexObservations, DexObservation{})
+			if err := m.DexObservations[len(m.DexObservations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Targets = append(m.Targets, TargetParams{})
+			if err := m.Targets[len(m.Targets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeederDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
@@ -993,6 +1550,120 @@ func (m *MissCounter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PriceFeed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceFeed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceFeed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -20,6 +20,12 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_oracle"
+
+	// PortID is the IBC port to which the module binds
+	PortID = ModuleName
+
+	// Version defines the current version of the IBC oracle module
+	Version = "warmage-oracle-1"
 )
 
 // Prefix keys for oracle module store
@@ -31,6 +37,17 @@ var (
 	AggregateExchangeRateVoteKey    = []byte{0x05} // prefix for each key to a aggregate vote
 	VoteTargetKey                   = []byte{0x06} // prefix for each key to a vote target
 	TargetKey                       = []byte{0x07} // prefix for each key to a target
+	HistoricalRateKey               = []byte{0x08} // prefix for each key to a historical exchange rate
+	DexTargetKey                    = []byte{0x09} // prefix for each key to a DEX quoted target
+	InterchainTargetKey             = []byte{0x0A} // prefix for each key to an inter-chain quoted target
+	InterchainExchangeRateKey       = []byte{0x0B} // prefix for each key to an inter-chain quoted exchange rate
+	RewardPoolInflowKey             = []byte{0x0C} // prefix for each key to a reward pool fee inflow
+	ValidatorPerformanceKey         = []byte{0x0D} // prefix for each key to a validator performance in the current slash window
+	ValidatorOracleStatsKey         = []byte{0x0E} // prefix for each key to a validator performance in a past slash window
+	VoteTargetParamsKey             = []byte{0x0F} // prefix for each key to a vote target with tally overrides
+	ExchangeRateStatusKey           = []byte{0x10} // prefix for each key to a last accepted exchange rate
+	PriceFeedKey                    = []byte{0x11} // prefix for each key to a price feed contract
+	DexObservationKey               = []byte{0x12} // prefix for each key to a DEX pair price observation
 )
 
 // GetExchangeRateKey - stored by *denom*
@@ -63,11 +80,31 @@ func GetVoteTargetKey(d string) []byte {
 	return append(VoteTargetKey, []byte(d)...)
 }
 
+// GetVoteTargetParamsKey - stored by *denom* bytes
+func GetVoteTargetParamsKey(d string) []byte {
+	return append(VoteTargetParamsKey, []byte(d)...)
+}
+
+// GetExchangeRateStatusKey - stored by *denom* bytes
+func GetExchangeRateStatusKey(d string) []byte {
+	return append(ExchangeRateStatusKey, []byte(d)...)
+}
+
 // GetTargetKey - stored by *denom* bytes
 func GetTargetKey(d string) []byte {
 	return append(TargetKey, []byte(d)...)
 }
 
+// GetHistoricalRatesKey - stored by *denom* bytes
+func GetHistoricalRatesKey(d string) []byte {
+	return append(HistoricalRateKey, address.MustLengthPrefix([]byte(d))...)
+}
+
+// GetHistoricalRateKey - stored by *denom* bytes and *height*
+func GetHistoricalRateKey(d string, height int64) []byte {
+	return append(GetHistoricalRatesKey(d), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
 // ExtractDenomFromVoteTargetKey - split denom from the vote target key
 func ExtractDenomFromVoteTargetKey(key []byte) (denom string) {
 	denom = string(key[1:])
@@ -79,3 +116,54 @@ func ExtractDenomFromTargetKey(key []byte) (denom string) {
 	denom = string(key[1:])
 	return
 }
+
+// GetDexTargetKey - stored by *denom* bytes
+func GetDexTargetKey(d string) []byte {
+	return append(DexTargetKey, []byte(d)...)
+}
+
+// ExtractDenomFromDexTargetKey - split denom from the DEX target key
+func ExtractDenomFromDexTargetKey(key []byte) (denom string) {
+	denom = string(key[1:])
+	return
+}
+
+// GetInterchainTargetKey - stored by *denom* bytes
+func GetInterchainTargetKey(d string) []byte {
+	return append(InterchainTargetKey, []byte(d)...)
+}
+
+// GetInterchainExchangeRateKey - stored by *denom* bytes
+func GetInterchainExchangeRateKey(d string) []byte {
+	return append(InterchainExchangeRateKey, []byte(d)...)
+}
+
+// GetRewardPoolInflowKey - stored by *denom* bytes
+func GetRewardPoolInflowKey(d string) []byte {
+	return append(RewardPoolInflowKey, []byte(d)...)
+}
+
+// GetValidatorPerformanceKey - stored by *Validator* address
+func GetValidatorPerformanceKey(v sdk.ValAddress) []byte {
+	return append(ValidatorPerformanceKey, address.MustLengthPrefix(v)...)
+}
+
+// GetValidatorOracleHistoryKey - stored by *Validator* address
+func GetValidatorOracleHistoryKey(v sdk.ValAddress) []byte {
+	return append(ValidatorOracleStatsKey, address.MustLengthPrefix(v)...)
+}
+
+// GetValidatorOracleStatsKey - stored by *Validator* address and *window end height*
+func GetValidatorOracleStatsKey(v sdk.ValAddress, windowEndHeight int64) []byte {
+	return append(GetValidatorOracleHistoryKey(v), sdk.Uint64ToBigEndian(uint64(windowEndHeight))...)
+}
+
+// GetPriceFeedKey - stored by *denom*
+func GetPriceFeedKey(denom string) []byte {
+	return append(PriceFeedKey, []byte(denom)...)
+}
+
+// GetDexObservationKey - stored by *denom*
+func GetDexObservationKey(denom string) []byte {
+	return append(DexObservationKey, []byte(denom)...)
+}
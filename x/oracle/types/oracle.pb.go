@@ -8,15 +8,20 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -63,7 +68,7 @@ func (x TargetSource) String() string {
 }
 
 func (TargetSource) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_968b7e916587bd39, []int{0}
+	return fileDescriptor_ee6ef6b0e93376d8, []int{0}
 }
 
 // Params defines the parameters for the oracle module.
@@ -75,12 +80,32 @@ type Params struct {
 	SlashFraction            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
 	SlashWindow              uint64                                 `protobuf:"varint,6,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty" yaml:"slash_window"`
 	MinValidPerWindow        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=min_valid_per_window,json=minValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_valid_per_window" yaml:"min_valid_per_window"`
+	// historical_rates_lookback is the number of blocks for which exchange rate
+	// snapshots are kept.
+	HistoricalRatesLookback uint64 `protobuf:"varint,8,opt,name=historical_rates_lookback,json=historicalRatesLookback,proto3" json:"historical_rates_lookback,omitempty" yaml:"historical_rates_lookback"`
+	// dex_price_band is the max relative deviation of the spot price of the
+	// pair contract of a DEX target from its time-weighted average price over
+	// the vote period, beyond which the DEX quoted exchange rate is dropped.
+	DexPriceBand github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=dex_price_band,json=dexPriceBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dex_price_band" yaml:"dex_price_band"`
+	// interchain_rate_max_age is the max age of an exchange rate quoted from a
+	// counterparty chain, beyond which it is stale and not used.
+	InterchainRateMaxAge time.Duration `protobuf:"bytes,10,opt,name=interchain_rate_max_age,json=interchainRateMaxAge,proto3,stdduration" json:"interchain_rate_max_age" yaml:"interchain_rate_max_age"`
+	// maker_fee_split is the split of maker fees between oracle voters, the
+	// community pool and ve holders.
+	MakerFeeSplit FeeSplit `protobuf:"bytes,11,opt,name=maker_fee_split,json=makerFeeSplit,proto3" json:"maker_fee_split" yaml:"maker_fee_split"`
+	// max_price_change is the maximum change of a tallied exchange rate from the
+	// last accepted one, beyond which the circuit breaker holds the last
+	// accepted rate.
+	MaxPriceChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=max_price_change,json=maxPriceChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_change" yaml:"max_price_change"`
+	// max_held_rounds is the maximum number of consecutive vote periods the
+	// circuit breaker holds the last accepted exchange rate for.
+	MaxHeldRounds uint64 `protobuf:"varint,13,opt,name=max_held_rounds,json=maxHeldRounds,proto3" json:"max_held_rounds,omitempty" yaml:"max_held_rounds"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_968b7e916587bd39, []int{0}
+	return fileDescriptor_ee6ef6b0e93376d8, []int{0}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -130,6 +155,76 @@ func (m *Params) GetSlashWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetHistoricalRatesLookback() uint64 {
+	if m != nil {
+		return m.HistoricalRatesLookback
+	}
+	return 0
+}
+
+func (m *Params) GetInterchainRateMaxAge() time.Duration {
+	if m != nil {
+		return m.InterchainRateMaxAge
+	}
+	return 0
+}
+
+func (m *Params) GetMakerFeeSplit() FeeSplit {
+	if m != nil {
+		return m.MakerFeeSplit
+	}
+	return FeeSplit{}
+}
+
+func (m *Params) GetMaxHeldRounds() uint64 {
+	if m != nil {
+		return m.MaxHeldRounds
+	}
+	return 0
+}
+
+// FeeSplit defines the shares of fees allocated to oracle voters, the
+// community pool and ve holders, which sum up to one.
+type FeeSplit struct {
+	OracleVoters  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=oracle_voters,json=oracleVoters,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"oracle_voters" yaml:"oracle_voters"`
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool" yaml:"community_pool"`
+	// ve_holders is paid into the ve distribution pool.
+	VeHolders github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=ve_holders,json=veHolders,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ve_holders" yaml:"ve_holders"`
+}
+
+func (m *FeeSplit) Reset()         { *m = FeeSplit{} }
+func (m *FeeSplit) String() string { return proto.CompactTextString(m) }
+func (*FeeSplit) ProtoMessage()    {}
+func (*FeeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee6ef6b0e93376d8, []int{1}
+}
+func (m *FeeSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSplit.Merge(m, src)
+}
+func (m *FeeSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSplit proto.InternalMessageInfo
+
 // AggregateExchangeRatePrevote represents the aggregate prevoting on the
 // ExchangeRateVote. The purpose of aggregate prevoting is to hide vote exchange
 // rates with hash which is formatted as hex string in SHA256("{salt}:{exchange
@@ -143,7 +238,7 @@ type AggregateExchangeRatePrevote struct {
 func (m *AggregateExchangeRatePrevote) Reset()      { *m = AggregateExchangeRatePrevote{} }
 func (*AggregateExchangeRatePrevote) ProtoMessage() {}
 func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_968b7e916587bd39, []int{1}
+	return fileDescriptor_ee6ef6b0e93376d8, []int{2}
 }
 func (m *AggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -182,7 +277,7 @@ type AggregateExchangeRateVote struct {
 func (m *AggregateExchangeRateVote) Reset()      { *m = AggregateExchangeRateVote{} }
 func (*AggregateExchangeRateVote) ProtoMessage() {}
 func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_968b7e916587bd39, []int{2}
+	return fileDescriptor_ee6ef6b0e93376d8, []int{3}
 }
 func (m *AggregateExchangeRateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -220,7 +315,7 @@ type ExchangeRateTuple struct {
 func (m *ExchangeRateTuple) Reset()      { *m = ExchangeRateTuple{} }
 func (*ExchangeRateTuple) ProtoMessage() {}
 func (*ExchangeRateTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_968b7e916587bd39, []int{3}
+	return fileDescriptor_ee6ef6b0e93376d8, []int{4}
 }
 func (m *ExchangeRateTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,7 +359,7 @@ func (m *RegisterTargetProposal) Reset()         { *m = RegisterTargetProposal{}
 func (m *RegisterTargetProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterTargetProposal) ProtoMessage()    {}
 func (*RegisterTargetProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_968b7e916587bd39, []int{4}
+	return fileDescriptor_ee6ef6b0e93376d8, []int{5}
 }
 func (m *RegisterTargetProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -314,6 +409,136 @@ func (m *RegisterTargetProposal) GetTargetParams() TargetParams {
 	return TargetParams{}
 }
 
+// UpdateTargetProposal is a gov Content type to update the quotation source
+// of a registered target asset.
+type UpdateTargetProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// target params
+	TargetParams TargetParams `protobuf:"bytes,3,opt,name=target_params,json=targetParams,proto3" json:"target_params"`
+}
+
+func (m *UpdateTargetProposal) Reset()         { *m = UpdateTargetProposal{} }
+func (m *UpdateTargetProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateTargetProposal) ProtoMessage()    {}
+func (*UpdateTargetProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee6ef6b0e93376d8, []int{6}
+}
+func (m *UpdateTargetProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateTargetProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateTargetProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateTargetProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTargetProposal.Merge(m, src)
+}
+func (m *UpdateTargetProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateTargetProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTargetProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateTargetProposal proto.InternalMessageInfo
+
+func (m *UpdateTargetProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *UpdateTargetProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *UpdateTargetProposal) GetTargetParams() TargetParams {
+	if m != nil {
+		return m.TargetParams
+	}
+	return TargetParams{}
+}
+
+// DeregisterTargetProposal is a gov Content type to deregister a target asset,
+// which will no longer be price quoted.
+type DeregisterTargetProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// coin denom
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *DeregisterTargetProposal) Reset()         { *m = DeregisterTargetProposal{} }
+func (m *DeregisterTargetProposal) String() string { return proto.CompactTextString(m) }
+func (*DeregisterTargetProposal) ProtoMessage()    {}
+func (*DeregisterTargetProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee6ef6b0e93376d8, []int{7}
+}
+func (m *DeregisterTargetProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeregisterTargetProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeregisterTargetProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeregisterTargetProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeregisterTargetProposal.Merge(m, src)
+}
+func (m *DeregisterTargetProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeregisterTargetProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeregisterTargetProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeregisterTargetProposal proto.InternalMessageInfo
+
+func (m *DeregisterTargetProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *DeregisterTargetProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *DeregisterTargetProposal) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type TargetParams struct {
 	// coin denom
 	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -321,13 +546,24 @@ type TargetParams struct {
 	Source TargetSource `protobuf:"varint,2,opt,name=source,proto3,enum=warmage.oracle.v1.TargetSource" json:"source,omitempty"`
 	// quotation source DEX contract address
 	SourceDexContract string `protobuf:"bytes,3,opt,name=source_dex_contract,json=sourceDexContract,proto3" json:"source_dex_contract,omitempty"`
+	// quotation source IBC channel of the oracle port, for inter-chain sources
+	SourceChannel string `protobuf:"bytes,4,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	// denom on the counterparty chain, for inter-chain sources
+	SourceDenom string `protobuf:"bytes,5,opt,name=source_denom,json=sourceDenom,proto3" json:"source_denom,omitempty"`
+	// vote threshold overriding the VoteThreshold param, for validator sources
+	VoteThreshold *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold,omitempty" yaml:"vote_threshold"`
+	// reward band overriding the RewardBand param, for validator sources
+	RewardBand *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=reward_band,json=rewardBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_band,omitempty" yaml:"reward_band"`
+	// minimum number of distinct voters for a ballot to pass, for validator
+	// sources
+	MinVoters uint64 `protobuf:"varint,8,opt,name=min_voters,json=minVoters,proto3" json:"min_voters,omitempty" yaml:"min_voters"`
 }
 
 func (m *TargetParams) Reset()         { *m = TargetParams{} }
 func (m *TargetParams) String() string { return proto.CompactTextString(m) }
 func (*TargetParams) ProtoMessage()    {}
 func (*TargetParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_968b7e916587bd39, []int{5}
+	return fileDescriptor_ee6ef6b0e93376d8, []int{8}
 }
 func (m *TargetParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -377,152 +613,603 @@ func (m *TargetParams) GetSourceDexContract() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("warmage.oracle.v1.TargetSource", TargetSource_name, TargetSource_value)
-	proto.RegisterType((*Params)(nil), "warmage.oracle.v1.Params")
-	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "warmage.oracle.v1.AggregateExchangeRatePrevote")
-	proto.RegisterType((*AggregateExchangeRateVote)(nil), "warmage.oracle.v1.AggregateExchangeRateVote")
-	proto.RegisterType((*ExchangeRateTuple)(nil), "warmage.oracle.v1.ExchangeRateTuple")
-	proto.RegisterType((*RegisterTargetProposal)(nil), "warmage.oracle.v1.RegisterTargetProposal")
-	proto.RegisterType((*TargetParams)(nil), "warmage.oracle.v1.TargetParams")
+func (m *TargetParams) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("warmage/oracle/v1/oracle.proto", fileDescriptor_968b7e916587bd39) }
-
-var fileDescriptor_968b7e916587bd39 = []byte{
-	// 897 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
-	0x18, 0xdd, 0x6d, 0x9c, 0x40, 0xc7, 0x4e, 0xb1, 0xa7, 0x6e, 0x71, 0x43, 0xf0, 0x9a, 0x2d, 0x54,
-	0x11, 0x52, 0x6d, 0xb5, 0x1c, 0x2a, 0x7c, 0xf3, 0xaf, 0xb4, 0x46, 0x25, 0xb1, 0x26, 0x6e, 0x40,
-	0x5c, 0x56, 0xe3, 0xdd, 0x61, 0xbd, 0xca, 0xee, 0x8e, 0x35, 0x3b, 0x4e, 0x52, 0x0e, 0x9c, 0x7b,
-	0x04, 0x71, 0xe1, 0x18, 0xc1, 0x8d, 0x0b, 0x07, 0x04, 0x7f, 0x43, 0x8f, 0x3d, 0x22, 0x0e, 0x0b,
-	0x4a, 0x84, 0xc4, 0xd9, 0x7f, 0x01, 0x9a, 0x1f, 0x6e, 0xd7, 0x89, 0x41, 0x44, 0x9c, 0xbc, 0xdf,
-	0x7b, 0x9f, 0xdf, 0xf7, 0xe6, 0xed, 0xb7, 0xbb, 0xa0, 0x1a, 0x11, 0x16, 0x06, 0x34, 0x6e, 0x50,
-	0x86, 0xdd, 0x90, 0x34, 0x0e, 0xef, 0xe9, 0xab, 0xfa, 0x84, 0x51, 0x4e, 0x61, 0x49, 0xf3, 0x75,
-	0x8d, 0x1e, 0xde, 0xdb, 0x28, 0xfb, 0xd4, 0xa7, 0x92, 0x6d, 0x88, 0x2b, 0xd5, 0x68, 0xff, 0xb4,
-	0x0a, 0xd6, 0x06, 0x98, 0xe1, 0x28, 0x81, 0x0f, 0x40, 0xfe, 0x90, 0x72, 0xe2, 0x4c, 0x08, 0x0b,
-	0xa8, 0x57, 0x31, 0x6b, 0xe6, 0x56, 0xae, 0x7d, 0x73, 0x96, 0x5a, 0xf0, 0x29, 0x8e, 0xc2, 0xa6,
-	0x9d, 0x21, 0x6d, 0x04, 0x44, 0x35, 0x90, 0x05, 0x8c, 0xc1, 0x35, 0xc9, 0xf1, 0x31, 0x23, 0xc9,
-	0x98, 0x86, 0x5e, 0xe5, 0x4a, 0xcd, 0xdc, 0xba, 0xda, 0x7e, 0xf8, 0x3c, 0xb5, 0x8c, 0xdf, 0x52,
-	0xeb, 0x8e, 0x1f, 0xf0, 0xf1, 0x74, 0x54, 0x77, 0x69, 0xd4, 0x70, 0x69, 0x12, 0xd1, 0x44, 0xff,
-	0xdc, 0x4d, 0xbc, 0x83, 0x06, 0x7f, 0x3a, 0x21, 0x49, 0xbd, 0x4b, 0xdc, 0x59, 0x6a, 0xdd, 0xc8,
-	0x4c, 0x7a, 0xa9, 0x66, 0xa3, 0x75, 0x01, 0x0c, 0xe7, 0x35, 0x24, 0x20, 0xcf, 0xc8, 0x11, 0x66,
-	0x9e, 0x33, 0xc2, 0xb1, 0x57, 0x59, 0x91, 0xc3, 0xba, 0x97, 0x1e, 0xa6, 0x8f, 0x95, 0x91, 0xb2,
-	0x11, 0x50, 0x55, 0x1b, 0xc7, 0x1e, 0x74, 0xc1, 0x86, 0xe6, 0xbc, 0x20, 0xe1, 0x2c, 0x18, 0x4d,
-	0x79, 0x40, 0x63, 0xe7, 0x28, 0x88, 0x3d, 0x7a, 0x54, 0xc9, 0xc9, 0x78, 0xde, 0x9b, 0xa5, 0xd6,
-	0x3b, 0x0b, 0x3a, 0x4b, 0x7a, 0x6d, 0x54, 0x51, 0x64, 0x37, 0xc3, 0x7d, 0x22, 0x29, 0x91, 0x5d,
-	0x12, 0xe2, 0x64, 0xec, 0x7c, 0xce, 0xb0, 0x2b, 0xf0, 0xca, 0xea, 0xff, 0xcb, 0x6e, 0x51, 0xcd,
-	0x46, 0xeb, 0x12, 0xd8, 0xd6, 0x35, 0x6c, 0x82, 0x82, 0xea, 0xd0, 0xc7, 0x58, 0x93, 0xc7, 0x78,
-	0x73, 0x96, 0x5a, 0xd7, 0xb3, 0xff, 0x9f, 0x1b, 0xcf, 0xcb, 0x52, 0x7b, 0xfd, 0x12, 0x94, 0xa3,
-	0x20, 0x76, 0x0e, 0x71, 0x18, 0x78, 0x62, 0x11, 0xe6, 0x1a, 0xaf, 0x49, 0xc7, 0x1f, 0x5f, 0xda,
-	0xf1, 0x5b, 0x6a, 0xe2, 0x32, 0x4d, 0x1b, 0x95, 0xa2, 0x20, 0xde, 0x17, 0xe8, 0x80, 0x30, 0x35,
-	0xbf, 0xf9, 0xfa, 0xb7, 0x27, 0x96, 0xf1, 0xd7, 0x89, 0x65, 0xda, 0x3f, 0x9b, 0x60, 0xb3, 0xe5,
-	0xfb, 0x8c, 0xf8, 0x98, 0x93, 0xde, 0xb1, 0x3b, 0xc6, 0xb1, 0x4f, 0x10, 0xe6, 0x64, 0xc0, 0x88,
-	0xd8, 0x15, 0x78, 0x1b, 0xe4, 0xc6, 0x38, 0x19, 0xcb, 0x25, 0xbe, 0xda, 0x7e, 0x63, 0x96, 0x5a,
-	0x79, 0x35, 0x4c, 0xa0, 0x36, 0x92, 0x24, 0xbc, 0x03, 0x56, 0x45, 0x33, 0xd3, 0xeb, 0x5a, 0x9c,
-	0xa5, 0x56, 0xe1, 0xd5, 0x02, 0x32, 0x1b, 0x29, 0x5a, 0x66, 0x36, 0x1d, 0x45, 0x01, 0x77, 0x46,
-	0x21, 0x75, 0x0f, 0xe4, 0xc2, 0x2d, 0x66, 0x96, 0x61, 0x45, 0x66, 0xb2, 0x6c, 0x8b, 0xaa, 0x59,
-	0x78, 0x76, 0x62, 0x19, 0xda, 0xb7, 0x61, 0xff, 0x69, 0x82, 0x5b, 0x4b, 0x7d, 0xef, 0x0b, 0xd3,
-	0x5f, 0x9b, 0xa0, 0x4c, 0x34, 0xe8, 0x30, 0x2c, 0x9e, 0x81, 0xe9, 0x24, 0x24, 0x49, 0xc5, 0xac,
-	0xad, 0x6c, 0xe5, 0xef, 0xbf, 0x5b, 0xbf, 0xf0, 0x50, 0xd7, 0xb3, 0x1a, 0x43, 0xd1, 0xdc, 0xfe,
-	0x50, 0xdc, 0x86, 0x57, 0xe1, 0x2e, 0xd3, 0xb3, 0x7f, 0xf8, 0xdd, 0x82, 0x17, 0xfe, 0x99, 0x20,
-	0x48, 0x2e, 0x60, 0xff, 0x35, 0xa3, 0x73, 0xe7, 0xfc, 0xc5, 0x04, 0xa5, 0x0b, 0x03, 0x84, 0x96,
-	0x47, 0x62, 0x1a, 0xe9, 0xbb, 0x92, 0xd1, 0x92, 0xb0, 0x8d, 0x14, 0x0d, 0x0f, 0xc0, 0xfa, 0x82,
-	0x6d, 0x3d, 0x7b, 0xfb, 0xd2, 0x0b, 0x56, 0x5e, 0x92, 0x81, 0x8d, 0x0a, 0xd9, 0x63, 0x9e, 0x33,
-	0xfe, 0x9d, 0x09, 0x6e, 0x22, 0xe2, 0x07, 0x09, 0x27, 0x6c, 0x88, 0x99, 0x4f, 0xf8, 0x80, 0xd1,
-	0x09, 0x4d, 0x70, 0x08, 0xcb, 0x60, 0x95, 0x07, 0x3c, 0x24, 0xca, 0x3d, 0x52, 0x05, 0xac, 0x81,
-	0xbc, 0x47, 0x12, 0x97, 0x05, 0x13, 0xf9, 0xf0, 0x4a, 0xa7, 0x28, 0x0b, 0xc1, 0x8f, 0xc0, 0x3a,
-	0x97, 0x4a, 0xce, 0x44, 0xbe, 0x67, 0xe5, 0xfa, 0xe4, 0xef, 0x5b, 0x4b, 0xee, 0xa6, 0x9e, 0x28,
-	0xdb, 0xda, 0x39, 0x71, 0x5c, 0x54, 0xe0, 0x19, 0xac, 0x99, 0x93, 0x26, 0xbf, 0x31, 0x41, 0x21,
-	0xdb, 0x2a, 0xac, 0x65, 0x82, 0x9d, 0xc7, 0xf8, 0x00, 0xac, 0x25, 0x74, 0xca, 0x5c, 0x95, 0xdf,
-	0xb5, 0x7f, 0x99, 0xb8, 0x27, 0xdb, 0x90, 0x6e, 0x87, 0x75, 0x70, 0x5d, 0x5d, 0x39, 0x1e, 0x39,
-	0x76, 0x5c, 0x1a, 0x73, 0xf1, 0xf6, 0x50, 0xef, 0x59, 0x54, 0x52, 0x54, 0x97, 0x1c, 0x77, 0x34,
-	0xa1, 0x5c, 0xbd, 0xff, 0xe3, 0x4b, 0x57, 0x4a, 0x0e, 0xbe, 0x0d, 0x6e, 0x0d, 0x5b, 0xe8, 0x61,
-	0x6f, 0xe8, 0xec, 0xed, 0x3e, 0x41, 0x9d, 0x9e, 0xf3, 0x64, 0x67, 0x6f, 0xd0, 0xeb, 0xf4, 0xb7,
-	0xfb, 0xbd, 0x6e, 0xd1, 0x80, 0x9b, 0xa0, 0xb2, 0x48, 0xef, 0xb7, 0x1e, 0xf7, 0xbb, 0xad, 0xe1,
-	0x2e, 0xda, 0x2b, 0x9a, 0xf0, 0x06, 0x28, 0x2d, 0xb2, 0xdd, 0xde, 0xa7, 0xc5, 0x2b, 0xb0, 0x06,
-	0x36, 0x17, 0xe1, 0xfe, 0xce, 0xb0, 0x87, 0x3a, 0x8f, 0x5a, 0xfd, 0x1d, 0xd9, 0xb1, 0x02, 0x6f,
-	0x03, 0xeb, 0x1f, 0x3b, 0x76, 0x51, 0xab, 0xf3, 0xb8, 0x57, 0xcc, 0x6d, 0xe4, 0x9e, 0x7d, 0x5f,
-	0x35, 0xda, 0x8f, 0x9e, 0x9f, 0x56, 0xcd, 0x17, 0xa7, 0x55, 0xf3, 0x8f, 0xd3, 0xaa, 0xf9, 0xd5,
-	0x59, 0xd5, 0x78, 0x71, 0x56, 0x35, 0x7e, 0x3d, 0xab, 0x1a, 0x9f, 0xd5, 0x33, 0x2b, 0xa6, 0x43,
-	0xbb, 0xfb, 0x05, 0x8d, 0xc9, 0xbc, 0x68, 0x1c, 0xcf, 0x3f, 0xbc, 0x72, 0xdd, 0x46, 0x6b, 0xf2,
-	0x63, 0xfa, 0xc1, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3e, 0xd2, 0xad, 0xed, 0x97, 0x07, 0x00,
-	0x00,
+func (m *TargetParams) GetSourceDenom() string {
+	if m != nil {
+		return m.SourceDenom
+	}
+	return ""
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *TargetParams) GetMinVoters() uint64 {
+	if m != nil {
+		return m.MinVoters
 	}
+	return 0
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ExchangeRateSnapshot is the exchange rate of a denom tallied at a block.
+type ExchangeRateSnapshot struct {
+	Denom        string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Height       int64                                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	Time         time.Time                              `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+}
+
+func (m *ExchangeRateSnapshot) Reset()         { *m = ExchangeRateSnapshot{} }
+func (m *ExchangeRateSnapshot) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRateSnapshot) ProtoMessage()    {}
+func (*ExchangeRateSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee6ef6b0e93376d8, []int{9}
+}
+func (m *ExchangeRateSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.VotePeriod != that1.VotePeriod {
-		return false
-	}
-	if !this.VoteThreshold.Equal(that1.VoteThreshold) {
-		return false
-	}
-	if !this.RewardBand.Equal(that1.RewardBand) {
-		return false
-	}
-	if this.RewardDistributionWindow != that1.RewardDistributionWindow {
-		return false
-	}
-	if !this.SlashFraction.Equal(that1.SlashFraction) {
-		return false
-	}
-	if this.SlashWindow != that1.SlashWindow {
-		return false
-	}
-	if !this.MinValidPerWindow.Equal(that1.MinValidPerWindow) {
-		return false
-	}
-	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ExchangeRateSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateSnapshot.Merge(m, src)
+}
+func (m *ExchangeRateSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateSnapshot proto.InternalMessageInfo
+
+// InterchainExchangeRate is an exchange rate quoted from a counterparty chain.
+type InterchainExchangeRate struct {
+	Denom        string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+	// block time of the counterparty chain at which the exchange rate is quoted
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	// block height of the counterparty chain at which the exchange rate is
+	// quoted
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+}
+
+func (m *InterchainExchangeRate) Reset()         { *m = InterchainExchangeRate{} }
+func (m *InterchainExchangeRate) String() string { return proto.CompactTextString(m) }
+func (*InterchainExchangeRate) ProtoMessage()    {}
+func (*InterchainExchangeRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee6ef6b0e93376d8, []int{10}
+}
+func (m *InterchainExchangeRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterchainExchangeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterchainExchangeRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *InterchainExchangeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainExchangeRate.Merge(m, src)
+}
+func (m *InterchainExchangeRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterchainExchangeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainExchangeRate.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_InterchainExchangeRate proto.InternalMessageInfo
+
+// ValidatorPerformance is the oracle performance of a validator accumulated
+// in the current slash window.
+type ValidatorPerformance struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// number of vote periods the validator was in the active set
+	VotePeriods uint64 `protobuf:"varint,2,opt,name=vote_periods,json=votePeriods,proto3" json:"vote_periods,omitempty" yaml:"vote_periods"`
+	// number of ballots the validator voted within the reward band
+	Wins uint64 `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty" yaml:"wins"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MinValidPerWindow.Size()
-		i -= size
-		if _, err := m.MinValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *ValidatorPerformance) Reset()         { *m = ValidatorPerformance{} }
+func (m *ValidatorPerformance) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformance) ProtoMessage()    {}
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee6ef6b0e93376d8, []int{11}
+}
+func (m *ValidatorPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x3a
-	if m.SlashWindow != 0 {
+}
+func (m *ValidatorPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformance.Merge(m, src)
+}
+func (m *ValidatorPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformance proto.InternalMessageInfo
+
+// ValidatorOracleStats is the oracle performance of a validator over a past
+// slash window.
+type ValidatorOracleStats struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// block height at which the slash window ended
+	WindowEndHeight int64 `protobuf:"varint,2,opt,name=window_end_height,json=windowEndHeight,proto3" json:"window_end_height,omitempty" yaml:"window_end_height"`
+	// number of vote periods the validator was in the active set
+	VotePeriods uint64 `protobuf:"varint,3,opt,name=vote_periods,json=votePeriods,proto3" json:"vote_periods,omitempty" yaml:"vote_periods"`
+	// number of vote periods the validator missed
+	Misses uint64 `protobuf:"varint,4,opt,name=misses,proto3" json:"misses,omitempty" yaml:"misses"`
+	// number of ballots the validator voted within the reward band
+	Wins uint64 `protobuf:"varint,5,opt,name=wins,proto3" json:"wins,omitempty" yaml:"wins"`
+	// whether the oracle slashed the validator at the end of the window
+	Slashed bool `protobuf:"varint,6,opt,name=slashed,proto3" json:"slashed,omitempty" yaml:"slashed"`
+	// whether the validator was jailed at the end of the window
+	Jailed bool `protobuf:"varint,7,opt,name=jailed,proto3" json:"jailed,omitempty" yaml:"jailed"`
+}
+
+func (m *ValidatorOracleStats) Reset()         { *m = ValidatorOracleStats{} }
+func (m *ValidatorOracleStats) String() string { return proto.CompactTextString(m) }
+func (*ValidatorOracleStats) ProtoMessage()    {}
+func (*ValidatorOracleStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee6ef6b0e93376d8, []int{12}
+}
+func (m *ValidatorOracleStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorOracleStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorOracleStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorOracleStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorOracleStats.Merge(m, src)
+}
+func (m *ValidatorOracleStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorOracleStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorOracleStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorOracleStats proto.InternalMessageInfo
+
+// ExchangeRateStatus is the last exchange rate of a denom accepted from a
+// ballot, and whether the circuit breaker is holding it.
+type ExchangeRateStatus struct {
+	Denom        string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+	// block time at which the exchange rate was accepted
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	// block height at which the exchange rate was accepted
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	// number of consecutive vote periods the exchange rate has been held for
+	// since, zero if it is fresh
+	HeldRounds uint64 `protobuf:"varint,5,opt,name=held_rounds,json=heldRounds,proto3" json:"held_rounds,omitempty" yaml:"held_rounds"`
+}
+
+func (m *ExchangeRateStatus) Reset()         { *m = ExchangeRateStatus{} }
+func (m *ExchangeRateStatus) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRateStatus) ProtoMessage()    {}
+func (*ExchangeRateStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee6ef6b0e93376d8, []int{13}
+}
+func (m *ExchangeRateStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRateStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateStatus.Merge(m, src)
+}
+func (m *ExchangeRateStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateStatus proto.InternalMessageInfo
+
+// DexObservation is the price accumulator of the pair contract of a DEX target
+// last observed, from which the time-weighted average exchange rate over the
+// next vote period is quoted.
+type DexObservation struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// cumulative price of the denom in the counterpart token, as a UQ112x112
+	// number accumulated by the pair contract
+	CumulativePrice github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=cumulative_price,json=cumulativePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cumulative_price" yaml:"cumulative_price"`
+	// block timestamp modulo 2^32 at which the cumulative price was observed,
+	// as kept by the pair contract
+	Timestamp uint32 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty" yaml:"timestamp"`
+}
+
+func (m *DexObservation) Reset()         { *m = DexObservation{} }
+func (m *DexObservation) String() string { return proto.CompactTextString(m) }
+func (*DexObservation) ProtoMessage()    {}
+func (*DexObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee6ef6b0e93376d8, []int{14}
+}
+func (m *DexObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DexObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DexObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DexObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DexObservation.Merge(m, src)
+}
+func (m *DexObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *DexObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_DexObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DexObservation proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("warmage.oracle.v1.TargetSource", TargetSource_name, TargetSource_value)
+	proto.RegisterType((*Params)(nil), "warmage.oracle.v1.Params")
+	proto.RegisterType((*FeeSplit)(nil), "warmage.oracle.v1.FeeSplit")
+	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "warmage.oracle.v1.AggregateExchangeRatePrevote")
+	proto.RegisterType((*AggregateExchangeRateVote)(nil), "warmage.oracle.v1.AggregateExchangeRateVote")
+	proto.RegisterType((*ExchangeRateTuple)(nil), "warmage.oracle.v1.ExchangeRateTuple")
+	proto.RegisterType((*RegisterTargetProposal)(nil), "warmage.oracle.v1.RegisterTargetProposal")
+	proto.RegisterType((*UpdateTargetProposal)(nil), "warmage.oracle.v1.UpdateTargetProposal")
+	proto.RegisterType((*DeregisterTargetProposal)(nil), "warmage.oracle.v1.DeregisterTargetProposal")
+	proto.RegisterType((*TargetParams)(nil), "warmage.oracle.v1.TargetParams")
+	proto.RegisterType((*ExchangeRateSnapshot)(nil), "warmage.oracle.v1.ExchangeRateSnapshot")
+	proto.RegisterType((*InterchainExchangeRate)(nil), "warmage.oracle.v1.InterchainExchangeRate")
+	proto.RegisterType((*ValidatorPerformance)(nil), "warmage.oracle.v1.ValidatorPerformance")
+	proto.RegisterType((*ValidatorOracleStats)(nil), "warmage.oracle.v1.ValidatorOracleStats")
+	proto.RegisterType((*ExchangeRateStatus)(nil), "warmage.oracle.v1.ExchangeRateStatus")
+	proto.RegisterType((*DexObservation)(nil), "warmage.oracle.v1.DexObservation")
+}
+
+func init() { proto.RegisterFile("warmage/oracle/v1/oracle.proto", fileDescriptor_ee6ef6b0e93376d8) }
+
+var fileDescriptor_ee6ef6b0e93376d8 = []byte{
+	// 1754 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xe7, 0x92, 0x94, 0x2c, 0x0d, 0x49, 0x99, 0x9c, 0xd0, 0xd6, 0x5a, 0x71, 0xb9, 0xca, 0xe4,
+	0x03, 0x4e, 0x90, 0x50, 0x88, 0x5b, 0xc0, 0xa8, 0x6e, 0xa2, 0x48, 0x59, 0x2c, 0x1c, 0x8b, 0x18,
+	0xc9, 0x6e, 0xd1, 0xcb, 0x76, 0xb8, 0x3b, 0x26, 0xb7, 0xda, 0x0f, 0x62, 0x66, 0x49, 0xd1, 0x40,
+	0xd1, 0xb3, 0x8f, 0xe9, 0x2d, 0xe8, 0x49, 0x68, 0x4f, 0x6d, 0x0f, 0x3d, 0xb5, 0x7f, 0x43, 0x0e,
+	0x3d, 0xa4, 0xb7, 0xa2, 0x07, 0x26, 0xb0, 0x51, 0xa0, 0x28, 0x7a, 0xe2, 0x5f, 0x50, 0xcc, 0x07,
+	0xc9, 0x25, 0x29, 0xa7, 0x16, 0xac, 0x00, 0x3e, 0xe4, 0xc4, 0x7d, 0x1f, 0x7c, 0x1f, 0xbf, 0x99,
+	0xf7, 0xf6, 0xbd, 0x05, 0x95, 0x33, 0xc2, 0x02, 0xd2, 0xa1, 0x3b, 0x11, 0x23, 0x8e, 0x4f, 0x77,
+	0x06, 0x9f, 0xea, 0xa7, 0x6a, 0x8f, 0x45, 0x71, 0x04, 0x4b, 0x5a, 0x5e, 0xd5, 0xdc, 0xc1, 0xa7,
+	0x5b, 0xe5, 0x4e, 0xd4, 0x89, 0xa4, 0x74, 0x47, 0x3c, 0x29, 0xc5, 0xad, 0x4a, 0x27, 0x8a, 0x3a,
+	0x3e, 0xdd, 0x91, 0x54, 0xbb, 0xff, 0x64, 0xc7, 0xed, 0x33, 0x12, 0x7b, 0x51, 0xa8, 0xe5, 0xd6,
+	0xa2, 0x3c, 0xf6, 0x02, 0xca, 0x63, 0x12, 0xf4, 0x94, 0x02, 0x3a, 0x07, 0x60, 0xb5, 0x45, 0x18,
+	0x09, 0x38, 0xbc, 0x07, 0x72, 0x83, 0x28, 0xa6, 0x76, 0x8f, 0x32, 0x2f, 0x72, 0x4d, 0x63, 0xdb,
+	0xb8, 0x93, 0xad, 0xdd, 0x1c, 0x8f, 0x2c, 0xf8, 0x94, 0x04, 0xfe, 0x2e, 0x4a, 0x08, 0x11, 0x06,
+	0x82, 0x6a, 0x49, 0x02, 0x86, 0x60, 0x43, 0xca, 0xe2, 0x2e, 0xa3, 0xbc, 0x1b, 0xf9, 0xae, 0x99,
+	0xde, 0x36, 0xee, 0xac, 0xd7, 0xee, 0x7f, 0x39, 0xb2, 0x52, 0xff, 0x1c, 0x59, 0x1f, 0x74, 0xbc,
+	0xb8, 0xdb, 0x6f, 0x57, 0x9d, 0x28, 0xd8, 0x71, 0x22, 0x1e, 0x44, 0x5c, 0xff, 0x7c, 0xc2, 0xdd,
+	0xd3, 0x9d, 0xf8, 0x69, 0x8f, 0xf2, 0x6a, 0x9d, 0x3a, 0xe3, 0x91, 0x75, 0x23, 0xe1, 0x69, 0x6a,
+	0x0d, 0xe1, 0x82, 0x60, 0x9c, 0x4c, 0x68, 0x48, 0x41, 0x8e, 0xd1, 0x33, 0xc2, 0x5c, 0xbb, 0x4d,
+	0x42, 0xd7, 0xcc, 0x48, 0x67, 0xf5, 0x4b, 0x3b, 0xd3, 0x69, 0x25, 0x4c, 0x21, 0x0c, 0x14, 0x55,
+	0x23, 0xa1, 0x0b, 0x1d, 0xb0, 0xa5, 0x65, 0xae, 0xc7, 0x63, 0xe6, 0xb5, 0xfb, 0x02, 0x58, 0xfb,
+	0xcc, 0x0b, 0xdd, 0xe8, 0xcc, 0xcc, 0x4a, 0x78, 0xde, 0x1f, 0x8f, 0xac, 0x77, 0xe6, 0xec, 0x5c,
+	0xa0, 0x8b, 0xb0, 0xa9, 0x84, 0xf5, 0x84, 0xec, 0xa7, 0x52, 0x24, 0xb0, 0xe3, 0x3e, 0xe1, 0x5d,
+	0xfb, 0x09, 0x23, 0x8e, 0xe0, 0x9b, 0x2b, 0xaf, 0x87, 0xdd, 0xbc, 0x35, 0x84, 0x0b, 0x92, 0x71,
+	0xa0, 0x69, 0xb8, 0x0b, 0xf2, 0x4a, 0x43, 0xa7, 0xb1, 0x2a, 0xd3, 0xd8, 0x1c, 0x8f, 0xac, 0xb7,
+	0x92, 0xff, 0x9f, 0x04, 0x9e, 0x93, 0xa4, 0x8e, 0xf5, 0xd7, 0xa0, 0x1c, 0x78, 0xa1, 0x3d, 0x20,
+	0xbe, 0xe7, 0x8a, 0x8b, 0x30, 0xb1, 0x71, 0x4d, 0x46, 0xfc, 0xd9, 0xa5, 0x23, 0x7e, 0x5b, 0x79,
+	0xbc, 0xc8, 0x26, 0xc2, 0xa5, 0xc0, 0x0b, 0x1f, 0x0b, 0x6e, 0x8b, 0x32, 0xed, 0xff, 0x17, 0xe0,
+	0x56, 0xd7, 0xe3, 0x71, 0xc4, 0x3c, 0x87, 0xf8, 0x36, 0x23, 0x31, 0xe5, 0xb6, 0x1f, 0x45, 0xa7,
+	0x6d, 0xe2, 0x9c, 0x9a, 0x6b, 0x32, 0x91, 0xf7, 0xc6, 0x23, 0x6b, 0x5b, 0x99, 0x7d, 0xa9, 0x2a,
+	0xc2, 0x9b, 0x33, 0x19, 0x16, 0xa2, 0x07, 0x5a, 0x02, 0x03, 0xb0, 0xe1, 0xd2, 0xa1, 0xdd, 0x63,
+	0x9e, 0x43, 0xd5, 0xe5, 0x5a, 0x7f, 0xbd, 0xd3, 0x98, 0xb7, 0x86, 0x70, 0xde, 0xa5, 0xc3, 0x96,
+	0xa0, 0xe5, 0x0d, 0xfb, 0x15, 0xd8, 0xf4, 0xc2, 0x98, 0x32, 0xa7, 0x4b, 0xbc, 0x50, 0x46, 0x69,
+	0x07, 0x64, 0x68, 0x93, 0x0e, 0x35, 0xc1, 0xb6, 0x71, 0x27, 0x77, 0xf7, 0x56, 0x55, 0xd5, 0x6f,
+	0x75, 0x52, 0xbf, 0xd5, 0xba, 0xae, 0xef, 0xda, 0x47, 0x22, 0xa4, 0xf1, 0xc8, 0xaa, 0x28, 0x47,
+	0x2f, 0xb1, 0x83, 0xbe, 0xf8, 0xda, 0x32, 0x70, 0x79, 0x26, 0x15, 0xf9, 0x7e, 0x46, 0x86, 0x7b,
+	0x1d, 0x0a, 0x1d, 0x70, 0x3d, 0x20, 0xa7, 0x94, 0xd9, 0x4f, 0x28, 0xb5, 0x79, 0xcf, 0xf7, 0x62,
+	0x33, 0x27, 0xbd, 0xbe, 0x5d, 0x5d, 0x6a, 0x3f, 0xd5, 0x03, 0x4a, 0x8f, 0x85, 0x4a, 0xad, 0xa2,
+	0xfd, 0xde, 0xd4, 0x87, 0x37, 0x6f, 0x01, 0xe1, 0x82, 0xe4, 0x4c, 0xd4, 0x21, 0x07, 0xc5, 0x80,
+	0x4c, 0x30, 0x70, 0xba, 0x24, 0xec, 0x50, 0x33, 0x2f, 0x31, 0x6d, 0x5e, 0x1a, 0xd3, 0xcd, 0x89,
+	0xcb, 0x79, 0x7b, 0x08, 0x6f, 0x04, 0x44, 0xa1, 0xba, 0x2f, 0x19, 0xb0, 0x26, 0x32, 0x1b, 0xda,
+	0x5d, 0xea, 0xbb, 0x36, 0x8b, 0xfa, 0xa1, 0xcb, 0xcd, 0x82, 0xbc, 0x1e, 0x5b, 0xc9, 0xc0, 0xe7,
+	0x14, 0x64, 0xe0, 0xc3, 0x43, 0xea, 0xbb, 0x58, 0xd2, 0xbb, 0x6b, 0x5f, 0x9c, 0x5b, 0xa9, 0x7f,
+	0x9f, 0x5b, 0x06, 0xfa, 0x7b, 0x1a, 0xac, 0x4d, 0xf3, 0x39, 0x05, 0x05, 0x05, 0x8a, 0x2d, 0x7a,
+	0x12, 0xe3, 0xb2, 0x4d, 0xae, 0xd7, 0x0e, 0x2e, 0x9d, 0x4c, 0x59, 0x85, 0x31, 0x67, 0x0c, 0xe1,
+	0xbc, 0xa2, 0x1f, 0x4b, 0x52, 0x34, 0x07, 0x27, 0x0a, 0x82, 0x7e, 0xe8, 0xc5, 0x4f, 0xed, 0x5e,
+	0x14, 0xf9, 0xaf, 0xdb, 0x58, 0xe7, 0xad, 0x21, 0x5c, 0x98, 0x32, 0x5a, 0x51, 0xe4, 0xc3, 0x36,
+	0x00, 0x03, 0x6a, 0x8b, 0x1e, 0x2b, 0x32, 0x53, 0x7d, 0x75, 0xff, 0xd2, 0xbe, 0x4a, 0xba, 0x89,
+	0x4f, 0x2d, 0x21, 0xbc, 0x3e, 0xa0, 0x87, 0xea, 0x79, 0x37, 0x2b, 0x31, 0xfd, 0x8b, 0x01, 0x6e,
+	0xef, 0x75, 0x3a, 0x8c, 0x76, 0x48, 0x4c, 0x1b, 0x43, 0x75, 0x90, 0xe2, 0x6e, 0xb6, 0x18, 0x15,
+	0x58, 0xc0, 0x77, 0x41, 0xb6, 0x4b, 0x78, 0x57, 0xc3, 0x7b, 0x7d, 0x3c, 0xb2, 0x72, 0xba, 0xac,
+	0x09, 0xef, 0x22, 0x2c, 0x85, 0xf0, 0x03, 0xb0, 0x22, 0x81, 0xd3, 0xb0, 0x14, 0xc7, 0x23, 0x2b,
+	0x3f, 0x7b, 0x83, 0x30, 0x84, 0x95, 0x58, 0x36, 0xbd, 0x7e, 0x3b, 0xf0, 0x62, 0xbb, 0xed, 0x47,
+	0xce, 0xa9, 0x99, 0x59, 0x6a, 0x7a, 0x09, 0xa9, 0x68, 0x7a, 0x92, 0xac, 0x09, 0x6a, 0x37, 0xff,
+	0xec, 0xdc, 0x4a, 0xe9, 0xbb, 0x90, 0x42, 0xff, 0x32, 0xc0, 0xad, 0x0b, 0xe3, 0x16, 0x27, 0x06,
+	0x7f, 0x63, 0x80, 0x32, 0xd5, 0x4c, 0x55, 0x86, 0x71, 0xbf, 0xe7, 0x53, 0x71, 0x49, 0x32, 0x77,
+	0x72, 0x77, 0xdf, 0xbb, 0xa0, 0xae, 0x92, 0x36, 0x4e, 0x84, 0x72, 0xed, 0xc7, 0xba, 0xc0, 0x74,
+	0x77, 0xbc, 0xc8, 0x1e, 0xfa, 0xe3, 0xd7, 0x16, 0x5c, 0xfa, 0x27, 0xc7, 0x90, 0x2e, 0xf1, 0x5e,
+	0x15, 0xa3, 0x85, 0x3c, 0xff, 0x6a, 0x80, 0xd2, 0x92, 0x03, 0x61, 0xcb, 0xa5, 0x61, 0x14, 0x98,
+	0xc6, 0xa2, 0x2d, 0xc9, 0x46, 0x58, 0x89, 0x45, 0x91, 0xcc, 0x85, 0x6d, 0xa6, 0x5f, 0xaf, 0x48,
+	0xe6, 0x8c, 0x21, 0x9c, 0x4f, 0xa6, 0xb9, 0x10, 0xf8, 0xef, 0x0c, 0x70, 0x13, 0xd3, 0x8e, 0xc7,
+	0x63, 0xca, 0x4e, 0x08, 0xeb, 0xd0, 0xb8, 0xc5, 0xa2, 0x5e, 0xc4, 0x89, 0x0f, 0xcb, 0x60, 0x25,
+	0xf6, 0x62, 0x9f, 0xaa, 0xe8, 0xb1, 0x22, 0xe0, 0x36, 0xc8, 0xb9, 0x94, 0x3b, 0xcc, 0xeb, 0xc9,
+	0xb7, 0xaf, 0x8c, 0x14, 0x27, 0x59, 0xf0, 0x27, 0xa0, 0x10, 0x4b, 0x4b, 0x76, 0x4f, 0x0e, 0x4a,
+	0xf2, 0xfa, 0xe4, 0xee, 0x5a, 0x17, 0x9c, 0xa6, 0xf6, 0x28, 0xd5, 0x6a, 0x59, 0x91, 0x2e, 0xce,
+	0xc7, 0x09, 0x9e, 0xbc, 0xfd, 0x29, 0x74, 0x6e, 0x80, 0xf2, 0xa3, 0x9e, 0x2b, 0x70, 0x7d, 0x53,
+	0x43, 0x0c, 0x81, 0x59, 0xa7, 0xec, 0x6a, 0x81, 0x2c, 0x4f, 0xae, 0x4f, 0x46, 0xfd, 0x4f, 0x12,
+	0xda, 0xdf, 0x7f, 0x32, 0x20, 0x9f, 0x0c, 0x6d, 0xa6, 0x6c, 0x24, 0x94, 0xe1, 0x3d, 0xb0, 0xca,
+	0xa3, 0x3e, 0x73, 0xd4, 0x95, 0xda, 0xf8, 0x96, 0x0c, 0x8f, 0xa5, 0x1a, 0xd6, 0xea, 0xb0, 0x0a,
+	0xde, 0x52, 0x4f, 0xb6, 0x78, 0x25, 0x3b, 0x51, 0x18, 0x8b, 0x89, 0x48, 0x47, 0x52, 0x52, 0xa2,
+	0x3a, 0x1d, 0xee, 0x6b, 0x01, 0x7c, 0x1f, 0x6c, 0x68, 0x7d, 0x71, 0xd5, 0x42, 0xea, 0xcb, 0x81,
+	0x6f, 0x1d, 0x17, 0x14, 0x77, 0x5f, 0x31, 0xe1, 0x3b, 0x20, 0x3f, 0x35, 0x2b, 0x82, 0x5d, 0x51,
+	0x59, 0x4f, 0xec, 0x89, 0x90, 0x97, 0xa7, 0xe3, 0xd5, 0x69, 0x13, 0x37, 0xbe, 0xfb, 0xe9, 0xf8,
+	0xda, 0x74, 0x3a, 0x36, 0xae, 0x74, 0x3a, 0xfe, 0x11, 0x00, 0x72, 0x70, 0x53, 0x6f, 0x41, 0x35,
+	0x7d, 0xdd, 0x98, 0x75, 0xff, 0x99, 0x0c, 0xe1, 0x75, 0x31, 0xca, 0xc9, 0x67, 0x7d, 0xd8, 0x7f,
+	0x48, 0x83, 0x72, 0xb2, 0xbb, 0x1c, 0x87, 0xa4, 0xc7, 0xbb, 0x51, 0xfc, 0xca, 0x0d, 0xe6, 0x43,
+	0xb0, 0xda, 0xa5, 0x5e, 0xa7, 0x1b, 0xcb, 0x6b, 0x90, 0xa9, 0x95, 0xc6, 0x23, 0xab, 0xa0, 0xdf,
+	0x0f, 0x92, 0x8f, 0xb0, 0x56, 0x80, 0xf7, 0x41, 0x56, 0xec, 0x3c, 0xba, 0x22, 0xb6, 0x96, 0x06,
+	0xaa, 0x93, 0xc9, 0x42, 0x54, 0xdb, 0xd4, 0x8d, 0x57, 0xbf, 0x68, 0xc4, 0xbf, 0xd0, 0xe7, 0x62,
+	0x7c, 0x92, 0x06, 0x96, 0x9b, 0x5a, 0xf6, 0x3b, 0x6c, 0x6a, 0x6b, 0xcf, 0x26, 0x0d, 0xed, 0x4f,
+	0x69, 0x70, 0xb3, 0x39, 0x1d, 0xdf, 0x92, 0xa8, 0xbd, 0x91, 0xed, 0xf8, 0xea, 0xf0, 0x9e, 0x9d,
+	0x71, 0xf6, 0xff, 0x9c, 0x71, 0x02, 0xad, 0xbf, 0x19, 0xa0, 0x2c, 0xb7, 0x06, 0x12, 0x47, 0xac,
+	0x45, 0xd9, 0x93, 0x88, 0x05, 0x24, 0x74, 0x28, 0x6c, 0x82, 0xd2, 0x60, 0xc2, 0xb7, 0x89, 0xeb,
+	0x32, 0xca, 0x27, 0xb3, 0xdb, 0xed, 0xf1, 0xc8, 0x32, 0x75, 0x69, 0x2d, 0xaa, 0x20, 0x5c, 0x9c,
+	0xf2, 0xf6, 0x14, 0x4b, 0x4c, 0x13, 0x89, 0x55, 0x98, 0x9b, 0xe9, 0xc5, 0x69, 0x22, 0x29, 0x45,
+	0x38, 0x37, 0xdb, 0x94, 0xb9, 0x18, 0x6b, 0xce, 0xbc, 0x90, 0xeb, 0x09, 0x24, 0x31, 0xd6, 0x08,
+	0x2e, 0xc2, 0x52, 0x98, 0x48, 0xe7, 0xb7, 0x99, 0x44, 0x3a, 0x47, 0xb2, 0xc3, 0x1d, 0xc7, 0x24,
+	0xe6, 0x57, 0x99, 0xce, 0x21, 0x28, 0xa9, 0x9d, 0xcb, 0xa6, 0xa1, 0x6b, 0xcf, 0x95, 0x55, 0xc2,
+	0xd4, 0x92, 0x0a, 0xc2, 0xd7, 0x15, 0xaf, 0x11, 0xba, 0x87, 0xaa, 0xd4, 0x16, 0x81, 0xc9, 0x5c,
+	0x02, 0x98, 0x0f, 0xc1, 0x6a, 0xe0, 0x71, 0x4e, 0xb9, 0x5e, 0xac, 0x13, 0xa7, 0xad, 0xf8, 0x08,
+	0x6b, 0x85, 0x29, 0x86, 0x2b, 0xdf, 0x82, 0x21, 0xfc, 0x18, 0x5c, 0x93, 0xab, 0x2b, 0x55, 0xed,
+	0x76, 0xad, 0x06, 0xc7, 0x23, 0x6b, 0x23, 0xb1, 0xe2, 0x52, 0x17, 0xe1, 0x89, 0x8a, 0xf0, 0xfe,
+	0x4b, 0xe2, 0xf9, 0x54, 0xb5, 0xcb, 0xb5, 0xa4, 0x77, 0xc5, 0x47, 0x58, 0x2b, 0x24, 0x0e, 0xe7,
+	0x9b, 0x34, 0x98, 0x1b, 0xc2, 0xc4, 0xc9, 0xf4, 0xf9, 0xf7, 0x55, 0x39, 0xe9, 0xbc, 0xf7, 0x40,
+	0x2e, 0xb9, 0x81, 0xad, 0x2c, 0x7e, 0x4f, 0x9a, 0xdb, 0xbe, 0x40, 0x37, 0xb1, 0x7a, 0x4d, 0x21,
+	0xfe, 0xaf, 0x01, 0x36, 0xea, 0x74, 0x78, 0xd4, 0xe6, 0x94, 0x0d, 0xe4, 0xde, 0xfb, 0xca, 0xf0,
+	0xc6, 0xa0, 0xe8, 0xf4, 0x83, 0xbe, 0x4f, 0x62, 0x6f, 0x40, 0xd5, 0xbe, 0x68, 0xa6, 0x2f, 0xbd,
+	0x78, 0x36, 0xc3, 0x78, 0xb6, 0x78, 0x2e, 0xda, 0x43, 0xf8, 0xfa, 0x8c, 0x25, 0xf7, 0x4f, 0x78,
+	0x17, 0xac, 0x4f, 0xbf, 0xb0, 0x49, 0xb0, 0x0b, 0xb5, 0xf2, 0x78, 0x64, 0x15, 0x67, 0x60, 0x4a,
+	0x11, 0xc2, 0x33, 0xb5, 0x59, 0xba, 0x1f, 0xfd, 0xd9, 0x00, 0xf9, 0xe4, 0xf4, 0x02, 0x7f, 0x00,
+	0x6e, 0x9d, 0xec, 0xe1, 0xfb, 0x8d, 0x13, 0xfb, 0xf8, 0xe8, 0x11, 0xde, 0x6f, 0xd8, 0x8f, 0x1e,
+	0x1e, 0xb7, 0x1a, 0xfb, 0xcd, 0x83, 0x66, 0xa3, 0x5e, 0x4c, 0xc1, 0xdb, 0xc0, 0x9c, 0x17, 0x3f,
+	0xde, 0x7b, 0xd0, 0xac, 0xef, 0x9d, 0x1c, 0xe1, 0xe3, 0xa2, 0x01, 0x6f, 0x80, 0xd2, 0xbc, 0xb4,
+	0xde, 0xf8, 0x59, 0x31, 0x0d, 0xb7, 0xc1, 0xed, 0x79, 0x76, 0xf3, 0xe1, 0x49, 0x03, 0xef, 0x1f,
+	0xee, 0x35, 0x1f, 0x4a, 0x8d, 0x0c, 0x7c, 0x17, 0x58, 0x2f, 0xd5, 0x38, 0xc2, 0x7b, 0xfb, 0x0f,
+	0x1a, 0xc5, 0xec, 0x56, 0xf6, 0xd9, 0xef, 0x2b, 0xa9, 0xda, 0xc1, 0x97, 0xcf, 0x2b, 0xc6, 0x57,
+	0xcf, 0x2b, 0xc6, 0x37, 0xcf, 0x2b, 0xc6, 0xe7, 0x2f, 0x2a, 0xa9, 0xaf, 0x5e, 0x54, 0x52, 0xff,
+	0x78, 0x51, 0x49, 0xfd, 0xfc, 0xe3, 0x04, 0xba, 0x3d, 0x1a, 0x33, 0xef, 0x13, 0x9f, 0xb4, 0xf9,
+	0xce, 0xe4, 0xc3, 0xe7, 0x70, 0xf2, 0xe9, 0x53, 0xe2, 0xdc, 0x5e, 0x95, 0x37, 0xf1, 0x87, 0xff,
+	0x1b, 0x00, 0x87, 0x94, 0xfb, 0x4b, 0x19, 0x15, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.VotePeriod != that1.VotePeriod {
+		return false
+	}
+	if !this.VoteThreshold.Equal(that1.VoteThreshold) {
+		return false
+	}
+	if !this.RewardBand.Equal(that1.RewardBand) {
+		return false
+	}
+	if this.RewardDistributionWindow != that1.RewardDistributionWindow {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if this.SlashWindow != that1.SlashWindow {
+		return false
+	}
+	if !this.MinValidPerWindow.Equal(that1.MinValidPerWindow) {
+		return false
+	}
+	if this.HistoricalRatesLookback != that1.HistoricalRatesLookback {
+		return false
+	}
+	if !this.DexPriceBand.Equal(that1.DexPriceBand) {
+		return false
+	}
+	if this.InterchainRateMaxAge != that1.InterchainRateMaxAge {
+		return false
+	}
+	if !this.MakerFeeSplit.Equal(&that1.MakerFeeSplit) {
+		return false
+	}
+	if !this.MaxPriceChange.Equal(that1.MaxPriceChange) {
+		return false
+	}
+	if this.MaxHeldRounds != that1.MaxHeldRounds {
+		return false
+	}
+	return true
+}
+func (this *FeeSplit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeSplit)
+	if !ok {
+		that2, ok := that.(FeeSplit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.OracleVoters.Equal(that1.OracleVoters) {
+		return false
+	}
+	if !this.CommunityPool.Equal(that1.CommunityPool) {
+		return false
+	}
+	if !this.VeHolders.Equal(that1.VeHolders) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxHeldRounds != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxHeldRounds))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.MaxPriceChange.Size()
+		i -= size
+		if _, err := m.MaxPriceChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.MakerFeeSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.InterchainRateMaxAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.InterchainRateMaxAge):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.DexPriceBand.Size()
+		i -= size
+		if _, err := m.DexPriceBand.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.HistoricalRatesLookback != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.HistoricalRatesLookback))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MinValidPerWindow.Size()
+		i -= size
+		if _, err := m.MinValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.SlashWindow != 0 {
 		i = encodeVarintOracle(dAtA, i, uint64(m.SlashWindow))
 		i--
 		dAtA[i] = 0x30
@@ -570,7 +1257,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
+func (m *FeeSplit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -580,37 +1267,90 @@ func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AggregateExchangeRatePrevote) MarshalTo(dAtA []byte) (int, error) {
+func (m *FeeSplit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AggregateExchangeRatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SubmitBlock != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.SubmitBlock))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size := m.VeHolders.Size()
+		i -= size
+		if _, err := m.VeHolders.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.OracleVoters.Size()
+		i -= size
+		if _, err := m.OracleVoters.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateExchangeRatePrevote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateExchangeRatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubmitBlock != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SubmitBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
 
 func (m *AggregateExchangeRateVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -743,6 +1483,97 @@ func (m *RegisterTargetProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *UpdateTargetProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTargetProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTargetProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TargetParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeregisterTargetProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeregisterTargetProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeregisterTargetProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TargetParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -763,6 +1594,49 @@ func (m *TargetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinVoters != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinVoters))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RewardBand != nil {
+		{
+			size := m.RewardBand.Size()
+			i -= size
+			if _, err := m.RewardBand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.VoteThreshold != nil {
+		{
+			size := m.VoteThreshold.Size()
+			i -= size
+			if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SourceDenom) > 0 {
+		i -= len(m.SourceDenom)
+		copy(dAtA[i:], m.SourceDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.SourceDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.SourceDexContract) > 0 {
 		i -= len(m.SourceDexContract)
 		copy(dAtA[i:], m.SourceDexContract)
@@ -785,139 +1659,677 @@ func (m *TargetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ExchangeRateSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.VotePeriod != 0 {
-		n += 1 + sovOracle(uint64(m.VotePeriod))
-	}
-	l = m.VoteThreshold.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.RewardBand.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.RewardDistributionWindow != 0 {
-		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
-	}
-	l = m.SlashFraction.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.SlashWindow != 0 {
-		n += 1 + sovOracle(uint64(m.SlashWindow))
-	}
-	l = m.MinValidPerWindow.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+
+func (m *ExchangeRateSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AggregateExchangeRatePrevote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ExchangeRateSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
 	}
-	if m.SubmitBlock != 0 {
-		n += 1 + sovOracle(uint64(m.SubmitBlock))
+	i -= n5
+	i = encodeVarintOracle(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AggregateExchangeRateVote) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InterchainExchangeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InterchainExchangeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainExchangeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ExchangeRateTuples) > 0 {
-		for _, e := range m.ExchangeRateTuples {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
-		}
+	if m.Height != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err6 != nil {
+		return 0, err6
 	}
-	return n
-}
+	i -= n6
+	i = encodeVarintOracle(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
 
-func (m *ExchangeRateTuple) Size() (n int) {
+func (m *ValidatorPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Wins != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Wins))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VotePeriods != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriods))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorOracleStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorOracleStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorOracleStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Slashed {
+		i--
+		if m.Slashed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Wins != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Wins))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Misses != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Misses))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VotePeriods != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriods))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowEndHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.WindowEndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExchangeRateStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRateStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HeldRounds != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.HeldRounds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Height != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintOracle(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DexObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DexObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DexObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.CumulativePrice.Size()
+		i -= size
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.VotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriod))
 	}
-	l = m.ExchangeRate.Size()
+	l = m.VoteThreshold.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.RewardBand.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.RewardDistributionWindow != 0 {
+		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.SlashWindow != 0 {
+		n += 1 + sovOracle(uint64(m.SlashWindow))
+	}
+	l = m.MinValidPerWindow.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.HistoricalRatesLookback != 0 {
+		n += 1 + sovOracle(uint64(m.HistoricalRatesLookback))
+	}
+	l = m.DexPriceBand.Size()
 	n += 1 + l + sovOracle(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.InterchainRateMaxAge)
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.MakerFeeSplit.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.MaxPriceChange.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.MaxHeldRounds != 0 {
+		n += 1 + sovOracle(uint64(m.MaxHeldRounds))
+	}
 	return n
 }
 
-func (m *RegisterTargetProposal) Size() (n int) {
+func (m *FeeSplit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Title)
+	l = m.OracleVoters.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.VeHolders.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *AggregateExchangeRatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
-	l = len(m.Description)
+	l = len(m.Voter)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
-	l = m.TargetParams.Size()
-	n += 1 + l + sovOracle(uint64(l))
+	if m.SubmitBlock != 0 {
+		n += 1 + sovOracle(uint64(m.SubmitBlock))
+	}
 	return n
 }
 
-func (m *TargetParams) Size() (n int) {
+func (m *AggregateExchangeRateVote) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	if m.Source != 0 {
-		n += 1 + sovOracle(uint64(m.Source))
+	if len(m.ExchangeRateTuples) > 0 {
+		for _, e := range m.ExchangeRateTuples {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
 	}
-	l = len(m.SourceDexContract)
+	l = len(m.Voter)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
 	return n
 }
 
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+func (m *ExchangeRateTuple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *RegisterTargetProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.TargetParams.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *UpdateTargetProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.TargetParams.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *DeregisterTargetProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *TargetParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Source != 0 {
+		n += 1 + sovOracle(uint64(m.Source))
+	}
+	l = len(m.SourceDexContract)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.SourceDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.VoteThreshold != nil {
+		l = m.VoteThreshold.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.RewardBand != nil {
+		l = m.RewardBand.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.MinVoters != 0 {
+		n += 1 + sovOracle(uint64(m.MinVoters))
+	}
+	return n
+}
+
+func (m *ExchangeRateSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovOracle(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *InterchainExchangeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovOracle(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ValidatorPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.VotePeriods != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriods))
+	}
+	if m.Wins != 0 {
+		n += 1 + sovOracle(uint64(m.Wins))
+	}
+	return n
+}
+
+func (m *ValidatorOracleStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.WindowEndHeight != 0 {
+		n += 1 + sovOracle(uint64(m.WindowEndHeight))
+	}
+	if m.VotePeriods != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriods))
+	}
+	if m.Misses != 0 {
+		n += 1 + sovOracle(uint64(m.Misses))
+	}
+	if m.Wins != 0 {
+		n += 1 + sovOracle(uint64(m.Wins))
+	}
+	if m.Slashed {
+		n += 2
+	}
+	if m.Jailed {
+		n += 2
+	}
+	return n
+}
+
+func (m *ExchangeRateStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovOracle(uint64(m.Height))
+	}
+	if m.HeldRounds != 0 {
+		n += 1 + sovOracle(uint64(m.HeldRounds))
+	}
+	return n
+}
+
+func (m *DexObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
 func sozOracle(x uint64) (n int) {
 	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
@@ -931,30 +2343,1517 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowOracle
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+			}
+			m.RewardDistributionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+			}
+			m.SlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalRatesLookback", wireType)
+			}
+			m.HistoricalRatesLookback = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalRatesLookback |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DexPriceBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DexPriceBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainRateMaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.InterchainRateMaxAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeldRounds", wireType)
+			}
+			m.MaxHeldRounds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeldRounds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleVoters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleVoters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeHolders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VeHolders.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+			}
+			m.SubmitBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
+			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterTargetProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterTargetProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterTargetProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateTargetProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateTargetProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateTargetProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeregisterTargetProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeregisterTargetProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeregisterTargetProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TargetParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TargetParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TargetParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= TargetSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDexContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SourceDexContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.VotePeriod = 0
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDenom", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -964,12 +3863,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotePeriod |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
 			}
@@ -999,11 +3911,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.VoteThreshold = &v
 			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
 			}
@@ -1033,15 +3947,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.RewardBand = &v
 			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
 			}
-			m.RewardDistributionWindow = 0
+			m.MinVoters = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1051,14 +3967,64 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				m.MinVoters |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRateSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1086,15 +4052,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.SlashWindow = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1104,14 +4068,47 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashWindow |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1139,7 +4136,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1164,7 +4161,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+func (m *InterchainExchangeRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1187,15 +4184,47 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+			return fmt.Errorf("proto: InterchainExchangeRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InterchainExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1223,13 +4252,15 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1239,29 +4270,30 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.SubmitBlock = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1271,7 +4303,7 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubmitBlock |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1297,7 +4329,7 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+func (m *ValidatorPerformance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1320,17 +4352,17 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1340,31 +4372,29 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
-			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriods", wireType)
 			}
-			var stringLen uint64
+			m.VotePeriods = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1374,24 +4404,30 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.VotePeriods |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wins", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Wins = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Wins |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1413,7 +4449,7 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
+func (m *ValidatorOracleStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1436,15 +4472,15 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorOracleStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorOracleStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1472,13 +4508,13 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEndHeight", wireType)
 			}
-			var stringLen uint64
+			m.WindowEndHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1488,26 +4524,108 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.WindowEndHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriods", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
+			m.VotePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misses", wireType)
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Misses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Misses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wins", wireType)
+			}
+			m.Wins = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Wins |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Slashed = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1529,7 +4647,7 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterTargetProposal) Unmarshal(dAtA []byte) error {
+func (m *ExchangeRateStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1552,15 +4670,15 @@ func (m *RegisterTargetProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterTargetProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExchangeRateStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterTargetProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExchangeRateStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1588,11 +4706,11 @@ func (m *RegisterTargetProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1620,11 +4738,13 @@ func (m *RegisterTargetProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1651,10 +4771,48 @@ func (m *RegisterTargetProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TargetParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldRounds", wireType)
+			}
+			m.HeldRounds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeldRounds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1676,7 +4834,7 @@ func (m *RegisterTargetProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TargetParams) Unmarshal(dAtA []byte) error {
+func (m *DexObservation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1699,10 +4857,10 @@ func (m *TargetParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TargetParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: DexObservation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TargetParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DexObservation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1738,27 +4896,8 @@ func (m *TargetParams) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
-			}
-			m.Source = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Source |= TargetSource(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceDexContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1786,8 +4925,29 @@ func (m *TargetParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceDexContract = string(dAtA[iNdEx:postIndex])
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ValidateBasic performs a basic check of the request packet data
+func (p ExchangeRateRequestPacketData) ValidateBasic() error {
+	if len(p.Queries) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacket, "empty queries")
+	}
+	for _, query := range p.Queries {
+		if err := sdk.ValidateDenom(query.Denom); err != nil {
+			return sdkerrors.Wrap(ErrInvalidPacket, err.Error())
+		}
+		if len(query.DexContract) > 0 && !common.IsHexAddress(query.DexContract) {
+			return sdkerrors.Wrapf(ErrInvalidPacket, "invalid DEX contract address: '%s'", query.DexContract)
+		}
+	}
+	return nil
+}
+
+// GetBytes returns the sorted JSON encoding of the request packet data
+func (p ExchangeRateRequestPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&p))
+}
+
+// ValidateBasic performs a basic check of the response packet data against
+// the request packet data
+func (p ExchangeRateResponsePacketData) ValidateBasic(request ExchangeRateRequestPacketData) error {
+	if len(p.ExchangeRates) != len(request.Queries) {
+		return sdkerrors.Wrapf(ErrInvalidPacket, "expected %d exchange rates, got %d", len(request.Queries), len(p.ExchangeRates))
+	}
+	for i, tuple := range p.ExchangeRates {
+		if tuple.Denom != request.Queries[i].Denom {
+			return sdkerrors.Wrapf(ErrInvalidPacket, "expected exchange rate of %s, got %s", request.Queries[i].Denom, tuple.Denom)
+		}
+		if tuple.ExchangeRate.IsNil() || tuple.ExchangeRate.IsNegative() {
+			return sdkerrors.Wrapf(ErrInvalidPacket, "invalid exchange rate of %s", tuple.Denom)
+		}
+	}
+	if p.Height <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidPacket, "invalid height %d", p.Height)
+	}
+	return nil
+}
+
+// GetBytes returns the sorted JSON encoding of the response packet data
+func (p ExchangeRateResponsePacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&p))
+}
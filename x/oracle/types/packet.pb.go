@@ -0,0 +1,846 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: warmage/oracle/v1/packet.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExchangeRateRequestPacketData requests exchange rates from the oracle of the
+// counterparty chain.
+type ExchangeRateRequestPacketData struct {
+	Queries []ExchangeRateQuery `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries"`
+}
+
+func (m *ExchangeRateRequestPacketData) Reset()         { *m = ExchangeRateRequestPacketData{} }
+func (m *ExchangeRateRequestPacketData) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRateRequestPacketData) ProtoMessage()    {}
+func (*ExchangeRateRequestPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ea42e0ecb3c825a, []int{0}
+}
+func (m *ExchangeRateRequestPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateRequestPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateRequestPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRateRequestPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateRequestPacketData.Merge(m, src)
+}
+func (m *ExchangeRateRequestPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateRequestPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateRequestPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateRequestPacketData proto.InternalMessageInfo
+
+func (m *ExchangeRateRequestPacketData) GetQueries() []ExchangeRateQuery {
+	if m != nil {
+		return m.Queries
+	}
+	return nil
+}
+
+// ExchangeRateQuery queries the exchange rate of a denom on the counterparty
+// chain.
+type ExchangeRateQuery struct {
+	// denom on the counterparty chain
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// DEX pair contract on the counterparty chain to quote the denom from; if
+	// empty, the exchange rate of the counterparty oracle is queried
+	DexContract string `protobuf:"bytes,2,opt,name=dex_contract,json=dexContract,proto3" json:"dex_contract,omitempty"`
+}
+
+func (m *ExchangeRateQuery) Reset()         { *m = ExchangeRateQuery{} }
+func (m *ExchangeRateQuery) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRateQuery) ProtoMessage()    {}
+func (*ExchangeRateQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ea42e0ecb3c825a, []int{1}
+}
+func (m *ExchangeRateQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRateQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateQuery.Merge(m, src)
+}
+func (m *ExchangeRateQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateQuery proto.InternalMessageInfo
+
+func (m *ExchangeRateQuery) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ExchangeRateQuery) GetDexContract() string {
+	if m != nil {
+		return m.DexContract
+	}
+	return ""
+}
+
+// ExchangeRateResponsePacketData is the acknowledgement result of an
+// ExchangeRateRequestPacketData.
+type ExchangeRateResponsePacketData struct {
+	// exchange rates in the order of the queries; zero for those which are not
+	// available
+	ExchangeRates ExchangeRateTuples `protobuf:"bytes,1,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=ExchangeRateTuples" json:"exchange_rates"`
+	// block time at which the exchange rates are quoted
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// block height at which the exchange rates are quoted
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ExchangeRateResponsePacketData) Reset()         { *m = ExchangeRateResponsePacketData{} }
+func (m *ExchangeRateResponsePacketData) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRateResponsePacketData) ProtoMessage()    {}
+func (*ExchangeRateResponsePacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ea42e0ecb3c825a, []int{2}
+}
+func (m *ExchangeRateResponsePacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateResponsePacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateResponsePacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRateResponsePacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateResponsePacketData.Merge(m, src)
+}
+func (m *ExchangeRateResponsePacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateResponsePacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateResponsePacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateResponsePacketData proto.InternalMessageInfo
+
+func (m *ExchangeRateResponsePacketData) GetExchangeRates() ExchangeRateTuples {
+	if m != nil {
+		return m.ExchangeRates
+	}
+	return nil
+}
+
+func (m *ExchangeRateResponsePacketData) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *ExchangeRateResponsePacketData) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ExchangeRateRequestPacketData)(nil), "warmage.oracle.v1.ExchangeRateRequestPacketData")
+	proto.RegisterType((*ExchangeRateQuery)(nil), "warmage.oracle.v1.ExchangeRateQuery")
+	proto.RegisterType((*ExchangeRateResponsePacketData)(nil), "warmage.oracle.v1.ExchangeRateResponsePacketData")
+}
+
+func init() { proto.RegisterFile("warmage/oracle/v1/packet.proto", fileDescriptor_1ea42e0ecb3c825a) }
+
+var fileDescriptor_1ea42e0ecb3c825a = []byte{
+	// 385 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x8e, 0xd3, 0x30,
+	0x14, 0x45, 0x63, 0x3a, 0x0c, 0xe0, 0x02, 0xd2, 0x44, 0x23, 0x14, 0x45, 0xc2, 0x29, 0x15, 0x8b,
+	0x2e, 0xc0, 0xd6, 0x0c, 0x1b, 0xd6, 0x61, 0x60, 0xc5, 0x02, 0xa2, 0x59, 0xb1, 0x19, 0x39, 0xe9,
+	0xc3, 0x89, 0x48, 0xe2, 0x8c, 0xed, 0x94, 0xf4, 0x2f, 0xfa, 0x1d, 0x7c, 0x49, 0x97, 0x5d, 0x22,
+	0x21, 0x51, 0xd4, 0xfe, 0x08, 0x8a, 0x93, 0x48, 0x45, 0x65, 0x31, 0xbb, 0xbc, 0x77, 0xef, 0xbb,
+	0x39, 0xf2, 0xc5, 0xe4, 0x3b, 0x57, 0x05, 0x17, 0xc0, 0xa4, 0xe2, 0x49, 0x0e, 0x6c, 0x71, 0xc1,
+	0x2a, 0x9e, 0x7c, 0x03, 0x43, 0x2b, 0x25, 0x8d, 0x74, 0xcf, 0x7a, 0x9d, 0x76, 0x3a, 0x5d, 0x5c,
+	0xf8, 0xe7, 0x42, 0x0a, 0x69, 0x55, 0xd6, 0x7e, 0x75, 0x46, 0x3f, 0x10, 0x52, 0x8a, 0x1c, 0x98,
+	0x9d, 0xe2, 0xfa, 0x2b, 0x33, 0x59, 0x01, 0xda, 0xf0, 0xa2, 0xea, 0x0d, 0xff, 0xf9, 0x53, 0x9f,
+	0x69, 0xf5, 0x29, 0xe0, 0xe7, 0xef, 0x9b, 0x24, 0xe5, 0xa5, 0x80, 0x88, 0x1b, 0x88, 0xe0, 0xb6,
+	0x06, 0x6d, 0x3e, 0x59, 0x98, 0x2b, 0x6e, 0xb8, 0x7b, 0x85, 0x1f, 0xdc, 0xd6, 0xa0, 0x32, 0xd0,
+	0x1e, 0x9a, 0x8c, 0x66, 0xe3, 0xcb, 0x97, 0xf4, 0x08, 0x8e, 0x1e, 0x46, 0x7c, 0xae, 0x41, 0x2d,
+	0xc3, 0x93, 0xf5, 0xef, 0xc0, 0x89, 0x86, 0xd3, 0xe9, 0x47, 0x7c, 0x76, 0xe4, 0x71, 0xcf, 0xf1,
+	0xfd, 0x39, 0x94, 0xb2, 0xf0, 0xd0, 0x04, 0xcd, 0x1e, 0x45, 0xdd, 0xe0, 0xbe, 0xc0, 0x8f, 0xe7,
+	0xd0, 0xdc, 0x24, 0xb2, 0x34, 0x8a, 0x27, 0xc6, 0xbb, 0x67, 0xc5, 0xf1, 0x1c, 0x9a, 0x77, 0xfd,
+	0x6a, 0xfa, 0x0b, 0x61, 0xf2, 0x2f, 0xb5, 0xae, 0x64, 0xa9, 0xe1, 0x00, 0x5b, 0xe0, 0xa7, 0xd0,
+	0x3b, 0x6e, 0x14, 0x37, 0x77, 0xa6, 0xbf, 0xae, 0xab, 0x1c, 0x42, 0xbf, 0xa5, 0xff, 0xb1, 0x0d,
+	0xdc, 0x23, 0x49, 0x47, 0x4f, 0xe0, 0x60, 0xa7, 0xdd, 0xb7, 0xf8, 0xa4, 0x7d, 0x73, 0x8b, 0x39,
+	0xbe, 0xf4, 0x69, 0x57, 0x08, 0x1d, 0x0a, 0xa1, 0xd7, 0x43, 0x21, 0xe1, 0xc3, 0x36, 0x74, 0xb5,
+	0x0d, 0x50, 0x64, 0x2f, 0xdc, 0x67, 0xf8, 0x34, 0x85, 0x4c, 0xa4, 0xc6, 0x1b, 0x4d, 0xd0, 0x6c,
+	0x14, 0xf5, 0x53, 0xf8, 0x61, 0xbd, 0x23, 0x68, 0xb3, 0x23, 0xe8, 0xcf, 0x8e, 0xa0, 0xd5, 0x9e,
+	0x38, 0x9b, 0x3d, 0x71, 0x7e, 0xee, 0x89, 0xf3, 0xe5, 0x95, 0xc8, 0x4c, 0x5a, 0xc7, 0x34, 0x91,
+	0x05, 0xab, 0xc0, 0xa8, 0xec, 0x75, 0xce, 0x63, 0xcd, 0x86, 0x8a, 0x9b, 0xa1, 0x64, 0xb3, 0xac,
+	0x40, 0xc7, 0xa7, 0x96, 0xe1, 0xcd, 0xdf, 0x01, 0x00, 0x08, 0x37, 0x51, 0x11, 0x6d, 0x02, 0x00,
+	0x00,
+}
+
+func (m *ExchangeRateRequestPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRateRequestPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateRequestPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queries) > 0 {
+		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExchangeRateQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRateQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DexContract) > 0 {
+		i -= len(m.DexContract)
+		copy(dAtA[i:], m.DexContract)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.DexContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExchangeRateResponsePacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRateResponsePacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateResponsePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPacket(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ExchangeRates) > 0 {
+		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExchangeRateRequestPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queries) > 0 {
+		for _, e := range m.Queries {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExchangeRateQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.DexContract)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangeRateResponsePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovPacket(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovPacket(uint64(m.Height))
+	}
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExchangeRateRequestPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateRequestPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateRequestPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queries = append(m.Queries, ExchangeRateQuery{})
+			if err := m.Queries[len(m.Queries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRateQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DexContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DexContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRateResponsePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateResponsePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateResponsePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, ExchangeRateTuple{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacket = fmt.Errorf("proto: unexpected end of group")
+)
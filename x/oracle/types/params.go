@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -18,6 +19,12 @@ var (
 	KeySlashFraction            = []byte("SlashFraction")
 	KeySlashWindow              = []byte("SlashWindow")
 	KeyMinValidPerWindow        = []byte("MinValidPerWindow")
+	KeyHistoricalRatesLookback  = []byte("HistoricalRatesLookback")
+	KeyDexPriceBand             = []byte("DexPriceBand")
+	KeyInterchainRateMaxAge     = []byte("InterchainRateMaxAge")
+	KeyMakerFeeSplit            = []byte("MakerFeeSplit")
+	KeyMaxPriceChange           = []byte("MaxPriceChange")
+	KeyMaxHeldRounds            = []byte("MaxHeldRounds")
 )
 
 // Default parameter values
@@ -25,6 +32,9 @@ const (
 	DefaultVotePeriod               = types.BlocksPerMinute // 60 seconds
 	DefaultSlashWindow              = types.BlocksPerWeek   // slash window for a week
 	DefaultRewardDistributionWindow = types.BlocksPerYear   // reward distribution window for a year
+	DefaultHistoricalRatesLookback  = types.BlocksPerDay    // historical exchange rates for a day
+	DefaultInterchainRateMaxAge     = 5 * time.Minute       // inter-chain exchange rates for 5 minutes
+	DefaultMaxHeldRounds            = 5                     // hold the last accepted exchange rates for 5 vote periods
 )
 
 // Default parameter values
@@ -33,8 +43,17 @@ var (
 	DefaultRewardBand        = sdk.NewDecWithPrec(2, 2)  // 2% (-1, 1)
 	DefaultSlashFraction     = sdk.NewDecWithPrec(1, 4)  // 0.01%
 	DefaultMinValidPerWindow = sdk.NewDecWithPrec(5, 2)  // 5%
+	DefaultDexPriceBand      = sdk.NewDecWithPrec(10, 2) // 10%
+	DefaultMaxPriceChange    = sdk.NewDecWithPrec(25, 2) // 25%
 )
 
+// DefaultMakerFeeSplit allocates all maker fees to oracle voters
+var DefaultMakerFeeSplit = FeeSplit{
+	OracleVoters:  sdk.OneDec(),
+	CommunityPool: sdk.ZeroDec(),
+	VeHolders:     sdk.ZeroDec(),
+}
+
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 // ParamKeyTable the param key table for launch module
@@ -57,6 +76,12 @@ func DefaultParams() Params {
 		SlashFraction:            DefaultSlashFraction,
 		SlashWindow:              DefaultSlashWindow,
 		MinValidPerWindow:        DefaultMinValidPerWindow,
+		HistoricalRatesLookback:  DefaultHistoricalRatesLookback,
+		DexPriceBand:             DefaultDexPriceBand,
+		InterchainRateMaxAge:     DefaultInterchainRateMaxAge,
+		MakerFeeSplit:            DefaultMakerFeeSplit,
+		MaxPriceChange:           DefaultMaxPriceChange,
+		MaxHeldRounds:            DefaultMaxHeldRounds,
 	}
 }
 
@@ -70,6 +95,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeySlashFraction, &p.SlashFraction, validateSlashFraction),
 		paramtypes.NewParamSetPair(KeySlashWindow, &p.SlashWindow, validateSlashWindow),
 		paramtypes.NewParamSetPair(KeyMinValidPerWindow, &p.MinValidPerWindow, validateMinValidPerWindow),
+		paramtypes.NewParamSetPair(KeyHistoricalRatesLookback, &p.HistoricalRatesLookback, validateHistoricalRatesLookback),
+		paramtypes.NewParamSetPair(KeyDexPriceBand, &p.DexPriceBand, validateDexPriceBand),
+		paramtypes.NewParamSetPair(KeyInterchainRateMaxAge, &p.InterchainRateMaxAge, validateInterchainRateMaxAge),
+		paramtypes.NewParamSetPair(KeyMakerFeeSplit, &p.MakerFeeSplit, validateMakerFeeSplit),
+		paramtypes.NewParamSetPair(KeyMaxPriceChange, &p.MaxPriceChange, validateMaxPriceChange),
+		paramtypes.NewParamSetPair(KeyMaxHeldRounds, &p.MaxHeldRounds, validateMaxHeldRounds),
 	}
 }
 
@@ -102,6 +133,26 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter MinValidPerWindow must be between [0, 1]")
 	}
 
+	if p.HistoricalRatesLookback < p.VotePeriod {
+		return fmt.Errorf("oracle parameter HistoricalRatesLookback must be greater than or equal with VotePeriod")
+	}
+
+	if p.DexPriceBand.GT(sdk.OneDec()) || p.DexPriceBand.IsNegative() {
+		return fmt.Errorf("oracle parameter DexPriceBand must be between [0, 1]")
+	}
+
+	if p.InterchainRateMaxAge <= 0 {
+		return fmt.Errorf("oracle parameter InterchainRateMaxAge must be positive")
+	}
+
+	if err := p.MakerFeeSplit.Validate(); err != nil {
+		return fmt.Errorf("oracle parameter MakerFeeSplit is invalid: %w", err)
+	}
+
+	if !p.MaxPriceChange.IsPositive() {
+		return fmt.Errorf("oracle parameter MaxPriceChange must be positive")
+	}
+
 	return nil
 }
 
@@ -217,3 +268,93 @@ func validateMinValidPerWindow(i interface{}) error {
 
 	return nil
 }
+
+func validateHistoricalRatesLookback(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("historical rates lookback must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateDexPriceBand(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("dex price band must be positive: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("dex price band is too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxPriceChange(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsPositive() {
+		return fmt.Errorf("max price change must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxHeldRounds(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateInterchainRateMaxAge(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("interchain rate max age must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateMakerFeeSplit(i interface{}) error {
+	v, ok := i.(FeeSplit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+// Validate validates the shares of the fee split, which must be non-negative
+// and sum up to one.
+func (s FeeSplit) Validate() error {
+	for _, share := range []sdk.Dec{s.OracleVoters, s.CommunityPool, s.VeHolders} {
+		if share.IsNil() || share.IsNegative() {
+			return fmt.Errorf("fee split share must be non-negative: %s", share)
+		}
+	}
+
+	if !s.OracleVoters.Add(s.CommunityPool).Add(s.VeHolders).Equal(sdk.OneDec()) {
+		return fmt.Errorf("fee split shares must sum up to one: %s", s)
+	}
+
+	return nil
+}
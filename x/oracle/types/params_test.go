@@ -55,6 +55,43 @@ func TestParamsEqual(t *testing.T) {
 	err = p7.Validate()
 	require.Error(t, err)
 
+	// dex price band out of range
+	p8 := types.DefaultParams()
+	p8.DexPriceBand = sdk.NewDecWithPrec(11, 1)
+	err = p8.Validate()
+	require.Error(t, err)
+
+	// non-positive interchain rate max age
+	p9 := types.DefaultParams()
+	p9.InterchainRateMaxAge = 0
+	err = p9.Validate()
+	require.Error(t, err)
+
+	// maker fee split shares not summing up to one
+	p10 := types.DefaultParams()
+	p10.MakerFeeSplit.CommunityPool = sdk.NewDecWithPrec(1, 1)
+	err = p10.Validate()
+	require.Error(t, err)
+
+	// negative maker fee split share
+	p10.MakerFeeSplit.OracleVoters = sdk.NewDecWithPrec(11, 1)
+	p10.MakerFeeSplit.VeHolders = sdk.NewDecWithPrec(-2, 1)
+	err = p10.Validate()
+	require.Error(t, err)
+
+	// ve holders share
+	p10 = types.DefaultParams()
+	p10.MakerFeeSplit.OracleVoters = sdk.NewDecWithPrec(8, 1)
+	p10.MakerFeeSplit.VeHolders = sdk.NewDecWithPrec(2, 1)
+	err = p10.Validate()
+	require.NoError(t, err)
+
+	// non-positive max price change
+	p10 = types.DefaultParams()
+	p10.MaxPriceChange = sdk.ZeroDec()
+	err = p10.Validate()
+	require.Error(t, err)
+
 	p11 := types.DefaultParams()
 	require.NotNil(t, p11.ParamSetPairs())
 	require.NotNil(t, p11.String())
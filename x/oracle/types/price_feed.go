@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/petri-labs/warmage/contracts"
+)
+
+// Storage slots of a price feed contract written by the oracle module, as laid
+// out by contracts/PriceFeed.sol.
+const (
+	PriceFeedSlotRoundID = iota
+	PriceFeedSlotAnswer
+	PriceFeedSlotStartedAt
+	PriceFeedSlotUpdatedAt
+)
+
+// PriceFeedDecimals is the number of decimals of the price feed answers,
+// which are exchange rates scaled by 10^18.
+const PriceFeedDecimals = sdk.Precision
+
+// PriceFeedABI is the Chainlink AggregatorV3Interface, which the price feed
+// contracts implement.
+var PriceFeedABI abi.ABI = contracts.PriceFeedContract.ABI
+
+// PriceFeedDescription returns the description of the price feed contract of denom.
+func PriceFeedDescription(denom string) string {
+	return fmt.Sprintf("%s / USD", denom)
+}
+
+// PriceFeedSlot returns the storage key of a price feed contract slot.
+func PriceFeedSlot(slot int64) common.Hash {
+	return common.BigToHash(big.NewInt(slot))
+}
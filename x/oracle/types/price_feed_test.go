@@ -0,0 +1,86 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/stretchr/testify/require"
+
+	"github.com/petri-labs/warmage/contracts"
+	"github.com/petri-labs/warmage/x/oracle/types"
+)
+
+func setupPriceFeed(t *testing.T, denom string) (common.Address, *runtime.Config) {
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	require.NoError(t, err)
+	cfg := &runtime.Config{State: statedb}
+
+	ctorArgs, err := types.PriceFeedABI.Pack("", uint8(types.PriceFeedDecimals), types.PriceFeedDescription(denom))
+	require.NoError(t, err)
+	_, addr, _, err := runtime.Create(append(contracts.PriceFeedContract.Bin, ctorArgs...), cfg)
+	require.NoError(t, err)
+
+	statedb.SetState(addr, types.PriceFeedSlot(types.PriceFeedSlotRoundID), common.BigToHash(big.NewInt(100)))
+	statedb.SetState(addr, types.PriceFeedSlot(types.PriceFeedSlotAnswer), common.BigToHash(big.NewInt(1234)))
+	statedb.SetState(addr, types.PriceFeedSlot(types.PriceFeedSlotStartedAt), common.BigToHash(big.NewInt(1000)))
+	statedb.SetState(addr, types.PriceFeedSlot(types.PriceFeedSlotUpdatedAt), common.BigToHash(big.NewInt(1001)))
+
+	return addr, cfg
+}
+
+func callPriceFeed(t *testing.T, addr common.Address, cfg *runtime.Config, method string, args ...interface{}) ([]interface{}, error) {
+	input, err := types.PriceFeedABI.Pack(method, args...)
+	require.NoError(t, err)
+
+	ret, _, err := runtime.Call(addr, input, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return types.PriceFeedABI.Unpack(method, ret)
+}
+
+func TestPriceFeed(t *testing.T) {
+	for _, denom := range []string{"amage", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"} {
+		addr, cfg := setupPriceFeed(t, denom)
+
+		res, err := callPriceFeed(t, addr, cfg, "decimals")
+		require.NoError(t, err)
+		require.Equal(t, uint8(types.PriceFeedDecimals), res[0])
+
+		res, err = callPriceFeed(t, addr, cfg, "version")
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(1), res[0])
+
+		res, err = callPriceFeed(t, addr, cfg, "description")
+		require.NoError(t, err)
+		require.Equal(t, types.PriceFeedDescription(denom), res[0])
+
+		expected := []interface{}{big.NewInt(100), big.NewInt(1234), big.NewInt(1000), big.NewInt(1001), big.NewInt(100)}
+		res, err = callPriceFeed(t, addr, cfg, "latestRoundData")
+		require.NoError(t, err)
+		require.Equal(t, expected, res)
+
+		res, err = callPriceFeed(t, addr, cfg, "getRoundData", big.NewInt(100))
+		require.NoError(t, err)
+		require.Equal(t, expected, res)
+
+		// Only the latest round is kept
+		_, err = callPriceFeed(t, addr, cfg, "getRoundData", big.NewInt(99))
+		require.ErrorIs(t, err, vm.ErrExecutionReverted)
+
+		// Unknown functions and value transfers revert
+		_, _, err = runtime.Call(addr, []byte{0x01, 0x02, 0x03, 0x04}, cfg)
+		require.ErrorIs(t, err, vm.ErrExecutionReverted)
+		input, err := types.PriceFeedABI.Pack("latestRoundData")
+		require.NoError(t, err)
+		cfg.State.AddBalance(cfg.Origin, big.NewInt(1))
+		cfg.Value = big.NewInt(1)
+		_, _, err = runtime.Call(addr, input, cfg)
+		require.ErrorIs(t, err, vm.ErrExecutionReverted)
+	}
+}
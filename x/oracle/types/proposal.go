@@ -5,19 +5,29 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 const (
-	ProposalTypeRegisterTarget = "RegisterTarget"
+	ProposalTypeRegisterTarget   = "RegisterTarget"
+	ProposalTypeUpdateTarget     = "UpdateTarget"
+	ProposalTypeDeregisterTarget = "DeregisterTarget"
 )
 
 var (
 	_ govtypes.Content = &RegisterTargetProposal{}
+	_ govtypes.Content = &UpdateTargetProposal{}
+	_ govtypes.Content = &DeregisterTargetProposal{}
 )
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeRegisterTarget)
+	govtypes.RegisterProposalType(ProposalTypeUpdateTarget)
+	govtypes.RegisterProposalType(ProposalTypeDeregisterTarget)
 	govtypes.RegisterProposalTypeCodec(&RegisterTargetProposal{}, "oracle/RegisterTargetProposal")
+	govtypes.RegisterProposalTypeCodec(&UpdateTargetProposal{}, "oracle/UpdateTargetProposal")
+	govtypes.RegisterProposalTypeCodec(&DeregisterTargetProposal{}, "oracle/DeregisterTargetProposal")
 }
 
 func (m *RegisterTargetProposal) ProposalRoute() string {
@@ -32,6 +42,30 @@ func (m *RegisterTargetProposal) ValidateBasic() error {
 	return validateTargetParams(&m.TargetParams)
 }
 
+func (m *UpdateTargetProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *UpdateTargetProposal) ProposalType() string {
+	return ProposalTypeUpdateTarget
+}
+
+func (m *UpdateTargetProposal) ValidateBasic() error {
+	return validateTargetParams(&m.TargetParams)
+}
+
+func (m *DeregisterTargetProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *DeregisterTargetProposal) ProposalType() string {
+	return ProposalTypeDeregisterTarget
+}
+
+func (m *DeregisterTargetProposal) ValidateBasic() error {
+	return sdk.ValidateDenom(m.Denom)
+}
+
 func validateTargetParams(params *TargetParams) error {
 	err := sdk.ValidateDenom(params.Denom)
 	if err != nil {
@@ -40,6 +74,36 @@ func validateTargetParams(params *TargetParams) error {
 	if params.Source <= TARGET_SOURCE_UNSPECIFIED {
 		return fmt.Errorf("target source must be specified")
 	}
+	if (params.Source == TARGET_SOURCE_DEX || params.Source == TARGET_SOURCE_INTERCHAIN_DEX) &&
+		!common.IsHexAddress(params.SourceDexContract) {
+		return fmt.Errorf("invalid source DEX contract address: '%s'", params.SourceDexContract)
+	}
+	if params.Source == TARGET_SOURCE_INTERCHAIN_DEX || params.Source == TARGET_SOURCE_INTERCHAIN_ORACLE {
+		if err := host.ChannelIdentifierValidator(params.SourceChannel); err != nil {
+			return fmt.Errorf("invalid source channel: %w", err)
+		}
+		if err := sdk.ValidateDenom(params.SourceDenom); err != nil {
+			return fmt.Errorf("invalid source denom: %w", err)
+		}
+	}
+	if params.HasTallyOverrides() && params.Source != TARGET_SOURCE_VALIDATORS {
+		return fmt.Errorf("tally overrides apply to targets quoted from validators only")
+	}
+	if params.VoteThreshold != nil {
+		if err := validateVoteThreshold(*params.VoteThreshold); err != nil {
+			return err
+		}
+	}
+	if params.RewardBand != nil {
+		if err := validateRewardBand(*params.RewardBand); err != nil {
+			return err
+		}
+	}
 	// TODO
 	return nil
 }
+
+// HasTallyOverrides returns whether the target overrides any tally param.
+func (params TargetParams) HasTallyOverrides() bool {
+	return params.VoteThreshold != nil || params.RewardBand != nil || params.MinVoters > 0
+}
@@ -8,22 +8,27 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -42,7 +47,7 @@ func (m *QueryExchangeRateRequest) Reset()         { *m = QueryExchangeRateReque
 func (m *QueryExchangeRateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryExchangeRateRequest) ProtoMessage()    {}
 func (*QueryExchangeRateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{0}
+	return fileDescriptor_cad837bc35ea0c5d, []int{0}
 }
 func (m *QueryExchangeRateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -83,7 +88,7 @@ func (m *QueryExchangeRateResponse) Reset()         { *m = QueryExchangeRateResp
 func (m *QueryExchangeRateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryExchangeRateResponse) ProtoMessage()    {}
 func (*QueryExchangeRateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{1}
+	return fileDescriptor_cad837bc35ea0c5d, []int{1}
 }
 func (m *QueryExchangeRateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -121,7 +126,7 @@ func (m *QueryExchangeRatesRequest) Reset()         { *m = QueryExchangeRatesReq
 func (m *QueryExchangeRatesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryExchangeRatesRequest) ProtoMessage()    {}
 func (*QueryExchangeRatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{2}
+	return fileDescriptor_cad837bc35ea0c5d, []int{2}
 }
 func (m *QueryExchangeRatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -162,7 +167,7 @@ func (m *QueryExchangeRatesResponse) Reset()         { *m = QueryExchangeRatesRe
 func (m *QueryExchangeRatesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryExchangeRatesResponse) ProtoMessage()    {}
 func (*QueryExchangeRatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{3}
+	return fileDescriptor_cad837bc35ea0c5d, []int{3}
 }
 func (m *QueryExchangeRatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,7 +211,7 @@ func (m *QueryActivesRequest) Reset()         { *m = QueryActivesRequest{} }
 func (m *QueryActivesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryActivesRequest) ProtoMessage()    {}
 func (*QueryActivesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{4}
+	return fileDescriptor_cad837bc35ea0c5d, []int{4}
 }
 func (m *QueryActivesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -247,7 +252,7 @@ func (m *QueryActivesResponse) Reset()         { *m = QueryActivesResponse{} }
 func (m *QueryActivesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryActivesResponse) ProtoMessage()    {}
 func (*QueryActivesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{5}
+	return fileDescriptor_cad837bc35ea0c5d, []int{5}
 }
 func (m *QueryActivesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -292,7 +297,7 @@ func (m *QueryVoteTargetsRequest) Reset()         { *m = QueryVoteTargetsRequest
 func (m *QueryVoteTargetsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteTargetsRequest) ProtoMessage()    {}
 func (*QueryVoteTargetsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{6}
+	return fileDescriptor_cad837bc35ea0c5d, []int{6}
 }
 func (m *QueryVoteTargetsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -333,7 +338,7 @@ func (m *QueryVoteTargetsResponse) Reset()         { *m = QueryVoteTargetsRespon
 func (m *QueryVoteTargetsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteTargetsResponse) ProtoMessage()    {}
 func (*QueryVoteTargetsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{7}
+	return fileDescriptor_cad837bc35ea0c5d, []int{7}
 }
 func (m *QueryVoteTargetsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +383,7 @@ func (m *QueryTargetsRequest) Reset()         { *m = QueryTargetsRequest{} }
 func (m *QueryTargetsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTargetsRequest) ProtoMessage()    {}
 func (*QueryTargetsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{8}
+	return fileDescriptor_cad837bc35ea0c5d, []int{8}
 }
 func (m *QueryTargetsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,7 +424,7 @@ func (m *QueryTargetsResponse) Reset()         { *m = QueryTargetsResponse{} }
 func (m *QueryTargetsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTargetsResponse) ProtoMessage()    {}
 func (*QueryTargetsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{9}
+	return fileDescriptor_cad837bc35ea0c5d, []int{9}
 }
 func (m *QueryTargetsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -455,25 +460,23 @@ func (m *QueryTargetsResponse) GetTargets() []string {
 	return nil
 }
 
-// QueryFeederDelegationRequest is the request type for the
-// Query/FeederDelegation RPC method.
-type QueryFeederDelegationRequest struct {
-	// validator defines the validator address to query for.
-	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+// QueryTargetParamsRequest is the request type for the Query/TargetParams RPC
+// method.
+type QueryTargetParamsRequest struct {
 }
 
-func (m *QueryFeederDelegationRequest) Reset()         { *m = QueryFeederDelegationRequest{} }
-func (m *QueryFeederDelegationRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryFeederDelegationRequest) ProtoMessage()    {}
-func (*QueryFeederDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{10}
+func (m *QueryTargetParamsRequest) Reset()         { *m = QueryTargetParamsRequest{} }
+func (m *QueryTargetParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTargetParamsRequest) ProtoMessage()    {}
+func (*QueryTargetParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{10}
 }
-func (m *QueryFeederDelegationRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTargetParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFeederDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTargetParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFeederDelegationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTargetParamsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -483,37 +486,37 @@ func (m *QueryFeederDelegationRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryFeederDelegationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFeederDelegationRequest.Merge(m, src)
+func (m *QueryTargetParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTargetParamsRequest.Merge(m, src)
 }
-func (m *QueryFeederDelegationRequest) XXX_Size() int {
+func (m *QueryTargetParamsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFeederDelegationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFeederDelegationRequest.DiscardUnknown(m)
+func (m *QueryTargetParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTargetParamsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFeederDelegationRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTargetParamsRequest proto.InternalMessageInfo
 
-// QueryFeederDelegationResponse is response type for the
-// Query/FeederDelegation RPC method.
-type QueryFeederDelegationResponse struct {
-	// feeder_addr defines the feeder delegation of a validator.
-	FeederAddr string `protobuf:"bytes,1,opt,name=feeder_addr,json=feederAddr,proto3" json:"feeder_addr,omitempty"`
+// QueryTargetParamsResponse is response type for the
+// Query/TargetParams RPC method.
+type QueryTargetParamsResponse struct {
+	// target_params defines the quotation source params of all targets.
+	TargetParams []TargetParams `protobuf:"bytes,1,rep,name=target_params,json=targetParams,proto3" json:"target_params"`
 }
 
-func (m *QueryFeederDelegationResponse) Reset()         { *m = QueryFeederDelegationResponse{} }
-func (m *QueryFeederDelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryFeederDelegationResponse) ProtoMessage()    {}
-func (*QueryFeederDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{11}
+func (m *QueryTargetParamsResponse) Reset()         { *m = QueryTargetParamsResponse{} }
+func (m *QueryTargetParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTargetParamsResponse) ProtoMessage()    {}
+func (*QueryTargetParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{11}
 }
-func (m *QueryFeederDelegationResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTargetParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFeederDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTargetParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFeederDelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTargetParamsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -523,44 +526,42 @@ func (m *QueryFeederDelegationResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryFeederDelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFeederDelegationResponse.Merge(m, src)
+func (m *QueryTargetParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTargetParamsResponse.Merge(m, src)
 }
-func (m *QueryFeederDelegationResponse) XXX_Size() int {
+func (m *QueryTargetParamsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFeederDelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFeederDelegationResponse.DiscardUnknown(m)
+func (m *QueryTargetParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTargetParamsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFeederDelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTargetParamsResponse proto.InternalMessageInfo
 
-func (m *QueryFeederDelegationResponse) GetFeederAddr() string {
+func (m *QueryTargetParamsResponse) GetTargetParams() []TargetParams {
 	if m != nil {
-		return m.FeederAddr
+		return m.TargetParams
 	}
-	return ""
+	return nil
 }
 
-// QueryMissCounterRequest is the request type for the Query/MissCounter RPC
+// QueryRewardPoolRequest is the request type for the Query/RewardPool RPC
 // method.
-type QueryMissCounterRequest struct {
-	// validator defines the validator address to query for.
-	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+type QueryRewardPoolRequest struct {
 }
 
-func (m *QueryMissCounterRequest) Reset()         { *m = QueryMissCounterRequest{} }
-func (m *QueryMissCounterRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryMissCounterRequest) ProtoMessage()    {}
-func (*QueryMissCounterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{12}
+func (m *QueryRewardPoolRequest) Reset()         { *m = QueryRewardPoolRequest{} }
+func (m *QueryRewardPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolRequest) ProtoMessage()    {}
+func (*QueryRewardPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{12}
 }
-func (m *QueryMissCounterRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryRewardPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMissCounterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRewardPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMissCounterRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRewardPoolRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -570,37 +571,39 @@ func (m *QueryMissCounterRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryMissCounterRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMissCounterRequest.Merge(m, src)
+func (m *QueryRewardPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolRequest.Merge(m, src)
 }
-func (m *QueryMissCounterRequest) XXX_Size() int {
+func (m *QueryRewardPoolRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMissCounterRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMissCounterRequest.DiscardUnknown(m)
+func (m *QueryRewardPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMissCounterRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryRewardPoolRequest proto.InternalMessageInfo
 
-// QueryMissCounterResponse is response type for the
-// Query/MissCounter RPC method.
-type QueryMissCounterResponse struct {
-	// miss_counter defines the oracle miss counter of a validator.
-	MissCounter uint64 `protobuf:"varint,1,opt,name=miss_counter,json=missCounter,proto3" json:"miss_counter,omitempty"`
+// QueryRewardPoolResponse is response type for the
+// Query/RewardPool RPC method.
+type QueryRewardPoolResponse struct {
+	// balances defines the coins held by the reward pool.
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+	// inflows defines the fees accumulated into the reward pool per denom.
+	Inflows github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=inflows,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"inflows"`
 }
 
-func (m *QueryMissCounterResponse) Reset()         { *m = QueryMissCounterResponse{} }
-func (m *QueryMissCounterResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryMissCounterResponse) ProtoMessage()    {}
-func (*QueryMissCounterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{13}
+func (m *QueryRewardPoolResponse) Reset()         { *m = QueryRewardPoolResponse{} }
+func (m *QueryRewardPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolResponse) ProtoMessage()    {}
+func (*QueryRewardPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{13}
 }
-func (m *QueryMissCounterResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryRewardPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMissCounterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRewardPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMissCounterResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRewardPoolResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -610,44 +613,49 @@ func (m *QueryMissCounterResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryMissCounterResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMissCounterResponse.Merge(m, src)
+func (m *QueryRewardPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolResponse.Merge(m, src)
 }
-func (m *QueryMissCounterResponse) XXX_Size() int {
+func (m *QueryRewardPoolResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMissCounterResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMissCounterResponse.DiscardUnknown(m)
+func (m *QueryRewardPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMissCounterResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryRewardPoolResponse proto.InternalMessageInfo
 
-func (m *QueryMissCounterResponse) GetMissCounter() uint64 {
+func (m *QueryRewardPoolResponse) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.MissCounter
+		return m.Balances
 	}
-	return 0
+	return nil
 }
 
-// QueryAggregatePrevoteRequest is the request type for the
-// Query/AggregatePrevote RPC method.
-type QueryAggregatePrevoteRequest struct {
-	// validator defines the validator address to query for.
-	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+func (m *QueryRewardPoolResponse) GetInflows() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Inflows
+	}
+	return nil
 }
 
-func (m *QueryAggregatePrevoteRequest) Reset()         { *m = QueryAggregatePrevoteRequest{} }
-func (m *QueryAggregatePrevoteRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregatePrevoteRequest) ProtoMessage()    {}
-func (*QueryAggregatePrevoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{14}
+// QueryPeriodRewardsRequest is the request type for the Query/PeriodRewards
+// RPC method.
+type QueryPeriodRewardsRequest struct {
 }
-func (m *QueryAggregatePrevoteRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryPeriodRewardsRequest) Reset()         { *m = QueryPeriodRewardsRequest{} }
+func (m *QueryPeriodRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPeriodRewardsRequest) ProtoMessage()    {}
+func (*QueryPeriodRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{14}
+}
+func (m *QueryPeriodRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregatePrevoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPeriodRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregatePrevoteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPeriodRewardsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -657,38 +665,37 @@ func (m *QueryAggregatePrevoteRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregatePrevoteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregatePrevoteRequest.Merge(m, src)
+func (m *QueryPeriodRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPeriodRewardsRequest.Merge(m, src)
 }
-func (m *QueryAggregatePrevoteRequest) XXX_Size() int {
+func (m *QueryPeriodRewardsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregatePrevoteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregatePrevoteRequest.DiscardUnknown(m)
+func (m *QueryPeriodRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPeriodRewardsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregatePrevoteRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPeriodRewardsRequest proto.InternalMessageInfo
 
-// QueryAggregatePrevoteResponse is response type for the
-// Query/AggregatePrevote RPC method.
-type QueryAggregatePrevoteResponse struct {
-	// aggregate_prevote defines oracle aggregate prevote submitted by a validator
-	// in the current vote period.
-	AggregatePrevote AggregateExchangeRatePrevote `protobuf:"bytes,1,opt,name=aggregate_prevote,json=aggregatePrevote,proto3" json:"aggregate_prevote"`
+// QueryPeriodRewardsResponse is response type for the
+// Query/PeriodRewards RPC method.
+type QueryPeriodRewardsResponse struct {
+	// period_rewards defines the rewards given out per vote period.
+	PeriodRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=period_rewards,json=periodRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"period_rewards"`
 }
 
-func (m *QueryAggregatePrevoteResponse) Reset()         { *m = QueryAggregatePrevoteResponse{} }
-func (m *QueryAggregatePrevoteResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregatePrevoteResponse) ProtoMessage()    {}
-func (*QueryAggregatePrevoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{15}
+func (m *QueryPeriodRewardsResponse) Reset()         { *m = QueryPeriodRewardsResponse{} }
+func (m *QueryPeriodRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPeriodRewardsResponse) ProtoMessage()    {}
+func (*QueryPeriodRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{15}
 }
-func (m *QueryAggregatePrevoteResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPeriodRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregatePrevoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPeriodRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregatePrevoteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPeriodRewardsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -698,42 +705,44 @@ func (m *QueryAggregatePrevoteResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregatePrevoteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregatePrevoteResponse.Merge(m, src)
+func (m *QueryPeriodRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPeriodRewardsResponse.Merge(m, src)
 }
-func (m *QueryAggregatePrevoteResponse) XXX_Size() int {
+func (m *QueryPeriodRewardsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregatePrevoteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregatePrevoteResponse.DiscardUnknown(m)
+func (m *QueryPeriodRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPeriodRewardsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregatePrevoteResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPeriodRewardsResponse proto.InternalMessageInfo
 
-func (m *QueryAggregatePrevoteResponse) GetAggregatePrevote() AggregateExchangeRatePrevote {
+func (m *QueryPeriodRewardsResponse) GetPeriodRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
 	if m != nil {
-		return m.AggregatePrevote
+		return m.PeriodRewards
 	}
-	return AggregateExchangeRatePrevote{}
+	return nil
 }
 
-// QueryAggregatePrevotesRequest is the request type for the
-// Query/AggregatePrevotes RPC method.
-type QueryAggregatePrevotesRequest struct {
+// QueryExchangeRateStatusRequest is the request type for the
+// Query/ExchangeRateStatus RPC method.
+type QueryExchangeRateStatusRequest struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryAggregatePrevotesRequest) Reset()         { *m = QueryAggregatePrevotesRequest{} }
-func (m *QueryAggregatePrevotesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregatePrevotesRequest) ProtoMessage()    {}
-func (*QueryAggregatePrevotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{16}
+func (m *QueryExchangeRateStatusRequest) Reset()         { *m = QueryExchangeRateStatusRequest{} }
+func (m *QueryExchangeRateStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateStatusRequest) ProtoMessage()    {}
+func (*QueryExchangeRateStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{16}
 }
-func (m *QueryAggregatePrevotesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryExchangeRateStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregatePrevotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryExchangeRateStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregatePrevotesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryExchangeRateStatusRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -743,38 +752,37 @@ func (m *QueryAggregatePrevotesRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregatePrevotesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregatePrevotesRequest.Merge(m, src)
+func (m *QueryExchangeRateStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateStatusRequest.Merge(m, src)
 }
-func (m *QueryAggregatePrevotesRequest) XXX_Size() int {
+func (m *QueryExchangeRateStatusRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregatePrevotesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregatePrevotesRequest.DiscardUnknown(m)
+func (m *QueryExchangeRateStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateStatusRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregatePrevotesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryExchangeRateStatusRequest proto.InternalMessageInfo
 
-// QueryAggregatePrevotesResponse is response type for the
-// Query/AggregatePrevotes RPC method.
-type QueryAggregatePrevotesResponse struct {
-	// aggregate_prevotes defines all oracle aggregate prevotes submitted in the
-	// current vote period.
-	AggregatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,1,rep,name=aggregate_prevotes,json=aggregatePrevotes,proto3" json:"aggregate_prevotes"`
+// QueryExchangeRateStatusResponse is response type for the
+// Query/ExchangeRateStatus RPC method.
+type QueryExchangeRateStatusResponse struct {
+	// exchange_rate_status defines the last accepted exchange rate of the denom.
+	ExchangeRateStatus ExchangeRateStatus `protobuf:"bytes,1,opt,name=exchange_rate_status,json=exchangeRateStatus,proto3" json:"exchange_rate_status"`
 }
 
-func (m *QueryAggregatePrevotesResponse) Reset()         { *m = QueryAggregatePrevotesResponse{} }
-func (m *QueryAggregatePrevotesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregatePrevotesResponse) ProtoMessage()    {}
-func (*QueryAggregatePrevotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{17}
+func (m *QueryExchangeRateStatusResponse) Reset()         { *m = QueryExchangeRateStatusResponse{} }
+func (m *QueryExchangeRateStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateStatusResponse) ProtoMessage()    {}
+func (*QueryExchangeRateStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{17}
 }
-func (m *QueryAggregatePrevotesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryExchangeRateStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregatePrevotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryExchangeRateStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregatePrevotesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryExchangeRateStatusResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -784,44 +792,44 @@ func (m *QueryAggregatePrevotesResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregatePrevotesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregatePrevotesResponse.Merge(m, src)
+func (m *QueryExchangeRateStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateStatusResponse.Merge(m, src)
 }
-func (m *QueryAggregatePrevotesResponse) XXX_Size() int {
+func (m *QueryExchangeRateStatusResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregatePrevotesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregatePrevotesResponse.DiscardUnknown(m)
+func (m *QueryExchangeRateStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateStatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregatePrevotesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryExchangeRateStatusResponse proto.InternalMessageInfo
 
-func (m *QueryAggregatePrevotesResponse) GetAggregatePrevotes() []AggregateExchangeRatePrevote {
+func (m *QueryExchangeRateStatusResponse) GetExchangeRateStatus() ExchangeRateStatus {
 	if m != nil {
-		return m.AggregatePrevotes
+		return m.ExchangeRateStatus
 	}
-	return nil
+	return ExchangeRateStatus{}
 }
 
-// QueryAggregateVoteRequest is the request type for the Query/AggregateVote RPC
+// QueryPriceFeedRequest is the request type for the Query/PriceFeed RPC
 // method.
-type QueryAggregateVoteRequest struct {
-	// validator defines the validator address to query for.
-	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+type QueryPriceFeedRequest struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryAggregateVoteRequest) Reset()         { *m = QueryAggregateVoteRequest{} }
-func (m *QueryAggregateVoteRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregateVoteRequest) ProtoMessage()    {}
-func (*QueryAggregateVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{18}
+func (m *QueryPriceFeedRequest) Reset()         { *m = QueryPriceFeedRequest{} }
+func (m *QueryPriceFeedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceFeedRequest) ProtoMessage()    {}
+func (*QueryPriceFeedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{18}
 }
-func (m *QueryAggregateVoteRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPriceFeedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregateVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceFeedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregateVoteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceFeedRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -831,38 +839,36 @@ func (m *QueryAggregateVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregateVoteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregateVoteRequest.Merge(m, src)
+func (m *QueryPriceFeedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceFeedRequest.Merge(m, src)
 }
-func (m *QueryAggregateVoteRequest) XXX_Size() int {
+func (m *QueryPriceFeedRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregateVoteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregateVoteRequest.DiscardUnknown(m)
+func (m *QueryPriceFeedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceFeedRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregateVoteRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPriceFeedRequest proto.InternalMessageInfo
 
-// QueryAggregateVoteResponse is response type for the
-// Query/AggregateVote RPC method.
-type QueryAggregateVoteResponse struct {
-	// aggregate_vote defines oracle aggregate vote submitted by a validator in
-	// the current vote period.
-	AggregateVote AggregateExchangeRateVote `protobuf:"bytes,1,opt,name=aggregate_vote,json=aggregateVote,proto3" json:"aggregate_vote"`
+// QueryPriceFeedResponse is response type for the Query/PriceFeed RPC method.
+type QueryPriceFeedResponse struct {
+	// address defines the hex address of the price feed contract.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryAggregateVoteResponse) Reset()         { *m = QueryAggregateVoteResponse{} }
-func (m *QueryAggregateVoteResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregateVoteResponse) ProtoMessage()    {}
-func (*QueryAggregateVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{19}
+func (m *QueryPriceFeedResponse) Reset()         { *m = QueryPriceFeedResponse{} }
+func (m *QueryPriceFeedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceFeedResponse) ProtoMessage()    {}
+func (*QueryPriceFeedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{19}
 }
-func (m *QueryAggregateVoteResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPriceFeedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregateVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceFeedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregateVoteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceFeedResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -872,42 +878,45 @@ func (m *QueryAggregateVoteResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregateVoteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregateVoteResponse.Merge(m, src)
+func (m *QueryPriceFeedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceFeedResponse.Merge(m, src)
 }
-func (m *QueryAggregateVoteResponse) XXX_Size() int {
+func (m *QueryPriceFeedResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregateVoteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregateVoteResponse.DiscardUnknown(m)
+func (m *QueryPriceFeedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceFeedResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregateVoteResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPriceFeedResponse proto.InternalMessageInfo
 
-func (m *QueryAggregateVoteResponse) GetAggregateVote() AggregateExchangeRateVote {
+func (m *QueryPriceFeedResponse) GetAddress() string {
 	if m != nil {
-		return m.AggregateVote
+		return m.Address
 	}
-	return AggregateExchangeRateVote{}
+	return ""
 }
 
-// QueryAggregateVotesRequest is the request type for the Query/AggregateVotes
-// RPC method.
-type QueryAggregateVotesRequest struct {
+// QueryTWAPRequest is the request type for the Query/TWAP RPC method.
+type QueryTWAPRequest struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// window defines the time window over which to average.
+	Window time.Duration `protobuf:"bytes,2,opt,name=window,proto3,stdduration" json:"window"`
 }
 
-func (m *QueryAggregateVotesRequest) Reset()         { *m = QueryAggregateVotesRequest{} }
-func (m *QueryAggregateVotesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregateVotesRequest) ProtoMessage()    {}
-func (*QueryAggregateVotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{20}
+func (m *QueryTWAPRequest) Reset()         { *m = QueryTWAPRequest{} }
+func (m *QueryTWAPRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPRequest) ProtoMessage()    {}
+func (*QueryTWAPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{20}
 }
-func (m *QueryAggregateVotesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTWAPRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregateVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTWAPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregateVotesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTWAPRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -917,38 +926,37 @@ func (m *QueryAggregateVotesRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregateVotesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregateVotesRequest.Merge(m, src)
+func (m *QueryTWAPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPRequest.Merge(m, src)
 }
-func (m *QueryAggregateVotesRequest) XXX_Size() int {
+func (m *QueryTWAPRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregateVotesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregateVotesRequest.DiscardUnknown(m)
+func (m *QueryTWAPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregateVotesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTWAPRequest proto.InternalMessageInfo
 
-// QueryAggregateVotesResponse is response type for the
-// Query/AggregateVotes RPC method.
-type QueryAggregateVotesResponse struct {
-	// aggregate_votes defines all oracle aggregate votes submitted in the current
-	// vote period.
-	AggregateVotes []AggregateExchangeRateVote `protobuf:"bytes,1,rep,name=aggregate_votes,json=aggregateVotes,proto3" json:"aggregate_votes"`
+// QueryTWAPResponse is response type for the Query/TWAP RPC method.
+type QueryTWAPResponse struct {
+	// twap defines the time-weighted average exchange rate of the denom asset
+	// denominated in uUSD.
+	Twap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=twap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"twap"`
 }
 
-func (m *QueryAggregateVotesResponse) Reset()         { *m = QueryAggregateVotesResponse{} }
-func (m *QueryAggregateVotesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAggregateVotesResponse) ProtoMessage()    {}
-func (*QueryAggregateVotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{21}
+func (m *QueryTWAPResponse) Reset()         { *m = QueryTWAPResponse{} }
+func (m *QueryTWAPResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPResponse) ProtoMessage()    {}
+func (*QueryTWAPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{21}
 }
-func (m *QueryAggregateVotesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTWAPResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAggregateVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTWAPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAggregateVotesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTWAPResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -958,41 +966,39 @@ func (m *QueryAggregateVotesResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAggregateVotesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAggregateVotesResponse.Merge(m, src)
+func (m *QueryTWAPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPResponse.Merge(m, src)
 }
-func (m *QueryAggregateVotesResponse) XXX_Size() int {
+func (m *QueryTWAPResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAggregateVotesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAggregateVotesResponse.DiscardUnknown(m)
+func (m *QueryTWAPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAggregateVotesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTWAPResponse proto.InternalMessageInfo
 
-func (m *QueryAggregateVotesResponse) GetAggregateVotes() []AggregateExchangeRateVote {
-	if m != nil {
-		return m.AggregateVotes
-	}
-	return nil
+// QueryHistoricalRateRequest is the request type for the Query/HistoricalRate
+// RPC method.
+type QueryHistoricalRateRequest struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// height defines the block height to query for.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+func (m *QueryHistoricalRateRequest) Reset()         { *m = QueryHistoricalRateRequest{} }
+func (m *QueryHistoricalRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalRateRequest) ProtoMessage()    {}
+func (*QueryHistoricalRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{22}
 }
-
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{22}
-}
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryHistoricalRateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryHistoricalRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryHistoricalRateRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1002,36 +1008,37 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryHistoricalRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalRateRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryHistoricalRateRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryHistoricalRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalRateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryHistoricalRateRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params holds all the parameters of this module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+// QueryHistoricalRateResponse is response type for the Query/HistoricalRate
+// RPC method.
+type QueryHistoricalRateResponse struct {
+	// snapshot defines the latest exchange rate snapshot at or before the height.
+	Snapshot ExchangeRateSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot"`
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{23}
+func (m *QueryHistoricalRateResponse) Reset()         { *m = QueryHistoricalRateResponse{} }
+func (m *QueryHistoricalRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalRateResponse) ProtoMessage()    {}
+func (*QueryHistoricalRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{23}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryHistoricalRateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryHistoricalRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryHistoricalRateResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1041,1641 +1048,4640 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryHistoricalRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalRateResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryHistoricalRateResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryHistoricalRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalRateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryHistoricalRateResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryHistoricalRateResponse) GetSnapshot() ExchangeRateSnapshot {
 	if m != nil {
-		return m.Params
+		return m.Snapshot
 	}
-	return Params{}
+	return ExchangeRateSnapshot{}
 }
 
-func init() {
-	proto.RegisterType((*QueryExchangeRateRequest)(nil), "warmage.oracle.v1.QueryExchangeRateRequest")
-	proto.RegisterType((*QueryExchangeRateResponse)(nil), "warmage.oracle.v1.QueryExchangeRateResponse")
-	proto.RegisterType((*QueryExchangeRatesRequest)(nil), "warmage.oracle.v1.QueryExchangeRatesRequest")
-	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "warmage.oracle.v1.QueryExchangeRatesResponse")
-	proto.RegisterType((*QueryActivesRequest)(nil), "warmage.oracle.v1.QueryActivesRequest")
-	proto.RegisterType((*QueryActivesResponse)(nil), "warmage.oracle.v1.QueryActivesResponse")
-	proto.RegisterType((*QueryVoteTargetsRequest)(nil), "warmage.oracle.v1.QueryVoteTargetsRequest")
-	proto.RegisterType((*QueryVoteTargetsResponse)(nil), "warmage.oracle.v1.QueryVoteTargetsResponse")
-	proto.RegisterType((*QueryTargetsRequest)(nil), "warmage.oracle.v1.QueryTargetsRequest")
-	proto.RegisterType((*QueryTargetsResponse)(nil), "warmage.oracle.v1.QueryTargetsResponse")
-	proto.RegisterType((*QueryFeederDelegationRequest)(nil), "warmage.oracle.v1.QueryFeederDelegationRequest")
-	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "warmage.oracle.v1.QueryFeederDelegationResponse")
-	proto.RegisterType((*QueryMissCounterRequest)(nil), "warmage.oracle.v1.QueryMissCounterRequest")
-	proto.RegisterType((*QueryMissCounterResponse)(nil), "warmage.oracle.v1.QueryMissCounterResponse")
-	proto.RegisterType((*QueryAggregatePrevoteRequest)(nil), "warmage.oracle.v1.QueryAggregatePrevoteRequest")
-	proto.RegisterType((*QueryAggregatePrevoteResponse)(nil), "warmage.oracle.v1.QueryAggregatePrevoteResponse")
-	proto.RegisterType((*QueryAggregatePrevotesRequest)(nil), "warmage.oracle.v1.QueryAggregatePrevotesRequest")
-	proto.RegisterType((*QueryAggregatePrevotesResponse)(nil), "warmage.oracle.v1.QueryAggregatePrevotesResponse")
-	proto.RegisterType((*QueryAggregateVoteRequest)(nil), "warmage.oracle.v1.QueryAggregateVoteRequest")
-	proto.RegisterType((*QueryAggregateVoteResponse)(nil), "warmage.oracle.v1.QueryAggregateVoteResponse")
-	proto.RegisterType((*QueryAggregateVotesRequest)(nil), "warmage.oracle.v1.QueryAggregateVotesRequest")
-	proto.RegisterType((*QueryAggregateVotesResponse)(nil), "warmage.oracle.v1.QueryAggregateVotesResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "warmage.oracle.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "warmage.oracle.v1.QueryParamsResponse")
+// QueryFeederDelegationRequest is the request type for the
+// Query/FeederDelegation RPC method.
+type QueryFeederDelegationRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func init() { proto.RegisterFile("warmage/oracle/v1/query.proto", fileDescriptor_4a44d78ace854082) }
-
-var fileDescriptor_4a44d78ace854082 = []byte{
-	// 1113 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xc6, 0x3d, 0xdf, 0x6f, 0x9b, 0xd0, 0x71, 0xec, 0x26, 0x43, 0x10, 0x8e, 0x9b, 0xda, 0xed,
-	0x42, 0xd3, 0x90, 0x34, 0xbb, 0x71, 0xa2, 0x28, 0xa8, 0x28, 0xa2, 0x71, 0x5b, 0x54, 0x21, 0x7e,
-	0x14, 0x83, 0x22, 0x01, 0x07, 0x6b, 0xec, 0x1d, 0xb6, 0x2b, 0xec, 0x1d, 0x77, 0x67, 0x63, 0xd2,
-	0x56, 0xbd, 0x50, 0x81, 0x38, 0x22, 0x21, 0xf5, 0x88, 0x2a, 0x90, 0x38, 0x70, 0x41, 0x02, 0xee,
-	0x5c, 0x7b, 0xac, 0xc4, 0x05, 0x71, 0x28, 0x28, 0xe1, 0xc0, 0x9f, 0x81, 0x76, 0xf6, 0xdd, 0xf5,
-	0xae, 0x77, 0x87, 0x6c, 0xc2, 0x29, 0xf1, 0xbc, 0xef, 0x3c, 0xef, 0x67, 0x9e, 0x5d, 0xcf, 0x23,
-	0xe3, 0xb3, 0x7d, 0xe6, 0xf6, 0x6c, 0xee, 0x18, 0xdc, 0xa5, 0xdd, 0x1e, 0x33, 0x86, 0x0d, 0xe3,
-	0xf6, 0x2e, 0x73, 0xef, 0xe8, 0x03, 0x97, 0x7b, 0x9c, 0xcc, 0x40, 0x59, 0x0f, 0xca, 0xfa, 0xb0,
-	0x51, 0x9d, 0xb5, 0xb8, 0xc5, 0x65, 0xd5, 0xf0, 0xff, 0x0b, 0x1a, 0xab, 0xf3, 0x16, 0xe7, 0x56,
-	0x8f, 0x19, 0x74, 0x60, 0x1b, 0xd4, 0x71, 0xb8, 0x47, 0x3d, 0x9b, 0x3b, 0x02, 0xaa, 0xb5, 0x2e,
-	0x17, 0x7d, 0x2e, 0x8c, 0x0e, 0x15, 0xfe, 0x88, 0x0e, 0xf3, 0x68, 0xc3, 0xe8, 0x72, 0xdb, 0x09,
-	0xeb, 0x69, 0x0a, 0x18, 0x28, 0xeb, 0xda, 0x65, 0x5c, 0x79, 0xc7, 0xa7, 0xba, 0xbe, 0xd7, 0xbd,
-	0x45, 0x1d, 0x8b, 0xb5, 0xa8, 0xc7, 0x5a, 0xec, 0xf6, 0x2e, 0x13, 0x1e, 0x99, 0xc5, 0x27, 0x4d,
-	0xe6, 0xf0, 0x7e, 0x05, 0x9d, 0x43, 0x8b, 0xa7, 0x5a, 0xc1, 0x87, 0xcb, 0xcf, 0x7c, 0xf1, 0xa8,
-	0x5e, 0xf8, 0xfb, 0x51, 0xbd, 0xa0, 0x0d, 0xf0, 0x5c, 0xc6, 0x5e, 0x31, 0xe0, 0x8e, 0x60, 0xe4,
-	0x5d, 0x5c, 0x62, 0xb0, 0xde, 0x76, 0xa9, 0xc7, 0x02, 0x91, 0xa6, 0xfe, 0xf8, 0x69, 0xbd, 0xf0,
-	0xfb, 0xd3, 0xfa, 0x82, 0x65, 0x7b, 0xb7, 0x76, 0x3b, 0x7a, 0x97, 0xf7, 0x0d, 0x38, 0x42, 0xf0,
-	0x67, 0x45, 0x98, 0x1f, 0x1b, 0xde, 0x9d, 0x01, 0x13, 0xfa, 0x35, 0xd6, 0x6d, 0x4d, 0xb1, 0x98,
-	0xb8, 0x76, 0x26, 0x63, 0xa2, 0x00, 0x5c, 0xed, 0x21, 0xc2, 0xd5, 0xac, 0x2a, 0x00, 0xed, 0xe1,
-	0x72, 0x02, 0x48, 0x54, 0xd0, 0xb9, 0xff, 0x2f, 0x16, 0xd7, 0xe6, 0xf5, 0x60, 0xb0, 0xee, 0x5b,
-	0xa8, 0x83, 0x85, 0xfe, 0xec, 0xab, 0xdc, 0x76, 0x9a, 0xeb, 0x3e, 0xef, 0xf7, 0x7f, 0xd4, 0x97,
-	0xf3, 0xf1, 0xfa, 0x7b, 0x44, 0xab, 0x14, 0x87, 0x16, 0xda, 0x73, 0xf8, 0x59, 0xc9, 0xb5, 0xdd,
-	0xf5, 0xec, 0xe1, 0x88, 0x77, 0x15, 0xcf, 0x26, 0x97, 0x01, 0xb4, 0x82, 0x27, 0x69, 0xb0, 0x24,
-	0x09, 0x4f, 0xb5, 0xc2, 0x8f, 0xda, 0x1c, 0x7e, 0x5e, 0xee, 0xd8, 0xe1, 0x1e, 0x7b, 0x8f, 0xba,
-	0x16, 0xf3, 0x22, 0xb1, 0x2d, 0x78, 0x8e, 0x89, 0x12, 0x08, 0x9e, 0xc7, 0x53, 0x43, 0xee, 0xb1,
-	0xb6, 0x17, 0xac, 0x83, 0x6a, 0x71, 0x38, 0x6a, 0x8d, 0x10, 0xc7, 0x54, 0x43, 0xc4, 0x71, 0xc5,
-	0x0a, 0x9e, 0x4c, 0x8a, 0x85, 0x1f, 0xb5, 0xb7, 0xf1, 0xbc, 0xdc, 0xf1, 0x1a, 0x63, 0x26, 0x73,
-	0xaf, 0xb1, 0x1e, 0xb3, 0xe4, 0xfb, 0x1a, 0xbe, 0x53, 0x17, 0x70, 0x79, 0x48, 0x7b, 0xb6, 0x49,
-	0x3d, 0xee, 0xb6, 0xa9, 0x69, 0xba, 0xf0, 0x72, 0x95, 0xa2, 0xd5, 0x6d, 0xd3, 0x74, 0x63, 0x2f,
-	0xd9, 0x15, 0x7c, 0x56, 0x21, 0x08, 0x2c, 0x75, 0x5c, 0xfc, 0x48, 0xd6, 0xe2, 0x72, 0x38, 0x58,
-	0xf2, 0xb5, 0xb4, 0xd7, 0xc1, 0xb5, 0x37, 0x6d, 0x21, 0xae, 0xf2, 0x5d, 0xc7, 0x63, 0xee, 0xb1,
-	0x69, 0x42, 0x9b, 0x13, 0x5a, 0x23, 0x9b, 0xfb, 0xb6, 0x10, 0xed, 0x6e, 0xb0, 0x2e, 0xa5, 0x4e,
-	0xb4, 0x8a, 0xfd, 0x51, 0x6b, 0xe4, 0xce, 0xb6, 0x65, 0xb9, 0xfe, 0x39, 0xd8, 0x4d, 0x97, 0xf9,
-	0x8f, 0xe1, 0xd8, 0x3c, 0x0f, 0x10, 0xd8, 0x93, 0x56, 0x04, 0xaa, 0x0e, 0x9e, 0xa1, 0x61, 0xad,
-	0x3d, 0x08, 0x8a, 0x52, 0xb5, 0xb8, 0x66, 0xe8, 0xa9, 0x3b, 0x48, 0x8f, 0x74, 0xe2, 0x5f, 0x22,
-	0xd0, 0x6c, 0x9e, 0xf0, 0xbf, 0x0c, 0xad, 0x69, 0x3a, 0x36, 0x4b, 0xab, 0x2b, 0x20, 0xa2, 0xf7,
-	0xe8, 0x73, 0x84, 0x6b, 0xaa, 0x0e, 0xe0, 0x34, 0x31, 0x49, 0x71, 0x86, 0x5f, 0xd1, 0x63, 0x82,
-	0xce, 0x8c, 0x83, 0x0a, 0xed, 0x0d, 0xb8, 0x40, 0xa2, 0xdd, 0x3b, 0xff, 0xc5, 0xfd, 0x4f, 0xe0,
-	0xc2, 0x19, 0x53, 0x83, 0x13, 0xbd, 0x8f, 0xcb, 0xa3, 0x13, 0xc5, 0x6c, 0xbf, 0x94, 0xf7, 0x34,
-	0x3b, 0xa3, 0xa3, 0x94, 0x68, 0x7c, 0x84, 0x36, 0x9f, 0x35, 0x38, 0x72, 0xfb, 0x2e, 0x3e, 0x93,
-	0x59, 0x05, 0xae, 0x0f, 0xf1, 0xe9, 0x24, 0x57, 0x68, 0xf3, 0x71, 0xc0, 0xca, 0x09, 0x30, 0xa1,
-	0xcd, 0x62, 0x22, 0x67, 0xdf, 0xa4, 0x2e, 0xed, 0x47, 0x44, 0x6f, 0xc1, 0xf5, 0x12, 0xae, 0x02,
-	0xc9, 0x26, 0x9e, 0x18, 0xc8, 0x15, 0x70, 0x66, 0x2e, 0x03, 0x20, 0xd8, 0x02, 0xd3, 0xa0, 0x7d,
-	0xed, 0x87, 0xd3, 0xf8, 0xa4, 0x14, 0x24, 0xdf, 0x20, 0x3c, 0x15, 0x47, 0x23, 0xcb, 0x19, 0x1a,
-	0xaa, 0x84, 0xab, 0x5e, 0xca, 0xd7, 0x1c, 0xe0, 0x6a, 0x9b, 0x9f, 0xfe, 0xfa, 0xd7, 0x57, 0xff,
-	0x6b, 0x10, 0xc3, 0x48, 0x87, 0xaa, 0xcc, 0x46, 0x61, 0xdc, 0x93, 0x7f, 0xef, 0x1b, 0x89, 0xa4,
-	0x21, 0x5f, 0x23, 0x5c, 0x4a, 0x84, 0x12, 0xc9, 0x35, 0x38, 0xb4, 0xaf, 0xba, 0x92, 0xb3, 0x1b,
-	0x38, 0x57, 0x25, 0xe7, 0x12, 0x59, 0x54, 0x73, 0x26, 0x93, 0x90, 0x7c, 0x86, 0xf0, 0x24, 0xc4,
-	0x10, 0x59, 0x50, 0x0d, 0x4b, 0xc6, 0x57, 0xf5, 0xe2, 0xa1, 0x7d, 0x80, 0xf3, 0x92, 0xc4, 0x79,
-	0x81, 0x9c, 0x57, 0xe3, 0x40, 0xc0, 0x91, 0x87, 0x08, 0x17, 0x63, 0x09, 0x46, 0x96, 0x54, 0x33,
-	0xd2, 0x09, 0x58, 0x5d, 0xce, 0xd5, 0x0b, 0x4c, 0xba, 0x64, 0x5a, 0x24, 0x0b, 0x6a, 0xa6, 0x78,
-	0x64, 0x4a, 0x83, 0x42, 0x28, 0xa5, 0x41, 0x63, 0x40, 0x17, 0x0f, 0xed, 0xcb, 0x6f, 0x50, 0xc8,
-	0xf1, 0x33, 0xc2, 0xd3, 0xe3, 0x49, 0x48, 0x0c, 0xd5, 0x20, 0x45, 0x08, 0x57, 0x57, 0xf3, 0x6f,
-	0x00, 0xc4, 0x2d, 0x89, 0xb8, 0x49, 0x36, 0x32, 0x10, 0xa3, 0xdb, 0x51, 0x18, 0xf7, 0x92, 0xf7,
-	0xe7, 0x7d, 0x23, 0x88, 0x61, 0xf2, 0x2d, 0xc2, 0xc5, 0x58, 0x64, 0xaa, 0x9f, 0x6b, 0x3a, 0xa3,
-	0xd5, 0xcf, 0x35, 0x23, 0x83, 0xb5, 0x57, 0x24, 0xe7, 0x06, 0x59, 0x3f, 0x22, 0xa7, 0x1f, 0xd2,
-	0xe4, 0x17, 0x84, 0xa7, 0xc7, 0x03, 0x4a, 0x6d, 0xae, 0x22, 0xc3, 0xd5, 0xe6, 0xaa, 0x22, 0x5a,
-	0xbb, 0x21, 0xa1, 0x9b, 0xe4, 0xca, 0x11, 0xa1, 0x53, 0x79, 0x49, 0x7e, 0x44, 0x78, 0x26, 0x15,
-	0xb1, 0x24, 0x37, 0x51, 0xf4, 0xea, 0x36, 0x8e, 0xb0, 0x03, 0x0e, 0xf1, 0xb2, 0x3c, 0xc4, 0x1a,
-	0x59, 0xfd, 0xf7, 0x43, 0xa4, 0x33, 0x9e, 0xfc, 0x84, 0x70, 0x29, 0x11, 0x55, 0xea, 0xdb, 0x31,
-	0x2b, 0xb6, 0xd5, 0xb7, 0x63, 0x66, 0x2c, 0x6b, 0xd7, 0x25, 0xe8, 0xab, 0x64, 0x2b, 0x1b, 0xd4,
-	0xb4, 0x0f, 0x75, 0x5b, 0x5a, 0xfd, 0x1d, 0xc2, 0xe5, 0x64, 0xc0, 0x92, 0x7c, 0x20, 0x91, 0xc9,
-	0x7a, 0xde, 0x76, 0x00, 0xdf, 0x90, 0xe0, 0x06, 0x59, 0xc9, 0xeb, 0x70, 0x60, 0xef, 0x03, 0x84,
-	0x27, 0x82, 0x10, 0x25, 0x17, 0x54, 0x13, 0x13, 0x69, 0x5d, 0x5d, 0x38, 0xac, 0x0d, 0x80, 0x96,
-	0x24, 0xd0, 0x8b, 0x44, 0x0b, 0x81, 0xee, 0x72, 0x87, 0x8d, 0xc3, 0x05, 0x89, 0xdd, 0xbc, 0xf1,
-	0x78, 0xbf, 0x86, 0x9e, 0xec, 0xd7, 0xd0, 0x9f, 0xfb, 0x35, 0xf4, 0xe5, 0x41, 0xad, 0xf0, 0xe4,
-	0xa0, 0x56, 0xf8, 0xed, 0xa0, 0x56, 0xf8, 0x40, 0x8f, 0xfd, 0xb2, 0x82, 0xbd, 0x2b, 0x09, 0xa1,
-	0xbd, 0x50, 0x4a, 0xfe, 0xca, 0xea, 0x4c, 0xc8, 0x1f, 0xae, 0xeb, 0xff, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0xe5, 0xa2, 0xd1, 0xa9, 0x60, 0x0f, 0x00, 0x00,
+func (m *QueryFeederDelegationRequest) Reset()         { *m = QueryFeederDelegationRequest{} }
+func (m *QueryFeederDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeederDelegationRequest) ProtoMessage()    {}
+func (*QueryFeederDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{24}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ExchangeRate returns exchange rate of a denom.
-	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
-	// ExchangeRates returns exchange rates of all denoms.
-	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
-	// Actives returns all active denoms.
-	Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error)
-	// VoteTargets returns all vote target denoms.
-	VoteTargets(ctx context.Context, in *QueryVoteTargetsRequest, opts ...grpc.CallOption) (*QueryVoteTargetsResponse, error)
-	// Targets returns all target denoms (including vote targets).
-	Targets(ctx context.Context, in *QueryTargetsRequest, opts ...grpc.CallOption) (*QueryTargetsResponse, error)
-	// FeederDelegation returns feeder delegation of a validator.
-	FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
-	// MissCounter returns oracle miss counter of a validator.
-	MissCounter(ctx context.Context, in *QueryMissCounterRequest, opts ...grpc.CallOption) (*QueryMissCounterResponse, error)
-	// AggregatePrevote returns an aggregate prevote of a validator.
-	AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error)
-	// AggregatePrevotes returns aggregate prevotes of all validators.
-	AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotesRequest, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error)
-	// AggregateVote returns an aggregate vote of a validator.
-	AggregateVote(ctx context.Context, in *QueryAggregateVoteRequest, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error)
-	// AggregateVotes returns aggregate votes of all validators.
-	AggregateVotes(ctx context.Context, in *QueryAggregateVotesRequest, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error)
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryFeederDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryFeederDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeederDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryFeederDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeederDelegationRequest.Merge(m, src)
 }
-
-func (c *queryClient) ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error) {
-	out := new(QueryExchangeRateResponse)
-	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/ExchangeRate", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryFeederDelegationRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
-	out := new(QueryExchangeRatesResponse)
-	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/ExchangeRates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryFeederDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeederDelegationRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error) {
-	out := new(QueryActivesResponse)
-	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/Actives", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryFeederDelegationRequest proto.InternalMessageInfo
 
-func (c *queryClient) VoteTargets(ctx context.Context, in *QueryVoteTargetsRequest, opts ...grpc.CallOption) (*QueryVoteTargetsResponse, error) {
-	out := new(QueryVoteTargetsResponse)
-	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/VoteTargets", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryFeederDelegationResponse is response type for the
+// Query/FeederDelegation RPC method.
+type QueryFeederDelegationResponse struct {
+	// feeder_addr defines the feeder delegation of a validator.
+	FeederAddr string `protobuf:"bytes,1,opt,name=feeder_addr,json=feederAddr,proto3" json:"feeder_addr,omitempty"`
 }
 
-func (c *queryClient) Targets(ctx context.Context, in *QueryTargetsRequest, opts ...grpc.CallOption) (*QueryTargetsResponse, error) {
-	out := new(QueryTargetsResponse)
-	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/Targets", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryFeederDelegationResponse) Reset()         { *m = QueryFeederDelegationResponse{} }
+func (m *QueryFeederDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeederDelegationResponse) ProtoMessage()    {}
+func (*QueryFeederDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{25}
 }
-
-func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
-	out := new(QueryFeederDelegationResponse)
-	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/FeederDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryFeederDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) MissCounter(ctx context.Context, in *QueryMissCounterRequest, opts ...grpc.CallOption) (*QueryMissCounterResponse, error) {
-	out := new(QueryMissCounterResponse)
-	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/MissCounter", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFeederDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeederDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error) {
-	out := new(QueryAggregatePrevoteResponse)
-	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/AggregatePrevote", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryFeederDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeederDelegationResponse.Merge(m, src)
 }
-
-func (c *queryClient) AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotesRequest, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error) {
-	out := new(QueryAggregatePrevotesResponse)
-	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/AggregatePrevotes", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryFeederDelegationResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) AggregateVote(ctx context.Context, in *QueryAggregateVoteRequest, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error) {
-	out := new(QueryAggregateVoteResponse)
-	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/AggregateVote", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryFeederDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeederDelegationResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AggregateVotes(ctx context.Context, in *QueryAggregateVotesRequest, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error) {
-	out := new(QueryAggregateVotesResponse)
-	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/AggregateVotes", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryFeederDelegationResponse proto.InternalMessageInfo
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFeederDelegationResponse) GetFeederAddr() string {
+	if m != nil {
+		return m.FeederAddr
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ExchangeRate returns exchange rate of a denom.
-	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
-	// ExchangeRates returns exchange rates of all denoms.
-	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
-	// Actives returns all active denoms.
-	Actives(context.Context, *QueryActivesRequest) (*QueryActivesResponse, error)
-	// VoteTargets returns all vote target denoms.
-	VoteTargets(context.Context, *QueryVoteTargetsRequest) (*QueryVoteTargetsResponse, error)
-	// Targets returns all target denoms (including vote targets).
-	Targets(context.Context, *QueryTargetsRequest) (*QueryTargetsResponse, error)
-	// FeederDelegation returns feeder delegation of a validator.
-	FeederDelegation(context.Context, *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error)
-	// MissCounter returns oracle miss counter of a validator.
-	MissCounter(context.Context, *QueryMissCounterRequest) (*QueryMissCounterResponse, error)
-	// AggregatePrevote returns an aggregate prevote of a validator.
-	AggregatePrevote(context.Context, *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error)
-	// AggregatePrevotes returns aggregate prevotes of all validators.
-	AggregatePrevotes(context.Context, *QueryAggregatePrevotesRequest) (*QueryAggregatePrevotesResponse, error)
-	// AggregateVote returns an aggregate vote of a validator.
-	AggregateVote(context.Context, *QueryAggregateVoteRequest) (*QueryAggregateVoteResponse, error)
-	// AggregateVotes returns aggregate votes of all validators.
-	AggregateVotes(context.Context, *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error)
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+// QueryMissCounterRequest is the request type for the Query/MissCounter RPC
+// method.
+type QueryMissCounterRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryMissCounterRequest) Reset()         { *m = QueryMissCounterRequest{} }
+func (m *QueryMissCounterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMissCounterRequest) ProtoMessage()    {}
+func (*QueryMissCounterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{26}
 }
-
-func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRate not implemented")
+func (m *QueryMissCounterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
+func (m *QueryMissCounterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissCounterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Actives(ctx context.Context, req *QueryActivesRequest) (*QueryActivesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Actives not implemented")
+func (m *QueryMissCounterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissCounterRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) VoteTargets(ctx context.Context, req *QueryVoteTargetsRequest) (*QueryVoteTargetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VoteTargets not implemented")
+func (m *QueryMissCounterRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Targets(ctx context.Context, req *QueryTargetsRequest) (*QueryTargetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Targets not implemented")
+func (m *QueryMissCounterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissCounterRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FeederDelegation not implemented")
+
+var xxx_messageInfo_QueryMissCounterRequest proto.InternalMessageInfo
+
+// QueryMissCounterResponse is response type for the
+// Query/MissCounter RPC method.
+type QueryMissCounterResponse struct {
+	// miss_counter defines the oracle miss counter of a validator.
+	MissCounter uint64 `protobuf:"varint,1,opt,name=miss_counter,json=missCounter,proto3" json:"miss_counter,omitempty"`
 }
-func (*UnimplementedQueryServer) MissCounter(ctx context.Context, req *QueryMissCounterRequest) (*QueryMissCounterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MissCounter not implemented")
+
+func (m *QueryMissCounterResponse) Reset()         { *m = QueryMissCounterResponse{} }
+func (m *QueryMissCounterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMissCounterResponse) ProtoMessage()    {}
+func (*QueryMissCounterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{27}
 }
-func (*UnimplementedQueryServer) AggregatePrevote(ctx context.Context, req *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevote not implemented")
+func (m *QueryMissCounterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AggregatePrevotes(ctx context.Context, req *QueryAggregatePrevotesRequest) (*QueryAggregatePrevotesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevotes not implemented")
+func (m *QueryMissCounterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissCounterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AggregateVote(ctx context.Context, req *QueryAggregateVoteRequest) (*QueryAggregateVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregateVote not implemented")
+func (m *QueryMissCounterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissCounterResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) AggregateVotes(ctx context.Context, req *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregateVotes not implemented")
+func (m *QueryMissCounterResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryMissCounterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissCounterResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryMissCounterResponse proto.InternalMessageInfo
 
-func _Query_ExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryExchangeRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ExchangeRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.oracle.v1.Query/ExchangeRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ExchangeRate(ctx, req.(*QueryExchangeRateRequest))
+func (m *QueryMissCounterResponse) GetMissCounter() uint64 {
+	if m != nil {
+		return m.MissCounter
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryExchangeRatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ExchangeRates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.oracle.v1.Query/ExchangeRates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ExchangeRates(ctx, req.(*QueryExchangeRatesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryValidatorOracleHistoryRequest is the request type for the
+// Query/ValidatorOracleHistory RPC method.
+type QueryValidatorOracleHistoryRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Actives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryActivesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Actives(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.oracle.v1.Query/Actives",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Actives(ctx, req.(*QueryActivesRequest))
+func (m *QueryValidatorOracleHistoryRequest) Reset()         { *m = QueryValidatorOracleHistoryRequest{} }
+func (m *QueryValidatorOracleHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorOracleHistoryRequest) ProtoMessage()    {}
+func (*QueryValidatorOracleHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{28}
+}
+func (m *QueryValidatorOracleHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorOracleHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorOracleHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryValidatorOracleHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorOracleHistoryRequest.Merge(m, src)
+}
+func (m *QueryValidatorOracleHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorOracleHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorOracleHistoryRequest.DiscardUnknown(m)
 }
 
-func _Query_VoteTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVoteTargetsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VoteTargets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.oracle.v1.Query/VoteTargets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VoteTargets(ctx, req.(*QueryVoteTargetsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryValidatorOracleHistoryRequest proto.InternalMessageInfo
+
+// QueryValidatorOracleHistoryResponse is response type for the
+// Query/ValidatorOracleHistory RPC method.
+type QueryValidatorOracleHistoryResponse struct {
+	// current defines the oracle performance of a validator accumulated so far
+	// in the current slash window.
+	Current ValidatorOracleStats `protobuf:"bytes,1,opt,name=current,proto3" json:"current"`
+	// history defines the oracle performance of a validator in past slash
+	// windows, in ascending order of the window end height.
+	History []ValidatorOracleStats `protobuf:"bytes,2,rep,name=history,proto3" json:"history"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Targets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTargetsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Targets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.oracle.v1.Query/Targets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Targets(ctx, req.(*QueryTargetsRequest))
+func (m *QueryValidatorOracleHistoryResponse) Reset()         { *m = QueryValidatorOracleHistoryResponse{} }
+func (m *QueryValidatorOracleHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorOracleHistoryResponse) ProtoMessage()    {}
+func (*QueryValidatorOracleHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{29}
+}
+func (m *QueryValidatorOracleHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorOracleHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorOracleHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryValidatorOracleHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorOracleHistoryResponse.Merge(m, src)
+}
+func (m *QueryValidatorOracleHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorOracleHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorOracleHistoryResponse.DiscardUnknown(m)
 }
 
-func _Query_FeederDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFeederDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FeederDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.oracle.v1.Query/FeederDelegation",
+var xxx_messageInfo_QueryValidatorOracleHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorOracleHistoryResponse) GetCurrent() ValidatorOracleStats {
+	if m != nil {
+		return m.Current
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FeederDelegation(ctx, req.(*QueryFeederDelegationRequest))
+	return ValidatorOracleStats{}
+}
+
+func (m *QueryValidatorOracleHistoryResponse) GetHistory() []ValidatorOracleStats {
+	if m != nil {
+		return m.History
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_MissCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMissCounterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryValidatorOracleHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).MissCounter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.oracle.v1.Query/MissCounter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MissCounter(ctx, req.(*QueryMissCounterRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_AggregatePrevote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregatePrevoteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregatePrevote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.oracle.v1.Query/AggregatePrevote",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregatePrevote(ctx, req.(*QueryAggregatePrevoteRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryAggregatePrevoteRequest is the request type for the
+// Query/AggregatePrevote RPC method.
+type QueryAggregatePrevoteRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func _Query_AggregatePrevotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregatePrevotesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregatePrevotes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.oracle.v1.Query/AggregatePrevotes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregatePrevotes(ctx, req.(*QueryAggregatePrevotesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryAggregatePrevoteRequest) Reset()         { *m = QueryAggregatePrevoteRequest{} }
+func (m *QueryAggregatePrevoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePrevoteRequest) ProtoMessage()    {}
+func (*QueryAggregatePrevoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{30}
 }
-
-func _Query_AggregateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregateVoteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregateVote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.oracle.v1.Query/AggregateVote",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregateVote(ctx, req.(*QueryAggregateVoteRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryAggregatePrevoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_AggregateVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregateVotesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregateVotes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.oracle.v1.Query/AggregateVotes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregateVotes(ctx, req.(*QueryAggregateVotesRequest))
+func (m *QueryAggregatePrevoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatePrevoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.oracle.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryAggregatePrevoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePrevoteRequest.Merge(m, src)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "warmage.oracle.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ExchangeRate",
-			Handler:    _Query_ExchangeRate_Handler,
-		},
-		{
-			MethodName: "ExchangeRates",
-			Handler:    _Query_ExchangeRates_Handler,
-		},
-		{
-			MethodName: "Actives",
-			Handler:    _Query_Actives_Handler,
-		},
-		{
-			MethodName: "VoteTargets",
-			Handler:    _Query_VoteTargets_Handler,
-		},
-		{
-			MethodName: "Targets",
-			Handler:    _Query_Targets_Handler,
-		},
-		{
-			MethodName: "FeederDelegation",
-			Handler:    _Query_FeederDelegation_Handler,
-		},
-		{
-			MethodName: "MissCounter",
-			Handler:    _Query_MissCounter_Handler,
-		},
-		{
-			MethodName: "AggregatePrevote",
-			Handler:    _Query_AggregatePrevote_Handler,
-		},
-		{
-			MethodName: "AggregatePrevotes",
-			Handler:    _Query_AggregatePrevotes_Handler,
-		},
-		{
-			MethodName: "AggregateVote",
-			Handler:    _Query_AggregateVote_Handler,
-		},
-		{
-			MethodName: "AggregateVotes",
-			Handler:    _Query_AggregateVotes_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "warmage/oracle/v1/query.proto",
+func (m *QueryAggregatePrevoteRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryExchangeRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAggregatePrevoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePrevoteRequest.DiscardUnknown(m)
 }
 
-func (m *QueryExchangeRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryAggregatePrevoteRequest proto.InternalMessageInfo
+
+// QueryAggregatePrevoteResponse is response type for the
+// Query/AggregatePrevote RPC method.
+type QueryAggregatePrevoteResponse struct {
+	// aggregate_prevote defines oracle aggregate prevote submitted by a validator
+	// in the current vote period.
+	AggregatePrevote AggregateExchangeRatePrevote `protobuf:"bytes,1,opt,name=aggregate_prevote,json=aggregatePrevote,proto3" json:"aggregate_prevote"`
 }
 
-func (m *QueryExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryAggregatePrevoteResponse) Reset()         { *m = QueryAggregatePrevoteResponse{} }
+func (m *QueryAggregatePrevoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePrevoteResponse) ProtoMessage()    {}
+func (*QueryAggregatePrevoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{31}
+}
+func (m *QueryAggregatePrevoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatePrevoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatePrevoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAggregatePrevoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePrevoteResponse.Merge(m, src)
+}
+func (m *QueryAggregatePrevoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregatePrevoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePrevoteResponse.DiscardUnknown(m)
 }
 
-func (m *QueryExchangeRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAggregatePrevoteResponse proto.InternalMessageInfo
+
+func (m *QueryAggregatePrevoteResponse) GetAggregatePrevote() AggregateExchangeRatePrevote {
+	if m != nil {
+		return m.AggregatePrevote
 	}
-	return dAtA[:n], nil
+	return AggregateExchangeRatePrevote{}
 }
 
-func (m *QueryExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAggregatePrevotesRequest is the request type for the
+// Query/AggregatePrevotes RPC method.
+type QueryAggregatePrevotesRequest struct {
 }
 
-func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ExchangeRate.Size()
-		i -= size
-		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryAggregatePrevotesRequest) Reset()         { *m = QueryAggregatePrevotesRequest{} }
+func (m *QueryAggregatePrevotesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePrevotesRequest) ProtoMessage()    {}
+func (*QueryAggregatePrevotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{32}
+}
+func (m *QueryAggregatePrevotesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatePrevotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatePrevotesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAggregatePrevotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePrevotesRequest.Merge(m, src)
 }
-
-func (m *QueryExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAggregatePrevotesRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAggregatePrevotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePrevotesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryAggregatePrevotesRequest proto.InternalMessageInfo
 
-func (m *QueryExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAggregatePrevotesResponse is response type for the
+// Query/AggregatePrevotes RPC method.
+type QueryAggregatePrevotesResponse struct {
+	// aggregate_prevotes defines all oracle aggregate prevotes submitted in the
+	// current vote period.
+	AggregatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,1,rep,name=aggregate_prevotes,json=aggregatePrevotes,proto3" json:"aggregate_prevotes"`
 }
 
-func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExchangeRates) > 0 {
-		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAggregatePrevotesResponse) Reset()         { *m = QueryAggregatePrevotesResponse{} }
+func (m *QueryAggregatePrevotesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePrevotesResponse) ProtoMessage()    {}
+func (*QueryAggregatePrevotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{33}
+}
+func (m *QueryAggregatePrevotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatePrevotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatePrevotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAggregatePrevotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePrevotesResponse.Merge(m, src)
+}
+func (m *QueryAggregatePrevotesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregatePrevotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePrevotesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryActivesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAggregatePrevotesResponse proto.InternalMessageInfo
+
+func (m *QueryAggregatePrevotesResponse) GetAggregatePrevotes() []AggregateExchangeRatePrevote {
+	if m != nil {
+		return m.AggregatePrevotes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryActivesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAggregateVoteRequest is the request type for the Query/AggregateVote RPC
+// method.
+type QueryAggregateVoteRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func (m *QueryActivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAggregateVoteRequest) Reset()         { *m = QueryAggregateVoteRequest{} }
+func (m *QueryAggregateVoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregateVoteRequest) ProtoMessage()    {}
+func (*QueryAggregateVoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{34}
 }
-
-func (m *QueryActivesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAggregateVoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregateVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregateVoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryAggregateVoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregateVoteRequest.Merge(m, src)
+}
+func (m *QueryAggregateVoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregateVoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregateVoteRequest.DiscardUnknown(m)
 }
 
-func (m *QueryActivesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryAggregateVoteRequest proto.InternalMessageInfo
+
+// QueryAggregateVoteResponse is response type for the
+// Query/AggregateVote RPC method.
+type QueryAggregateVoteResponse struct {
+	// aggregate_vote defines oracle aggregate vote submitted by a validator in
+	// the current vote period.
+	AggregateVote AggregateExchangeRateVote `protobuf:"bytes,1,opt,name=aggregate_vote,json=aggregateVote,proto3" json:"aggregate_vote"`
 }
 
-func (m *QueryActivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Actives) > 0 {
-		for iNdEx := len(m.Actives) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Actives[iNdEx])
-			copy(dAtA[i:], m.Actives[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Actives[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAggregateVoteResponse) Reset()         { *m = QueryAggregateVoteResponse{} }
+func (m *QueryAggregateVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregateVoteResponse) ProtoMessage()    {}
+func (*QueryAggregateVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{35}
+}
+func (m *QueryAggregateVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregateVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregateVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAggregateVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregateVoteResponse.Merge(m, src)
+}
+func (m *QueryAggregateVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregateVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregateVoteResponse.DiscardUnknown(m)
 }
 
-func (m *QueryVoteTargetsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAggregateVoteResponse proto.InternalMessageInfo
+
+func (m *QueryAggregateVoteResponse) GetAggregateVote() AggregateExchangeRateVote {
+	if m != nil {
+		return m.AggregateVote
 	}
-	return dAtA[:n], nil
+	return AggregateExchangeRateVote{}
 }
 
-func (m *QueryVoteTargetsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAggregateVotesRequest is the request type for the Query/AggregateVotes
+// RPC method.
+type QueryAggregateVotesRequest struct {
 }
 
-func (m *QueryVoteTargetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryVoteTargetsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAggregateVotesRequest) Reset()         { *m = QueryAggregateVotesRequest{} }
+func (m *QueryAggregateVotesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregateVotesRequest) ProtoMessage()    {}
+func (*QueryAggregateVotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{36}
 }
-
-func (m *QueryVoteTargetsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAggregateVotesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryVoteTargetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VoteTargets) > 0 {
-		for iNdEx := len(m.VoteTargets) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.VoteTargets[iNdEx])
-			copy(dAtA[i:], m.VoteTargets[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.VoteTargets[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAggregateVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregateVotesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryTargetsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAggregateVotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregateVotesRequest.Merge(m, src)
 }
-
-func (m *QueryTargetsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAggregateVotesRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryTargetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAggregateVotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregateVotesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTargetsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryAggregateVotesRequest proto.InternalMessageInfo
 
-func (m *QueryTargetsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAggregateVotesResponse is response type for the
+// Query/AggregateVotes RPC method.
+type QueryAggregateVotesResponse struct {
+	// aggregate_votes defines all oracle aggregate votes submitted in the current
+	// vote period.
+	AggregateVotes []AggregateExchangeRateVote `protobuf:"bytes,1,rep,name=aggregate_votes,json=aggregateVotes,proto3" json:"aggregate_votes"`
 }
 
-func (m *QueryTargetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Targets) > 0 {
-		for iNdEx := len(m.Targets) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Targets[iNdEx])
-			copy(dAtA[i:], m.Targets[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Targets[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAggregateVotesResponse) Reset()         { *m = QueryAggregateVotesResponse{} }
+func (m *QueryAggregateVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregateVotesResponse) ProtoMessage()    {}
+func (*QueryAggregateVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{37}
+}
+func (m *QueryAggregateVotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregateVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregateVotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryFeederDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAggregateVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregateVotesResponse.Merge(m, src)
 }
-
-func (m *QueryFeederDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAggregateVotesResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryFeederDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryAggregateVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregateVotesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFeederDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAggregateVotesResponse proto.InternalMessageInfo
+
+func (m *QueryAggregateVotesResponse) GetAggregateVotes() []AggregateExchangeRateVote {
+	if m != nil {
+		return m.AggregateVotes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFeederDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
 }
 
-func (m *QueryFeederDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeederAddr) > 0 {
-		i -= len(m.FeederAddr)
-		copy(dAtA[i:], m.FeederAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeederAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{38}
 }
-
-func (m *QueryMissCounterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryMissCounterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
 }
-
-func (m *QueryMissCounterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryMissCounterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMissCounterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *QueryMissCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MissCounter != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MissCounter))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cad837bc35ea0c5d, []int{39}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAggregatePrevoteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *QueryAggregatePrevoteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryExchangeRateRequest)(nil), "warmage.oracle.v1.QueryExchangeRateRequest")
+	proto.RegisterType((*QueryExchangeRateResponse)(nil), "warmage.oracle.v1.QueryExchangeRateResponse")
+	proto.RegisterType((*QueryExchangeRatesRequest)(nil), "warmage.oracle.v1.QueryExchangeRatesRequest")
+	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "warmage.oracle.v1.QueryExchangeRatesResponse")
+	proto.RegisterType((*QueryActivesRequest)(nil), "warmage.oracle.v1.QueryActivesRequest")
+	proto.RegisterType((*QueryActivesResponse)(nil), "warmage.oracle.v1.QueryActivesResponse")
+	proto.RegisterType((*QueryVoteTargetsRequest)(nil), "warmage.oracle.v1.QueryVoteTargetsRequest")
+	proto.RegisterType((*QueryVoteTargetsResponse)(nil), "warmage.oracle.v1.QueryVoteTargetsResponse")
+	proto.RegisterType((*QueryTargetsRequest)(nil), "warmage.oracle.v1.QueryTargetsRequest")
+	proto.RegisterType((*QueryTargetsResponse)(nil), "warmage.oracle.v1.QueryTargetsResponse")
+	proto.RegisterType((*QueryTargetParamsRequest)(nil), "warmage.oracle.v1.QueryTargetParamsRequest")
+	proto.RegisterType((*QueryTargetParamsResponse)(nil), "warmage.oracle.v1.QueryTargetParamsResponse")
+	proto.RegisterType((*QueryRewardPoolRequest)(nil), "warmage.oracle.v1.QueryRewardPoolRequest")
+	proto.RegisterType((*QueryRewardPoolResponse)(nil), "warmage.oracle.v1.QueryRewardPoolResponse")
+	proto.RegisterType((*QueryPeriodRewardsRequest)(nil), "warmage.oracle.v1.QueryPeriodRewardsRequest")
+	proto.RegisterType((*QueryPeriodRewardsResponse)(nil), "warmage.oracle.v1.QueryPeriodRewardsResponse")
+	proto.RegisterType((*QueryExchangeRateStatusRequest)(nil), "warmage.oracle.v1.QueryExchangeRateStatusRequest")
+	proto.RegisterType((*QueryExchangeRateStatusResponse)(nil), "warmage.oracle.v1.QueryExchangeRateStatusResponse")
+	proto.RegisterType((*QueryPriceFeedRequest)(nil), "warmage.oracle.v1.QueryPriceFeedRequest")
+	proto.RegisterType((*QueryPriceFeedResponse)(nil), "warmage.oracle.v1.QueryPriceFeedResponse")
+	proto.RegisterType((*QueryTWAPRequest)(nil), "warmage.oracle.v1.QueryTWAPRequest")
+	proto.RegisterType((*QueryTWAPResponse)(nil), "warmage.oracle.v1.QueryTWAPResponse")
+	proto.RegisterType((*QueryHistoricalRateRequest)(nil), "warmage.oracle.v1.QueryHistoricalRateRequest")
+	proto.RegisterType((*QueryHistoricalRateResponse)(nil), "warmage.oracle.v1.QueryHistoricalRateResponse")
+	proto.RegisterType((*QueryFeederDelegationRequest)(nil), "warmage.oracle.v1.QueryFeederDelegationRequest")
+	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "warmage.oracle.v1.QueryFeederDelegationResponse")
+	proto.RegisterType((*QueryMissCounterRequest)(nil), "warmage.oracle.v1.QueryMissCounterRequest")
+	proto.RegisterType((*QueryMissCounterResponse)(nil), "warmage.oracle.v1.QueryMissCounterResponse")
+	proto.RegisterType((*QueryValidatorOracleHistoryRequest)(nil), "warmage.oracle.v1.QueryValidatorOracleHistoryRequest")
+	proto.RegisterType((*QueryValidatorOracleHistoryResponse)(nil), "warmage.oracle.v1.QueryValidatorOracleHistoryResponse")
+	proto.RegisterType((*QueryAggregatePrevoteRequest)(nil), "warmage.oracle.v1.QueryAggregatePrevoteRequest")
+	proto.RegisterType((*QueryAggregatePrevoteResponse)(nil), "warmage.oracle.v1.QueryAggregatePrevoteResponse")
+	proto.RegisterType((*QueryAggregatePrevotesRequest)(nil), "warmage.oracle.v1.QueryAggregatePrevotesRequest")
+	proto.RegisterType((*QueryAggregatePrevotesResponse)(nil), "warmage.oracle.v1.QueryAggregatePrevotesResponse")
+	proto.RegisterType((*QueryAggregateVoteRequest)(nil), "warmage.oracle.v1.QueryAggregateVoteRequest")
+	proto.RegisterType((*QueryAggregateVoteResponse)(nil), "warmage.oracle.v1.QueryAggregateVoteResponse")
+	proto.RegisterType((*QueryAggregateVotesRequest)(nil), "warmage.oracle.v1.QueryAggregateVotesRequest")
+	proto.RegisterType((*QueryAggregateVotesResponse)(nil), "warmage.oracle.v1.QueryAggregateVotesResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "warmage.oracle.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "warmage.oracle.v1.QueryParamsResponse")
 }
 
-func (m *QueryAggregatePrevoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("warmage/oracle/v1/query.proto", fileDescriptor_cad837bc35ea0c5d) }
+
+var fileDescriptor_cad837bc35ea0c5d = []byte{
+	// 1802 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xdf, 0x6f, 0xdc, 0x4a,
+	0x15, 0xc7, 0xe3, 0x34, 0x4d, 0xd2, 0x93, 0x6c, 0x48, 0xa6, 0x69, 0xba, 0x71, 0xd3, 0xdd, 0xd6,
+	0x6d, 0x93, 0x34, 0x3f, 0xec, 0x24, 0x25, 0x4d, 0xd5, 0x2a, 0xd0, 0xa4, 0x3f, 0xa9, 0x80, 0x86,
+	0x6d, 0xd5, 0x0a, 0x10, 0x5a, 0x79, 0x77, 0xa7, 0x5e, 0x8b, 0xcd, 0x7a, 0xeb, 0xf1, 0x26, 0xfd,
+	0xa1, 0x4a, 0x40, 0x05, 0x42, 0xe2, 0x05, 0x09, 0xa9, 0x42, 0x48, 0xa0, 0x0a, 0x24, 0x1e, 0x78,
+	0x04, 0xde, 0x78, 0xe0, 0x91, 0x3e, 0x56, 0xe2, 0x05, 0x21, 0xd4, 0xa2, 0xf6, 0x3e, 0xdc, 0xfb,
+	0x5f, 0x5c, 0x79, 0xe6, 0xd8, 0x6b, 0xaf, 0xed, 0x5d, 0x6f, 0xee, 0xbd, 0x4f, 0xc9, 0xfa, 0x9c,
+	0xf3, 0x9d, 0xcf, 0x9c, 0x39, 0x9e, 0x99, 0x63, 0x38, 0xb9, 0xaf, 0xdb, 0xbb, 0xba, 0x41, 0x35,
+	0xcb, 0xd6, 0xcb, 0x35, 0xaa, 0xed, 0xad, 0x6a, 0x8f, 0x9b, 0xd4, 0x7e, 0xaa, 0x36, 0x6c, 0xcb,
+	0xb1, 0xc8, 0x04, 0x9a, 0x55, 0x61, 0x56, 0xf7, 0x56, 0xe5, 0x49, 0xc3, 0x32, 0x2c, 0x6e, 0xd5,
+	0xdc, 0xff, 0x84, 0xa3, 0x3c, 0x63, 0x58, 0x96, 0x51, 0xa3, 0x9a, 0xde, 0x30, 0x35, 0xbd, 0x5e,
+	0xb7, 0x1c, 0xdd, 0x31, 0xad, 0x3a, 0x43, 0x6b, 0x0e, 0xad, 0xfc, 0x57, 0xa9, 0xf9, 0x48, 0xab,
+	0x34, 0x6d, 0xee, 0xe0, 0xd9, 0xcb, 0x16, 0xdb, 0xb5, 0x98, 0x56, 0xd2, 0x99, 0x8b, 0x50, 0xa2,
+	0x8e, 0xbe, 0xaa, 0x95, 0x2d, 0xd3, 0xb3, 0x2f, 0x04, 0xed, 0x9c, 0xcf, 0xf7, 0x6a, 0xe8, 0x86,
+	0x59, 0x0f, 0x69, 0x45, 0x67, 0x84, 0xf0, 0xdc, 0xae, 0x5c, 0x86, 0xec, 0xf7, 0x5c, 0x85, 0x1b,
+	0x4f, 0xca, 0x55, 0xbd, 0x6e, 0xd0, 0x82, 0xee, 0xd0, 0x02, 0x7d, 0xdc, 0xa4, 0xcc, 0x21, 0x93,
+	0x70, 0xb8, 0x42, 0xeb, 0xd6, 0x6e, 0x56, 0x3a, 0x25, 0xcd, 0x1f, 0x29, 0x88, 0x1f, 0x97, 0x87,
+	0x7f, 0xf9, 0x3a, 0xdf, 0xf7, 0xe9, 0xeb, 0x7c, 0x9f, 0xd2, 0x80, 0xe9, 0x98, 0x58, 0xd6, 0xb0,
+	0xea, 0x8c, 0x92, 0x7b, 0x90, 0xa1, 0xf8, 0xbc, 0x68, 0xeb, 0x0e, 0x15, 0x22, 0xdb, 0xea, 0x9b,
+	0x77, 0xf9, 0xbe, 0xff, 0xbe, 0xcb, 0xcf, 0x1a, 0xa6, 0x53, 0x6d, 0x96, 0xd4, 0xb2, 0xb5, 0xab,
+	0xe1, 0x74, 0xc4, 0x9f, 0x65, 0x56, 0xf9, 0xb1, 0xe6, 0x3c, 0x6d, 0x50, 0xa6, 0x5e, 0xa7, 0xe5,
+	0xc2, 0x28, 0x0d, 0x88, 0x2b, 0x27, 0x62, 0x46, 0x64, 0x88, 0xab, 0xbc, 0x92, 0x40, 0x8e, 0xb3,
+	0x22, 0xd0, 0x13, 0x18, 0x0b, 0x01, 0xb1, 0xac, 0x74, 0xea, 0xd0, 0xfc, 0xc8, 0xda, 0x8c, 0x2a,
+	0x06, 0x56, 0xdd, 0x74, 0xaa, 0x98, 0x48, 0x77, 0xec, 0x6b, 0x96, 0x59, 0xdf, 0xbe, 0xe0, 0xf2,
+	0xfe, 0xe5, 0x7d, 0x7e, 0x31, 0x1d, 0xaf, 0x1b, 0xc3, 0x0a, 0x99, 0x20, 0x34, 0x53, 0x8e, 0xc1,
+	0x51, 0xce, 0xb5, 0x55, 0x76, 0xcc, 0xbd, 0x16, 0xef, 0x0a, 0x4c, 0x86, 0x1f, 0x23, 0x68, 0x16,
+	0x86, 0x74, 0xf1, 0x88, 0x13, 0x1e, 0x29, 0x78, 0x3f, 0x95, 0x69, 0x38, 0xce, 0x23, 0x1e, 0x58,
+	0x0e, 0xbd, 0xaf, 0xdb, 0x06, 0x75, 0x7c, 0xb1, 0x4d, 0xc8, 0x46, 0x4d, 0x28, 0x78, 0x1a, 0x46,
+	0xf7, 0x2c, 0x87, 0x16, 0x1d, 0xf1, 0x1c, 0x55, 0x47, 0xf6, 0x5a, 0xae, 0x3e, 0x62, 0x9b, 0xaa,
+	0x87, 0xd8, 0xae, 0x98, 0x85, 0xa1, 0xb0, 0x98, 0xf7, 0x53, 0x91, 0x21, 0x1b, 0x88, 0xd8, 0xd1,
+	0x6d, 0x7d, 0xd7, 0x57, 0x33, 0x60, 0x3a, 0xc6, 0x86, 0x92, 0x77, 0x20, 0x23, 0x34, 0x8a, 0x0d,
+	0x6e, 0xc0, 0xd5, 0xc9, 0xab, 0x91, 0x77, 0x4e, 0x0d, 0xc6, 0x6f, 0x0f, 0xb8, 0x0b, 0x54, 0x18,
+	0x75, 0x02, 0xcf, 0x94, 0x2c, 0x4c, 0xf1, 0x81, 0x0a, 0x74, 0x5f, 0xb7, 0x2b, 0x3b, 0x96, 0x55,
+	0xf3, 0x10, 0x3e, 0x93, 0xe0, 0x78, 0xc4, 0x84, 0x04, 0x06, 0x0c, 0x97, 0xf4, 0x9a, 0x5e, 0x2f,
+	0xfb, 0xa5, 0x31, 0x1d, 0x5b, 0x1a, 0xbc, 0x2e, 0x56, 0xb0, 0x2e, 0xe6, 0x53, 0xd4, 0x85, 0x28,
+	0x0a, 0x5f, 0x9c, 0x50, 0x18, 0x32, 0xeb, 0x8f, 0x6a, 0xd6, 0x3e, 0xcb, 0xf6, 0x7f, 0xf9, 0xe3,
+	0x78, 0xda, 0xfe, 0xcb, 0xb2, 0x43, 0x6d, 0xd3, 0xaa, 0x88, 0x09, 0x47, 0x5f, 0x96, 0x36, 0x6b,
+	0xeb, 0x65, 0x69, 0x70, 0x43, 0xd1, 0x16, 0x96, 0xaf, 0xf0, 0x65, 0x69, 0x04, 0x09, 0x94, 0xab,
+	0x90, 0x8b, 0xbc, 0xc4, 0xf7, 0x1c, 0xdd, 0x69, 0xb2, 0xb4, 0xdb, 0xd2, 0x4f, 0x24, 0xc8, 0x27,
+	0x4a, 0xe0, 0xfc, 0x7e, 0x04, 0x93, 0xa1, 0xcd, 0xa0, 0xc8, 0xb8, 0x9d, 0x4b, 0x8e, 0xac, 0x9d,
+	0x8b, 0x29, 0xba, 0xa8, 0x18, 0x96, 0x1e, 0xa1, 0x11, 0x8b, 0xb2, 0x01, 0xc7, 0x44, 0x72, 0x6d,
+	0xb3, 0x4c, 0x6f, 0x52, 0x5a, 0x49, 0xcb, 0xbe, 0x06, 0x53, 0xed, 0x81, 0x81, 0x5d, 0xa1, 0x52,
+	0xb1, 0x29, 0x63, 0x18, 0xeb, 0xfd, 0x54, 0x2c, 0x18, 0x17, 0xaf, 0xd5, 0xc3, 0xad, 0x9d, 0x8e,
+	0xe3, 0x90, 0x2b, 0x30, 0xb8, 0x6f, 0xd6, 0x2b, 0xd6, 0x7e, 0xb6, 0x9f, 0xcf, 0x73, 0x5a, 0x15,
+	0x27, 0x91, 0xea, 0x9d, 0x44, 0xea, 0x75, 0x3c, 0x89, 0xb6, 0x87, 0xdd, 0xb9, 0xfd, 0xf6, 0x7d,
+	0x5e, 0x2a, 0x60, 0x48, 0x00, 0xf2, 0x21, 0x4c, 0x04, 0x06, 0x44, 0xbe, 0x6d, 0x18, 0x70, 0xf6,
+	0xf5, 0xc6, 0x01, 0xb7, 0x79, 0x1e, 0xab, 0xdc, 0xc7, 0x9a, 0xbc, 0x6d, 0x32, 0xc7, 0xb2, 0xcd,
+	0xb2, 0x5e, 0xeb, 0x7a, 0x1c, 0x91, 0x29, 0x18, 0xac, 0x52, 0xd3, 0xa8, 0x3a, 0x7c, 0x4e, 0x87,
+	0x0a, 0xf8, 0x2b, 0x80, 0x5b, 0x85, 0x13, 0xb1, 0xaa, 0x08, 0xfe, 0x2d, 0x18, 0x66, 0x75, 0xbd,
+	0xc1, 0xaa, 0x96, 0x83, 0xcb, 0x3f, 0xd7, 0x6d, 0xf9, 0xd1, 0x1d, 0x0b, 0xc0, 0x0f, 0x57, 0xee,
+	0xc2, 0x0c, 0x1f, 0xc9, 0x5d, 0x38, 0x6a, 0x5f, 0xa7, 0x35, 0x6a, 0xf0, 0x6c, 0x7a, 0x33, 0x38,
+	0x07, 0x63, 0x7b, 0x7a, 0xcd, 0xac, 0xe8, 0x8e, 0x65, 0x17, 0xdd, 0xe5, 0xc3, 0xa9, 0x64, 0xfc,
+	0xa7, 0x5b, 0x95, 0x8a, 0x1d, 0x40, 0xbf, 0x0a, 0x27, 0x13, 0x04, 0x11, 0x3e, 0x0f, 0x23, 0x8f,
+	0xb8, 0x2d, 0x28, 0x07, 0xe2, 0x91, 0xab, 0xa5, 0xdc, 0xc1, 0xfd, 0xee, 0x3b, 0x26, 0x63, 0xd7,
+	0xac, 0x66, 0xdd, 0xa1, 0xf6, 0x81, 0x69, 0xbc, 0x33, 0x26, 0xa4, 0xd5, 0x3a, 0x63, 0x76, 0x4d,
+	0xc6, 0x8a, 0x65, 0xf1, 0x9c, 0x4b, 0x0d, 0x14, 0x46, 0x76, 0x5b, 0xae, 0xca, 0xef, 0x25, 0x50,
+	0xc4, 0x19, 0xe5, 0xe9, 0xdf, 0xe5, 0xe9, 0x15, 0xeb, 0xf2, 0xb4, 0x37, 0x2c, 0x72, 0x13, 0xa0,
+	0x75, 0xd9, 0xc1, 0x7a, 0x9e, 0x0d, 0xed, 0x4e, 0xe2, 0xe6, 0xe6, 0xed, 0x51, 0x3b, 0xba, 0xe1,
+	0x55, 0x52, 0x21, 0x10, 0x19, 0xdc, 0x37, 0xfa, 0xe1, 0x4c, 0x47, 0x3e, 0x9c, 0xea, 0x2d, 0x18,
+	0x2a, 0x37, 0x6d, 0x9b, 0xd6, 0x3b, 0xd5, 0x4b, 0x9b, 0x86, 0xbb, 0x2f, 0x78, 0x1b, 0x86, 0x17,
+	0xed, 0x0a, 0x55, 0x85, 0x36, 0x9e, 0x03, 0xbd, 0x0a, 0x61, 0x34, 0xb9, 0x15, 0xca, 0xc5, 0x21,
+	0x84, 0xea, 0x96, 0x0b, 0x31, 0x9d, 0x60, 0x32, 0xfc, 0x02, 0xde, 0x32, 0x0c, 0xdb, 0x2d, 0x35,
+	0xba, 0x63, 0xd3, 0x3d, 0xcb, 0xa1, 0xbd, 0xad, 0x4d, 0x20, 0xa7, 0x2f, 0x25, 0x38, 0x99, 0xa0,
+	0x88, 0xd9, 0x2c, 0xc1, 0x84, 0xee, 0xd9, 0x8a, 0x0d, 0x61, 0xc4, 0xbc, 0x6a, 0x31, 0xe9, 0xf0,
+	0x75, 0x82, 0x2f, 0x24, 0x6a, 0x62, 0x5a, 0xc6, 0xf5, 0xb6, 0xb1, 0x94, 0x7c, 0x02, 0x84, 0x7f,
+	0x1a, 0xfe, 0x42, 0x82, 0x5c, 0x92, 0x07, 0x72, 0x56, 0x80, 0x44, 0x38, 0xbd, 0x53, 0xf1, 0x80,
+	0xa0, 0x13, 0xed, 0xa0, 0x4c, 0xf9, 0x36, 0x9e, 0xd9, 0x7e, 0xf4, 0x83, 0x2f, 0x92, 0xfd, 0x7d,
+	0x90, 0xe3, 0xd4, 0x70, 0x46, 0xdf, 0x87, 0xb1, 0xd6, 0x8c, 0x02, 0x69, 0x5f, 0x4a, 0x3b, 0x9b,
+	0x07, 0xad, 0xa9, 0x64, 0xf4, 0xe0, 0x10, 0xca, 0x4c, 0xdc, 0xc0, 0x7e, 0xb6, 0x9f, 0xc1, 0x89,
+	0x58, 0x2b, 0x72, 0xfd, 0x10, 0xbe, 0x16, 0xe6, 0xf2, 0xd2, 0x7c, 0x10, 0xb0, 0xb1, 0x10, 0x18,
+	0x53, 0x26, 0x81, 0x88, 0x03, 0x36, 0x74, 0x33, 0xfd, 0x2e, 0x1c, 0x0d, 0x3d, 0x45, 0x92, 0x0d,
+	0x18, 0xf4, 0x2f, 0xa3, 0xe2, 0xbc, 0x8c, 0x02, 0x84, 0xae, 0xa1, 0xe8, 0xbe, 0xf6, 0xbf, 0x69,
+	0x38, 0xcc, 0x05, 0xc9, 0x1f, 0x25, 0x18, 0x0d, 0xa2, 0x91, 0xc5, 0x18, 0x8d, 0xa4, 0x0e, 0x4c,
+	0x5e, 0x4a, 0xe7, 0x2c, 0x70, 0x95, 0x8d, 0x9f, 0xfd, 0xfb, 0x93, 0xdf, 0xf4, 0xaf, 0x12, 0x4d,
+	0x8b, 0x36, 0x7d, 0xfc, 0xb0, 0x64, 0xda, 0x73, 0xfe, 0xf7, 0x85, 0x16, 0xba, 0xfc, 0x90, 0x3f,
+	0x48, 0x90, 0x09, 0x2a, 0x32, 0x92, 0x6a, 0x60, 0x2f, 0x7d, 0xf2, 0x72, 0x4a, 0x6f, 0xe4, 0x5c,
+	0xe1, 0x9c, 0x0b, 0x64, 0x3e, 0x99, 0x33, 0xc4, 0xc7, 0xc8, 0xcf, 0x25, 0x18, 0xc2, 0x36, 0x89,
+	0xcc, 0x26, 0x0d, 0x16, 0x6e, 0xaf, 0xe4, 0xb9, 0xae, 0x7e, 0x88, 0x73, 0x9e, 0xe3, 0x9c, 0x21,
+	0xa7, 0x93, 0x71, 0xb0, 0x01, 0x23, 0xaf, 0x24, 0x18, 0x09, 0x74, 0x58, 0x64, 0x21, 0x69, 0x8c,
+	0x68, 0x87, 0x26, 0x2f, 0xa6, 0xf2, 0x45, 0x26, 0x95, 0x33, 0xcd, 0x93, 0xd9, 0x64, 0xa6, 0x60,
+	0x4b, 0xc7, 0x13, 0xe4, 0x41, 0x25, 0x26, 0xa8, 0x0d, 0x68, 0xae, 0xab, 0x5f, 0xfa, 0x04, 0x79,
+	0x1c, 0xbf, 0x93, 0x60, 0x34, 0xd8, 0x9e, 0x25, 0x97, 0x7b, 0x4c, 0x83, 0x28, 0x2f, 0xa5, 0x73,
+	0x46, 0x2c, 0x8d, 0x63, 0x9d, 0x27, 0x73, 0xdd, 0xb0, 0xb0, 0xa3, 0x24, 0xff, 0x90, 0x80, 0x44,
+	0xaf, 0xf1, 0x64, 0x35, 0x4d, 0xf5, 0x86, 0x5a, 0x10, 0x79, 0xad, 0x97, 0x10, 0xc4, 0xfd, 0x06,
+	0xc7, 0xbd, 0x44, 0x2e, 0xf6, 0xf8, 0x76, 0x62, 0x6b, 0xe2, 0xd6, 0xde, 0x11, 0xbf, 0x2d, 0x20,
+	0xf3, 0x49, 0x04, 0xed, 0x2d, 0x87, 0x7c, 0x3e, 0x85, 0x27, 0x22, 0x7e, 0x9d, 0x23, 0xaa, 0x64,
+	0xa9, 0x3b, 0x62, 0xc3, 0x0d, 0x2e, 0xba, 0x17, 0x4d, 0xf2, 0x53, 0x09, 0x06, 0xdc, 0x56, 0x80,
+	0x9c, 0x49, 0x5c, 0xbe, 0x56, 0x67, 0x22, 0x9f, 0xed, 0xec, 0x94, 0xbe, 0xfe, 0x3d, 0x12, 0xb7,
+	0x73, 0x20, 0x7f, 0x97, 0x60, 0x2c, 0x7c, 0xbf, 0x27, 0x89, 0x9b, 0x52, 0x6c, 0x77, 0x21, 0xab,
+	0x69, 0xdd, 0x91, 0xf0, 0x1a, 0x27, 0xdc, 0x24, 0x57, 0xba, 0x13, 0x56, 0x7d, 0x05, 0xb1, 0x9d,
+	0x69, 0xcf, 0x45, 0x8f, 0xf2, 0x82, 0xfc, 0x4a, 0x02, 0x68, 0x7d, 0x89, 0x20, 0x89, 0x4b, 0x15,
+	0xf9, 0x90, 0x21, 0x2f, 0xa4, 0x71, 0x45, 0xd4, 0x59, 0x8e, 0x7a, 0x8a, 0xe4, 0x62, 0x50, 0x45,
+	0x7b, 0x5f, 0x6c, 0xb8, 0xc3, 0xff, 0x49, 0x82, 0x4c, 0xe8, 0x73, 0x40, 0xf2, 0x31, 0x10, 0xf7,
+	0x4d, 0x41, 0x5e, 0x4e, 0xe9, 0x8d, 0x58, 0xeb, 0x1c, 0x4b, 0x23, 0xcb, 0x9d, 0xb1, 0xb4, 0xf0,
+	0x87, 0x08, 0x77, 0xa9, 0xc7, 0xdb, 0xfb, 0x21, 0xa2, 0x25, 0x0d, 0x9d, 0xd0, 0x8a, 0xc9, 0x2b,
+	0xe9, 0x03, 0x10, 0x77, 0x93, 0xe3, 0x6e, 0x90, 0xf5, 0x18, 0x5c, 0xff, 0x02, 0xc6, 0xb4, 0xe7,
+	0xe1, 0x2b, 0xda, 0x0b, 0x4d, 0x34, 0x63, 0x6e, 0x72, 0x47, 0x02, 0x8d, 0x53, 0xf2, 0xd1, 0x11,
+	0xed, 0xd4, 0xe4, 0xc5, 0x54, 0xbe, 0xc8, 0x79, 0x85, 0x73, 0xae, 0x93, 0x0b, 0x3d, 0x72, 0xba,
+	0xad, 0x1a, 0xf9, 0x97, 0x04, 0x53, 0xf1, 0xed, 0x0f, 0x59, 0x4f, 0x3c, 0xbf, 0x3a, 0xb5, 0x73,
+	0xf2, 0xc5, 0x5e, 0xc3, 0x52, 0x6c, 0x97, 0x9d, 0xa6, 0xe1, 0xf5, 0x44, 0xff, 0x94, 0x60, 0xbc,
+	0xfd, 0x36, 0x9f, 0x5c, 0x26, 0x09, 0x0d, 0x8f, 0xbc, 0x92, 0x3e, 0x00, 0xb9, 0x6f, 0x73, 0xee,
+	0x6d, 0x72, 0xb5, 0x47, 0xee, 0x48, 0x73, 0x41, 0xfe, 0x2a, 0xc1, 0x44, 0xfb, 0x30, 0x8c, 0xa4,
+	0x26, 0xf2, 0x5f, 0xcb, 0xd5, 0x1e, 0x22, 0x70, 0x12, 0x97, 0xf8, 0x24, 0xd6, 0xc8, 0x4a, 0xe7,
+	0x49, 0x44, 0x98, 0x19, 0xf9, 0x9b, 0x04, 0x99, 0xd0, 0xbd, 0x3e, 0x79, 0x0f, 0x89, 0xeb, 0x71,
+	0xe4, 0xe5, 0x94, 0xde, 0x08, 0x7a, 0x83, 0x83, 0x7e, 0x93, 0x6c, 0xc6, 0x83, 0x56, 0xcc, 0xae,
+	0xd9, 0xe6, 0xa9, 0xfe, 0xb3, 0x04, 0x63, 0xa1, 0x01, 0x18, 0x49, 0x07, 0xc2, 0xba, 0x1e, 0x1f,
+	0xf1, 0x4d, 0x4e, 0xc7, 0xcd, 0x2f, 0x36, 0xc3, 0x22, 0xbd, 0x2f, 0x25, 0x18, 0xc4, 0x9b, 0xd5,
+	0xb9, 0xc4, 0xdd, 0x36, 0x74, 0xa7, 0x9a, 0xed, 0xe6, 0x86, 0x40, 0x0b, 0x1c, 0xe8, 0x2c, 0x51,
+	0x3c, 0xa0, 0x67, 0x56, 0x9d, 0xb6, 0xc3, 0x89, 0x8b, 0xd4, 0xf6, 0xcd, 0x37, 0x1f, 0x72, 0xd2,
+	0xdb, 0x0f, 0x39, 0xe9, 0xff, 0x1f, 0x72, 0xd2, 0xaf, 0x3f, 0xe6, 0xfa, 0xde, 0x7e, 0xcc, 0xf5,
+	0xfd, 0xe7, 0x63, 0xae, 0xef, 0x07, 0x4b, 0x81, 0xef, 0x7d, 0x0d, 0xea, 0xd8, 0xe6, 0x72, 0x4d,
+	0x2f, 0x31, 0x5f, 0xe6, 0x89, 0x27, 0xc4, 0xbf, 0xfc, 0x95, 0x06, 0xf9, 0x77, 0xc7, 0x0b, 0x9f,
+	0x0f, 0x00, 0x08, 0xe3, 0x6a, 0x08, 0x77, 0x1b, 0x00, 0x00,
 }
 
-func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ExchangeRate returns exchange rate of a denom.
+	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
+	// ExchangeRates returns exchange rates of all denoms.
+	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
+	// Actives returns all active denoms.
+	Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error)
+	// VoteTargets returns all vote target denoms.
+	VoteTargets(ctx context.Context, in *QueryVoteTargetsRequest, opts ...grpc.CallOption) (*QueryVoteTargetsResponse, error)
+	// Targets returns all target denoms (including vote targets).
+	Targets(ctx context.Context, in *QueryTargetsRequest, opts ...grpc.CallOption) (*QueryTargetsResponse, error)
+	// TargetParams returns the quotation source params of all targets.
+	TargetParams(ctx context.Context, in *QueryTargetParamsRequest, opts ...grpc.CallOption) (*QueryTargetParamsResponse, error)
+	// ExchangeRateStatus returns the last accepted exchange rate of a denom,
+	// and whether the circuit breaker is holding it.
+	ExchangeRateStatus(ctx context.Context, in *QueryExchangeRateStatusRequest, opts ...grpc.CallOption) (*QueryExchangeRateStatusResponse, error)
+	// PriceFeed returns the address of the Chainlink AggregatorV3 compatible
+	// price feed contract of a denom, through which EVM contracts read its
+	// exchange rate.
+	PriceFeed(ctx context.Context, in *QueryPriceFeedRequest, opts ...grpc.CallOption) (*QueryPriceFeedResponse, error)
+	// TWAP returns the time-weighted average exchange rate of a denom over a
+	// window up to the current block.
+	TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error)
+	// HistoricalRate returns the exchange rate of a denom in effect at a height.
+	HistoricalRate(ctx context.Context, in *QueryHistoricalRateRequest, opts ...grpc.CallOption) (*QueryHistoricalRateResponse, error)
+	// RewardPool returns the balances of the oracle reward pool, along with the
+	// accumulated fee inflows per denom.
+	RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error)
+	// PeriodRewards returns the projected rewards given out to ballot winners
+	// at the end of the current vote period.
+	PeriodRewards(ctx context.Context, in *QueryPeriodRewardsRequest, opts ...grpc.CallOption) (*QueryPeriodRewardsResponse, error)
+	// FeederDelegation returns feeder delegation of a validator.
+	FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
+	// MissCounter returns oracle miss counter of a validator.
+	MissCounter(ctx context.Context, in *QueryMissCounterRequest, opts ...grpc.CallOption) (*QueryMissCounterResponse, error)
+	// ValidatorOracleHistory returns the oracle performance of a validator in
+	// the current and past slash windows.
+	ValidatorOracleHistory(ctx context.Context, in *QueryValidatorOracleHistoryRequest, opts ...grpc.CallOption) (*QueryValidatorOracleHistoryResponse, error)
+	// AggregatePrevote returns an aggregate prevote of a validator.
+	AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error)
+	// AggregatePrevotes returns aggregate prevotes of all validators.
+	AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotesRequest, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error)
+	// AggregateVote returns an aggregate vote of a validator.
+	AggregateVote(ctx context.Context, in *QueryAggregateVoteRequest, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error)
+	// AggregateVotes returns aggregate votes of all validators.
+	AggregateVotes(ctx context.Context, in *QueryAggregateVotesRequest, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error)
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
-func (m *QueryAggregatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AggregatePrevote.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryAggregatePrevotesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error) {
+	out := new(QueryExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/ExchangeRate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAggregatePrevotesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryAggregatePrevotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
+	out := new(QueryExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/ExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAggregatePrevotesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error) {
+	out := new(QueryActivesResponse)
+	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/Actives", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAggregatePrevotesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) VoteTargets(ctx context.Context, in *QueryVoteTargetsRequest, opts ...grpc.CallOption) (*QueryVoteTargetsResponse, error) {
+	out := new(QueryVoteTargetsResponse)
+	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/VoteTargets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAggregatePrevotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AggregatePrevotes) > 0 {
-		for iNdEx := len(m.AggregatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AggregatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) Targets(ctx context.Context, in *QueryTargetsRequest, opts ...grpc.CallOption) (*QueryTargetsResponse, error) {
+	out := new(QueryTargetsResponse)
+	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/Targets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAggregateVoteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TargetParams(ctx context.Context, in *QueryTargetParamsRequest, opts ...grpc.CallOption) (*QueryTargetParamsResponse, error) {
+	out := new(QueryTargetParamsResponse)
+	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/TargetParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAggregateVoteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ExchangeRateStatus(ctx context.Context, in *QueryExchangeRateStatusRequest, opts ...grpc.CallOption) (*QueryExchangeRateStatusResponse, error) {
+	out := new(QueryExchangeRateStatusResponse)
+	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/ExchangeRateStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAggregateVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PriceFeed(ctx context.Context, in *QueryPriceFeedRequest, opts ...grpc.CallOption) (*QueryPriceFeedResponse, error) {
+	out := new(QueryPriceFeedResponse)
+	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/PriceFeed", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAggregateVoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error) {
+	out := new(QueryTWAPResponse)
+	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/TWAP", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAggregateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAggregateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AggregateVote.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) HistoricalRate(ctx context.Context, in *QueryHistoricalRateRequest, opts ...grpc.CallOption) (*QueryHistoricalRateResponse, error) {
+	out := new(QueryHistoricalRateResponse)
+	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/HistoricalRate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAggregateVotesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error) {
+	out := new(QueryRewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/RewardPool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAggregateVotesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PeriodRewards(ctx context.Context, in *QueryPeriodRewardsRequest, opts ...grpc.CallOption) (*QueryPeriodRewardsResponse, error) {
+	out := new(QueryPeriodRewardsResponse)
+	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/PeriodRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAggregateVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
+	out := new(QueryFeederDelegationResponse)
+	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/FeederDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAggregateVotesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) MissCounter(ctx context.Context, in *QueryMissCounterRequest, opts ...grpc.CallOption) (*QueryMissCounterResponse, error) {
+	out := new(QueryMissCounterResponse)
+	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/MissCounter", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAggregateVotesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ValidatorOracleHistory(ctx context.Context, in *QueryValidatorOracleHistoryRequest, opts ...grpc.CallOption) (*QueryValidatorOracleHistoryResponse, error) {
+	out := new(QueryValidatorOracleHistoryResponse)
+	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/ValidatorOracleHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAggregateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AggregateVotes) > 0 {
-		for iNdEx := len(m.AggregateVotes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AggregateVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error) {
+	out := new(QueryAggregatePrevoteResponse)
+	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/AggregatePrevote", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotesRequest, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error) {
+	out := new(QueryAggregatePrevotesResponse)
+	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/AggregatePrevotes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AggregateVote(ctx context.Context, in *QueryAggregateVoteRequest, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error) {
+	out := new(QueryAggregateVoteResponse)
+	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/AggregateVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) AggregateVotes(ctx context.Context, in *QueryAggregateVotesRequest, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error) {
+	out := new(QueryAggregateVotesResponse)
+	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/AggregateVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/warmage.oracle.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ExchangeRate returns exchange rate of a denom.
+	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
+	// ExchangeRates returns exchange rates of all denoms.
+	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
+	// Actives returns all active denoms.
+	Actives(context.Context, *QueryActivesRequest) (*QueryActivesResponse, error)
+	// VoteTargets returns all vote target denoms.
+	VoteTargets(context.Context, *QueryVoteTargetsRequest) (*QueryVoteTargetsResponse, error)
+	// Targets returns all target denoms (including vote targets).
+	Targets(context.Context, *QueryTargetsRequest) (*QueryTargetsResponse, error)
+	// TargetParams returns the quotation source params of all targets.
+	TargetParams(context.Context, *QueryTargetParamsRequest) (*QueryTargetParamsResponse, error)
+	// ExchangeRateStatus returns the last accepted exchange rate of a denom,
+	// and whether the circuit breaker is holding it.
+	ExchangeRateStatus(context.Context, *QueryExchangeRateStatusRequest) (*QueryExchangeRateStatusResponse, error)
+	// PriceFeed returns the address of the Chainlink AggregatorV3 compatible
+	// price feed contract of a denom, through which EVM contracts read its
+	// exchange rate.
+	PriceFeed(context.Context, *QueryPriceFeedRequest) (*QueryPriceFeedResponse, error)
+	// TWAP returns the time-weighted average exchange rate of a denom over a
+	// window up to the current block.
+	TWAP(context.Context, *QueryTWAPRequest) (*QueryTWAPResponse, error)
+	// HistoricalRate returns the exchange rate of a denom in effect at a height.
+	HistoricalRate(context.Context, *QueryHistoricalRateRequest) (*QueryHistoricalRateResponse, error)
+	// RewardPool returns the balances of the oracle reward pool, along with the
+	// accumulated fee inflows per denom.
+	RewardPool(context.Context, *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error)
+	// PeriodRewards returns the projected rewards given out to ballot winners
+	// at the end of the current vote period.
+	PeriodRewards(context.Context, *QueryPeriodRewardsRequest) (*QueryPeriodRewardsResponse, error)
+	// FeederDelegation returns feeder delegation of a validator.
+	FeederDelegation(context.Context, *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error)
+	// MissCounter returns oracle miss counter of a validator.
+	MissCounter(context.Context, *QueryMissCounterRequest) (*QueryMissCounterResponse, error)
+	// ValidatorOracleHistory returns the oracle performance of a validator in
+	// the current and past slash windows.
+	ValidatorOracleHistory(context.Context, *QueryValidatorOracleHistoryRequest) (*QueryValidatorOracleHistoryResponse, error)
+	// AggregatePrevote returns an aggregate prevote of a validator.
+	AggregatePrevote(context.Context, *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error)
+	// AggregatePrevotes returns aggregate prevotes of all validators.
+	AggregatePrevotes(context.Context, *QueryAggregatePrevotesRequest) (*QueryAggregatePrevotesResponse, error)
+	// AggregateVote returns an aggregate vote of a validator.
+	AggregateVote(context.Context, *QueryAggregateVoteRequest) (*QueryAggregateVoteResponse, error)
+	// AggregateVotes returns aggregate votes of all validators.
+	AggregateVotes(context.Context, *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error)
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRate not implemented")
 }
-func (m *QueryExchangeRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
 }
-
-func (m *QueryExchangeRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ExchangeRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (*UnimplementedQueryServer) Actives(ctx context.Context, req *QueryActivesRequest) (*QueryActivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Actives not implemented")
 }
-
-func (m *QueryExchangeRatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedQueryServer) VoteTargets(ctx context.Context, req *QueryVoteTargetsRequest) (*QueryVoteTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteTargets not implemented")
 }
-
-func (m *QueryExchangeRatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ExchangeRates) > 0 {
-		for _, e := range m.ExchangeRates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (*UnimplementedQueryServer) Targets(ctx context.Context, req *QueryTargetsRequest) (*QueryTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Targets not implemented")
 }
-
-func (m *QueryActivesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedQueryServer) TargetParams(ctx context.Context, req *QueryTargetParamsRequest) (*QueryTargetParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TargetParams not implemented")
 }
-
-func (m *QueryActivesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Actives) > 0 {
-		for _, s := range m.Actives {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (*UnimplementedQueryServer) ExchangeRateStatus(ctx context.Context, req *QueryExchangeRateStatusRequest) (*QueryExchangeRateStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRateStatus not implemented")
+}
+func (*UnimplementedQueryServer) PriceFeed(ctx context.Context, req *QueryPriceFeedRequest) (*QueryPriceFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceFeed not implemented")
+}
+func (*UnimplementedQueryServer) TWAP(ctx context.Context, req *QueryTWAPRequest) (*QueryTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TWAP not implemented")
+}
+func (*UnimplementedQueryServer) HistoricalRate(ctx context.Context, req *QueryHistoricalRateRequest) (*QueryHistoricalRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalRate not implemented")
+}
+func (*UnimplementedQueryServer) RewardPool(ctx context.Context, req *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardPool not implemented")
+}
+func (*UnimplementedQueryServer) PeriodRewards(ctx context.Context, req *QueryPeriodRewardsRequest) (*QueryPeriodRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeriodRewards not implemented")
+}
+func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeederDelegation not implemented")
+}
+func (*UnimplementedQueryServer) MissCounter(ctx context.Context, req *QueryMissCounterRequest) (*QueryMissCounterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissCounter not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorOracleHistory(ctx context.Context, req *QueryValidatorOracleHistoryRequest) (*QueryValidatorOracleHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorOracleHistory not implemented")
+}
+func (*UnimplementedQueryServer) AggregatePrevote(ctx context.Context, req *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevote not implemented")
+}
+func (*UnimplementedQueryServer) AggregatePrevotes(ctx context.Context, req *QueryAggregatePrevotesRequest) (*QueryAggregatePrevotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevotes not implemented")
+}
+func (*UnimplementedQueryServer) AggregateVote(ctx context.Context, req *QueryAggregateVoteRequest) (*QueryAggregateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateVote not implemented")
+}
+func (*UnimplementedQueryServer) AggregateVotes(ctx context.Context, req *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateVotes not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
 
-func (m *QueryVoteTargetsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryVoteTargetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.VoteTargets) > 0 {
-		for _, s := range m.VoteTargets {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRate(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryTargetsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.oracle.v1.Query/ExchangeRate",
 	}
-	var l int
-	_ = l
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRate(ctx, req.(*QueryExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTargetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Targets) > 0 {
-		for _, s := range m.Targets {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRates(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryFeederDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.oracle.v1.Query/ExchangeRates",
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRates(ctx, req.(*QueryExchangeRatesRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFeederDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Actives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.FeederAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Actives(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryMissCounterRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.oracle.v1.Query/Actives",
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Actives(ctx, req.(*QueryActivesRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryMissCounterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_VoteTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.MissCounter != 0 {
-		n += 1 + sovQuery(uint64(m.MissCounter))
+	if interceptor == nil {
+		return srv.(QueryServer).VoteTargets(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryAggregatePrevoteRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.oracle.v1.Query/VoteTargets",
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteTargets(ctx, req.(*QueryVoteTargetsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregatePrevoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Targets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AggregatePrevote.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Targets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.oracle.v1.Query/Targets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Targets(ctx, req.(*QueryTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregatePrevotesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_TargetParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTargetParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).TargetParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.oracle.v1.Query/TargetParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TargetParams(ctx, req.(*QueryTargetParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregatePrevotesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ExchangeRateStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.AggregatePrevotes) > 0 {
-		for _, e := range m.AggregatePrevotes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRateStatus(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.oracle.v1.Query/ExchangeRateStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRateStatus(ctx, req.(*QueryExchangeRateStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregateVoteRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PriceFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).PriceFeed(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.oracle.v1.Query/PriceFeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceFeed(ctx, req.(*QueryPriceFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregateVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_TWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AggregateVote.Size()
+	if interceptor == nil {
+		return srv.(QueryServer).TWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.oracle.v1.Query/TWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TWAP(ctx, req.(*QueryTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HistoricalRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricalRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.oracle.v1.Query/HistoricalRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalRate(ctx, req.(*QueryHistoricalRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.oracle.v1.Query/RewardPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardPool(ctx, req.(*QueryRewardPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PeriodRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPeriodRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PeriodRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.oracle.v1.Query/PeriodRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PeriodRewards(ctx, req.(*QueryPeriodRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeederDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeederDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeederDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.oracle.v1.Query/FeederDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeederDelegation(ctx, req.(*QueryFeederDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MissCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissCounterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MissCounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.oracle.v1.Query/MissCounter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MissCounter(ctx, req.(*QueryMissCounterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorOracleHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorOracleHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorOracleHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.oracle.v1.Query/ValidatorOracleHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorOracleHistory(ctx, req.(*QueryValidatorOracleHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregatePrevote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregatePrevoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatePrevote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.oracle.v1.Query/AggregatePrevote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatePrevote(ctx, req.(*QueryAggregatePrevoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregatePrevotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregatePrevotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatePrevotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.oracle.v1.Query/AggregatePrevotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatePrevotes(ctx, req.(*QueryAggregatePrevotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregateVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.oracle.v1.Query/AggregateVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregateVote(ctx, req.(*QueryAggregateVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregateVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregateVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregateVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.oracle.v1.Query/AggregateVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregateVotes(ctx, req.(*QueryAggregateVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.oracle.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "warmage.oracle.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExchangeRate",
+			Handler:    _Query_ExchangeRate_Handler,
+		},
+		{
+			MethodName: "ExchangeRates",
+			Handler:    _Query_ExchangeRates_Handler,
+		},
+		{
+			MethodName: "Actives",
+			Handler:    _Query_Actives_Handler,
+		},
+		{
+			MethodName: "VoteTargets",
+			Handler:    _Query_VoteTargets_Handler,
+		},
+		{
+			MethodName: "Targets",
+			Handler:    _Query_Targets_Handler,
+		},
+		{
+			MethodName: "TargetParams",
+			Handler:    _Query_TargetParams_Handler,
+		},
+		{
+			MethodName: "ExchangeRateStatus",
+			Handler:    _Query_ExchangeRateStatus_Handler,
+		},
+		{
+			MethodName: "PriceFeed",
+			Handler:    _Query_PriceFeed_Handler,
+		},
+		{
+			MethodName: "TWAP",
+			Handler:    _Query_TWAP_Handler,
+		},
+		{
+			MethodName: "HistoricalRate",
+			Handler:    _Query_HistoricalRate_Handler,
+		},
+		{
+			MethodName: "RewardPool",
+			Handler:    _Query_RewardPool_Handler,
+		},
+		{
+			MethodName: "PeriodRewards",
+			Handler:    _Query_PeriodRewards_Handler,
+		},
+		{
+			MethodName: "FeederDelegation",
+			Handler:    _Query_FeederDelegation_Handler,
+		},
+		{
+			MethodName: "MissCounter",
+			Handler:    _Query_MissCounter_Handler,
+		},
+		{
+			MethodName: "ValidatorOracleHistory",
+			Handler:    _Query_ValidatorOracleHistory_Handler,
+		},
+		{
+			MethodName: "AggregatePrevote",
+			Handler:    _Query_AggregatePrevote_Handler,
+		},
+		{
+			MethodName: "AggregatePrevotes",
+			Handler:    _Query_AggregatePrevotes_Handler,
+		},
+		{
+			MethodName: "AggregateVote",
+			Handler:    _Query_AggregateVote_Handler,
+		},
+		{
+			MethodName: "AggregateVotes",
+			Handler:    _Query_AggregateVotes_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "warmage/oracle/v1/query.proto",
+}
+
+func (m *QueryExchangeRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExchangeRates) > 0 {
+		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActivesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActivesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActivesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActivesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actives) > 0 {
+		for iNdEx := len(m.Actives) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Actives[iNdEx])
+			copy(dAtA[i:], m.Actives[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Actives[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteTargetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteTargetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteTargetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteTargetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteTargetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteTargetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoteTargets) > 0 {
+		for iNdEx := len(m.VoteTargets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VoteTargets[iNdEx])
+			copy(dAtA[i:], m.VoteTargets[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.VoteTargets[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTargetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTargetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTargetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTargetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTargetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTargetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Targets) > 0 {
+		for iNdEx := len(m.Targets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Targets[iNdEx])
+			copy(dAtA[i:], m.Targets[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Targets[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTargetParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTargetParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTargetParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTargetParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTargetParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTargetParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetParams) > 0 {
+		for iNdEx := len(m.TargetParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TargetParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Inflows) > 0 {
+		for iNdEx := len(m.Inflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPeriodRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPeriodRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPeriodRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPeriodRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPeriodRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPeriodRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PeriodRewards) > 0 {
+		for iNdEx := len(m.PeriodRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRateStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRateStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ExchangeRateStatus.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceFeedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceFeedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceFeedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceFeedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceFeedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceFeedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTWAPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTWAPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTWAPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTWAPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTWAPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTWAPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricalRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricalRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricalRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricalRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricalRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricalRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeederDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeederDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeederDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeederDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeederDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeederDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeederAddr) > 0 {
+		i -= len(m.FeederAddr)
+		copy(dAtA[i:], m.FeederAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeederAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMissCounterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissCounterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissCounterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMissCounterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissCounterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissCounter))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOracleHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOracleHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOracleHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOracleHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOracleHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOracleHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Current.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AggregatePrevote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregatePrevotes) > 0 {
+		for iNdEx := len(m.AggregatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateVoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateVoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AggregateVote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateVotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregateVotes) > 0 {
+		for iNdEx := len(m.AggregateVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregateVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryExchangeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExchangeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExchangeRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryActivesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Actives) > 0 {
+		for _, s := range m.Actives {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVoteTargetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVoteTargetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VoteTargets) > 0 {
+		for _, s := range m.VoteTargets {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTargetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTargetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Targets) > 0 {
+		for _, s := range m.Targets {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTargetParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTargetParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TargetParams) > 0 {
+		for _, e := range m.TargetParams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Inflows) > 0 {
+		for _, e := range m.Inflows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPeriodRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPeriodRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PeriodRewards) > 0 {
+		for _, e := range m.PeriodRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryExchangeRateStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExchangeRateStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangeRateStatus.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPriceFeedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceFeedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTWAPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTWAPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Twap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHistoricalRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryHistoricalRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Snapshot.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeederDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeederDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeederAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissCounterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissCounterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MissCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissCounter))
+	}
+	return n
+}
+
+func (m *QueryValidatorOracleHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOracleHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Current.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregatePrevoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregatePrevoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AggregatePrevote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAggregatePrevotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAggregatePrevotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AggregatePrevotes) > 0 {
+		for _, e := range m.AggregatePrevotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAggregateVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AggregateVote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAggregateVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAggregateVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AggregateVotes) > 0 {
+		for _, e := range m.AggregateVotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryAggregateVotesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actives", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actives = append(m.Actives, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteTargetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteTargetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteTargets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteTargets = append(m.VoteTargets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTargetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTargetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTargetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTargetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Targets = append(m.Targets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTargetParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTargetParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTargetParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTargetParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTargetParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTargetParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetParams = append(m.TargetParams, TargetParams{})
+			if err := m.TargetParams[len(m.TargetParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
+}
+func (m *QueryRewardPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inflows = append(m.Inflows, types.Coin{})
+			if err := m.Inflows[len(m.Inflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *QueryPeriodRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPeriodRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPeriodRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryAggregateVotesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.AggregateVotes) > 0 {
-		for _, e := range m.AggregateVotes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryPeriodRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPeriodRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPeriodRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodRewards = append(m.PeriodRewards, types.DecCoin{})
+			if err := m.PeriodRewards[len(m.PeriodRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExchangeRateStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2698,10 +5704,10 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExchangeRateStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExchangeRateStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2757,7 +5763,7 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExchangeRateStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2780,17 +5786,17 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExchangeRateStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExchangeRateStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateStatus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2800,23 +5806,22 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangeRateStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2841,7 +5846,7 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceFeedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2864,12 +5869,44 @@ func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceFeedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceFeedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2891,7 +5928,7 @@ func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceFeedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2914,17 +5951,17 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceFeedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceFeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2934,25 +5971,23 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
-			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2975,7 +6010,7 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTWAPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2998,12 +6033,77 @@ func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTWAPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTWAPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3025,7 +6125,7 @@ func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTWAPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3048,15 +6148,15 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTWAPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3084,7 +6184,9 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actives = append(m.Actives, string(dAtA[iNdEx:postIndex]))
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3107,7 +6209,7 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHistoricalRateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3117,25 +6219,76 @@ func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteTargetsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3157,7 +6310,7 @@ func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHistoricalRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3180,17 +6333,17 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteTargetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHistoricalRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHistoricalRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteTargets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3200,23 +6353,24 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoteTargets = append(m.VoteTargets, string(dAtA[iNdEx:postIndex]))
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3239,7 +6393,7 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTargetsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3262,12 +6416,44 @@ func (m *QueryTargetsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTargetsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3289,7 +6475,7 @@ func (m *QueryTargetsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTargetsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3312,15 +6498,15 @@ func (m *QueryTargetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTargetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3348,7 +6534,7 @@ func (m *QueryTargetsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Targets = append(m.Targets, string(dAtA[iNdEx:postIndex]))
+			m.FeederAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3371,7 +6557,7 @@ func (m *QueryTargetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3394,10 +6580,10 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMissCounterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMissCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3453,7 +6639,7 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3476,17 +6662,17 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
 			}
-			var stringLen uint64
+			m.MissCounter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3496,24 +6682,11 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MissCounter |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FeederAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3535,7 +6708,7 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorOracleHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3558,10 +6731,10 @@ func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorOracleHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorOracleHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3596,6 +6769,42 @@ func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3617,7 +6826,7 @@ func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorOracleHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3640,17 +6849,17 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorOracleHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorOracleHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
 			}
-			m.MissCounter = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3660,11 +6869,95 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissCounter |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, ValidatorOracleStats{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -282,7 +282,7 @@ func (k Keeper) VeDelegate(
 	if !got {
 		veDelegation = types.VeDelegation{
 			DelegatorAddress: delAddr.String(),
-			ValidatorAddress: validator.String(),
+			ValidatorAddress: validator.OperatorAddress,
 		}
 	} else {
 		veDelegation = k.SettleVeDelegation(ctx, veDelegation, validator)
@@ -358,9 +358,13 @@ func (k Keeper) VeDelegate(
 		}
 
 		locked := k.veKeeper.GetLockedAmountByUser(ctx, veID)
-		if locked.End <= uint64(ctx.BlockTime().Unix()) {
+		// a permanent lock has no unlocking time
+		if !locked.IsPermanent && locked.End <= uint64(ctx.BlockTime().Unix()) {
 			return sdk.ZeroDec(), sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "ve expired due to unlocking time %s", time.Unix(int64(locked.End), 0))
 		}
+		if locked.Denom != k.BondDenom(ctx) {
+			return sdk.ZeroDec(), sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "ve locks %s but bond denom is %s", locked.Denom, k.BondDenom(ctx))
+		}
 
 		veShares, _ := veDelegation.GetSharesByVeID(veID)
 
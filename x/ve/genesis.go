@@ -18,15 +18,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			panic(err)
 		}
 
-		k.SetTotalLockedAmount(ctx, sdk.ZeroInt())
+		k.SetTotalLockedAmount(ctx, genState.Params.LockDenom, sdk.ZeroInt())
 		k.SetNextVeID(ctx, types.FirstVeID)
 		k.SetEpoch(ctx, types.EmptyEpoch)
-		k.SetCheckpoint(ctx, types.EmptyEpoch, types.Checkpoint{
-			Bias:      sdk.ZeroInt(),
-			Slope:     sdk.ZeroInt(),
-			Timestamp: 0,
-			Block:     0,
-		})
+		k.SetCheckpoint(ctx, types.EmptyEpoch, types.NewCheckpoint())
 	}
 }
 
@@ -38,7 +38,7 @@ func (suite *GenesisTestSuite) TestVeInitGenesis() {
 
 	params := veKeeper.GetParams(suite.ctx)
 	suite.Require().Equal(params.GetLockDenom(), warmage.BaseDenom)
-	suite.Require().Equal(sdk.ZeroInt(), veKeeper.GetTotalLockedAmount(suite.ctx))
+	suite.Require().Equal(sdk.ZeroInt(), veKeeper.GetTotalLockedAmount(suite.ctx, warmage.BaseDenom))
 	suite.Require().EqualValues(types.FirstVeID, veKeeper.GetNextVeID(suite.ctx))
 	suite.Require().EqualValues(types.EmptyEpoch, veKeeper.GetEpoch(suite.ctx))
 	suite.Require().Equal(types.Checkpoint{
@@ -46,6 +46,7 @@ func (suite *GenesisTestSuite) TestVeInitGenesis() {
 		Slope:     sdk.ZeroInt(),
 		Timestamp: 0,
 		Block:     0,
+		Permanent: sdk.ZeroInt(),
 	}, veKeeper.GetCheckpoint(suite.ctx, types.EmptyEpoch))
 }
 
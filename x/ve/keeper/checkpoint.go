@@ -13,6 +13,9 @@ import (
 // lockedNew:
 //             Amount: can be zero
 //             End: must be in the future or be zero
+// Voting power is based on the locked amount weighted by the locked denom.
+// A permanent lock has no slope and its voting power is kept in the permanent
+// amount of the checkpoint, with zero end.
 func (k Keeper) RegulateUserCheckpoint(ctx sdk.Context, veID uint64, lockedOld types.LockedBalance, lockedNew types.LockedBalance) {
 	// check whether timestamp is regulated
 	types.CheckRegulatedUnixTime(lockedOld.End)
@@ -22,24 +25,29 @@ func (k Keeper) RegulateUserCheckpoint(ctx sdk.Context, veID uint64, lockedOld t
 	now := uint64(ctx.BlockTime().Unix())
 
 	// user point initialized with zero values
-	userPointOld := types.Checkpoint{
-		Bias:  sdk.ZeroInt(),
-		Slope: sdk.ZeroInt(),
+	userPointOld := types.NewCheckpoint()
+	userPointNew := types.NewCheckpoint()
+
+	weightedOld := lockedOld.WeightedAmount()
+	weightedNew := lockedNew.WeightedAmount()
+
+	// permanent lock keeps voting power equal to weighted locked amount
+	if lockedOld.IsPermanent {
+		userPointOld.Permanent = weightedOld
 	}
-	userPointNew := types.Checkpoint{
-		Bias:  sdk.ZeroInt(),
-		Slope: sdk.ZeroInt(),
+	if lockedNew.IsPermanent {
+		userPointNew.Permanent = weightedNew
 	}
 
 	// calculate slope and bias from now on,
 	// kept at zero after the unlocking time
-	if lockedOld.End > now && lockedOld.Amount.IsPositive() {
-		userPointOld.Slope = lockedOld.Amount.QuoRaw(types.MaxLockTime)
+	if lockedOld.End > now && weightedOld.IsPositive() {
+		userPointOld.Slope = weightedOld.QuoRaw(types.MaxLockTime)
 		userPointOld.Bias = userPointOld.Slope.MulRaw(int64(lockedOld.End - now))
 	}
-	if lockedNew.End > now && lockedNew.Amount.IsPositive() {
-		// slope is always proportional to locked amount
-		userPointNew.Slope = lockedNew.Amount.QuoRaw(types.MaxLockTime)
+	if lockedNew.End > now && weightedNew.IsPositive() {
+		// slope is always proportional to weighted locked amount
+		userPointNew.Slope = weightedNew.QuoRaw(types.MaxLockTime)
 		// bias represents the voting power at the present:
 		//     slope * (end - now)
 		// so that at any future time t, voting power will decay to:
@@ -50,7 +58,8 @@ func (k Keeper) RegulateUserCheckpoint(ctx sdk.Context, veID uint64, lockedOld t
 	// regulate system checkpoint history
 	userSlopeChange := userPointNew.Slope.Sub(userPointOld.Slope)
 	userBiasChange := userPointNew.Bias.Sub(userPointOld.Bias)
-	k.regulateCheckpoint(ctx, userSlopeChange, userBiasChange)
+	userPermanentChange := userPointNew.Permanent.Sub(userPointOld.Permanent)
+	k.regulateCheckpoint(ctx, userSlopeChange, userBiasChange, userPermanentChange)
 
 	slopeChangeOld := k.GetSlopeChange(ctx, lockedOld.End)
 	slopeChangeNew := k.GetSlopeChange(ctx, lockedNew.End)
@@ -86,6 +95,9 @@ func (k Keeper) RegulateUserCheckpoint(ctx sdk.Context, veID uint64, lockedOld t
 	userPointNew.Timestamp = now
 	userPointNew.Block = ctx.BlockHeight()
 	k.SetUserCheckpoint(ctx, veID, userEpoch, userPointNew)
+
+	// mirror the new lock into the ve NFT contract
+	k.syncVeNftContract(ctx, veID)
 }
 
 func (k Keeper) RegulateCheckpoint(ctx sdk.Context) {
@@ -93,21 +105,18 @@ func (k Keeper) RegulateCheckpoint(ctx sdk.Context) {
 	epoch := k.GetEpoch(ctx)
 	pointLast := k.GetCheckpoint(ctx, epoch)
 	if now-pointLast.Timestamp >= types.RegulatedPeriod {
-		k.regulateCheckpoint(ctx, sdk.ZeroInt(), sdk.ZeroInt())
+		k.regulateCheckpoint(ctx, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt())
 	}
 }
 
-func (k Keeper) regulateCheckpoint(ctx sdk.Context, userSlopeChange, userBiasChange sdk.Int) {
+func (k Keeper) regulateCheckpoint(ctx sdk.Context, userSlopeChange, userBiasChange, userPermanentChange sdk.Int) {
 	now := uint64(ctx.BlockTime().Unix())
 
 	epoch := k.GetEpoch(ctx)
 
-	pointLast := types.Checkpoint{
-		Bias:      sdk.ZeroInt(),
-		Slope:     sdk.ZeroInt(),
-		Timestamp: now,
-		Block:     ctx.BlockHeight(),
-	}
+	pointLast := types.NewCheckpoint()
+	pointLast.Timestamp = now
+	pointLast.Block = ctx.BlockHeight()
 	if epoch > 0 {
 		pointLast = k.GetCheckpoint(ctx, epoch)
 	}
@@ -180,18 +189,20 @@ func (k Keeper) regulateCheckpoint(ctx sdk.Context, userSlopeChange, userBiasCha
 	// set new last epoch
 	k.SetEpoch(ctx, epoch)
 
-	// add new change at now to the new last point
-	if userBiasChange.IsPositive() {
-		pointLast.Bias = pointLast.Bias.Add(userBiasChange)
-		if pointLast.Bias.IsNegative() {
-			pointLast.Bias = sdk.ZeroInt()
-		}
+	// add new change at now to the new last point,
+	// which is negative when a ve is merged, split or unlocked permanently
+	pointLast.Bias = pointLast.Bias.Add(userBiasChange)
+	if pointLast.Bias.IsNegative() {
+		pointLast.Bias = sdk.ZeroInt()
 	}
-	if userSlopeChange.IsPositive() {
-		pointLast.Slope = pointLast.Slope.Add(userSlopeChange)
-		if pointLast.Slope.IsNegative() {
-			pointLast.Slope = sdk.ZeroInt()
-		}
+	pointLast.Slope = pointLast.Slope.Add(userSlopeChange)
+	if pointLast.Slope.IsNegative() {
+		pointLast.Slope = sdk.ZeroInt()
+	}
+	pointLast.Permanent = pointLast.Permanent.Add(userPermanentChange)
+	if pointLast.Permanent.IsNegative() {
+		// cannot happen, just in case
+		pointLast.Permanent = sdk.ZeroInt()
 	}
 
 	// set new checkpoint
@@ -223,13 +234,14 @@ func (k Keeper) GetCheckpoint(ctx sdk.Context, epoch uint64) types.Checkpoint {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.PointKey(epoch))
 	if bz == nil {
-		return types.Checkpoint{
-			Bias:  sdk.ZeroInt(),
-			Slope: sdk.ZeroInt(),
-		}
+		return types.NewCheckpoint()
 	}
 	var point types.Checkpoint
 	k.cdc.MustUnmarshal(bz, &point)
+	if point.Permanent.IsNil() {
+		// checkpoint stored before permanent lock was introduced
+		point.Permanent = sdk.ZeroInt()
+	}
 	return point
 }
 
@@ -258,13 +270,14 @@ func (k Keeper) GetUserCheckpoint(ctx sdk.Context, veID uint64, epoch uint64) ty
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.UserPointKey(veID, epoch))
 	if bz == nil {
-		return types.Checkpoint{
-			Bias:  sdk.ZeroInt(),
-			Slope: sdk.ZeroInt(),
-		}
+		return types.NewCheckpoint()
 	}
 	var point types.Checkpoint
 	k.cdc.MustUnmarshal(bz, &point)
+	if point.Permanent.IsNil() {
+		// checkpoint stored before permanent lock was introduced
+		point.Permanent = sdk.ZeroInt()
+	}
 	return point
 }
 
@@ -14,32 +14,57 @@ func NewDistributor(keeper Keeper) Distributor {
 	return Distributor{keeper: keeper}
 }
 
-func (d Distributor) DistributePerPeriod(ctx sdk.Context) {
-	totalAmount := d.keeper.bankKeeper.GetBalance(ctx, d.keeper.accountKeeper.GetModuleAddress(types.DistributionPoolName), d.keeper.LockDenom(ctx)).Amount
-
-	totalAmountLast := d.keeper.GetDistributionTotalAmount(ctx)
-	d.keeper.SetDistributionTotalAmount(ctx, totalAmount)
-
-	amount := totalAmount.Sub(totalAmountLast)
+// DistributeFees sends the fees from the sender module into the distribution
+// pool and distributes them to ve holders. The lock denom is distributed as
+// usual, any other denom is paid to ve holders as an extra reward denom.
+func (k Keeper) DistributeFees(ctx sdk.Context, senderModule string, fees sdk.Coins) error {
+	if fees.IsZero() {
+		return nil
+	}
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.DistributionPoolName, fees)
+	if err != nil {
+		return err
+	}
+	NewDistributor(k).DistributePerPeriod(ctx)
+	return nil
+}
 
+func (d Distributor) DistributePerPeriod(ctx sdk.Context) {
 	now := uint64(ctx.BlockTime().Unix())
 	timeLast := d.keeper.GetDistributionAccruedLastTimestamp(ctx)
+	if timeLast == 0 {
+		// nothing is spread back over the periods before the first distribution
+		timeLast = now
+	}
 	d.keeper.SetDistributionAccruedLastTimestamp(ctx, now)
 
+	for _, denom := range d.denoms(ctx) {
+		d.distributePerPeriod(ctx, denom, timeLast, now)
+	}
+}
+
+func (d Distributor) distributePerPeriod(ctx sdk.Context, denom string, timeLast uint64, now uint64) {
+	totalAmount := d.keeper.bankKeeper.GetBalance(ctx, d.keeper.accountKeeper.GetModuleAddress(types.DistributionPoolName), denom).Amount
+
+	totalAmountLast := d.totalAmount(ctx, denom)
+	d.setTotalAmount(ctx, denom, totalAmount)
+
+	amount := totalAmount.Sub(totalAmountLast)
+
 	duration := now - timeLast
 
 	epochTime := types.RegulatedUnixTime(timeLast)
 	for {
-		amountExisting := d.keeper.GetDistributionPerPeriod(ctx, epochTime)
+		amountExisting := d.amountPerPeriod(ctx, denom, epochTime)
 		if duration == 0 || epochTime >= now {
-			d.keeper.SetDistributionPerPeriod(ctx, epochTime, amountExisting.Add(amount))
+			d.setAmountPerPeriod(ctx, denom, epochTime, amountExisting.Add(amount))
 			break
 		}
 
 		nextEpochTime := types.NextRegulatedUnixTime(epochTime)
 		endTime := warmage.Min(nextEpochTime, now)
 		amountAdd := amount.MulRaw(int64(endTime - timeLast)).QuoRaw(int64(duration))
-		d.keeper.SetDistributionPerPeriod(ctx, epochTime, amountExisting.Add(amountAdd))
+		d.setAmountPerPeriod(ctx, denom, epochTime, amountExisting.Add(amountAdd))
 
 		if nextEpochTime >= now {
 			break
@@ -58,9 +83,49 @@ func (d Distributor) Claim(ctx sdk.Context, veID uint64) error {
 	if now-timeLast < types.RegulatedPeriod {
 		return nil
 	}
+	coins := d.ClaimableCoins(ctx, veID)
 	d.keeper.SetDistributionClaimLastTimestampByUser(ctx, veID, now)
 
+	if coins.IsZero() {
+		return nil
+	}
+	// claimed coins leave the pool, so they must not be distributed again
+	for _, coin := range coins {
+		d.setTotalAmount(ctx, coin.Denom, d.totalAmount(ctx, coin.Denom).Sub(coin.Amount))
+	}
+	err := d.keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.DistributionPoolName, owner, coins)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Claimable returns the amount of the distribution pool that the ve can claim
+// for the periods elapsed since its last claim
+func (d Distributor) Claimable(ctx sdk.Context, veID uint64) sdk.Int {
+	return d.claimable(ctx, veID, d.keeper.LockDenom(ctx))
+}
+
+// ClaimableCoins returns the coins of every denom distributed by the
+// distribution pool that the ve can claim for the periods elapsed since its
+// last claim
+func (d Distributor) ClaimableCoins(ctx sdk.Context, veID uint64) sdk.Coins {
+	var coins sdk.Coins
+	for _, denom := range d.denoms(ctx) {
+		coins = coins.Add(sdk.NewCoin(denom, d.claimable(ctx, veID, denom)))
+	}
+	return coins
+}
+
+func (d Distributor) claimable(ctx sdk.Context, veID uint64, denom string) sdk.Int {
 	amount := sdk.ZeroInt()
+
+	now := uint64(ctx.BlockTime().Unix())
+	timeLast := d.keeper.GetDistributionClaimLastTimestampByUser(ctx, veID)
+	if now-timeLast < types.RegulatedPeriod {
+		return amount
+	}
+
 	epochTime := types.RegulatedUnixTime(timeLast)
 	for {
 		epochTime = types.NextRegulatedUnixTime(epochTime)
@@ -68,21 +133,72 @@ func (d Distributor) Claim(ctx sdk.Context, veID uint64) error {
 			break
 		}
 
-		amountOfPeriod := d.keeper.GetDistributionPerPeriod(ctx, types.PreviousRegulatedUnixTime(epochTime))
+		amountOfPeriod := d.amountPerPeriod(ctx, denom, types.PreviousRegulatedUnixTime(epochTime))
+		if !amountOfPeriod.IsPositive() {
+			continue
+		}
 		votingPower := d.keeper.GetVotingPower(ctx, veID, epochTime, 0)
 		totalVotingPower := d.keeper.GetTotalVotingPower(ctx, epochTime, 0)
+		if !totalVotingPower.IsPositive() {
+			continue
+		}
 		amount = amount.Add(amountOfPeriod.Mul(votingPower).Quo(totalVotingPower))
 	}
 
-	if !amount.IsPositive() {
-		return nil
+	return amount
+}
+
+// denoms returns the lock denom followed by every other denom held or
+// distributed by the distribution pool
+func (d Distributor) denoms(ctx sdk.Context) []string {
+	denoms := []string{d.keeper.LockDenom(ctx)}
+	seen := map[string]bool{denoms[0]: true}
+	add := func(denom string) {
+		if !seen[denom] {
+			seen[denom] = true
+			denoms = append(denoms, denom)
+		}
 	}
-	coin := sdk.NewCoin(d.keeper.LockDenom(ctx), amount)
-	err := d.keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.DistributionPoolName, owner, sdk.NewCoins(coin))
-	if err != nil {
-		return err
+	d.keeper.IterateDistributionTotalAmountsByDenom(ctx, func(denom string, _ sdk.Int) bool {
+		add(denom)
+		return false
+	})
+	for _, coin := range d.keeper.bankKeeper.GetAllBalances(ctx, d.keeper.accountKeeper.GetModuleAddress(types.DistributionPoolName)) {
+		add(coin.Denom)
 	}
-	return nil
+	return denoms
+}
+
+// totalAmount returns the amount of denom distributed by the pool so far. The
+// lock denom is accounted under the keys predating other distributed denoms.
+func (d Distributor) totalAmount(ctx sdk.Context, denom string) sdk.Int {
+	if denom == d.keeper.LockDenom(ctx) {
+		return d.keeper.GetDistributionTotalAmount(ctx)
+	}
+	return d.keeper.GetDistributionTotalAmountByDenom(ctx, denom)
+}
+
+func (d Distributor) setTotalAmount(ctx sdk.Context, denom string, total sdk.Int) {
+	if denom == d.keeper.LockDenom(ctx) {
+		d.keeper.SetDistributionTotalAmount(ctx, total)
+		return
+	}
+	d.keeper.SetDistributionTotalAmountByDenom(ctx, denom, total)
+}
+
+func (d Distributor) amountPerPeriod(ctx sdk.Context, denom string, timestamp uint64) sdk.Int {
+	if denom == d.keeper.LockDenom(ctx) {
+		return d.keeper.GetDistributionPerPeriod(ctx, timestamp)
+	}
+	return d.keeper.GetDistributionPerPeriodByDenom(ctx, denom, timestamp)
+}
+
+func (d Distributor) setAmountPerPeriod(ctx sdk.Context, denom string, timestamp uint64, amount sdk.Int) {
+	if denom == d.keeper.LockDenom(ctx) {
+		d.keeper.SetDistributionPerPeriod(ctx, timestamp, amount)
+		return
+	}
+	d.keeper.SetDistributionPerPeriodByDenom(ctx, denom, timestamp, amount)
 }
 
 func (k Keeper) SetDistributionAccruedLastTimestamp(ctx sdk.Context, timestamp uint64) {
@@ -133,6 +249,56 @@ func (k Keeper) GetDistributionPerPeriod(ctx sdk.Context, timestamp uint64) sdk.
 	return amount.Int
 }
 
+func (k Keeper) SetDistributionTotalAmountByDenom(ctx sdk.Context, denom string, total sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: total})
+	store.Set(types.DistributionTotalAmountByDenomKey(denom), bz)
+}
+
+func (k Keeper) GetDistributionTotalAmountByDenom(ctx sdk.Context, denom string) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DistributionTotalAmountByDenomKey(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var total sdk.IntProto
+	k.cdc.MustUnmarshal(bz, &total)
+	return total.Int
+}
+
+// IterateDistributionTotalAmountsByDenom iterates over the total amounts of the
+// denoms other than the lock denom distributed by the distribution pool
+func (k Keeper) IterateDistributionTotalAmountsByDenom(ctx sdk.Context, handler func(denom string, total sdk.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixDistributionTotalAmountByDenom)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Key()[len(types.KeyPrefixDistributionTotalAmountByDenom):])
+		var total sdk.IntProto
+		k.cdc.MustUnmarshal(iter.Value(), &total)
+		if handler(denom, total.Int) {
+			break
+		}
+	}
+}
+
+func (k Keeper) SetDistributionPerPeriodByDenom(ctx sdk.Context, denom string, timestamp uint64, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: amount})
+	store.Set(types.DistributionPerPeriodByDenomKey(denom, timestamp), bz)
+}
+
+func (k Keeper) GetDistributionPerPeriodByDenom(ctx sdk.Context, denom string, timestamp uint64) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DistributionPerPeriodByDenomKey(denom, timestamp))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var amount sdk.IntProto
+	k.cdc.MustUnmarshal(bz, &amount)
+	return amount.Int
+}
+
 func (k Keeper) SetDistributionClaimLastTimestampByUser(ctx sdk.Context, veID uint64, timestamp uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.DistributionClaimLastTimestampByUserKey(veID), sdk.Uint64ToBigEndian(timestamp))
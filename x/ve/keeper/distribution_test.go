@@ -1,7 +1,14 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/petri-labs/warmage/app"
+	makertypes "github.com/petri-labs/warmage/x/maker/types"
+	oracletypes "github.com/petri-labs/warmage/x/oracle/types"
+	"github.com/petri-labs/warmage/x/ve/keeper"
+	"github.com/petri-labs/warmage/x/ve/types"
 )
 
 func (suite *KeeperTestSuite) TestDistributor_DistributePerPeriod() {
@@ -12,6 +19,90 @@ func (suite *KeeperTestSuite) TestDistributor_Claim() {
 	// TODO
 }
 
+func (suite *KeeperTestSuite) TestDistributor_Claimable() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	distributor := keeper.NewDistributor(k)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	amount := sdk.NewCoin("amage", sdk.NewIntWithDecimal(1, 18))
+	err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(amount))
+	require.NoError(err)
+	_, err = keeper.NewMsgServerImpl(k).Create(sdk.WrapSDKContext(suite.ctx), &types.MsgCreate{
+		Sender:       sender.String(),
+		To:           sender.String(),
+		Amount:       amount,
+		LockDuration: types.MaxLockTime,
+	})
+	require.NoError(err)
+
+	// distributed in the current period
+	distributed := sdk.NewInt(10000)
+	err = app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(sdk.NewCoin("amage", distributed)))
+	require.NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromAccountToModule(suite.ctx, sender, types.DistributionPoolName, sdk.NewCoins(sdk.NewCoin("amage", distributed)))
+	require.NoError(err)
+	now := uint64(suite.ctx.BlockTime().Unix())
+	k.SetDistributionPerPeriod(suite.ctx, types.RegulatedUnixTime(now), distributed)
+	require.Equal(sdk.ZeroInt(), distributor.Claimable(suite.ctx, 1))
+
+	// the only ve can claim all once the period ends
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(types.RegulatedPeriod * time.Second)).WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	require.Equal(distributed, distributor.Claimable(suite.ctx, 1))
+
+	// nothing more to claim once claimed
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, "amage").Amount
+	require.NoError(distributor.Claim(suite.ctx, 1))
+	require.Equal(balance.Add(distributed), suite.app.BankKeeper.GetBalance(suite.ctx, sender, "amage").Amount)
+	require.Equal(sdk.ZeroInt(), distributor.Claimable(suite.ctx, 1))
+}
+
+func (suite *KeeperTestSuite) TestDistributor_ClaimMakerFees() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	sender := sdk.AccAddress(suite.address.Bytes())
+	amount := sdk.NewCoin("amage", sdk.NewIntWithDecimal(1, 18))
+	require.NoError(app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(amount)))
+	_, err := keeper.NewMsgServerImpl(k).Create(sdk.WrapSDKContext(suite.ctx), &types.MsgCreate{
+		Sender:       sender.String(),
+		To:           sender.String(),
+		Amount:       amount,
+		LockDuration: types.MaxLockTime,
+	})
+	require.NoError(err)
+
+	// half of the maker fees goes to ve holders
+	params := suite.app.OracleKeeper.GetParams(suite.ctx)
+	params.MakerFeeSplit = oracletypes.FeeSplit{
+		OracleVoters:  sdk.NewDecWithPrec(5, 1),
+		CommunityPool: sdk.ZeroDec(),
+		VeHolders:     sdk.NewDecWithPrec(5, 1),
+	}
+	suite.app.OracleKeeper.SetParams(suite.ctx, params)
+	fees := sdk.NewCoins(sdk.NewInt64Coin("amage", 1000), sdk.NewInt64Coin("uusw", 100))
+	require.NoError(app.FundModuleAccount(suite.app.BankKeeper, suite.ctx, makertypes.ModuleName, fees))
+	require.NoError(suite.app.OracleKeeper.AllocateMakerFees(suite.ctx, makertypes.ModuleName, fees))
+
+	// the only ve claims the whole share, MAGE and the extra reward denom alike,
+	// once the period ends
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(types.RegulatedPeriod * time.Second)).WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	distributor := keeper.NewDistributor(k)
+	expected := sdk.NewCoins(sdk.NewInt64Coin("amage", 500), sdk.NewInt64Coin("uusw", 50))
+	require.Equal(expected, distributor.ClaimableCoins(suite.ctx, 1))
+
+	balances := suite.app.BankKeeper.GetAllBalances(suite.ctx, sender)
+	require.NoError(distributor.Claim(suite.ctx, 1))
+	require.Equal(balances.Add(expected...), suite.app.BankKeeper.GetAllBalances(suite.ctx, sender))
+	require.True(distributor.ClaimableCoins(suite.ctx, 1).IsZero())
+	require.True(suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.app.AccountKeeper.GetModuleAddress(types.DistributionPoolName)).IsZero())
+
+	// claimed fees are not distributed again
+	distributor.DistributePerPeriod(suite.ctx)
+	require.Equal(sdk.ZeroInt(), k.GetDistributionTotalAmountByDenom(suite.ctx, "uusw"))
+	require.Equal(sdk.ZeroInt(), k.GetDistributionPerPeriodByDenom(suite.ctx, "uusw", types.RegulatedUnixTime(uint64(suite.ctx.BlockTime().Unix()))))
+}
+
 func (suite *KeeperTestSuite) TestKeeper_SetDistributionAccruedLastTimestamp_GetDistributionAccruedLastTimestamp() {
 	suite.SetupTest()
 	timestamp := suite.app.VeKeeper.GetDistributionAccruedLastTimestamp(suite.ctx)
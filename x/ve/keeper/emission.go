@@ -31,11 +31,41 @@ func (e Emitter) AddTotalEmission(ctx sdk.Context, emission sdk.Int) {
 
 func (e Emitter) CirculationSupply(ctx sdk.Context) sdk.Int {
 	totalSupply := e.keeper.bankKeeper.GetSupply(ctx, e.keeper.LockDenom(ctx)).Amount
-	// actually voting power is degenerative locked amount by ve
+	// actually voting power is degenerative locked amount by ve,
+	// including permanently locked amount without decay
 	veLocked := e.keeper.GetTotalVotingPower(ctx, 0, ctx.BlockHeight())
+	// voting power also comes from other locked denoms, so only count the
+	// power of ve locking the lock denom
+	if e.hasOtherLockedDenoms(ctx) {
+		veLocked = e.lockDenomVotingPower(ctx)
+	}
 	return totalSupply.Sub(veLocked)
 }
 
+// hasOtherLockedDenoms returns whether any denom other than the lock denom is locked.
+func (e Emitter) hasOtherLockedDenoms(ctx sdk.Context) (found bool) {
+	lockDenom := e.keeper.LockDenom(ctx)
+	e.keeper.IterateTotalLockedAmount(ctx, func(denom string, amount sdk.Int) (stop bool) {
+		found = denom != lockDenom && amount.IsPositive()
+		return found
+	})
+	return
+}
+
+// lockDenomVotingPower returns the current voting power of all ve locking the lock denom.
+func (e Emitter) lockDenomVotingPower(ctx sdk.Context) sdk.Int {
+	lockDenom := e.keeper.LockDenom(ctx)
+	now := uint64(ctx.BlockTime().Unix())
+	power := sdk.ZeroInt()
+	e.keeper.IterateLockedAmountByUser(ctx, func(veID uint64, locked types.LockedBalance) (stop bool) {
+		if locked.Denom == lockDenom {
+			power = power.Add(e.keeper.GetVotingPower(ctx, veID, now, 0))
+		}
+		return false
+	})
+	return power
+}
+
 func (e Emitter) CirculationRate(ctx sdk.Context) sdk.Dec {
 	totalSupply := e.keeper.bankKeeper.GetSupply(ctx, e.keeper.LockDenom(ctx)).Amount
 	return e.CirculationSupply(ctx).ToDec().QuoInt(totalSupply)
@@ -4,7 +4,10 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/petri-labs/warmage/app"
 	"github.com/petri-labs/warmage/x/ve/keeper"
+	"github.com/petri-labs/warmage/x/ve/types"
 )
 
 func (suite *KeeperTestSuite) TestEmitter_AddTotalEmission() {
@@ -29,6 +32,49 @@ func (suite *KeeperTestSuite) TestEmitter_CirculationSupply() {
 	suite.Require().Equal(raw, supply.BigInt())
 }
 
+func (suite *KeeperTestSuite) TestEmitter_CirculationSupply_LockDenomWeights() {
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+	emitter := keeper.NewEmitter(k)
+	impl := keeper.NewMsgServerImpl(k)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	amount := sdk.NewIntWithDecimal(1, 18)
+	suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "alp", Exponent: 0}, {Denom: "LP", Exponent: 18}},
+		Base:       "alp",
+		Display:    "LP",
+		Name:       "LP",
+		Symbol:     "LP",
+	})
+	err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(sdk.NewCoin("amage", amount), sdk.NewCoin("alp", amount)))
+	suite.Require().NoError(err)
+
+	_, err = impl.Create(sdk.WrapSDKContext(suite.ctx), &types.MsgCreate{
+		Sender:       sender.String(),
+		Amount:       sdk.NewCoin("amage", amount),
+		LockDuration: types.MaxLockTime,
+	})
+	suite.Require().NoError(err)
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, "amage").Amount
+	power := k.GetTotalVotingPower(suite.ctx, 0, suite.ctx.BlockHeight())
+	suite.Require().True(power.IsPositive())
+	suite.Require().Equal(supply.Sub(power), emitter.CirculationSupply(suite.ctx))
+
+	// the voting power of other locked denoms does not lock the lock denom
+	params := k.GetParams(suite.ctx)
+	params.LockDenomWeights = []types.LockDenomWeight{{Denom: "alp", Weight: sdk.NewDec(2)}}
+	k.SetParams(suite.ctx, params)
+	_, err = impl.Create(sdk.WrapSDKContext(suite.ctx), &types.MsgCreate{
+		Sender:       sender.String(),
+		Amount:       sdk.NewCoin("alp", amount),
+		LockDuration: types.MaxLockTime,
+	})
+	suite.Require().NoError(err)
+	now := uint64(suite.ctx.BlockTime().Unix())
+	suite.Require().True(k.GetTotalVotingPower(suite.ctx, now, 0).GT(power.MulRaw(2)))
+	suite.Require().Equal(supply.Sub(k.GetVotingPower(suite.ctx, 1, now, 0)), emitter.CirculationSupply(suite.ctx))
+}
+
 func (suite *KeeperTestSuite) TestEmitter_CirculationRate() {
 	suite.SetupTest()
 	emitter := keeper.NewEmitter((suite.app.VeKeeper))
@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	nfttypes "github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tharsis/ethermint/server/config"
+	"github.com/tharsis/ethermint/x/evm/statedb"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/petri-labs/warmage/contracts"
+	"github.com/petri-labs/warmage/x/ve/types"
+)
+
+// SetEvmKeeper sets the EVM keeper for all copies of the keeper
+func (k Keeper) SetEvmKeeper(evmKeeper types.EVMKeeper) {
+	*k.evmKeeper = evmKeeper
+}
+
+// GetVeNftContract returns the address of the ve NFT contract, if it has
+// been deployed.
+func (k Keeper) GetVeNftContract(ctx sdk.Context) (common.Address, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.VeNftContractKey())
+	if bz == nil {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(bz), true
+}
+
+func (k Keeper) setVeNftContract(ctx sdk.Context, contract common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VeNftContractKey(), contract.Bytes())
+}
+
+// DeployVeNftContract deploys the ERC721 contract mirroring the ve NFTs with
+// the ve module account as deployer, and mirrors all existing ve NFTs into
+// it. From then on, every change of the ve NFTs is mirrored into the contract
+// as it happens. It is deployed once, at genesis or by the store migration.
+func (k Keeper) DeployVeNftContract(ctx sdk.Context) (common.Address, error) {
+	evmKeeper := *k.evmKeeper
+	if evmKeeper == nil {
+		return common.Address{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "evm keeper not set")
+	}
+	if contract, found := k.GetVeNftContract(ctx); found {
+		return common.Address{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "ve NFT contract already deployed at %s", contract)
+	}
+
+	ctorArgs, err := types.VeNftContractABI.Pack("", types.VeNftClass.Name, types.VeNftClass.Symbol)
+	if err != nil {
+		return common.Address{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid ve NFT class: %s", err.Error())
+	}
+
+	data := make([]byte, len(contracts.VeNFTContract.Bin)+len(ctorArgs))
+	copy(data[:len(contracts.VeNFTContract.Bin)], contracts.VeNFTContract.Bin)
+	copy(data[len(contracts.VeNFTContract.Bin):], ctorArgs)
+
+	moduleAddr := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName).GetAddress()
+	nonce, err := k.accountKeeper.GetSequence(ctx, moduleAddr)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	// there is no block proposer at genesis, hence no coinbase
+	ethCfg := evmKeeper.GetParams(ctx).ChainConfig.EthereumConfig(evmKeeper.ChainID())
+	cfg := &evmtypes.EVMConfig{
+		Params:      evmKeeper.GetParams(ctx),
+		ChainConfig: ethCfg,
+		BaseFee:     evmKeeper.GetBaseFee(ctx, ethCfg),
+	}
+	from := common.BytesToAddress(moduleAddr)
+	msg := ethtypes.NewMessage(
+		from,
+		nil,
+		nonce,
+		big.NewInt(0), // amount
+		config.DefaultGasCap,
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		data,
+		ethtypes.AccessList{},
+		true, // checkNonce
+	)
+	res, err := evmKeeper.ApplyMessageWithConfig(ctx, msg, evmtypes.NewNoOpTracer(), true, cfg, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	if err != nil {
+		return common.Address{}, sdkerrors.Wrap(err, "failed to deploy ve NFT contract")
+	}
+	if res.Failed() {
+		return common.Address{}, sdkerrors.Wrapf(evmtypes.ErrVMExecution, "failed to deploy ve NFT contract: %s", res.VmError)
+	}
+	contract := crypto.CreateAddress(from, nonce)
+	k.setVeNftContract(ctx, contract)
+
+	for _, token := range k.nftKeeper.GetNFTsOfClass(ctx, types.VeNftClass.Id) {
+		k.syncVeNftContract(ctx, types.Uint64FromVeID(token.Id))
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDeployNftContract{
+		Contract: contract.Hex(),
+	})
+	if err != nil {
+		return common.Address{}, err
+	}
+	return contract, nil
+}
+
+// syncVeNftContract mirrors the owner and the lock of the ve into the ve NFT
+// contract, if it has been deployed. The ve is cleared from the contract once
+// its NFT is burned. The approval of the ve is revoked when the owner changes,
+// as any ERC721 transfer does.
+func (k Keeper) syncVeNftContract(ctx sdk.Context, veID uint64) {
+	contract, found := k.GetVeNftContract(ctx)
+	if !found {
+		return
+	}
+
+	var owner common.Address
+	locked, point := types.NewLockedBalance(), types.NewCheckpoint()
+	if nftID := types.VeIDFromUint64(veID); k.nftKeeper.HasNFT(ctx, types.VeNftClass.Id, nftID) {
+		owner = common.BytesToAddress(k.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, nftID))
+		locked = k.GetLockedAmountByUser(ctx, veID)
+		point = k.GetUserCheckpoint(ctx, veID, k.GetUserEpoch(ctx, veID))
+	}
+
+	ownerOld := common.BytesToAddress((*k.evmKeeper).GetState(ctx, contract, types.VeNftOwnerSlot(veID)).Bytes())
+	if owner != ownerOld {
+		if ownerOld != (common.Address{}) {
+			k.addVeNftContractBalance(ctx, contract, ownerOld, -1)
+		}
+		if owner != (common.Address{}) {
+			k.addVeNftContractBalance(ctx, contract, owner, 1)
+		}
+		k.setVeNftContractState(ctx, contract, types.VeNftOwnerSlot(veID), owner.Hash())
+		k.setVeNftContractState(ctx, contract, types.VeNftApprovalSlot(veID), common.Hash{})
+	}
+
+	isPermanent := common.Big0
+	if locked.IsPermanent {
+		isPermanent = common.Big1
+	}
+	fields := []struct {
+		field int64
+		value *big.Int
+	}{
+		{types.VeNftLockAmount, locked.Amount.BigInt()},
+		{types.VeNftLockEnd, new(big.Int).SetUint64(locked.End)},
+		{types.VeNftLockIsPermanent, isPermanent},
+		{types.VeNftLockBias, point.Bias.BigInt()},
+		{types.VeNftLockSlope, point.Slope.BigInt()},
+		{types.VeNftLockTimestamp, new(big.Int).SetUint64(point.Timestamp)},
+		{types.VeNftLockPermanent, point.Permanent.BigInt()},
+	}
+	for _, f := range fields {
+		k.setVeNftContractState(ctx, contract, types.VeNftLockSlot(veID, f.field), common.BigToHash(f.value))
+	}
+}
+
+func (k Keeper) addVeNftContractBalance(ctx sdk.Context, contract, owner common.Address, delta int64) {
+	slot := types.VeNftBalanceSlot(owner)
+	balance := (*k.evmKeeper).GetState(ctx, contract, slot).Big()
+	k.setVeNftContractState(ctx, contract, slot, common.BigToHash(balance.Add(balance, big.NewInt(delta))))
+}
+
+// setVeNftContractState writes a slot of the ve NFT contract, deleting it if
+// the value is zero, as the EVM does.
+func (k Keeper) setVeNftContractState(ctx sdk.Context, contract common.Address, key common.Hash, value common.Hash) {
+	var bz []byte
+	if value != (common.Hash{}) {
+		bz = value.Bytes()
+	}
+	(*k.evmKeeper).SetState(ctx, contract, key, bz)
+}
+
+// transferVeFromContract syncs a transfer made by the ve NFT contract into the
+// nft module. It is subject to the same check as the nft Send message, and
+// also clears the vote delegation of the ve.
+func (k Keeper) transferVeFromContract(ctx sdk.Context, from, to common.Address, tokenID *big.Int) error {
+	if !tokenID.IsUint64() || tokenID.Uint64() == types.EmptyVeID {
+		return sdkerrors.Wrapf(types.ErrInvalidVeID, "invalid ve id %s", tokenID)
+	}
+	veID := tokenID.Uint64()
+	nftID := types.VeIDFromUint64(veID)
+
+	sender, receiver := sdk.AccAddress(from.Bytes()), sdk.AccAddress(to.Bytes())
+	owner := k.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, nftID)
+	if !sender.Equals(owner) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, nftID)
+	}
+
+	err := k.CheckVeAttached(ctx, veID)
+	if err != nil {
+		return err
+	}
+
+	err = k.nftKeeper.Transfer(ctx, types.VeNftClass.Id, nftID, receiver)
+	if err != nil {
+		return err
+	}
+
+	// the new owner decides on the delegation
+	k.DeleteVoteDelegatee(ctx, veID)
+
+	return ctx.EventManager().EmitTypedEvent(&nfttypes.EventSend{
+		ClassId:  types.VeNftClass.Id,
+		Id:       nftID,
+		Sender:   sender.String(),
+		Receiver: receiver.String(),
+	})
+}
@@ -0,0 +1,192 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	nfttypes "github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/petri-labs/warmage/app"
+	"github.com/petri-labs/warmage/x/ve/keeper"
+	"github.com/petri-labs/warmage/x/ve/types"
+	"github.com/tharsis/ethermint/tests"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) callVeNftContract(from common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	contract, found := suite.app.VeKeeper.GetVeNftContract(suite.ctx)
+	suite.Require().True(found)
+	res, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, types.VeNftContractABI, from, contract, method, args...)
+	if err != nil {
+		return nil, err
+	}
+	if method == "transferFrom" {
+		// sync the transfer as done after an ethereum tx
+		receipt := &ethtypes.Receipt{Logs: evmtypes.LogsToEthereum(res.Logs)}
+		return nil, suite.app.VeKeeper.EvmHooks().PostTxProcessing(suite.ctx, nil, receipt)
+	}
+	return types.VeNftContractABI.Unpack(method, res.Ret)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_VeNftContract() {
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+	impl := keeper.NewMsgServerImpl(k)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	receiver := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	moduleAddr := common.BytesToAddress(suite.app.AccountKeeper.GetModuleAddress(types.ModuleName))
+
+	// deployed once at genesis
+	contract, found := k.GetVeNftContract(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(crypto.CreateAddress(moduleAddr, 0), contract)
+	_, err := k.DeployVeNftContract(suite.ctx)
+	suite.Require().Error(err)
+
+	// enough to have voting power
+	err = app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(sdk.NewCoin("amage", sdk.NewIntWithDecimal(10, 18))))
+	suite.Require().NoError(err)
+
+	_, err = impl.Create(sdk.WrapSDKContext(suite.ctx), &types.MsgCreate{
+		Sender:       sender.String(),
+		Amount:       sdk.NewCoin("amage", sdk.NewIntWithDecimal(5, 18)),
+		LockDuration: types.MaxLockTime,
+	})
+	suite.Require().NoError(err)
+
+	// existing ve are mirrored at deployment, as done by the store migration
+	suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)).Delete(types.VeNftContractKey())
+	contract, err = k.DeployVeNftContract(suite.ctx)
+	suite.Require().NoError(err)
+	stored, found := k.GetVeNftContract(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(stored, contract)
+	suite.Require().Equal(crypto.CreateAddress(moduleAddr, 1), contract)
+	res, err := suite.callVeNftContract(suite.address, "name")
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.VeNftClass.Name, res[0])
+
+	res, err = suite.callVeNftContract(suite.address, "ownerOf", big.NewInt(1))
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.address, res[0])
+	res, err = suite.callVeNftContract(suite.address, "balanceOf", suite.address)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(1), res[0])
+	res, err = suite.callVeNftContract(suite.address, "locked", big.NewInt(1))
+	suite.Require().NoError(err)
+	locked := k.GetLockedAmountByUser(suite.ctx, 1)
+	suite.Require().Equal([]interface{}{locked.Amount.BigInt(), new(big.Int).SetUint64(locked.End), false}, res)
+	res, err = suite.callVeNftContract(suite.address, "balanceOfNFT", big.NewInt(1))
+	suite.Require().NoError(err)
+	suite.Require().True(res[0].(*big.Int).Sign() > 0)
+	suite.Require().Equal(k.GetVotingPower(suite.ctx, 1, uint64(suite.ctx.BlockTime().Unix()), 0).BigInt(), res[0])
+
+	// new ve and deposits are mirrored
+	_, err = impl.Create(sdk.WrapSDKContext(suite.ctx), &types.MsgCreate{
+		Sender:       sender.String(),
+		Amount:       sdk.NewCoin("amage", sdk.NewIntWithDecimal(1, 18)),
+		LockDuration: types.MaxLockTime,
+	})
+	suite.Require().NoError(err)
+	_, err = impl.Deposit(sdk.WrapSDKContext(suite.ctx), &types.MsgDeposit{
+		Sender: sender.String(),
+		VeId:   "ve-2",
+		Amount: sdk.NewCoin("amage", sdk.NewIntWithDecimal(1, 18)),
+	})
+	suite.Require().NoError(err)
+	res, err = suite.callVeNftContract(suite.address, "balanceOf", suite.address)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(2), res[0])
+	res, err = suite.callVeNftContract(suite.address, "locked", big.NewInt(2))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewIntWithDecimal(2, 18).BigInt(), res[0])
+
+	// nft Send is mirrored
+	_, err = suite.app.NftKeeper.Send(sdk.WrapSDKContext(suite.ctx), &nfttypes.MsgSend{
+		ClassId:  types.VeNftClass.Id,
+		Id:       "ve-2",
+		Sender:   sender.String(),
+		Receiver: receiver.String(),
+	})
+	suite.Require().NoError(err)
+	res, err = suite.callVeNftContract(suite.address, "ownerOf", big.NewInt(2))
+	suite.Require().NoError(err)
+	suite.Require().Equal(common.BytesToAddress(receiver), res[0])
+
+	// merging burns the ve, which is cleared
+	_, err = suite.app.NftKeeper.Send(sdk.WrapSDKContext(suite.ctx), &nfttypes.MsgSend{
+		ClassId:  types.VeNftClass.Id,
+		Id:       "ve-2",
+		Sender:   receiver.String(),
+		Receiver: sender.String(),
+	})
+	suite.Require().NoError(err)
+	_, err = impl.Merge(sdk.WrapSDKContext(suite.ctx), &types.MsgMerge{
+		Sender:   sender.String(),
+		FromVeId: "ve-2",
+		ToVeId:   "ve-1",
+	})
+	suite.Require().NoError(err)
+	_, err = suite.callVeNftContract(suite.address, "ownerOf", big.NewInt(2))
+	suite.Require().Error(err)
+	res, err = suite.callVeNftContract(suite.address, "balanceOf", suite.address)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(1), res[0])
+	res, err = suite.callVeNftContract(suite.address, "locked", big.NewInt(1))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewIntWithDecimal(7, 18).BigInt(), res[0])
+
+	// transfers of the contract are synced into the nft module
+	k.SetVoteDelegatee(suite.ctx, 1, receiver)
+	_, err = suite.callVeNftContract(suite.address, "transferFrom", suite.address, common.BytesToAddress(receiver), big.NewInt(1))
+	suite.Require().NoError(err)
+	suite.Require().Equal(receiver, suite.app.NftKeeper.GetOwner(suite.ctx, types.VeNftClass.Id, "ve-1"))
+	suite.Require().Nil(k.GetVoteDelegatee(suite.ctx, 1))
+}
+
+func (suite *KeeperTestSuite) TestEvmHooks_PostTxProcessing() {
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+	impl := keeper.NewMsgServerImpl(k)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	receiver := tests.GenerateAddress()
+	_, err := impl.Create(sdk.WrapSDKContext(suite.ctx), &types.MsgCreate{
+		Sender:       sender.String(),
+		Amount:       sdk.NewCoin("amage", sdk.NewInt(1000)),
+		LockDuration: types.MaxLockTime,
+	})
+	suite.Require().NoError(err)
+	contract, found := k.GetVeNftContract(suite.ctx)
+	suite.Require().True(found)
+
+	transfer := types.VeNftContractABI.Events[types.ERC721EventTransfer].ID
+	newReceipt := func(address, from common.Address, tokenID int64) *ethtypes.Receipt {
+		return &ethtypes.Receipt{Logs: []*ethtypes.Log{{
+			Address: address,
+			Topics:  []common.Hash{transfer, from.Hash(), receiver.Hash(), common.BigToHash(big.NewInt(tokenID))},
+		}}}
+	}
+
+	// logs of other contracts are ignored
+	err = k.EvmHooks().PostTxProcessing(suite.ctx, nil, newReceipt(tests.GenerateAddress(), suite.address, 1))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sender, suite.app.NftKeeper.GetOwner(suite.ctx, types.VeNftClass.Id, "ve-1"))
+
+	// the same check as the nft Send message
+	k.SetVeVoted(suite.ctx, 1, true)
+	err = k.EvmHooks().PostTxProcessing(suite.ctx, nil, newReceipt(contract, suite.address, 1))
+	suite.Require().ErrorIs(err, types.ErrVeAttached)
+	k.SetVeVoted(suite.ctx, 1, false)
+
+	err = k.EvmHooks().PostTxProcessing(suite.ctx, nil, newReceipt(contract, receiver, 1))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	err = k.EvmHooks().PostTxProcessing(suite.ctx, nil, newReceipt(contract, suite.address, 2))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	err = k.EvmHooks().PostTxProcessing(suite.ctx, nil, newReceipt(contract, suite.address, 1))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.AccAddress(receiver.Bytes()), suite.app.NftKeeper.GetOwner(suite.ctx, types.VeNftClass.Id, "ve-1"))
+}
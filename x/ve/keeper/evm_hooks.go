@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/petri-labs/warmage/x/ve/types"
+)
+
+// EvmHooks wrapper struct for ve keeper
+type EvmHooks struct {
+	k Keeper
+}
+
+var _ evmtypes.EvmHooks = EvmHooks{}
+
+// EvmHooks returns the wrapper struct
+func (k Keeper) EvmHooks() EvmHooks {
+	return EvmHooks{k}
+}
+
+// PostTxProcessing implements evmtypes.EvmHooks.PostTxProcessing.
+// Transfers made by the ve NFT contract are synced into the nft module, and
+// any failing one reverts the transaction.
+func (h EvmHooks) PostTxProcessing(
+	ctx sdk.Context,
+	msg core.Message,
+	receipt *ethtypes.Receipt,
+) error {
+	contract, found := h.k.GetVeNftContract(ctx)
+	if !found {
+		return nil
+	}
+
+	transfer := types.VeNftContractABI.Events[types.ERC721EventTransfer].ID
+
+	// We only care about event Transfer(from, to, tokenId) of the ve NFT contract
+	for i, log := range receipt.Logs {
+		if log.Address != contract || len(log.Topics) != 4 || log.Topics[0] != transfer {
+			continue
+		}
+
+		from := common.BytesToAddress(log.Topics[1].Bytes())
+		to := common.BytesToAddress(log.Topics[2].Bytes())
+		tokenID := log.Topics[3].Big()
+
+		if err := h.k.transferVeFromContract(ctx, from, to, tokenID); err != nil {
+			h.k.Logger(ctx).Error(
+				"failed to process EVM hook for ve NFT transfer",
+				"txHash", receipt.TxHash.Hex(), "logIndex", i,
+				"tokenId", tokenID, "error", err.Error(),
+			)
+			return err
+		}
+	}
+
+	return nil
+}
@@ -3,8 +3,10 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 	"github.com/petri-labs/warmage/x/ve/types"
 	"google.golang.org/grpc/codes"
@@ -60,6 +62,10 @@ func (k Keeper) GetTotalVotingPower(ctx sdk.Context, atTime uint64, atBlock int6
 			}
 
 			power = point.Bias.Sub(point.Slope.MulRaw(dt))
+			if power.IsNegative() {
+				power = sdk.ZeroInt()
+			}
+			power = power.Add(point.Permanent)
 
 		} else {
 			// in the future
@@ -82,6 +88,10 @@ func (k Keeper) GetTotalVotingPower(ctx sdk.Context, atTime uint64, atBlock int6
 			}
 
 			power = pointLast.Bias
+			if power.IsNegative() {
+				power = sdk.ZeroInt()
+			}
+			power = power.Add(pointLast.Permanent)
 		}
 
 	} else if atBlock > 0 {
@@ -99,13 +109,13 @@ func (k Keeper) GetTotalVotingPower(ctx sdk.Context, atTime uint64, atBlock int6
 		}
 
 		power = point.Bias.Sub(point.Slope.MulRaw(dt))
+		if power.IsNegative() {
+			power = sdk.ZeroInt()
+		}
+		power = power.Add(point.Permanent)
 
 	}
 
-	if power.IsNegative() {
-		power = sdk.ZeroInt()
-	}
-
 	return power
 }
 
@@ -156,6 +166,11 @@ func (k Keeper) GetVotingPower(ctx sdk.Context, veID uint64, atTime uint64, atBl
 			// in the future
 		}
 		power = userPoint.Bias.Sub(userPoint.Slope.MulRaw(int64(atTime - userPoint.Timestamp)))
+		if power.IsNegative() {
+			power = sdk.ZeroInt()
+		}
+		// permanent lock does not decay
+		power = power.Add(userPoint.Permanent)
 
 	} else if atBlock > 0 {
 		// find timestamp through system checkpoint history
@@ -181,14 +196,151 @@ func (k Keeper) GetVotingPower(ctx sdk.Context, veID uint64, atTime uint64, atBl
 		userPoint := k.GetUserCheckpoint(ctx, veID, targetUserEpoch)
 
 		power = userPoint.Bias.Sub(userPoint.Slope.MulRaw(int64(blockTimestamp - userPoint.Timestamp)))
+		if power.IsNegative() {
+			power = sdk.ZeroInt()
+		}
+		power = power.Add(userPoint.Permanent)
 
 	}
 
-	if power.IsNegative() {
-		power = sdk.ZeroInt()
+	return power
+}
+
+func (k Keeper) TotalVotingPowerSeries(c context.Context, msg *types.QueryTotalVotingPowerSeriesRequest) (*types.QueryTotalVotingPowerSeriesResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
+	ctx := sdk.UnwrapSDKContext(c)
 
-	return power
+	if msg.FromTime == 0 || msg.ToTime < msg.FromTime {
+		return nil, status.Error(codes.InvalidArgument, "invalid time range")
+	}
+	if msg.Step == 0 {
+		return nil, status.Error(codes.InvalidArgument, "step cannot be zero")
+	}
+	// voting power is zero after the max lock time, except the permanent amount
+	if msg.ToTime > uint64(ctx.BlockTime().Unix())+types.MaxLockTime {
+		return nil, status.Error(codes.InvalidArgument, "to time cannot exceed the max lock time from now")
+	}
+	count := (msg.ToTime-msg.FromTime)/msg.Step + 1
+	if count > types.MaxVotingPowerSeriesPoints {
+		return nil, status.Errorf(codes.InvalidArgument, "series cannot exceed %d points", types.MaxVotingPowerSeriesPoints)
+	}
+
+	powers := make([]types.TimedVotingPower, 0, count)
+	for i := uint64(0); i < count; i++ {
+		t := msg.FromTime + i*msg.Step
+		powers = append(powers, types.TimedVotingPower{
+			Timestamp: t,
+			Power:     k.GetTotalVotingPower(ctx, t, 0),
+		})
+	}
+
+	return &types.QueryTotalVotingPowerSeriesResponse{Powers: powers}, nil
+}
+
+func (k Keeper) Checkpoints(c context.Context, msg *types.QueryCheckpointsRequest) (*types.QueryCheckpointsResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if msg.ToEpoch != 0 && msg.ToEpoch < msg.FromEpoch {
+		return nil, status.Error(codes.InvalidArgument, "invalid epoch range")
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPointHistoryByEpoch)
+
+	var checkpoints []types.EpochCheckpoint
+	pageRes, err := query.FilteredPaginate(store, msg.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		epoch := sdk.BigEndianToUint64(key)
+		if epoch < msg.FromEpoch || (msg.ToEpoch != 0 && epoch > msg.ToEpoch) {
+			return false, nil
+		}
+		if accumulate {
+			checkpoints = append(checkpoints, types.EpochCheckpoint{
+				Epoch:      epoch,
+				Checkpoint: k.GetCheckpoint(ctx, epoch),
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCheckpointsResponse{
+		Checkpoints: checkpoints,
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (k Keeper) UserCheckpoints(c context.Context, msg *types.QueryUserCheckpointsRequest) (*types.QueryUserCheckpointsResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	veID := types.Uint64FromVeID(msg.VeId)
+	if veID == types.EmptyVeID {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidVeID, "invalid ve id: %s", msg.VeId)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserPointsKey(veID))
+
+	var checkpoints []types.EpochCheckpoint
+	pageRes, err := query.Paginate(store, msg.Pagination, func(key, _ []byte) error {
+		epoch := sdk.BigEndianToUint64(key)
+		checkpoints = append(checkpoints, types.EpochCheckpoint{
+			Epoch:      epoch,
+			Checkpoint: k.GetUserCheckpoint(ctx, veID, epoch),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUserCheckpointsResponse{
+		Checkpoints: checkpoints,
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (k Keeper) SlopeChanges(c context.Context, msg *types.QuerySlopeChangesRequest) (*types.QuerySlopeChangesResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if msg.ToTime != 0 && msg.ToTime < msg.FromTime {
+		return nil, status.Error(codes.InvalidArgument, "invalid time range")
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSlopeChange)
+
+	var slopeChanges []types.SlopeChange
+	pageRes, err := query.FilteredPaginate(store, msg.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		timestamp := sdk.BigEndianToUint64(key)
+		if timestamp < msg.FromTime || (msg.ToTime != 0 && timestamp > msg.ToTime) {
+			return false, nil
+		}
+		if accumulate {
+			slopeChanges = append(slopeChanges, types.SlopeChange{
+				Timestamp:   timestamp,
+				SlopeChange: k.GetSlopeChange(ctx, timestamp),
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlopeChangesResponse{
+		SlopeChanges: slopeChanges,
+		Pagination:   pageRes,
+	}, nil
 }
 
 func (k Keeper) VeNfts(c context.Context, msg *types.QueryVeNftsRequest) (*types.QueryVeNftsResponse, error) {
@@ -242,6 +394,122 @@ func (k Keeper) VeNft(c context.Context, msg *types.QueryVeNftRequest) (*types.Q
 	return &types.QueryVeNftResponse{Nft: nft}, nil
 }
 
+func (k Keeper) VeInfo(c context.Context, msg *types.QueryVeInfoRequest) (*types.QueryVeInfoResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := validateHistoricalQuery(ctx, msg.AtTime, msg.AtBlock); err != nil {
+		return nil, err
+	}
+
+	owner := k.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
+	if owner.Empty() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidVeID, "invalid ve id: %s", msg.VeId)
+	}
+
+	return &types.QueryVeInfoResponse{
+		Info: k.getVeInfo(ctx, msg.VeId, owner, msg.AtTime, msg.AtBlock),
+	}, nil
+}
+
+func (k Keeper) VeInfos(c context.Context, msg *types.QueryVeInfosRequest) (*types.QueryVeInfosResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := validateHistoricalQuery(ctx, msg.AtTime, msg.AtBlock); err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	nftsResponse, err := k.nftKeeper.NFTs(c, &nft.QueryNFTsRequest{
+		ClassId:    types.VeNftClass.Id,
+		Owner:      msg.Owner,
+		Pagination: msg.Pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]types.VeInfo, 0, len(nftsResponse.Nfts))
+	for _, nft := range nftsResponse.Nfts {
+		infos = append(infos, k.getVeInfo(ctx, nft.Id, owner, msg.AtTime, msg.AtBlock))
+	}
+
+	return &types.QueryVeInfosResponse{
+		Infos:      infos,
+		Pagination: nftsResponse.Pagination,
+	}, nil
+}
+
+func (k Keeper) getVeInfo(ctx sdk.Context, id string, owner sdk.AccAddress, atTime uint64, atBlock int64) types.VeInfo {
+	veID := types.Uint64FromVeID(id)
+
+	historicalPower := sdk.ZeroInt()
+	if atTime > 0 || atBlock > 0 {
+		historicalPower = k.GetVotingPower(ctx, veID, atTime, atBlock)
+	}
+
+	return types.VeInfo{
+		VeId:                  id,
+		Owner:                 owner.String(),
+		Locked:                k.GetLockedAmountByUser(ctx, veID),
+		VotingPower:           k.GetVotingPower(ctx, veID, 0, ctx.BlockHeight()),
+		HistoricalVotingPower: historicalPower,
+		UserEpoch:             k.GetUserEpoch(ctx, veID),
+		Attached:              k.GetVeAttached(ctx, veID),
+		Voted:                 k.GetVeVoted(ctx, veID),
+		DelegatedAmount:       k.GetDelegatedAmountByUser(ctx, veID),
+		Claimable:             NewDistributor(k).Claimable(ctx, veID),
+	}
+}
+
+// validateHistoricalQuery validates the optional time or block of a historical query
+func validateHistoricalQuery(ctx sdk.Context, atTime uint64, atBlock int64) error {
+	if atTime > 0 && atBlock > 0 {
+		return status.Error(codes.InvalidArgument, "at time and at block cannot both be specified")
+	}
+	if atBlock > ctx.BlockHeight() {
+		return status.Error(codes.InvalidArgument, "invalid block")
+	}
+	return nil
+}
+
+func (k Keeper) DelegatedVes(c context.Context, msg *types.QueryDelegatedVesRequest) (*types.QueryDelegatedVesResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delegatee, err := sdk.AccAddressFromBech32(msg.Delegatee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteDelegationsByDelegateeKey(delegatee))
+
+	var veIDs []string
+	pageRes, err := query.Paginate(store, msg.Pagination, func(key, _ []byte) error {
+		veIDs = append(veIDs, types.VeIDFromUint64(sdk.BigEndianToUint64(key)))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegatedVesResponse{
+		VeIds:      veIDs,
+		Pagination: pageRes,
+	}, nil
+}
+
 func (k Keeper) Params(c context.Context, msg *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if msg == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -1,10 +1,15 @@
 package keeper_test
 
 import (
+	"math"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/petri-labs/warmage/app"
+	"github.com/petri-labs/warmage/x/ve/keeper"
 	"github.com/petri-labs/warmage/x/ve/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -114,6 +119,214 @@ func (suite *KeeperTestSuite) TestKeeper_GetVotingPower() {
 	suite.Equal(sdk.ZeroInt(), power)
 }
 
+func (suite *KeeperTestSuite) TestKeeper_TotalVotingPowerSeries() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := k.TotalVotingPowerSeries(ctx, nil)
+	require.Nil(res)
+	require.Error(err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	now := uint64(suite.ctx.BlockTime().Unix())
+	for _, msg := range []*types.QueryTotalVotingPowerSeriesRequest{
+		{FromTime: 0, ToTime: now, Step: 1},
+		{FromTime: now, ToTime: now - 1, Step: 1},
+		{FromTime: now, ToTime: now, Step: 0},
+		{FromTime: now, ToTime: now + types.MaxVotingPowerSeriesPoints, Step: 1},
+		{FromTime: now, ToTime: now + types.MaxLockTime + 1, Step: types.MaxLockTime},
+		{FromTime: math.MaxUint64 - 10, ToTime: math.MaxUint64 - 1, Step: 5},
+	} {
+		_, err = k.TotalVotingPowerSeries(ctx, msg)
+		require.Error(err)
+	}
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	amount := sdk.NewCoin("amage", sdk.NewIntWithDecimal(1, 18))
+	err = app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(amount))
+	require.NoError(err)
+	_, err = keeper.NewMsgServerImpl(k).Create(ctx, &types.MsgCreate{
+		Sender:       sender.String(),
+		To:           sender.String(),
+		Amount:       amount,
+		LockDuration: 4 * types.RegulatedPeriod,
+	})
+	require.NoError(err)
+
+	res, err = k.TotalVotingPowerSeries(ctx, &types.QueryTotalVotingPowerSeriesRequest{
+		FromTime: now,
+		ToTime:   now + 10*types.RegulatedPeriod,
+		Step:     types.RegulatedPeriod,
+	})
+	require.NoError(err)
+	require.Len(res.Powers, 11)
+	for i, p := range res.Powers {
+		require.Equal(now+uint64(i)*types.RegulatedPeriod, p.Timestamp)
+		require.Equal(k.GetTotalVotingPower(suite.ctx, p.Timestamp, 0), p.Power)
+	}
+	require.True(res.Powers[0].Power.IsPositive())
+	require.True(res.Powers[1].Power.LT(res.Powers[0].Power))
+	require.True(res.Powers[10].Power.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestKeeper_Checkpoints() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := k.Checkpoints(ctx, nil)
+	require.Nil(res)
+	require.Error(err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	_, err = k.Checkpoints(ctx, &types.QueryCheckpointsRequest{FromEpoch: 2, ToEpoch: 1})
+	require.Error(err)
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	for i := 0; i < 3; i++ {
+		suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+		_, err = keeper.NewMsgServerImpl(k).Create(sdk.WrapSDKContext(suite.ctx), &types.MsgCreate{
+			Sender:       sender.String(),
+			To:           sender.String(),
+			Amount:       sdk.NewCoin("amage", sdk.NewInt(100)),
+			LockDuration: types.MaxLockTime,
+		})
+		require.NoError(err)
+	}
+	ctx = sdk.WrapSDKContext(suite.ctx)
+	epoch := k.GetEpoch(suite.ctx)
+	require.True(epoch >= 3)
+
+	res, err = k.Checkpoints(ctx, &types.QueryCheckpointsRequest{
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(err)
+	require.Equal(epoch+1, res.Pagination.Total)
+	for _, checkpoint := range res.Checkpoints {
+		require.Equal(k.GetCheckpoint(suite.ctx, checkpoint.Epoch), checkpoint.Checkpoint)
+	}
+
+	res, err = k.Checkpoints(ctx, &types.QueryCheckpointsRequest{
+		FromEpoch:  1,
+		ToEpoch:    epoch - 1,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Equal(epoch-1, res.Pagination.Total)
+	require.Len(res.Checkpoints, 1)
+	require.Equal(uint64(1), res.Checkpoints[0].Epoch)
+
+	res, err = k.Checkpoints(ctx, &types.QueryCheckpointsRequest{
+		FromEpoch: epoch,
+	})
+	require.NoError(err)
+	require.Len(res.Checkpoints, 1)
+	require.Equal(epoch, res.Checkpoints[0].Epoch)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_UserCheckpoints() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	impl := keeper.NewMsgServerImpl(k)
+
+	res, err := k.UserCheckpoints(ctx, nil)
+	require.Nil(res)
+	require.Error(err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	_, err = k.UserCheckpoints(ctx, &types.QueryUserCheckpointsRequest{VeId: "xxx"})
+	require.ErrorIs(err, types.ErrInvalidVeID)
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	for i := 0; i < 2; i++ {
+		_, err = impl.Create(ctx, &types.MsgCreate{
+			Sender:       sender.String(),
+			To:           sender.String(),
+			Amount:       sdk.NewCoin("amage", sdk.NewInt(100)),
+			LockDuration: types.MaxLockTime,
+		})
+		require.NoError(err)
+	}
+	_, err = impl.Deposit(ctx, &types.MsgDeposit{
+		Sender: sender.String(),
+		VeId:   "ve-1",
+		Amount: sdk.NewCoin("amage", sdk.NewInt(100)),
+	})
+	require.NoError(err)
+
+	res, err = k.UserCheckpoints(ctx, &types.QueryUserCheckpointsRequest{
+		VeId:       "ve-1",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(err)
+	require.Equal(uint64(2), res.Pagination.Total)
+	require.Equal(uint64(2), k.GetUserEpoch(suite.ctx, 1))
+	for i, checkpoint := range res.Checkpoints {
+		require.Equal(uint64(i+1), checkpoint.Epoch)
+		require.Equal(k.GetUserCheckpoint(suite.ctx, 1, checkpoint.Epoch), checkpoint.Checkpoint)
+	}
+
+	res, err = k.UserCheckpoints(ctx, &types.QueryUserCheckpointsRequest{VeId: "ve-2"})
+	require.NoError(err)
+	require.Len(res.Checkpoints, 1)
+
+	res, err = k.UserCheckpoints(ctx, &types.QueryUserCheckpointsRequest{VeId: "ve-3"})
+	require.NoError(err)
+	require.Empty(res.Checkpoints)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_SlopeChanges() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := k.SlopeChanges(ctx, nil)
+	require.Nil(res)
+	require.Error(err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	_, err = k.SlopeChanges(ctx, &types.QuerySlopeChangesRequest{FromTime: 2, ToTime: 1})
+	require.Error(err)
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	amount := sdk.NewCoin("amage", sdk.NewIntWithDecimal(1, 18))
+	err = app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(amount.Add(amount).Add(amount)))
+	require.NoError(err)
+	var ends []uint64
+	for i := 1; i <= 3; i++ {
+		_, err = keeper.NewMsgServerImpl(k).Create(ctx, &types.MsgCreate{
+			Sender:       sender.String(),
+			To:           sender.String(),
+			Amount:       amount,
+			LockDuration: uint64(i) * types.RegulatedPeriod,
+		})
+		require.NoError(err)
+		ends = append(ends, k.GetLockedAmountByUser(suite.ctx, uint64(i)).End)
+	}
+
+	res, err = k.SlopeChanges(ctx, &types.QuerySlopeChangesRequest{
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(err)
+	require.Equal(uint64(3), res.Pagination.Total)
+	for i, slopeChange := range res.SlopeChanges {
+		require.Equal(ends[i], slopeChange.Timestamp)
+		require.Equal(k.GetSlopeChange(suite.ctx, ends[i]), slopeChange.SlopeChange)
+		require.True(slopeChange.SlopeChange.IsNegative())
+	}
+
+	res, err = k.SlopeChanges(ctx, &types.QuerySlopeChangesRequest{
+		FromTime: ends[1],
+		ToTime:   ends[2],
+	})
+	require.NoError(err)
+	require.Len(res.SlopeChanges, 2)
+	require.Equal(ends[1], res.SlopeChanges[0].Timestamp)
+	require.Equal(ends[2], res.SlopeChanges[1].Timestamp)
+}
+
 func (suite *KeeperTestSuite) TestKeeper_VeNfts() {
 	suite.SetupTest()
 	k := suite.app.VeKeeper
@@ -167,6 +380,144 @@ func (suite *KeeperTestSuite) TestKeeper_VeNft() {
 	suite.Require().Equal(res.Nft.ClassId, types.VeNftClass.Id)
 }
 
+func (suite *KeeperTestSuite) TestKeeper_VeInfo() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := k.VeInfo(ctx, nil)
+	require.Nil(res)
+	require.Error(err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	_, err = k.VeInfo(ctx, &types.QueryVeInfoRequest{VeId: "ve-1"})
+	require.ErrorIs(err, types.ErrInvalidVeID)
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	amount := sdk.NewCoin("amage", sdk.NewIntWithDecimal(1, 18))
+	err = app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(amount))
+	require.NoError(err)
+	_, err = keeper.NewMsgServerImpl(k).Create(ctx, &types.MsgCreate{
+		Sender:       sender.String(),
+		To:           sender.String(),
+		Amount:       amount,
+		LockDuration: types.MaxLockTime,
+	})
+	require.NoError(err)
+
+	_, err = k.VeInfo(ctx, &types.QueryVeInfoRequest{VeId: "ve-1", AtTime: 1, AtBlock: 1})
+	require.Error(err)
+	_, err = k.VeInfo(ctx, &types.QueryVeInfoRequest{VeId: "ve-1", AtBlock: suite.ctx.BlockHeight() + 1})
+	require.Error(err)
+
+	res, err = k.VeInfo(ctx, &types.QueryVeInfoRequest{VeId: "ve-1"})
+	require.NoError(err)
+	info := res.Info
+	require.Equal("ve-1", info.VeId)
+	require.Equal(sender.String(), info.Owner)
+	require.Equal(k.GetLockedAmountByUser(suite.ctx, 1), info.Locked)
+	require.Equal(amount.Amount, info.Locked.Amount)
+	require.True(info.VotingPower.IsPositive())
+	require.Equal(k.GetVotingPower(suite.ctx, 1, 0, suite.ctx.BlockHeight()), info.VotingPower)
+	require.Equal(sdk.ZeroInt(), info.HistoricalVotingPower)
+	require.Equal(uint64(1), info.UserEpoch)
+	require.Equal(uint64(0), info.Attached)
+	require.False(info.Voted)
+	require.Equal(sdk.ZeroInt(), info.DelegatedAmount)
+	require.Equal(sdk.ZeroInt(), info.Claimable)
+
+	k.SetVeAttached(suite.ctx, 1, 2)
+	k.SetVeVoted(suite.ctx, 1, true)
+	suite.app.StakingKeeper.SetVeDelegatedAmount(suite.ctx, 1, sdk.NewInt(100))
+	atTime := uint64(suite.ctx.BlockTime().Unix()) + types.RegulatedPeriod
+	res, err = k.VeInfo(ctx, &types.QueryVeInfoRequest{VeId: "ve-1", AtTime: atTime})
+	require.NoError(err)
+	info = res.Info
+	require.Equal(k.GetVotingPower(suite.ctx, 1, atTime, 0), info.HistoricalVotingPower)
+	require.True(info.HistoricalVotingPower.LT(info.VotingPower))
+	require.Equal(uint64(2), info.Attached)
+	require.True(info.Voted)
+	require.Equal(sdk.NewInt(100), info.DelegatedAmount)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_VeInfos() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := k.VeInfos(ctx, nil)
+	require.Nil(res)
+	require.Error(err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	_, err = k.VeInfos(ctx, &types.QueryVeInfosRequest{Owner: "xxx"})
+	require.Error(err)
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	for i := 0; i < 3; i++ {
+		_, err = keeper.NewMsgServerImpl(k).Create(ctx, &types.MsgCreate{
+			Sender:       sender.String(),
+			To:           sender.String(),
+			Amount:       sdk.NewCoin("amage", sdk.NewInt(100)),
+			LockDuration: types.MaxLockTime,
+		})
+		require.NoError(err)
+	}
+
+	res, err = k.VeInfos(ctx, &types.QueryVeInfosRequest{
+		Owner:      sender.String(),
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Equal(uint64(3), res.Pagination.Total)
+	require.Len(res.Infos, 2)
+	require.Equal("ve-1", res.Infos[0].VeId)
+	require.Equal("ve-2", res.Infos[1].VeId)
+	require.Equal(sdk.NewInt(100), res.Infos[1].Locked.Amount)
+
+	res, err = k.VeInfos(ctx, &types.QueryVeInfosRequest{
+		Owner:      sender.String(),
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Len(res.Infos, 1)
+	require.Equal("ve-3", res.Infos[0].VeId)
+	require.Equal(sender.String(), res.Infos[0].Owner)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DelegatedVes() {
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+
+	res, err := k.DelegatedVes(sdk.WrapSDKContext(suite.ctx), nil)
+	suite.Require().Nil(res)
+	suite.Require().Error(err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	res, err = k.DelegatedVes(sdk.WrapSDKContext(suite.ctx), &types.QueryDelegatedVesRequest{Delegatee: "xxx"})
+	suite.Require().Nil(res)
+	suite.Require().Error(err)
+
+	delegatee := sdk.AccAddress(suite.address.Bytes())
+	for veID := uint64(1); veID <= 3; veID++ {
+		k.SetVoteDelegatee(suite.ctx, veID, delegatee)
+	}
+
+	res, err = k.DelegatedVes(sdk.WrapSDKContext(suite.ctx), &types.QueryDelegatedVesRequest{
+		Delegatee:  delegatee.String(),
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"ve-1", "ve-2"}, res.VeIds)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	res, err = k.DelegatedVes(sdk.WrapSDKContext(suite.ctx), &types.QueryDelegatedVesRequest{
+		Delegatee:  delegatee.String(),
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"ve-3"}, res.VeIds)
+}
+
 func (suite *KeeperTestSuite) TestKeeper_Params() {
 	suite.SetupTest()
 	k := suite.app.VeKeeper
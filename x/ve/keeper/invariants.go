@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/petri-labs/warmage/x/ve/types"
+)
+
+// RegisterInvariants registers the ve module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-locked", TotalLockedInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "nft-locked", NftLockedInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "voting-power", VotingPowerInvariant(k))
+}
+
+// AllInvariants runs all invariants of the ve module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			TotalLockedInvariant(k),
+			NftLockedInvariant(k),
+			VotingPowerInvariant(k),
+		} {
+			res, stop := inv(ctx)
+			if stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// TotalLockedInvariant checks that the total locked amount of each lockable
+// denom equals both the sum of the locked amounts of all ve and the module
+// balance, since amounts delegated for staking stay in the module account.
+func TotalLockedInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		sums := make(map[string]sdk.Int)
+		params := k.GetParams(ctx)
+		sums[params.LockDenom] = sdk.ZeroInt()
+		for _, w := range params.LockDenomWeights {
+			sums[w.Denom] = sdk.ZeroInt()
+		}
+		k.IterateLockedAmountByUser(ctx, func(_ uint64, locked types.LockedBalance) bool {
+			if sum, ok := sums[locked.Denom]; ok {
+				sums[locked.Denom] = sum.Add(locked.Amount)
+			} else {
+				sums[locked.Denom] = locked.Amount
+			}
+			return false
+		})
+
+		denoms := make([]string, 0, len(sums))
+		for denom := range sums {
+			denoms = append(denoms, denom)
+		}
+		sort.Strings(denoms)
+
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		for _, denom := range denoms {
+			total := k.GetTotalLockedAmount(ctx, denom)
+			if !total.Equal(sums[denom]) {
+				count++
+				msg += fmt.Sprintf("\ttotal locked amount of %s is unequal to ve: %s != %s\n", denom, total, sums[denom])
+			}
+			balance := k.bankKeeper.GetBalance(ctx, moduleAddr, denom)
+			if !balance.Amount.Equal(total) {
+				count++
+				msg += fmt.Sprintf("\tmodule balance of %s is unequal to total locked amount: %s != %s\n", denom, balance.Amount, total)
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "total-locked",
+			fmt.Sprintf("inconsistent total locked amounts found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// NftLockedInvariant checks that each minted ve NFT has a positive locked
+// amount, and that each locked amount belongs to a minted ve NFT.
+func NftLockedInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, token := range k.nftKeeper.GetNFTsOfClass(ctx, types.VeNftClass.Id) {
+			locked := k.GetLockedAmountByUser(ctx, types.Uint64FromVeID(token.Id))
+			if !locked.Amount.IsPositive() {
+				count++
+				msg += fmt.Sprintf("\tve %s has no locked amount\n", token.Id)
+			}
+		}
+		k.IterateLockedAmountByUser(ctx, func(veID uint64, _ types.LockedBalance) bool {
+			if !k.nftKeeper.HasNFT(ctx, types.VeNftClass.Id, types.VeIDFromUint64(veID)) {
+				count++
+				msg += fmt.Sprintf("\tlocked amount of ve %s has no nft\n", types.VeIDFromUint64(veID))
+			}
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "nft-locked",
+			fmt.Sprintf("inconsistent ve nfts found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// VotingPowerInvariant checks that the total voting power at the block time
+// equals the sum of the voting power of all ve at the block time. The global
+// checkpoint is clamped at zero rather than going negative, so any drift from
+// the user checkpoints shows up as a mismatch here.
+func VotingPowerInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+
+		// no ve has been locked before the first checkpoint
+		if k.GetEpoch(ctx) == 0 {
+			return sdk.FormatInvariant(types.ModuleName, "voting-power", "no checkpoint yet\n"), false
+		}
+
+		now := uint64(ctx.BlockTime().Unix())
+		sum := sdk.ZeroInt()
+		for _, token := range k.nftKeeper.GetNFTsOfClass(ctx, types.VeNftClass.Id) {
+			sum = sum.Add(k.GetVotingPower(ctx, types.Uint64FromVeID(token.Id), now, 0))
+		}
+		total := k.GetTotalVotingPower(ctx, now, 0)
+
+		broken := !total.Equal(sum)
+		if broken {
+			msg = fmt.Sprintf("	total voting power %s but sum of ve voting power %s\n", total, sum)
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "voting-power",
+			fmt.Sprintf("total voting power mismatch found\n%s", msg),
+		), broken
+	}
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/petri-labs/warmage/app"
+	"github.com/petri-labs/warmage/x/ve/keeper"
+	"github.com/petri-labs/warmage/x/ve/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		broken   bool
+	}{
+		{
+			name:   "consistent",
+			broken: false,
+		},
+		{
+			name: "total locked amount mismatch",
+			malleate: func() {
+				k := suite.app.VeKeeper
+				total := k.GetTotalLockedAmount(suite.ctx, "amage")
+				k.SetTotalLockedAmount(suite.ctx, "amage", total.AddRaw(1))
+			},
+			broken: true,
+		},
+		{
+			name: "module balance mismatch",
+			malleate: func() {
+				suite.fundModule(sdk.NewInt(1))
+			},
+			broken: true,
+		},
+		{
+			name: "locked amount without nft",
+			malleate: func() {
+				k := suite.app.VeKeeper
+				locked := k.GetLockedAmountByUser(suite.ctx, 1)
+				k.SetLockedAmountByUser(suite.ctx, 2, locked)
+				k.SetTotalLockedAmount(suite.ctx, "amage", locked.Amount.MulRaw(2))
+				suite.fundModule(locked.Amount)
+			},
+			broken: true,
+		},
+		{
+			name: "nft without locked amount",
+			malleate: func() {
+				k := suite.app.VeKeeper
+				locked := k.GetLockedAmountByUser(suite.ctx, 1)
+				k.DeleteLockedAmountByUser(suite.ctx, 1)
+				k.SetTotalLockedAmount(suite.ctx, "amage", sdk.ZeroInt())
+				err := suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sdk.AccAddress(suite.address.Bytes()), sdk.NewCoins(sdk.NewCoin("amage", locked.Amount)))
+				suite.Require().NoError(err)
+			},
+			broken: true,
+		},
+		{
+			name: "total voting power drift",
+			malleate: func() {
+				k := suite.app.VeKeeper
+				epoch := k.GetEpoch(suite.ctx)
+				point := k.GetCheckpoint(suite.ctx, epoch)
+				point.Bias = point.Bias.SubRaw(1)
+				k.SetCheckpoint(suite.ctx, epoch, point)
+			},
+			broken: true,
+		},
+		{
+			name: "total voting power drift clamped at zero",
+			malleate: func() {
+				k := suite.app.VeKeeper
+				epoch := k.GetEpoch(suite.ctx)
+				point := k.GetCheckpoint(suite.ctx, epoch)
+				point.Bias = sdk.ZeroInt()
+				k.SetCheckpoint(suite.ctx, epoch, point)
+			},
+			broken: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.setupInvariantTest()
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			_, broken := keeper.AllInvariants(suite.app.VeKeeper)(suite.ctx)
+			suite.Require().Equal(tc.broken, broken)
+		})
+	}
+}
+
+// setupInvariantTest creates a ve with consistent locked states.
+func (suite *KeeperTestSuite) setupInvariantTest() {
+	sender := sdk.AccAddress(suite.address.Bytes())
+	amount := sdk.NewCoin("amage", sdk.NewIntWithDecimal(1, 18))
+	err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(amount))
+	suite.Require().NoError(err)
+	_, err = keeper.NewMsgServerImpl(suite.app.VeKeeper).Create(sdk.WrapSDKContext(suite.ctx), &types.MsgCreate{
+		Sender:       sender.String(),
+		To:           sender.String(),
+		Amount:       amount,
+		LockDuration: types.MaxLockTime,
+	})
+	suite.Require().NoError(err)
+}
+
+// fundModule sends extra coins to the ve module account.
+func (suite *KeeperTestSuite) fundModule(amount sdk.Int) {
+	sender := sdk.AccAddress(suite.address.Bytes())
+	coins := sdk.NewCoins(sdk.NewCoin("amage", amount))
+	err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, coins)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromAccountToModule(suite.ctx, sender, types.ModuleName, coins)
+	suite.Require().NoError(err)
+}
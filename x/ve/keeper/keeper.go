@@ -22,7 +22,13 @@ type Keeper struct {
 	bankKeeper    types.BankKeeper
 	nftKeeper     types.NftKeeper
 
-	getDelegatedAmount func(ctx sdk.Context, veID uint64) sdk.Int
+	// getDelegatedAmount is shared by all copies of the keeper,
+	// since it is set by the staking keeper after they have been made
+	getDelegatedAmount *func(ctx sdk.Context, veID uint64) sdk.Int
+
+	// evmKeeper is shared by all copies of the keeper,
+	// since the EVM keeper is made after them
+	evmKeeper *types.EVMKeeper
 }
 
 // NewKeeper creates a new ve Keeper instance
@@ -53,6 +59,9 @@ func NewKeeper(
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
 		nftKeeper:     nftKeeper,
+
+		getDelegatedAmount: new(func(ctx sdk.Context, veID uint64) sdk.Int),
+		evmKeeper:          new(types.EVMKeeper),
 	}
 }
 
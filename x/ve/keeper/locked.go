@@ -1,21 +1,29 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/petri-labs/warmage/x/ve/types"
 )
 
-// SetTotalLockedAmount sets total locked amount
-func (k Keeper) SetTotalLockedAmount(ctx sdk.Context, amount sdk.Int) {
+// SetTotalLockedAmount sets total locked amount of the specified denom
+func (k Keeper) SetTotalLockedAmount(ctx sdk.Context, denom string, amount sdk.Int) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: amount})
-	store.Set(types.TotalLockedAmountKey(), bz)
+	store.Set(types.TotalLockedAmountKey(denom), bz)
+	if denom == k.LockDenom(ctx) {
+		store.Delete(types.LegacyTotalLockedAmountKey())
+	}
 }
 
-// GetTotalLockedAmount gets total locked amount
-func (k Keeper) GetTotalLockedAmount(ctx sdk.Context) sdk.Int {
+// GetTotalLockedAmount gets total locked amount of the specified denom
+func (k Keeper) GetTotalLockedAmount(ctx sdk.Context, denom string) sdk.Int {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.TotalLockedAmountKey())
+	bz := store.Get(types.TotalLockedAmountKey(denom))
+	// the total locked amount before other denoms were lockable is of the lock denom
+	if bz == nil && denom == k.LockDenom(ctx) {
+		bz = store.Get(types.LegacyTotalLockedAmountKey())
+	}
 	if bz == nil {
 		return sdk.ZeroInt()
 	}
@@ -24,6 +32,25 @@ func (k Keeper) GetTotalLockedAmount(ctx sdk.Context) sdk.Int {
 	return amount.Int
 }
 
+// IterateTotalLockedAmount iterates over the total locked amounts of all locked denoms
+func (k Keeper) IterateTotalLockedAmount(ctx sdk.Context, cb func(denom string, amount sdk.Int) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTotalLockedAmount)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key())
+		if denom == "" {
+			denom = k.LockDenom(ctx)
+		}
+		var amount sdk.IntProto
+		k.cdc.MustUnmarshal(iterator.Value(), &amount)
+		if cb(denom, amount.Int) {
+			break
+		}
+	}
+}
+
 // SetLockedAmountByUser sets locked amount of the specified ve
 func (k Keeper) SetLockedAmountByUser(ctx sdk.Context, veID uint64, amount types.LockedBalance) {
 	store := ctx.KVStore(k.storeKey)
@@ -40,11 +67,46 @@ func (k Keeper) GetLockedAmountByUser(ctx sdk.Context, veID uint64) types.Locked
 	}
 	var amount types.LockedBalance
 	k.cdc.MustUnmarshal(bz, &amount)
+	// locks created before other denoms were lockable lock the lock denom
+	if amount.Denom == "" {
+		amount.Denom = k.LockDenom(ctx)
+	}
+	if amount.Weight.IsNil() || amount.Weight.IsZero() {
+		amount.Weight = sdk.OneDec()
+	}
 	return amount
 }
 
+// IterateLockedAmountByUser iterates over the locked amounts of all ve
+func (k Keeper) IterateLockedAmountByUser(ctx sdk.Context, cb func(veID uint64, locked types.LockedBalance) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixLockedAmountByUser)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		veID := sdk.BigEndianToUint64(iterator.Key())
+		if cb(veID, k.GetLockedAmountByUser(ctx, veID)) {
+			break
+		}
+	}
+}
+
 // DeleteLockedAmountByUser deletes locked amount of the specified ve
 func (k Keeper) DeleteLockedAmountByUser(ctx sdk.Context, veID uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.LockedAmountByUserKey(veID))
 }
+
+// EarlyWithdrawPenalty gets the penalty for withdrawing the specified locked
+// before its unlocking time, which is proportional to the remaining locking
+// time and capped by the max early withdrawal penalty
+func (k Keeper) EarlyWithdrawPenalty(ctx sdk.Context, locked types.LockedBalance) sdk.Int {
+	now := uint64(ctx.BlockTime().Unix())
+	if locked.End <= now {
+		return sdk.ZeroInt()
+	}
+
+	ratio := sdk.NewDec(int64(locked.End - now)).QuoInt64(types.MaxLockTime)
+	ratio = sdk.MinDec(ratio, k.MaxEarlyWithdrawPenalty(ctx))
+	return locked.Amount.ToDec().Mul(ratio).TruncateInt()
+}
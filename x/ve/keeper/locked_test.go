@@ -7,12 +7,38 @@ import (
 
 func (suite *KeeperTestSuite) TestKeeper_SetTotalLockedAmount_GetTotalLockedAmount() {
 	suite.SetupTest()
-	amt := suite.app.VeKeeper.GetTotalLockedAmount(suite.ctx)
+	amt := suite.app.VeKeeper.GetTotalLockedAmount(suite.ctx, "amage")
 	suite.Require().Equal(sdk.ZeroInt(), amt)
 
-	suite.app.VeKeeper.SetTotalLockedAmount(suite.ctx, sdk.NewInt(10000))
-	amt = suite.app.VeKeeper.GetTotalLockedAmount(suite.ctx)
+	suite.app.VeKeeper.SetTotalLockedAmount(suite.ctx, "amage", sdk.NewInt(10000))
+	amt = suite.app.VeKeeper.GetTotalLockedAmount(suite.ctx, "amage")
 	suite.Require().Equal(sdk.NewInt(10000), amt)
+	suite.Require().Equal(sdk.ZeroInt(), suite.app.VeKeeper.GetTotalLockedAmount(suite.ctx, "alp"))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetTotalLockedAmount_Legacy() {
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	// stored as before other denoms were lockable
+	bz := suite.app.AppCodec().MustMarshal(&sdk.IntProto{Int: sdk.NewInt(10000)})
+	store.Delete(types.TotalLockedAmountKey("amage"))
+	store.Set(types.LegacyTotalLockedAmountKey(), bz)
+
+	// the legacy total locked amount is of the lock denom
+	suite.Require().Equal(sdk.NewInt(10000), k.GetTotalLockedAmount(suite.ctx, "amage"))
+	suite.Require().Equal(sdk.ZeroInt(), k.GetTotalLockedAmount(suite.ctx, "alp"))
+	var denoms []string
+	k.IterateTotalLockedAmount(suite.ctx, func(denom string, amount sdk.Int) (stop bool) {
+		denoms = append(denoms, denom)
+		return false
+	})
+	suite.Require().Equal([]string{"amage"}, denoms)
+
+	// setting the total locked amount of the lock denom replaces the legacy one
+	k.SetTotalLockedAmount(suite.ctx, "amage", sdk.NewInt(9000))
+	suite.Require().False(store.Has(types.LegacyTotalLockedAmountKey()))
+	suite.Require().Equal(sdk.NewInt(9000), k.GetTotalLockedAmount(suite.ctx, "amage"))
 }
 
 func (suite *KeeperTestSuite) TestKeeper_SetLockedAmountByUser_GetLockedAmountByUser() {
@@ -24,10 +50,21 @@ func (suite *KeeperTestSuite) TestKeeper_SetLockedAmountByUser_GetLockedAmountBy
 	locked := types.LockedBalance{
 		Amount: sdk.NewInt(1000),
 		End:    uint64(1754379718),
+		Denom:  "alp",
+		Weight: sdk.NewDecWithPrec(15, 1),
 	}
 	suite.app.VeKeeper.SetLockedAmountByUser(suite.ctx, uint64(10000), locked)
 	amt = suite.app.VeKeeper.GetLockedAmountByUser(suite.ctx, veID)
 	suite.Require().Equal(locked, amt)
+
+	// locks without denom lock the lock denom, weighted one
+	suite.app.VeKeeper.SetLockedAmountByUser(suite.ctx, uint64(10000), types.LockedBalance{
+		Amount: sdk.NewInt(1000),
+		End:    uint64(1754379718),
+	})
+	amt = suite.app.VeKeeper.GetLockedAmountByUser(suite.ctx, veID)
+	suite.Require().Equal("amage", amt.Denom)
+	suite.Require().Equal(sdk.OneDec(), amt.Weight)
 }
 
 func (suite *KeeperTestSuite) TestKeeper_DeleteLockedAmountByUser() {
@@ -42,3 +79,24 @@ func (suite *KeeperTestSuite) TestKeeper_DeleteLockedAmountByUser() {
 	amt := suite.app.VeKeeper.GetLockedAmountByUser(suite.ctx, veID)
 	suite.Require().Equal(types.NewLockedBalance(), amt)
 }
+
+func (suite *KeeperTestSuite) TestKeeper_EarlyWithdrawPenalty() {
+	suite.SetupTest()
+	now := uint64(suite.ctx.BlockTime().Unix())
+	amount := sdk.NewInt(1000000)
+
+	testCases := []struct {
+		name    string
+		end     uint64
+		penalty sdk.Int
+	}{
+		{"expired", now, sdk.ZeroInt()},
+		{"proportional to remaining time", now + types.MaxLockTime/2, sdk.NewInt(500000)},
+		{"capped", now + types.MaxLockTime, sdk.NewInt(750000)},
+	}
+
+	for _, tc := range testCases {
+		locked := types.LockedBalance{Amount: amount, End: tc.end}
+		suite.Require().Equal(tc.penalty, suite.app.VeKeeper.EarlyWithdrawPenalty(suite.ctx, locked), tc.name)
+	}
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/petri-labs/warmage/x/ve/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates from version 2 to 3, setting the params added since
+// version 2 to their default values, writing the lock denom and a weight of
+// one into the locked balances created before other denoms were lockable,
+// moving the total locked amount of the lock denom from the legacy key to the
+// key of the lock denom, and deploying the ve NFT contract.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.migrateParams(ctx)
+	m.migrateLockedBalances(ctx)
+
+	store := ctx.KVStore(m.keeper.storeKey)
+	if bz := store.Get(types.LegacyTotalLockedAmountKey()); bz != nil {
+		store.Set(types.TotalLockedAmountKey(m.keeper.LockDenom(ctx)), bz)
+		store.Delete(types.LegacyTotalLockedAmountKey())
+	}
+
+	if _, found := m.keeper.GetVeNftContract(ctx); !found {
+		if _, err := m.keeper.DeployVeNftContract(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Migrator) migrateParams(ctx sdk.Context) {
+	defaults := types.DefaultParams()
+	for _, pair := range []struct {
+		key   []byte
+		value interface{}
+	}{
+		{types.KeyMaxEarlyWithdrawPenalty, &defaults.MaxEarlyWithdrawPenalty},
+		{types.KeyBurnEarlyWithdrawPenalty, &defaults.BurnEarlyWithdrawPenalty},
+		{types.KeyLockDenomWeights, &defaults.LockDenomWeights},
+	} {
+		if !m.keeper.paramstore.Has(ctx, pair.key) {
+			m.keeper.paramstore.Set(ctx, pair.key, pair.value)
+		}
+	}
+}
+
+func (m Migrator) migrateLockedBalances(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.KeyPrefixLockedAmountByUser)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	legacy := make(map[uint64]types.LockedBalance)
+	var veIDs []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		var locked types.LockedBalance
+		m.keeper.cdc.MustUnmarshal(iterator.Value(), &locked)
+		if locked.Denom != "" && !locked.Weight.IsNil() && !locked.Weight.IsZero() {
+			continue
+		}
+		veID := sdk.BigEndianToUint64(iterator.Key())
+		legacy[veID] = locked
+		veIDs = append(veIDs, veID)
+	}
+
+	lockDenom := m.keeper.LockDenom(ctx)
+	for _, veID := range veIDs {
+		locked := legacy[veID]
+		if locked.Denom == "" {
+			locked.Denom = lockDenom
+		}
+		if locked.Weight.IsNil() || locked.Weight.IsZero() {
+			locked.Weight = sdk.OneDec()
+		}
+		m.keeper.SetLockedAmountByUser(ctx, veID, locked)
+	}
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/petri-labs/warmage/x/ve/keeper"
+	"github.com/petri-labs/warmage/x/ve/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrator_Migrate2to3() {
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	// stored as before other denoms were lockable
+	bz := suite.app.AppCodec().MustMarshal(&sdk.IntProto{Int: sdk.NewInt(10000)})
+	store.Delete(types.TotalLockedAmountKey("amage"))
+	store.Set(types.LegacyTotalLockedAmountKey(), bz)
+
+	err := keeper.NewMigrator(k).Migrate2to3(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().False(store.Has(types.LegacyTotalLockedAmountKey()))
+	suite.Require().Equal(bz, store.Get(types.TotalLockedAmountKey("amage")))
+	suite.Require().Equal(sdk.NewInt(10000), k.GetTotalLockedAmount(suite.ctx, "amage"))
+
+	// migrating again is a no-op
+	err = keeper.NewMigrator(k).Migrate2to3(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(10000), k.GetTotalLockedAmount(suite.ctx, "amage"))
+}
+
+func (suite *KeeperTestSuite) TestMigrator_Migrate2to3_Params() {
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+	// params of version 2 lack the keys added since
+	store := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey)), append([]byte(types.ModuleName), '/'))
+	store.Delete(types.KeyMaxEarlyWithdrawPenalty)
+	store.Delete(types.KeyBurnEarlyWithdrawPenalty)
+	store.Delete(types.KeyLockDenomWeights)
+	suite.Require().Panics(func() { k.GetParams(suite.ctx) })
+
+	err := keeper.NewMigrator(k).Migrate2to3(suite.ctx)
+	suite.Require().NoError(err)
+	params := k.GetParams(suite.ctx)
+	suite.Require().NoError(params.Validate())
+	suite.Require().Equal(types.DefaultMaxEarlyWithdrawPenalty, params.MaxEarlyWithdrawPenalty)
+	suite.Require().Equal(types.DefaultBurnEarlyWithdrawPenalty, params.BurnEarlyWithdrawPenalty)
+	suite.Require().Empty(params.LockDenomWeights)
+	suite.Require().Equal("amage", params.LockDenom)
+}
+
+func (suite *KeeperTestSuite) TestMigrator_Migrate2to3_LockedBalances() {
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	// stored as before other denoms were lockable, without denom and weight
+	legacy := types.LockedBalance{Amount: sdk.NewInt(10000), End: 1000}
+	store.Set(types.LockedAmountByUserKey(1), suite.app.AppCodec().MustMarshal(&legacy))
+	k.SetLockedAmountByUser(suite.ctx, 2, types.LockedBalance{Amount: sdk.NewInt(20000), End: 2000, Denom: "ausw", Weight: sdk.NewDecWithPrec(5, 1)})
+
+	err := keeper.NewMigrator(k).Migrate2to3(suite.ctx)
+	suite.Require().NoError(err)
+
+	var locked types.LockedBalance
+	suite.app.AppCodec().MustUnmarshal(store.Get(types.LockedAmountByUserKey(1)), &locked)
+	suite.Require().Equal("amage", locked.Denom)
+	suite.Require().Equal(sdk.OneDec(), locked.Weight)
+	suite.Require().Equal(sdk.NewInt(10000), locked.Amount)
+	suite.Require().Equal(uint64(1000), locked.End)
+
+	// locks of other denoms are kept
+	suite.app.AppCodec().MustUnmarshal(store.Get(types.LockedAmountByUserKey(2)), &locked)
+	suite.Require().Equal("ausw", locked.Denom)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), locked.Weight)
+}
+
+func (suite *KeeperTestSuite) TestMigrator_Migrate2to3_VeNftContract() {
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+	// deployed at genesis, so not before version 3
+	suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)).Delete(types.VeNftContractKey())
+
+	err := keeper.NewMigrator(k).Migrate2to3(suite.ctx)
+	suite.Require().NoError(err)
+	contract, found := k.GetVeNftContract(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().NotEmpty(suite.app.EvmKeeper.GetCode(suite.ctx, common.BytesToHash(suite.app.EvmKeeper.GetAccount(suite.ctx, contract).CodeHash)))
+
+	// migrating again keeps the contract
+	err = keeper.NewMigrator(k).Migrate2to3(suite.ctx)
+	suite.Require().NoError(err)
+	stored, _ := k.GetVeNftContract(suite.ctx)
+	suite.Require().Equal(contract, stored)
+}
@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	nfttypes "github.com/cosmos/cosmos-sdk/x/nft"
 	"github.com/petri-labs/warmage/x/ve/types"
 )
@@ -25,7 +26,7 @@ var _ types.MsgServer = msgServer{}
 func (m msgServer) Create(c context.Context, msg *types.MsgCreate) (*types.MsgCreateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	err := m.Keeper.checkLockDenom(ctx, msg.Amount)
+	weight, err := m.Keeper.checkLockDenom(ctx, msg.Amount)
 	if err != nil {
 		return nil, err
 	}
@@ -59,8 +60,11 @@ func (m msgServer) Create(c context.Context, msg *types.MsgCreate) (*types.MsgCr
 		return nil, err
 	}
 
-	// deposit for ve id
-	err = m.Keeper.DepositFor(ctx, sender, veID, msg.Amount.Amount, unlockTime, types.NewLockedBalance(), true)
+	// deposit for ve id, fixing the locked denom and its weight
+	locked := types.NewLockedBalance()
+	locked.Denom = msg.Amount.Denom
+	locked.Weight = weight
+	err = m.Keeper.DepositFor(ctx, sender, veID, msg.Amount.Amount, unlockTime, locked, true)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +95,7 @@ func (m msgServer) Create(c context.Context, msg *types.MsgCreate) (*types.MsgCr
 func (m msgServer) Deposit(c context.Context, msg *types.MsgDeposit) (*types.MsgDepositResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	err := m.Keeper.checkLockDenom(ctx, msg.Amount)
+	_, err := m.Keeper.checkLockDenom(ctx, msg.Amount)
 	if err != nil {
 		return nil, err
 	}
@@ -112,7 +116,10 @@ func (m msgServer) Deposit(c context.Context, msg *types.MsgDeposit) (*types.Msg
 		// should not happen
 		return nil, sdkerrors.Wrapf(types.ErrAmountNotPositive, "nothing is locked for ve %s", msg.VeId)
 	}
-	if locked.End <= uint64(ctx.BlockTime().Unix()) {
+	if msg.Amount.Denom != locked.Denom {
+		return nil, sdkerrors.Wrapf(types.ErrLockDenomMismatch, "amount denom %s but ve %s locks %s", msg.Amount.Denom, msg.VeId, locked.Denom)
+	}
+	if !locked.IsPermanent && locked.End <= uint64(ctx.BlockTime().Unix()) {
 		return nil, sdkerrors.Wrapf(types.ErrLockExpired, "unlocking time %s but now %s", time.Unix(int64(locked.End), 0), ctx.BlockTime())
 	}
 
@@ -158,6 +165,9 @@ func (m msgServer) ExtendTime(c context.Context, msg *types.MsgExtendTime) (*typ
 		// should not happen
 		return nil, sdkerrors.Wrapf(types.ErrAmountNotPositive, "nothing is locked for ve %s", msg.VeId)
 	}
+	if locked.IsPermanent {
+		return nil, sdkerrors.Wrapf(types.ErrLockPermanent, "ve %s is locked permanently", msg.VeId)
+	}
 	if locked.End <= uint64(ctx.BlockTime().Unix()) {
 		return nil, sdkerrors.Wrapf(types.ErrLockExpired, "unlocking time %s but now %s", time.Unix(int64(locked.End), 0), ctx.BlockTime())
 	}
@@ -225,21 +235,28 @@ func (m msgServer) Merge(c context.Context, msg *types.MsgMerge) (*types.MsgMerg
 
 	lockedFrom := m.Keeper.GetLockedAmountByUser(ctx, fromVeID)
 	lockedTo := m.Keeper.GetLockedAmountByUser(ctx, toVeID)
+	if lockedFrom.IsPermanent {
+		return nil, sdkerrors.Wrapf(types.ErrLockPermanent, "from ve %s is locked permanently", msg.FromVeId)
+	}
+	if lockedFrom.Denom != lockedTo.Denom {
+		return nil, sdkerrors.Wrapf(types.ErrLockDenomMismatch, "from ve %s locks %s but to ve %s locks %s", msg.FromVeId, lockedFrom.Denom, msg.ToVeId, lockedTo.Denom)
+	}
 
-	if m.Keeper.getDelegatedAmount != nil {
-		delegatedAmt := m.Keeper.getDelegatedAmount(ctx, fromVeID)
-		if delegatedAmt.IsPositive() {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "locked amount of from ve is delegated for staking")
-		}
+	if m.Keeper.GetDelegatedAmountByUser(ctx, fromVeID).IsPositive() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "locked amount of from ve is delegated for staking")
 	}
 
 	// NOTE: here do not check whether locks are expired
 
-	// take the longest end time
+	// take the longest end time,
+	// or keep permanent if to ve is locked permanently
 	end := lockedFrom.End
 	if lockedTo.End > end {
 		end = lockedTo.End
 	}
+	if lockedTo.IsPermanent {
+		end = 0
+	}
 
 	// delete user locked of fromVeID
 	m.Keeper.DeleteLockedAmountByUser(ctx, fromVeID)
@@ -248,6 +265,7 @@ func (m msgServer) Merge(c context.Context, msg *types.MsgMerge) (*types.MsgMerg
 	m.Keeper.RegulateUserCheckpoint(ctx, fromVeID, lockedFrom, types.NewLockedBalance())
 
 	// burn nft of fromVeID
+	m.Keeper.DeleteVoteDelegatee(ctx, fromVeID)
 	err = m.Keeper.nftKeeper.Burn(ctx, types.VeNftClass.Id, msg.FromVeId)
 	if err != nil {
 		return nil, err
@@ -298,40 +316,41 @@ func (m msgServer) Withdraw(c context.Context, msg *types.MsgWithdraw) (*types.M
 	}
 
 	locked := m.Keeper.GetLockedAmountByUser(ctx, veID)
+	if locked.IsPermanent {
+		return nil, sdkerrors.Wrapf(types.ErrLockPermanent, "ve %s is locked permanently", msg.VeId)
+	}
 	if locked.End > uint64(ctx.BlockTime().Unix()) {
 		return nil, sdkerrors.Wrapf(types.ErrLockNotExpired, "unlocking time %s but now %s", time.Unix(int64(locked.End), 0), ctx.BlockTime())
 	}
 
-	if m.Keeper.getDelegatedAmount != nil {
-		delegatedAmt := m.Keeper.getDelegatedAmount(ctx, veID)
-		if delegatedAmt.IsPositive() {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "locked amount is delegated for staking")
-		}
+	if m.Keeper.GetDelegatedAmountByUser(ctx, veID).IsPositive() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "locked amount is delegated for staking")
 	}
 
 	// delete user locked
 	m.Keeper.DeleteLockedAmountByUser(ctx, veID)
 
 	// update total locked
-	totalLocked := m.Keeper.GetTotalLockedAmount(ctx)
+	totalLocked := m.Keeper.GetTotalLockedAmount(ctx, locked.Denom)
 	totalLocked = totalLocked.Sub(locked.Amount)
 	if totalLocked.IsNegative() {
 		// should never happen
 		panic("total locked negative")
 	}
-	m.Keeper.SetTotalLockedAmount(ctx, totalLocked)
+	m.Keeper.SetTotalLockedAmount(ctx, locked.Denom, totalLocked)
 
 	// regulate checkpoint of veID
 	m.Keeper.RegulateUserCheckpoint(ctx, veID, locked, types.NewLockedBalance())
 
 	// burn nft of veID
+	m.Keeper.DeleteVoteDelegatee(ctx, veID)
 	err = m.Keeper.nftKeeper.Burn(ctx, types.VeNftClass.Id, msg.VeId)
 	if err != nil {
 		return nil, err
 	}
 
 	// send amount to sender
-	coin := sdk.NewCoin(m.Keeper.LockDenom(ctx), locked.Amount)
+	coin := sdk.NewCoin(locked.Denom, locked.Amount)
 	err = m.Keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(coin))
 	if err != nil {
 		return nil, err
@@ -354,6 +373,396 @@ func (m msgServer) Withdraw(c context.Context, msg *types.MsgWithdraw) (*types.M
 	return &types.MsgWithdrawResponse{}, nil
 }
 
+func (m msgServer) WithdrawEarly(c context.Context, msg *types.MsgWithdrawEarly) (*types.MsgWithdrawEarlyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
+	if !sender.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, owner)
+	}
+
+	veID := types.Uint64FromVeID(msg.VeId)
+
+	err = m.Keeper.CheckVeAttached(ctx, veID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "ve id attached")
+	}
+
+	locked := m.Keeper.GetLockedAmountByUser(ctx, veID)
+	if locked.IsPermanent {
+		return nil, sdkerrors.Wrapf(types.ErrLockPermanent, "ve %s is locked permanently", msg.VeId)
+	}
+	if locked.End <= uint64(ctx.BlockTime().Unix()) {
+		return nil, sdkerrors.Wrapf(types.ErrLockExpired, "unlocking time %s but now %s, so withdraw without penalty", time.Unix(int64(locked.End), 0), ctx.BlockTime())
+	}
+
+	if m.Keeper.GetDelegatedAmountByUser(ctx, veID).IsPositive() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "locked amount is delegated for staking")
+	}
+
+	penalty := m.Keeper.EarlyWithdrawPenalty(ctx, locked)
+
+	// delete user locked
+	m.Keeper.DeleteLockedAmountByUser(ctx, veID)
+
+	// update total locked
+	totalLocked := m.Keeper.GetTotalLockedAmount(ctx, locked.Denom)
+	totalLocked = totalLocked.Sub(locked.Amount)
+	if totalLocked.IsNegative() {
+		// should never happen
+		panic("total locked negative")
+	}
+	m.Keeper.SetTotalLockedAmount(ctx, locked.Denom, totalLocked)
+
+	// regulate checkpoint of veID
+	m.Keeper.RegulateUserCheckpoint(ctx, veID, locked, types.NewLockedBalance())
+
+	// burn nft of veID
+	m.Keeper.DeleteVoteDelegatee(ctx, veID)
+	err = m.Keeper.nftKeeper.Burn(ctx, types.VeNftClass.Id, msg.VeId)
+	if err != nil {
+		return nil, err
+	}
+
+	penaltyCoin := sdk.NewCoin(locked.Denom, penalty)
+	burned := m.Keeper.BurnEarlyWithdrawPenalty(ctx)
+	if penalty.IsPositive() {
+		if burned {
+			err = m.Keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(penaltyCoin))
+		} else if locked.Denom == m.Keeper.LockDenom(ctx) {
+			// reward the remaining ve holders
+			err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.DistributionPoolName, sdk.NewCoins(penaltyCoin))
+		} else {
+			// the distribution pool only distributes the lock denom,
+			// so other denoms are distributed to stakers as fees
+			err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(penaltyCoin))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// send remaining amount to sender
+	coin := sdk.NewCoin(locked.Denom, locked.Amount.Sub(penalty))
+	err = m.Keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(coin))
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventWithdrawEarly{
+		Sender:        sender.String(),
+		VeId:          msg.VeId,
+		Amount:        coin,
+		Penalty:       penaltyCoin,
+		PenaltyBurned: burned,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgWithdrawEarlyResponse{
+		Amount:  coin,
+		Penalty: penaltyCoin,
+	}, nil
+}
+
+func (m msgServer) Split(c context.Context, msg *types.MsgSplit) (*types.MsgSplitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
+	if !sender.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
+	}
+
+	veID := types.Uint64FromVeID(msg.VeId)
+
+	err = m.Keeper.CheckVeAttached(ctx, veID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "ve id attached")
+	}
+
+	locked := m.Keeper.GetLockedAmountByUser(ctx, veID)
+	if !locked.IsPermanent && locked.End <= uint64(ctx.BlockTime().Unix()) {
+		return nil, sdkerrors.Wrapf(types.ErrLockExpired, "unlocking time %s but now %s", time.Unix(int64(locked.End), 0), ctx.BlockTime())
+	}
+
+	if m.Keeper.GetDelegatedAmountByUser(ctx, veID).IsPositive() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "locked amount is delegated for staking")
+	}
+
+	// the remaining amount stays with veID
+	remaining := locked.Amount
+	for _, amount := range msg.Amounts {
+		remaining = remaining.Sub(amount)
+	}
+	if !remaining.IsPositive() {
+		return nil, sdkerrors.Wrapf(types.ErrAmountNotPositive, "split amounts exceed locked amount %s of ve %s", locked.Amount, msg.VeId)
+	}
+
+	// update user locked of veID
+	lockedRemaining := locked
+	lockedRemaining.Amount = remaining
+	m.Keeper.SetLockedAmountByUser(ctx, veID, lockedRemaining)
+
+	// regulate checkpoint of veID
+	m.Keeper.RegulateUserCheckpoint(ctx, veID, locked, lockedRemaining)
+
+	// NOTE: total locked amount is unchanged
+
+	newVeIDs := make([]string, 0, len(msg.Amounts))
+	for _, amount := range msg.Amounts {
+		// get new ve id
+		newVeID := m.Keeper.GetNextVeID(ctx)
+		if newVeID > types.MaxVeID || newVeID == types.EmptyVeID {
+			return nil, sdkerrors.Wrap(types.ErrInvalidVeID, "no available ve id")
+		}
+		m.Keeper.SetNextVeID(ctx, newVeID+1)
+
+		// mint nft for new ve id
+		err = m.Keeper.nftKeeper.Mint(ctx, nfttypes.NFT{
+			ClassId: types.VeNftClass.Id,
+			Id:      types.VeIDFromUint64(newVeID),
+		}, sender)
+		if err != nil {
+			return nil, err
+		}
+
+		// set user locked of new ve id, keeping the unlocking time or permanence,
+		// the locked denom and its weight
+		lockedNew := locked
+		lockedNew.Amount = amount
+		m.Keeper.SetLockedAmountByUser(ctx, newVeID, lockedNew)
+
+		// regulate checkpoint of new ve id
+		m.Keeper.RegulateUserCheckpoint(ctx, newVeID, types.NewLockedBalance(), lockedNew)
+
+		newVeIDs = append(newVeIDs, types.VeIDFromUint64(newVeID))
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventSplit{
+		Sender:   sender.String(),
+		VeId:     msg.VeId,
+		NewVeIds: newVeIDs,
+		Amounts:  msg.Amounts,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgSplitResponse{
+		VeIds: newVeIDs,
+	}, nil
+}
+
+func (m msgServer) LockPermanent(c context.Context, msg *types.MsgLockPermanent) (*types.MsgLockPermanentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
+	if !sender.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
+	}
+
+	veID := types.Uint64FromVeID(msg.VeId)
+
+	locked := m.Keeper.GetLockedAmountByUser(ctx, veID)
+	if !locked.Amount.IsPositive() {
+		// should not happen
+		return nil, sdkerrors.Wrapf(types.ErrAmountNotPositive, "nothing is locked for ve %s", msg.VeId)
+	}
+	if locked.IsPermanent {
+		return nil, sdkerrors.Wrapf(types.ErrLockPermanent, "ve %s is already locked permanently", msg.VeId)
+	}
+	if locked.End <= uint64(ctx.BlockTime().Unix()) {
+		return nil, sdkerrors.Wrapf(types.ErrLockExpired, "unlocking time %s but now %s", time.Unix(int64(locked.End), 0), ctx.BlockTime())
+	}
+
+	// permanent lock has no unlocking time
+	lockedNew := locked
+	lockedNew.End, lockedNew.IsPermanent = 0, true
+	m.Keeper.SetLockedAmountByUser(ctx, veID, lockedNew)
+
+	// regulate checkpoint of veID
+	m.Keeper.RegulateUserCheckpoint(ctx, veID, locked, lockedNew)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLockPermanent{
+		Sender: sender.String(),
+		VeId:   msg.VeId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgLockPermanentResponse{}, nil
+}
+
+func (m msgServer) UnlockPermanent(c context.Context, msg *types.MsgUnlockPermanent) (*types.MsgUnlockPermanentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
+	if !sender.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
+	}
+
+	veID := types.Uint64FromVeID(msg.VeId)
+
+	err = m.Keeper.CheckVeAttached(ctx, veID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "ve id attached")
+	}
+
+	locked := m.Keeper.GetLockedAmountByUser(ctx, veID)
+	if !locked.IsPermanent {
+		return nil, sdkerrors.Wrapf(types.ErrLockNotPermanent, "ve %s is not locked permanently", msg.VeId)
+	}
+
+	// start decaying from the max locking duration
+	unlockTime := types.RegulatedUnixTimeFromNow(ctx, types.MaxLockTime)
+	lockedNew := locked
+	lockedNew.End, lockedNew.IsPermanent = unlockTime, false
+	m.Keeper.SetLockedAmountByUser(ctx, veID, lockedNew)
+
+	// regulate checkpoint of veID
+	m.Keeper.RegulateUserCheckpoint(ctx, veID, locked, lockedNew)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventUnlockPermanent{
+		Sender:     sender.String(),
+		VeId:       msg.VeId,
+		UnlockTime: unlockTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgUnlockPermanentResponse{
+		UnlockTime: unlockTime,
+	}, nil
+}
+
+func (m msgServer) DelegateVotes(c context.Context, msg *types.MsgDelegateVotes) (*types.MsgDelegateVotesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	delegatee, err := sdk.AccAddressFromBech32(msg.Delegatee)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
+	if !sender.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
+	}
+
+	veID := types.Uint64FromVeID(msg.VeId)
+
+	// replace the existing delegation if any
+	m.Keeper.SetVoteDelegatee(ctx, veID, delegatee)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDelegateVotes{
+		Sender:    sender.String(),
+		VeId:      msg.VeId,
+		Delegatee: delegatee.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgDelegateVotesResponse{}, nil
+}
+
+func (m msgServer) UndelegateVotes(c context.Context, msg *types.MsgUndelegateVotes) (*types.MsgUndelegateVotesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
+	if !sender.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
+	}
+
+	veID := types.Uint64FromVeID(msg.VeId)
+
+	delegatee := m.Keeper.GetVoteDelegatee(ctx, veID)
+	if delegatee == nil {
+		return nil, sdkerrors.Wrapf(types.ErrVotesNotDelegated, "ve %s", msg.VeId)
+	}
+
+	// NOTE: the existing votes cast by the delegatee are kept
+	m.Keeper.DeleteVoteDelegatee(ctx, veID)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventUndelegateVotes{
+		Sender:    sender.String(),
+		VeId:      msg.VeId,
+		Delegatee: delegatee.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgUndelegateVotesResponse{}, nil
+}
+
 // DepositFor deposits some more amount and/or update locking end time for a veNFT.
 // 	 veID: must be valid ve id
 //   amount: locked amount to add; can be zero if no more amount to deposit
@@ -361,20 +770,18 @@ func (m msgServer) Withdraw(c context.Context, msg *types.MsgWithdraw) (*types.M
 //   locked: existing locked; may be zero if no existing locked
 //   sendCoins: false when extend time or merge
 func (k Keeper) DepositFor(ctx sdk.Context, sender sdk.AccAddress, veID uint64, amount sdk.Int, unlockTime uint64, locked types.LockedBalance, sendCoins bool) error {
-	if amount.IsPositive() {
+	if amount.IsPositive() && sendCoins {
 		// take the amount from sender
-		if sendCoins {
-			coin := sdk.NewCoin(k.LockDenom(ctx), amount)
-			err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(coin))
-			if err != nil {
-				return err
-			}
+		coin := sdk.NewCoin(locked.Denom, amount)
+		err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(coin))
+		if err != nil {
+			return err
 		}
 
-		// update total locked
-		totalLocked := k.GetTotalLockedAmount(ctx)
+		// update total locked; merged amount is already locked
+		totalLocked := k.GetTotalLockedAmount(ctx, locked.Denom)
 		totalLocked = totalLocked.Add(amount)
-		k.SetTotalLockedAmount(ctx, totalLocked)
+		k.SetTotalLockedAmount(ctx, locked.Denom, totalLocked)
 	}
 
 	// old locked
@@ -395,12 +802,14 @@ func (k Keeper) DepositFor(ctx sdk.Context, sender sdk.AccAddress, veID uint64,
 	return nil
 }
 
-func (k Keeper) checkLockDenom(ctx sdk.Context, amount sdk.Coin) error {
-	lockDenom := k.LockDenom(ctx)
-	if amount.Denom != lockDenom {
-		return sdkerrors.Wrapf(types.ErrInvalidLockDenom, "amount denom %s but should be %s", amount.Denom, lockDenom)
+// checkLockDenom checks that the amount denom is lockable, and returns the
+// weight of its voting power
+func (k Keeper) checkLockDenom(ctx sdk.Context, amount sdk.Coin) (sdk.Dec, error) {
+	weight, found := k.GetParams(ctx).LockDenomWeight(amount.Denom)
+	if !found {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidLockDenom, "amount denom %s is not lockable", amount.Denom)
 	}
-	return nil
+	return weight, nil
 }
 
 func getSenderReceiver(senderStr, toStr string) (sender sdk.AccAddress, receiver sdk.AccAddress, err error) {
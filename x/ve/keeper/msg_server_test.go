@@ -4,6 +4,10 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/petri-labs/warmage/app"
 	"github.com/petri-labs/warmage/x/ve/keeper"
 	"github.com/petri-labs/warmage/x/ve/types"
 	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
@@ -301,6 +305,489 @@ func (suite *KeeperTestSuite) TestVeWithdraw() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestVeWithdrawEarly() {
+	require := suite.Require()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	impl := keeper.NewMsgServerImpl(suite.app.VeKeeper)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	denom := "amage"
+	// Create Valid VeID
+	for i := 1; i <= 3; i++ {
+		res, err := impl.Create(ctx, &types.MsgCreate{
+			Sender:       sender.String(),
+			To:           sender.String(),
+			Amount:       sdk.NewCoin(denom, sdk.NewInt(1000)),
+			LockDuration: types.MaxLockTime,
+		})
+		require.NoError(err)
+		require.Equal(fmt.Sprintf("ve-%d", i), res.VeId)
+	}
+	suite.app.VeKeeper.SetVeVoted(suite.ctx, 2, true)
+	_, err := impl.LockPermanent(ctx, &types.MsgLockPermanent{
+		Sender: sender.String(),
+		VeId:   "ve-3",
+	})
+	require.NoError(err)
+
+	// Another NFT
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	receiver := sdk.AccAddress(priv.PubKey().Address())
+	_, err = impl.Create(ctx, &types.MsgCreate{
+		Sender:       sender.String(),
+		To:           receiver.String(),
+		Amount:       sdk.NewCoin(denom, sdk.NewInt(1000)),
+		LockDuration: types.RegulatedPeriod,
+	})
+	require.NoError(err)
+
+	locked := suite.app.VeKeeper.GetLockedAmountByUser(suite.ctx, 1)
+	penalty := suite.app.VeKeeper.EarlyWithdrawPenalty(suite.ctx, locked)
+	require.True(penalty.IsPositive())
+	require.True(penalty.LT(locked.Amount))
+	totalLocked := suite.app.VeKeeper.GetTotalLockedAmount(suite.ctx, "amage")
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, denom)
+	poolAddr := suite.app.AccountKeeper.GetModuleAddress(types.DistributionPoolName)
+	poolBalance := suite.app.BankKeeper.GetBalance(suite.ctx, poolAddr, denom)
+
+	testCases := []struct {
+		name   string
+		pass   bool
+		sender sdk.AccAddress
+		veId   string
+	}{
+		{"invalid sender", false, []byte("xxx"), "ve-1"},
+		{"user doesn't own veId", false, sender, "ve-4"},
+		{"ve voted", false, sender, "ve-2"},
+		{"permanent lock", false, sender, "ve-3"},
+		{"ok", true, sender, "ve-1"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			sender := tc.sender
+			res, err := impl.WithdrawEarly(ctx, &types.MsgWithdrawEarly{
+				Sender: sender.String(),
+				VeId:   tc.veId,
+			})
+			if tc.pass {
+				require.NoError(err, tc.name)
+				require.Equal(sdk.NewCoin(denom, penalty), res.Penalty)
+				require.Equal(sdk.NewCoin(denom, locked.Amount.Sub(penalty)), res.Amount)
+			} else {
+				require.Error(err, tc.name)
+			}
+		})
+	}
+
+	// The penalty goes to the distribution pool
+	require.False(suite.app.NftKeeper.HasNFT(suite.ctx, types.VeNftClass.Id, "ve-1"))
+	require.Equal(totalLocked.Sub(locked.Amount), suite.app.VeKeeper.GetTotalLockedAmount(suite.ctx, "amage"))
+	require.Equal(balance.AddAmount(locked.Amount.Sub(penalty)), suite.app.BankKeeper.GetBalance(suite.ctx, sender, denom))
+	require.Equal(poolBalance.AddAmount(penalty), suite.app.BankKeeper.GetBalance(suite.ctx, poolAddr, denom))
+
+	// Or is burned
+	params := suite.app.VeKeeper.GetParams(suite.ctx)
+	params.BurnEarlyWithdrawPenalty = true
+	suite.app.VeKeeper.SetParams(suite.ctx, params)
+	suite.app.VeKeeper.SetVeVoted(suite.ctx, 2, false)
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, denom)
+	res, err := impl.WithdrawEarly(ctx, &types.MsgWithdrawEarly{
+		Sender: sender.String(),
+		VeId:   "ve-2",
+	})
+	require.NoError(err)
+	require.Equal(supply.Sub(res.Penalty), suite.app.BankKeeper.GetSupply(suite.ctx, denom))
+	require.Equal(poolBalance.AddAmount(penalty), suite.app.BankKeeper.GetBalance(suite.ctx, poolAddr, denom))
+}
+
+func (suite *KeeperTestSuite) TestVeSplit() {
+	require := suite.Require()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	impl := keeper.NewMsgServerImpl(suite.app.VeKeeper)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	denom := "amage"
+	unit := sdk.NewIntWithDecimal(1, 18)
+	require.NoError(app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(sdk.NewCoin(denom, unit.MulRaw(300)))))
+	// Create Valid VeID
+	res, err := impl.Create(ctx, &types.MsgCreate{
+		Sender:       sender.String(),
+		To:           sender.String(),
+		Amount:       sdk.NewCoin(denom, unit.MulRaw(100)),
+		LockDuration: 2 * types.RegulatedPeriod,
+	})
+	require.NoError(err)
+	require.Equal("ve-1", res.VeId)
+	locked := suite.app.VeKeeper.GetLockedAmountByUser(suite.ctx, 1)
+
+	// Another NFT
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	receiver := sdk.AccAddress(priv.PubKey().Address())
+	_, err = impl.Create(ctx, &types.MsgCreate{
+		Sender:       sender.String(),
+		To:           receiver.String(),
+		Amount:       sdk.NewCoin(denom, unit.MulRaw(100)),
+		LockDuration: types.RegulatedPeriod,
+	})
+	require.NoError(err)
+
+	// Attached NFT
+	res, err = impl.Create(ctx, &types.MsgCreate{
+		Sender:       sender.String(),
+		To:           sender.String(),
+		Amount:       sdk.NewCoin(denom, unit.MulRaw(100)),
+		LockDuration: types.RegulatedPeriod,
+	})
+	require.NoError(err)
+	suite.app.VeKeeper.SetVeVoted(suite.ctx, types.Uint64FromVeID(res.VeId), true)
+	totalLocked := suite.app.VeKeeper.GetTotalLockedAmount(suite.ctx, "amage")
+	now := uint64(suite.ctx.BlockTime().Unix())
+	totalPower := suite.app.VeKeeper.GetTotalVotingPower(suite.ctx, now, 0)
+
+	testCases := []struct {
+		name    string
+		pass    bool
+		sender  sdk.AccAddress
+		veId    string
+		amounts []sdk.Int
+	}{
+		{"invalid sender", false, []byte("xxx"), "ve-1", []sdk.Int{unit.MulRaw(10)}},
+		{"user doesn't own veId", false, sender, "ve-2", []sdk.Int{unit.MulRaw(10)}},
+		{"ve voted", false, sender, "ve-3", []sdk.Int{unit.MulRaw(10)}},
+		{"nothing remains", false, sender, "ve-1", []sdk.Int{unit.MulRaw(60), unit.MulRaw(40)}},
+		{"ok", true, sender, "ve-1", []sdk.Int{unit.MulRaw(30), unit.MulRaw(20)}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			sender := tc.sender
+			res, err := impl.Split(ctx, &types.MsgSplit{
+				Sender:  sender.String(),
+				VeId:    tc.veId,
+				Amounts: tc.amounts,
+			})
+			if tc.pass {
+				require.NoError(err, tc.name)
+				require.Equal([]string{"ve-4", "ve-5"}, res.VeIds)
+			} else {
+				require.Error(err, tc.name)
+			}
+		})
+	}
+
+	// The new ve NFTs keep the unlocking time, and the total locked amount is unchanged
+	for veID, amount := range map[uint64]int64{1: 50, 4: 30, 5: 20} {
+		require.Equal(sender, suite.app.NftKeeper.GetOwner(suite.ctx, types.VeNftClass.Id, types.VeIDFromUint64(veID)))
+		lockedSplit := suite.app.VeKeeper.GetLockedAmountByUser(suite.ctx, veID)
+		require.Equal(unit.MulRaw(amount), lockedSplit.Amount)
+		require.Equal(locked.End, lockedSplit.End)
+		require.True(suite.app.VeKeeper.GetVotingPower(suite.ctx, veID, uint64(suite.ctx.BlockTime().Unix()), 0).IsPositive())
+	}
+	require.Equal(totalLocked, suite.app.VeKeeper.GetTotalLockedAmount(suite.ctx, "amage"))
+
+	// The total voting power stays the sum of all ve, only losing the rounding of the new slopes
+	sumPower := sdk.ZeroInt()
+	for veID := uint64(1); veID <= 5; veID++ {
+		sumPower = sumPower.Add(suite.app.VeKeeper.GetVotingPower(suite.ctx, veID, now, 0))
+	}
+	require.Equal(sumPower, suite.app.VeKeeper.GetTotalVotingPower(suite.ctx, now, 0))
+	require.True(sumPower.LTE(totalPower))
+	require.True(totalPower.Sub(sumPower).LT(sdk.NewIntFromUint64(3 * (locked.End - now))))
+}
+
+func (suite *KeeperTestSuite) TestVeSplit_MsgServiceRouter() {
+	require := suite.Require()
+	sender := sdk.AccAddress(suite.address.Bytes())
+	unit := sdk.NewIntWithDecimal(1, 18)
+	require.NoError(app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(sdk.NewCoin("amage", unit.MulRaw(100)))))
+
+	// The ve msgs are delivered through the message router of the app
+	router := suite.app.MsgServiceRouter()
+	msgCreate := &types.MsgCreate{
+		Sender:       sender.String(),
+		To:           sender.String(),
+		Amount:       sdk.NewCoin("amage", unit.MulRaw(100)),
+		LockDuration: types.RegulatedPeriod,
+	}
+	handler := router.Handler(msgCreate)
+	require.NotNil(handler)
+	_, err := handler(suite.ctx, msgCreate)
+	require.NoError(err)
+
+	msgSplit := &types.MsgSplit{
+		Sender:  sender.String(),
+		VeId:    "ve-1",
+		Amounts: []sdk.Int{unit.MulRaw(40)},
+	}
+	handler = router.Handler(msgSplit)
+	require.NotNil(handler)
+	res, err := handler(suite.ctx, msgSplit)
+	require.NoError(err)
+	require.NotNil(res)
+	require.Equal(unit.MulRaw(60), suite.app.VeKeeper.GetLockedAmountByUser(suite.ctx, 1).Amount)
+	require.Equal(unit.MulRaw(40), suite.app.VeKeeper.GetLockedAmountByUser(suite.ctx, 2).Amount)
+}
+
+func (suite *KeeperTestSuite) TestVeLockPermanent() {
+	require := suite.Require()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	impl := keeper.NewMsgServerImpl(suite.app.VeKeeper)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	denom := "amage"
+	unit := sdk.NewIntWithDecimal(1, 18)
+	require.NoError(app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(sdk.NewCoin(denom, unit.MulRaw(400)))))
+	// Create Valid VeID
+	for i := 1; i <= 2; i++ {
+		res, err := impl.Create(ctx, &types.MsgCreate{
+			Sender:       sender.String(),
+			To:           sender.String(),
+			Amount:       sdk.NewCoin(denom, unit.MulRaw(100)),
+			LockDuration: types.RegulatedPeriod,
+		})
+		require.NoError(err)
+		require.Equal(fmt.Sprintf("ve-%d", i), res.VeId)
+	}
+
+	// Another NFT
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	receiver := sdk.AccAddress(priv.PubKey().Address())
+	_, err = impl.Create(ctx, &types.MsgCreate{
+		Sender:       sender.String(),
+		To:           receiver.String(),
+		Amount:       sdk.NewCoin(denom, unit.MulRaw(100)),
+		LockDuration: types.RegulatedPeriod,
+	})
+	require.NoError(err)
+
+	testCases := []struct {
+		name   string
+		pass   bool
+		sender sdk.AccAddress
+		veId   string
+	}{
+		{"invalid sender", false, []byte("xxx"), "ve-1"},
+		{"user doesn't own veId", false, sender, "ve-3"},
+		{"ok", true, sender, "ve-1"},
+		{"already permanent", false, sender, "ve-1"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			sender := tc.sender
+			_, err := impl.LockPermanent(ctx, &types.MsgLockPermanent{
+				Sender: sender.String(),
+				VeId:   tc.veId,
+			})
+			if tc.pass {
+				require.NoError(err, tc.name)
+			} else {
+				require.Error(err, tc.name)
+			}
+		})
+	}
+
+	locked := suite.app.VeKeeper.GetLockedAmountByUser(suite.ctx, 1)
+	require.True(locked.IsPermanent)
+	require.Equal(uint64(0), locked.End)
+
+	// Voting power of the permanent lock does not decay
+	now := uint64(suite.ctx.BlockTime().Unix())
+	for _, atTime := range []uint64{now, now + 10*types.RegulatedPeriod, now + types.MaxLockTime} {
+		require.Equal(unit.MulRaw(100), suite.app.VeKeeper.GetVotingPower(suite.ctx, 1, atTime, 0))
+		require.Equal(unit.MulRaw(100), suite.app.VeKeeper.GetTotalVotingPower(suite.ctx, atTime+types.RegulatedPeriod, 0))
+	}
+
+	// Permanent lock can be deposited into, but not extended or withdrawn
+	_, err = impl.Deposit(ctx, &types.MsgDeposit{
+		Sender: sender.String(),
+		VeId:   "ve-1",
+		Amount: sdk.NewCoin(denom, unit.MulRaw(50)),
+	})
+	require.NoError(err)
+	_, err = impl.ExtendTime(ctx, &types.MsgExtendTime{
+		Sender:       sender.String(),
+		VeId:         "ve-1",
+		LockDuration: types.MaxLockTime,
+	})
+	require.ErrorIs(err, types.ErrLockPermanent)
+	_, err = impl.Withdraw(ctx, &types.MsgWithdraw{
+		Sender: sender.String(),
+		VeId:   "ve-1",
+	})
+	require.ErrorIs(err, types.ErrLockPermanent)
+
+	// Permanent lock cannot be merged from, but can be merged into
+	_, err = impl.Merge(ctx, &types.MsgMerge{
+		Sender:   sender.String(),
+		FromVeId: "ve-1",
+		ToVeId:   "ve-2",
+	})
+	require.ErrorIs(err, types.ErrLockPermanent)
+	_, err = impl.Merge(ctx, &types.MsgMerge{
+		Sender:   sender.String(),
+		FromVeId: "ve-2",
+		ToVeId:   "ve-1",
+	})
+	require.NoError(err)
+	locked = suite.app.VeKeeper.GetLockedAmountByUser(suite.ctx, 1)
+	require.True(locked.IsPermanent)
+	require.Equal(unit.MulRaw(250), locked.Amount)
+	require.Equal(unit.MulRaw(250), suite.app.VeKeeper.GetVotingPower(suite.ctx, 1, now+types.MaxLockTime, 0))
+	require.Equal(unit.MulRaw(250), suite.app.VeKeeper.GetTotalVotingPower(suite.ctx, now+2*types.RegulatedPeriod, 0))
+}
+
+func (suite *KeeperTestSuite) TestVeUnlockPermanent() {
+	require := suite.Require()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	impl := keeper.NewMsgServerImpl(suite.app.VeKeeper)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	denom := "amage"
+	unit := sdk.NewIntWithDecimal(1, 18)
+	require.NoError(app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(sdk.NewCoin(denom, unit.MulRaw(300)))))
+	// Create Valid VeID
+	for i := 1; i <= 3; i++ {
+		res, err := impl.Create(ctx, &types.MsgCreate{
+			Sender:       sender.String(),
+			To:           sender.String(),
+			Amount:       sdk.NewCoin(denom, unit.MulRaw(100)),
+			LockDuration: types.RegulatedPeriod,
+		})
+		require.NoError(err)
+		require.Equal(fmt.Sprintf("ve-%d", i), res.VeId)
+	}
+	for _, veId := range []string{"ve-1", "ve-3"} {
+		_, err := impl.LockPermanent(ctx, &types.MsgLockPermanent{
+			Sender: sender.String(),
+			VeId:   veId,
+		})
+		require.NoError(err)
+	}
+	suite.app.VeKeeper.SetVeVoted(suite.ctx, 3, true)
+
+	testCases := []struct {
+		name   string
+		pass   bool
+		sender sdk.AccAddress
+		veId   string
+	}{
+		{"invalid sender", false, []byte("xxx"), "ve-1"},
+		{"not permanent", false, sender, "ve-2"},
+		{"ve voted", false, sender, "ve-3"},
+		{"ok", true, sender, "ve-1"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			sender := tc.sender
+			res, err := impl.UnlockPermanent(ctx, &types.MsgUnlockPermanent{
+				Sender: sender.String(),
+				VeId:   tc.veId,
+			})
+			if tc.pass {
+				require.NoError(err, tc.name)
+				require.Equal(types.RegulatedUnixTimeFromNow(suite.ctx, types.MaxLockTime), res.UnlockTime)
+			} else {
+				require.Error(err, tc.name)
+			}
+		})
+	}
+
+	// The unlocked ve decays to zero at the unlocking time
+	locked := suite.app.VeKeeper.GetLockedAmountByUser(suite.ctx, 1)
+	require.False(locked.IsPermanent)
+	require.Equal(types.RegulatedUnixTimeFromNow(suite.ctx, types.MaxLockTime), locked.End)
+	now := uint64(suite.ctx.BlockTime().Unix())
+	power := suite.app.VeKeeper.GetVotingPower(suite.ctx, 1, now, 0)
+	require.True(power.IsPositive())
+	require.True(power.LTE(unit.MulRaw(100)))
+	require.True(suite.app.VeKeeper.GetVotingPower(suite.ctx, 1, now+types.RegulatedPeriod, 0).LT(power))
+	require.Equal(sdk.ZeroInt(), suite.app.VeKeeper.GetVotingPower(suite.ctx, 1, locked.End, 0))
+
+	// Only the still permanent ve remains after all others are unlocked
+	require.Equal(unit.MulRaw(100), suite.app.VeKeeper.GetTotalVotingPower(suite.ctx, locked.End, 0))
+}
+
+func (suite *KeeperTestSuite) TestVeDelegateVotes() {
+	require := suite.Require()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	impl := keeper.NewMsgServerImpl(suite.app.VeKeeper)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	denom := "amage"
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	delegatee := sdk.AccAddress(priv.PubKey().Address())
+	// Create Valid VeID
+	for i := 1; i <= 2; i++ {
+		res, err := impl.Create(ctx, &types.MsgCreate{
+			Sender:       sender.String(),
+			To:           sender.String(),
+			Amount:       sdk.NewCoin(denom, sdk.NewInt(1)),
+			LockDuration: types.RegulatedPeriod,
+		})
+		require.NoError(err)
+		require.Equal(fmt.Sprintf("ve-%d", i), res.VeId)
+	}
+
+	testCases := []struct {
+		name      string
+		pass      bool
+		sender    sdk.AccAddress
+		veId      string
+		delegatee sdk.AccAddress
+	}{
+		{"invalid sender", false, []byte("xxx"), "ve-1", delegatee},
+		{"user doesn't own veId", false, delegatee, "ve-1", delegatee},
+		{"ok", true, sender, "ve-1", delegatee},
+		{"ok another", true, sender, "ve-2", delegatee},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			_, err := impl.DelegateVotes(ctx, &types.MsgDelegateVotes{
+				Sender:    tc.sender.String(),
+				VeId:      tc.veId,
+				Delegatee: tc.delegatee.String(),
+			})
+			if tc.pass {
+				require.NoError(err, tc.name)
+				require.Equal(tc.delegatee, suite.app.VeKeeper.GetVoteDelegatee(suite.ctx, types.Uint64FromVeID(tc.veId)))
+			} else {
+				require.Error(err, tc.name)
+			}
+		})
+	}
+	require.Equal([]uint64{1, 2}, suite.app.VeKeeper.GetVeIDsDelegatedTo(suite.ctx, delegatee))
+
+	// Only the owner can revoke
+	_, err = impl.UndelegateVotes(ctx, &types.MsgUndelegateVotes{
+		Sender: delegatee.String(),
+		VeId:   "ve-1",
+	})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = impl.UndelegateVotes(ctx, &types.MsgUndelegateVotes{
+		Sender: sender.String(),
+		VeId:   "ve-1",
+	})
+	require.NoError(err)
+	require.Nil(suite.app.VeKeeper.GetVoteDelegatee(suite.ctx, 1))
+	_, err = impl.UndelegateVotes(ctx, &types.MsgUndelegateVotes{
+		Sender: sender.String(),
+		VeId:   "ve-1",
+	})
+	require.ErrorIs(err, types.ErrVotesNotDelegated)
+
+	// Merging clears the delegation of the burned ve
+	_, err = impl.Merge(ctx, &types.MsgMerge{
+		Sender:   sender.String(),
+		FromVeId: "ve-2",
+		ToVeId:   "ve-1",
+	})
+	require.NoError(err)
+	require.Empty(suite.app.VeKeeper.GetVeIDsDelegatedTo(suite.ctx, delegatee))
+}
+
 func (suite *KeeperTestSuite) TestKeeper_DepositFor() {
 	require := suite.Require()
 	ctx := sdk.WrapSDKContext(suite.ctx)
@@ -339,8 +826,116 @@ func (suite *KeeperTestSuite) TestKeeper_DepositFor() {
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
 			err := suite.app.VeKeeper.DepositFor(suite.ctx, sender, uint64(1),
-				tc.amount, 0, types.NewLockedBalance(), tc.sendCoins)
+				tc.amount, 0, suite.app.VeKeeper.GetLockedAmountByUser(suite.ctx, 1), tc.sendCoins)
 			require.NoError(err, tc.name)
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestVeLockDenomWeights() {
+	require := suite.Require()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	k := suite.app.VeKeeper
+	impl := keeper.NewMsgServerImpl(k)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	lpDenom := "alp"
+
+	suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: lpDenom, Exponent: 0}, {Denom: "LP", Exponent: 18}},
+		Base:       lpDenom,
+		Display:    "LP",
+		Name:       "LP",
+		Symbol:     "LP",
+	})
+	err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(
+		sdk.NewCoin("amage", sdk.NewIntWithDecimal(10, 18)),
+		sdk.NewCoin(lpDenom, sdk.NewIntWithDecimal(10, 18)),
+	))
+	require.NoError(err)
+
+	// not lockable until weighted
+	amount := sdk.NewCoin(lpDenom, sdk.NewIntWithDecimal(2, 18))
+	_, err = impl.Create(ctx, &types.MsgCreate{
+		Sender:       sender.String(),
+		Amount:       amount,
+		LockDuration: types.MaxLockTime,
+	})
+	require.ErrorIs(err, types.ErrInvalidLockDenom)
+
+	params := k.GetParams(suite.ctx)
+	params.LockDenomWeights = []types.LockDenomWeight{{Denom: lpDenom, Weight: sdk.NewDec(3)}}
+	k.SetParams(suite.ctx, params)
+
+	for i := 0; i < 2; i++ {
+		_, err = impl.Create(ctx, &types.MsgCreate{
+			Sender:       sender.String(),
+			Amount:       amount,
+			LockDuration: types.MaxLockTime,
+		})
+		require.NoError(err)
+	}
+	_, err = impl.Create(ctx, &types.MsgCreate{
+		Sender:       sender.String(),
+		Amount:       sdk.NewCoin("amage", sdk.NewIntWithDecimal(6, 18)),
+		LockDuration: types.MaxLockTime,
+	})
+	require.NoError(err)
+
+	// the lock keeps its denom and weight, which scales the voting power
+	locked := k.GetLockedAmountByUser(suite.ctx, 1)
+	require.Equal(lpDenom, locked.Denom)
+	require.Equal(sdk.NewDec(3), locked.Weight)
+	require.Equal(amount.Amount.MulRaw(2), k.GetTotalLockedAmount(suite.ctx, lpDenom))
+	require.Equal(sdk.NewIntWithDecimal(6, 18), k.GetTotalLockedAmount(suite.ctx, "amage"))
+	now := uint64(suite.ctx.BlockTime().Unix())
+	require.Equal(k.GetVotingPower(suite.ctx, 3, now, 0), k.GetVotingPower(suite.ctx, 1, now, 0))
+
+	// changing the weight does not affect existing locks
+	params.LockDenomWeights[0].Weight = sdk.OneDec()
+	k.SetParams(suite.ctx, params)
+	require.Equal(sdk.NewDec(3), k.GetLockedAmountByUser(suite.ctx, 1).Weight)
+
+	// deposits and merges need the same denom
+	_, err = impl.Deposit(ctx, &types.MsgDeposit{
+		Sender: sender.String(),
+		VeId:   "ve-1",
+		Amount: sdk.NewCoin("amage", sdk.NewInt(1000)),
+	})
+	require.ErrorIs(err, types.ErrLockDenomMismatch)
+	_, err = impl.Merge(ctx, &types.MsgMerge{
+		Sender:   sender.String(),
+		FromVeId: "ve-3",
+		ToVeId:   "ve-1",
+	})
+	require.ErrorIs(err, types.ErrLockDenomMismatch)
+	_, err = impl.Merge(ctx, &types.MsgMerge{
+		Sender:   sender.String(),
+		FromVeId: "ve-2",
+		ToVeId:   "ve-1",
+	})
+	require.NoError(err)
+	require.Equal(amount.Amount.MulRaw(2), k.GetLockedAmountByUser(suite.ctx, 1).Amount)
+
+	// splits keep the denom and weight
+	res, err := impl.Split(ctx, &types.MsgSplit{
+		Sender:  sender.String(),
+		VeId:    "ve-1",
+		Amounts: []sdk.Int{amount.Amount},
+	})
+	require.NoError(err)
+	require.Equal(locked, k.GetLockedAmountByUser(suite.ctx, types.Uint64FromVeID(res.VeIds[0])))
+
+	// withdrawing returns the locked denom, and the penalty goes to stakers
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, lpDenom)
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	fees := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, lpDenom)
+	withdrawn, err := impl.WithdrawEarly(ctx, &types.MsgWithdrawEarly{
+		Sender: sender.String(),
+		VeId:   "ve-1",
+	})
+	require.NoError(err)
+	require.Equal(lpDenom, withdrawn.Amount.Denom)
+	require.Equal(balance.Add(withdrawn.Amount), suite.app.BankKeeper.GetBalance(suite.ctx, sender, lpDenom))
+	require.Equal(fees.Add(withdrawn.Penalty), suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, lpDenom))
+	require.Equal(amount.Amount, k.GetTotalLockedAmount(suite.ctx, lpDenom))
+}
@@ -35,7 +35,8 @@ func NewNftKeeper(keeper nftkeeper.Keeper, veKeeper func() Keeper) NftKeeper {
 }
 
 // Send implement Send method of the types.MsgServer of the nft module.
-// Here we customize it with checking whether the ve NFT has been attached.
+// Here we customize it with checking whether the ve NFT has been attached,
+// and clearing the vote delegation of the ve NFT.
 func (k NftKeeper) Send(c context.Context, msg *nfttypes.MsgSend) (*nfttypes.MsgSendResponse, error) {
 	// only check for ve NFT class
 	if msg.ClassId == types.VeNftClass.Id {
@@ -45,11 +46,57 @@ func (k NftKeeper) Send(c context.Context, msg *nfttypes.MsgSend) (*nfttypes.Msg
 		if err != nil {
 			return nil, err
 		}
+
+		res, err := k.Keeper.Send(c, msg)
+		if err != nil {
+			return nil, err
+		}
+
+		// the new owner decides on the delegation
+		k.veKeeper().DeleteVoteDelegatee(ctx, veID)
+		k.veKeeper().syncVeNftContract(ctx, veID)
+		return res, nil
 	}
 
 	return k.Keeper.Send(c, msg)
 }
 
+// Mint implements Mint method of the nft keeper,
+// mirroring the minted ve NFT into the ve NFT contract.
+func (k NftKeeper) Mint(ctx sdk.Context, token nfttypes.NFT, receiver sdk.AccAddress) error {
+	if err := k.Keeper.Mint(ctx, token, receiver); err != nil {
+		return err
+	}
+	if token.ClassId == types.VeNftClass.Id {
+		k.veKeeper().syncVeNftContract(ctx, types.Uint64FromVeID(token.Id))
+	}
+	return nil
+}
+
+// Burn implements Burn method of the nft keeper,
+// clearing the burned ve NFT from the ve NFT contract.
+func (k NftKeeper) Burn(ctx sdk.Context, classID string, nftID string) error {
+	if err := k.Keeper.Burn(ctx, classID, nftID); err != nil {
+		return err
+	}
+	if classID == types.VeNftClass.Id {
+		k.veKeeper().syncVeNftContract(ctx, types.Uint64FromVeID(nftID))
+	}
+	return nil
+}
+
+// Transfer implements Transfer method of the nft keeper,
+// mirroring the new owner of the ve NFT into the ve NFT contract.
+func (k NftKeeper) Transfer(ctx sdk.Context, classID string, nftID string, receiver sdk.AccAddress) error {
+	if err := k.Keeper.Transfer(ctx, classID, nftID, receiver); err != nil {
+		return err
+	}
+	if classID == types.VeNftClass.Id {
+		k.veKeeper().syncVeNftContract(ctx, types.Uint64FromVeID(nftID))
+	}
+	return nil
+}
+
 // CheckVeAttached checks whether the ve has attached/voted
 func (k Keeper) CheckVeAttached(ctx sdk.Context, veID uint64) error {
 	if k.GetVeAttached(ctx, veID) != 0 || k.GetVeVoted(ctx, veID) {
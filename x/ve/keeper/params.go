@@ -20,3 +20,13 @@ func (k Keeper) LockDenom(ctx sdk.Context) (res string) {
 	k.paramstore.Get(ctx, types.KeyLockDenom, &res)
 	return
 }
+
+func (k Keeper) MaxEarlyWithdrawPenalty(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMaxEarlyWithdrawPenalty, &res)
+	return
+}
+
+func (k Keeper) BurnEarlyWithdrawPenalty(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyBurnEarlyWithdrawPenalty, &res)
+	return
+}
@@ -1,6 +1,9 @@
 package keeper_test
 
-import "github.com/petri-labs/warmage/x/ve/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/petri-labs/warmage/x/ve/types"
+)
 
 func (suite *KeeperTestSuite) TestKeeper_GetParams() {
 	suite.SetupTest()
@@ -12,9 +15,15 @@ func (suite *KeeperTestSuite) TestKeeper_GetParams() {
 func (suite *KeeperTestSuite) TestKeeper_SetParams() {
 	suite.SetupTest()
 	k := suite.app.VeKeeper
-	k.SetParams(suite.ctx, types.Params{LockDenom: "aaa"})
+	k.SetParams(suite.ctx, types.Params{
+		LockDenom:                "aaa",
+		MaxEarlyWithdrawPenalty:  sdk.NewDecWithPrec(5, 1),
+		BurnEarlyWithdrawPenalty: true,
+	})
 	params := k.GetParams(suite.ctx)
 	suite.Require().Equal("aaa", params.LockDenom)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), params.MaxEarlyWithdrawPenalty)
+	suite.Require().True(params.BurnEarlyWithdrawPenalty)
 }
 
 func (suite *KeeperTestSuite) TestKeeper_LockDenom() {
@@ -23,3 +32,10 @@ func (suite *KeeperTestSuite) TestKeeper_LockDenom() {
 	res := k.LockDenom(suite.ctx)
 	suite.Require().Equal("amage", res)
 }
+
+func (suite *KeeperTestSuite) TestKeeper_EarlyWithdrawPenaltyParams() {
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+	suite.Require().Equal(types.DefaultMaxEarlyWithdrawPenalty, k.MaxEarlyWithdrawPenalty(suite.ctx))
+	suite.Require().Equal(types.DefaultBurnEarlyWithdrawPenalty, k.BurnEarlyWithdrawPenalty(suite.ctx))
+}
@@ -6,19 +6,19 @@ import (
 )
 
 func (k Keeper) SlashLockedAmountByUser(ctx sdk.Context, veID uint64, amount sdk.Int) {
+	locked := k.GetLockedAmountByUser(ctx, veID)
+
 	// burn amount
-	coin := sdk.NewCoin(k.LockDenom(ctx), amount)
+	coin := sdk.NewCoin(locked.Denom, amount)
 	err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(coin))
 	if err != nil {
 		panic(err)
 	}
 
 	// update total locked
-	totalLocked := k.GetTotalLockedAmount(ctx)
+	totalLocked := k.GetTotalLockedAmount(ctx, locked.Denom)
 	totalLocked = totalLocked.Sub(amount)
-	k.SetTotalLockedAmount(ctx, totalLocked)
-
-	locked := k.GetLockedAmountByUser(ctx, veID)
+	k.SetTotalLockedAmount(ctx, locked.Denom, totalLocked)
 
 	lockedOld := locked
 	locked.Amount = lockedOld.Amount.Sub(amount)
@@ -32,5 +32,13 @@ func (k Keeper) SlashLockedAmountByUser(ctx sdk.Context, veID uint64, amount sdk
 }
 
 func (k Keeper) SetGetDelegatedAmountByUser(getDelegatedAmount func(ctx sdk.Context, veID uint64) sdk.Int) {
-	k.getDelegatedAmount = getDelegatedAmount
+	*k.getDelegatedAmount = getDelegatedAmount
+}
+
+// GetDelegatedAmountByUser returns the locked amount of the ve delegated for staking
+func (k Keeper) GetDelegatedAmountByUser(ctx sdk.Context, veID uint64) sdk.Int {
+	if *k.getDelegatedAmount == nil {
+		return sdk.ZeroInt()
+	}
+	return (*k.getDelegatedAmount)(ctx, veID)
 }
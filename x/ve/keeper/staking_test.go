@@ -1,9 +1,15 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/petri-labs/warmage/app"
+	stakingkeeper "github.com/petri-labs/warmage/x/staking/keeper"
+	stakingtypes "github.com/petri-labs/warmage/x/staking/types"
 	"github.com/petri-labs/warmage/x/ve/keeper"
 	"github.com/petri-labs/warmage/x/ve/types"
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
 )
 
 func (suite *KeeperTestSuite) TestKeeper_SlashLockedAmountByUser() {
@@ -25,14 +31,145 @@ func (suite *KeeperTestSuite) TestKeeper_SlashLockedAmountByUser() {
 	require.Equal("ve-1", res.VeId)
 
 	locked := k.GetLockedAmountByUser(suite.ctx, veID)
-	totalLocked := k.GetTotalLockedAmount(suite.ctx)
+	totalLocked := k.GetTotalLockedAmount(suite.ctx, "amage")
 	require.Equal(lockAmt.Amount, locked.Amount)
 	require.Equal(lockAmt.Amount, totalLocked)
 
 	slashed := sdk.NewInt(50)
 	k.SlashLockedAmountByUser(suite.ctx, veID, slashed)
 	locked = k.GetLockedAmountByUser(suite.ctx, veID)
-	totalLocked = k.GetTotalLockedAmount(suite.ctx)
+	totalLocked = k.GetTotalLockedAmount(suite.ctx, "amage")
 	require.Equal(lockAmt.Amount.Sub(slashed), locked.Amount)
 	require.Equal(lockAmt.Amount.Sub(slashed), totalLocked)
 }
+
+func (suite *KeeperTestSuite) TestKeeper_GetDelegatedAmountByUser() {
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+	veID := uint64(1)
+	suite.Require().Equal(sdk.ZeroInt(), k.GetDelegatedAmountByUser(suite.ctx, veID))
+
+	// set by the staking keeper
+	suite.app.StakingKeeper.SetVeDelegatedAmount(suite.ctx, veID, sdk.NewInt(100))
+	suite.Require().Equal(sdk.NewInt(100), k.GetDelegatedAmountByUser(suite.ctx, veID))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_VeDelegate() {
+	suite.SetupTest()
+	require := suite.Require()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	impl := keeper.NewMsgServerImpl(suite.app.VeKeeper)
+	stakingImpl := stakingkeeper.NewMsgServerImpl(suite.app.StakingKeeper)
+	amount := sdk.NewCoin("amage", sdk.NewInt(100))
+	for i := 0; i < 2; i++ {
+		_, err := impl.Create(ctx, &types.MsgCreate{
+			Sender:       sender.String(),
+			To:           sender.String(),
+			Amount:       amount,
+			LockDuration: types.RegulatedPeriod,
+		})
+		require.NoError(err)
+	}
+	_, err := impl.LockPermanent(ctx, &types.MsgLockPermanent{
+		Sender: sender.String(),
+		VeId:   "ve-2",
+	})
+	require.NoError(err)
+
+	// once expired, only the permanent lock can be delegated
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(2 * types.RegulatedPeriod * time.Second))
+	ctx = sdk.WrapSDKContext(suite.ctx)
+	valAddr := sdk.ValAddress(suite.address.Bytes())
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+
+	testCases := []struct {
+		name      string
+		delegator sdk.AccAddress
+		veId      string
+		pass      bool
+	}{
+		{"not owner", sdk.AccAddress(priv.PubKey().Address()), "ve-2", false},
+		{"expired", sender, "ve-1", false},
+		{"permanent", sender, "ve-2", true},
+	}
+	for _, tc := range testCases {
+		_, err = stakingImpl.VeDelegate(ctx, &stakingtypes.MsgVeDelegate{
+			DelegatorAddress: tc.delegator.String(),
+			ValidatorAddress: valAddr.String(),
+			VeId:             tc.veId,
+			Amount:           amount,
+		})
+		if tc.pass {
+			require.NoError(err, tc.name)
+		} else {
+			require.Error(err, tc.name)
+		}
+	}
+	require.Equal(amount.Amount, suite.app.StakingKeeper.GetVeDelegatedAmount(suite.ctx, 2))
+	require.True(suite.app.StakingKeeper.GetVeDelegatedAmount(suite.ctx, 1).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DelegatedVeGuards() {
+	suite.SetupTest()
+	require := suite.Require()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	k := suite.app.VeKeeper
+	impl := keeper.NewMsgServerImpl(k)
+	denom := "amage"
+	unit := sdk.NewIntWithDecimal(1, 18)
+	require.NoError(app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(sdk.NewCoin(denom, unit.MulRaw(200)))))
+	for i := 0; i < 2; i++ {
+		_, err := impl.Create(ctx, &types.MsgCreate{
+			Sender:       sender.String(),
+			To:           sender.String(),
+			Amount:       sdk.NewCoin(denom, unit.MulRaw(100)),
+			LockDuration: 2 * types.RegulatedPeriod,
+		})
+		require.NoError(err)
+	}
+
+	// part of ve-1 is delegated for staking, as set by the staking keeper
+	suite.app.StakingKeeper.SetVeDelegatedAmount(suite.ctx, 1, unit)
+
+	requireDelegated := func(err error) {
+		require.Error(err)
+		require.Contains(err.Error(), "delegated for staking")
+	}
+	_, err := impl.Merge(ctx, &types.MsgMerge{
+		Sender:   sender.String(),
+		FromVeId: "ve-1",
+		ToVeId:   "ve-2",
+	})
+	requireDelegated(err)
+	_, err = impl.Split(ctx, &types.MsgSplit{
+		Sender:  sender.String(),
+		VeId:    "ve-1",
+		Amounts: []sdk.Int{unit.MulRaw(10)},
+	})
+	requireDelegated(err)
+	_, err = impl.WithdrawEarly(ctx, &types.MsgWithdrawEarly{
+		Sender: sender.String(),
+		VeId:   "ve-1",
+	})
+	requireDelegated(err)
+
+	locked := k.GetLockedAmountByUser(suite.ctx, 1)
+	expiredCtx := sdk.WrapSDKContext(suite.ctx.WithBlockTime(time.Unix(int64(locked.End), 0)))
+	_, err = impl.Withdraw(expiredCtx, &types.MsgWithdraw{
+		Sender: sender.String(),
+		VeId:   "ve-1",
+	})
+	requireDelegated(err)
+
+	// nothing is guarded once undelegated
+	suite.app.StakingKeeper.SetVeDelegatedAmount(suite.ctx, 1, sdk.ZeroInt())
+	_, err = impl.Merge(ctx, &types.MsgMerge{
+		Sender:   sender.String(),
+		FromVeId: "ve-1",
+		ToVeId:   "ve-2",
+	})
+	require.NoError(err)
+}
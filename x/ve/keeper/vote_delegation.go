@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/petri-labs/warmage/x/ve/types"
+)
+
+// SetVoteDelegatee sets the address which the votes of ve are delegated to
+func (k Keeper) SetVoteDelegatee(ctx sdk.Context, veID uint64, delegatee sdk.AccAddress) {
+	k.DeleteVoteDelegatee(ctx, veID)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VoteDelegateeKey(veID), delegatee)
+	store.Set(types.VoteDelegationByDelegateeKey(delegatee, veID), []byte{})
+}
+
+// GetVoteDelegatee gets the address which the votes of ve are delegated to,
+// or nil if not delegated
+func (k Keeper) GetVoteDelegatee(ctx sdk.Context, veID uint64) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(types.VoteDelegateeKey(veID))
+}
+
+// DeleteVoteDelegatee deletes the vote delegation of ve if any
+func (k Keeper) DeleteVoteDelegatee(ctx sdk.Context, veID uint64) {
+	delegatee := k.GetVoteDelegatee(ctx, veID)
+	if delegatee == nil {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.VoteDelegateeKey(veID))
+	store.Delete(types.VoteDelegationByDelegateeKey(delegatee, veID))
+}
+
+// GetVeIDsDelegatedTo gets all ve ids whose votes are delegated to the delegatee
+func (k Keeper) GetVeIDsDelegatedTo(ctx sdk.Context, delegatee sdk.AccAddress) (veIDs []uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteDelegationsByDelegateeKey(delegatee))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		veIDs = append(veIDs, sdk.BigEndianToUint64(iterator.Key()))
+	}
+	return
+}
+
+// CheckVoteAuthorized checks whether the voter is the owner of ve or the
+// delegatee of its votes
+func (k Keeper) CheckVoteAuthorized(ctx sdk.Context, veID uint64, voter sdk.AccAddress) error {
+	owner := k.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, types.VeIDFromUint64(veID))
+	if owner.Empty() {
+		return sdkerrors.Wrapf(types.ErrInvalidVeID, "invalid ve id: %s", types.VeIDFromUint64(veID))
+	}
+	if voter.Equals(owner) || voter.Equals(k.GetVoteDelegatee(ctx, veID)) {
+		return nil
+	}
+	return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s neither owns ve %s nor is delegated its votes", voter, types.VeIDFromUint64(veID))
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	nfttypes "github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/petri-labs/warmage/x/ve/keeper"
+	"github.com/petri-labs/warmage/x/ve/types"
+	"github.com/tharsis/ethermint/tests"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_SetVoteDelegatee_GetVoteDelegatee() {
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+	delegatee1 := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	delegatee2 := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	suite.Require().Nil(k.GetVoteDelegatee(suite.ctx, 1))
+
+	k.SetVoteDelegatee(suite.ctx, 1, delegatee1)
+	k.SetVoteDelegatee(suite.ctx, 2, delegatee1)
+	suite.Require().Equal(delegatee1, k.GetVoteDelegatee(suite.ctx, 1))
+	suite.Require().Equal([]uint64{1, 2}, k.GetVeIDsDelegatedTo(suite.ctx, delegatee1))
+
+	// Replace the delegation
+	k.SetVoteDelegatee(suite.ctx, 1, delegatee2)
+	suite.Require().Equal(delegatee2, k.GetVoteDelegatee(suite.ctx, 1))
+	suite.Require().Equal([]uint64{2}, k.GetVeIDsDelegatedTo(suite.ctx, delegatee1))
+	suite.Require().Equal([]uint64{1}, k.GetVeIDsDelegatedTo(suite.ctx, delegatee2))
+
+	k.DeleteVoteDelegatee(suite.ctx, 1)
+	suite.Require().Nil(k.GetVoteDelegatee(suite.ctx, 1))
+	suite.Require().Empty(k.GetVeIDsDelegatedTo(suite.ctx, delegatee2))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_CheckVoteAuthorized() {
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+	impl := keeper.NewMsgServerImpl(k)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	delegatee := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	_, err := impl.Create(sdk.WrapSDKContext(suite.ctx), &types.MsgCreate{
+		Sender:       sender.String(),
+		Amount:       sdk.NewCoin("amage", sdk.NewInt(1)),
+		LockDuration: types.RegulatedPeriod,
+	})
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(k.CheckVoteAuthorized(suite.ctx, 1, sender))
+	suite.Require().ErrorIs(k.CheckVoteAuthorized(suite.ctx, 1, delegatee), sdkerrors.ErrUnauthorized)
+	suite.Require().ErrorIs(k.CheckVoteAuthorized(suite.ctx, 2, sender), types.ErrInvalidVeID)
+
+	k.SetVoteDelegatee(suite.ctx, 1, delegatee)
+	suite.Require().NoError(k.CheckVoteAuthorized(suite.ctx, 1, delegatee))
+	suite.Require().NoError(k.CheckVoteAuthorized(suite.ctx, 1, sender))
+
+	// Transferring the NFT clears the delegation
+	receiver := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	_, err = suite.app.NftKeeper.Send(sdk.WrapSDKContext(suite.ctx), &nfttypes.MsgSend{
+		ClassId:  types.VeNftClass.Id,
+		Id:       "ve-1",
+		Sender:   sender.String(),
+		Receiver: receiver.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Nil(k.GetVoteDelegatee(suite.ctx, 1))
+	suite.Require().Empty(k.GetVeIDsDelegatedTo(suite.ctx, delegatee))
+	suite.Require().ErrorIs(k.CheckVoteAuthorized(suite.ctx, 1, delegatee), sdkerrors.ErrUnauthorized)
+	suite.Require().NoError(k.CheckVoteAuthorized(suite.ctx, 1, receiver))
+}
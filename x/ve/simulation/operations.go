@@ -0,0 +1,339 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	warmage "github.com/petri-labs/warmage/types"
+	"github.com/petri-labs/warmage/x/ve/keeper"
+	"github.com/petri-labs/warmage/x/ve/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreate     = "op_weight_msg_create"
+	OpWeightMsgDeposit    = "op_weight_msg_deposit"
+	OpWeightMsgExtendTime = "op_weight_msg_extend_time"
+	OpWeightMsgMerge      = "op_weight_msg_merge"
+	OpWeightMsgWithdraw   = "op_weight_msg_withdraw"
+	OpWeightMsgSendNft    = "op_weight_msg_send_nft"
+
+	DefaultWeightMsgCreate     = 100
+	DefaultWeightMsgDeposit    = 60
+	DefaultWeightMsgExtendTime = 40
+	DefaultWeightMsgMerge      = 20
+	DefaultWeightMsgWithdraw   = 40
+	DefaultWeightMsgSendNft    = 20
+)
+
+var (
+	// maximum amount locked in a single operation
+	maxLockIn = sdk.NewIntWithDecimal(100, 18)
+	// maximum locking weeks of most locks, so that they expire within a
+	// simulation run
+	maxShortLockWeeks = 4
+)
+
+// TypeMsgSendNft is the type of the nft Send message, which is no legacy msg.
+var TypeMsgSendNft = sdk.MsgTypeURL(&nft.MsgSend{})
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec,
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	weight := func(key string, defaultWeight int) int {
+		var w int
+		appParams.GetOrGenerate(cdc, key, &w, nil, func(_ *rand.Rand) { w = defaultWeight })
+		return w
+	}
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgCreate, DefaultWeightMsgCreate),
+			SimulateMsgCreate(cdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgDeposit, DefaultWeightMsgDeposit),
+			SimulateMsgDeposit(cdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgExtendTime, DefaultWeightMsgExtendTime),
+			SimulateMsgExtendTime(cdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgMerge, DefaultWeightMsgMerge),
+			SimulateMsgMerge(cdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgWithdraw, DefaultWeightMsgWithdraw),
+			SimulateMsgWithdraw(cdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgSendNft, DefaultWeightMsgSendNft),
+			SimulateMsgSendNft(cdc, ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgCreate generates a MsgCreate with random values, mostly locking
+// for a few weeks so that the ve can be withdrawn as block time advances.
+func SimulateMsgCreate(cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+
+		amount := randomCoin(r, spendable, k.LockDenom(ctx), maxLockIn)
+		if !amount.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreate, "no coin to lock"), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &types.MsgCreate{
+			Sender:       simAccount.Address.String(),
+			To:           to.Address.String(),
+			Amount:       amount,
+			LockDuration: randomLockDuration(r),
+		}
+		return deliver(r, app, ctx, cdc, ak, bk, simAccount, msg, types.TypeMsgCreate, sdk.NewCoins(amount))
+	}
+}
+
+// SimulateMsgDeposit generates a MsgDeposit into a random unexpired ve of the
+// account.
+func SimulateMsgDeposit(cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		veID, locked, found := randomVe(r, ctx, k, simAccount.Address, func(locked types.LockedBalance) bool {
+			return locked.IsPermanent || locked.End > uint64(ctx.BlockTime().Unix())
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeposit, "account has no unexpired ve"), nil, nil
+		}
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		amount := randomCoin(r, spendable, locked.Denom, maxLockIn)
+		if !amount.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeposit, "no coin to lock"), nil, nil
+		}
+
+		msg := &types.MsgDeposit{
+			Sender: simAccount.Address.String(),
+			VeId:   types.VeIDFromUint64(veID),
+			Amount: amount,
+		}
+		return deliver(r, app, ctx, cdc, ak, bk, simAccount, msg, types.TypeMsgDeposit, sdk.NewCoins(amount))
+	}
+}
+
+// SimulateMsgExtendTime generates a MsgExtendTime for a random unexpired ve of
+// the account.
+func SimulateMsgExtendTime(cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		veID, _, found := randomVe(r, ctx, k, simAccount.Address, func(locked types.LockedBalance) bool {
+			return !locked.IsPermanent && locked.End > uint64(ctx.BlockTime().Unix())
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExtendTime, "account has no unexpired ve"), nil, nil
+		}
+
+		msg := &types.MsgExtendTime{
+			Sender:       simAccount.Address.String(),
+			VeId:         types.VeIDFromUint64(veID),
+			LockDuration: randomLockDuration(r),
+		}
+		return deliver(r, app, ctx, cdc, ak, bk, simAccount, msg, types.TypeMsgExtendTime, nil)
+	}
+}
+
+// SimulateMsgMerge generates a MsgMerge between two random ve of the account.
+func SimulateMsgMerge(cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		veIDs := getVeIDs(ctx, k, simAccount.Address)
+		if len(veIDs) < 2 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMerge, "account has less than two ve"), nil, nil
+		}
+		r.Shuffle(len(veIDs), func(i, j int) { veIDs[i], veIDs[j] = veIDs[j], veIDs[i] })
+
+		msg := &types.MsgMerge{
+			Sender:   simAccount.Address.String(),
+			FromVeId: types.VeIDFromUint64(veIDs[0]),
+			ToVeId:   types.VeIDFromUint64(veIDs[1]),
+		}
+		return deliver(r, app, ctx, cdc, ak, bk, simAccount, msg, types.TypeMsgMerge, nil)
+	}
+}
+
+// SimulateMsgWithdraw generates a MsgWithdraw for a random expired ve of the
+// account.
+func SimulateMsgWithdraw(cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		veID, _, found := randomVe(r, ctx, k, simAccount.Address, func(locked types.LockedBalance) bool {
+			return !locked.IsPermanent && locked.End <= uint64(ctx.BlockTime().Unix())
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdraw, "account has no expired ve"), nil, nil
+		}
+
+		msg := &types.MsgWithdraw{
+			Sender: simAccount.Address.String(),
+			VeId:   types.VeIDFromUint64(veID),
+		}
+		return deliver(r, app, ctx, cdc, ak, bk, simAccount, msg, types.TypeMsgWithdraw, nil)
+	}
+}
+
+// SimulateMsgSendNft generates a nft MsgSend of a random ve of the account to
+// another account.
+func SimulateMsgSendNft(cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		veID, _, found := randomVe(r, ctx, k, simAccount.Address, func(types.LockedBalance) bool { return true })
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSendNft, "account has no ve"), nil, nil
+		}
+		receiver, _ := simtypes.RandomAcc(r, accs)
+		if receiver.Address.Equals(simAccount.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSendNft, "send to self"), nil, nil
+		}
+
+		msg := &nft.MsgSend{
+			ClassId:  types.VeNftClass.Id,
+			Id:       types.VeIDFromUint64(veID),
+			Sender:   simAccount.Address.String(),
+			Receiver: receiver.Address.String(),
+		}
+		return deliver(r, app, ctx, cdc, ak, bk, simAccount, msg, TypeMsgSendNft, nil)
+	}
+}
+
+// deliver executes msg against a cached context first, so that the many
+// messages rejected by the ve rules end up as no-ops instead of failing the
+// simulation, and then delivers it in a transaction with random fees and the
+// gas used by the cached execution.
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, cdc codec.JSONCodec,
+	ak types.AccountKeeper, bk types.BankKeeper,
+	simAccount simtypes.Account, msg sdk.Msg, msgType string, coinsSpent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	handler := app.MsgServiceRouter().Handler(msg)
+	if handler == nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "no handler"), nil, nil
+	}
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	if _, err := handler(cacheCtx, msg); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+	}
+	// ve calls its nft contract, which may take more than the default gas of
+	// the SDK
+	gas := cacheCtx.GasMeter().GasConsumed() + helpers.DefaultGenTxGas
+
+	account := ak.GetAccount(ctx, simAccount.Address)
+	spendable, hasNeg := bk.SpendableCoins(ctx, simAccount.Address).SafeSub(coinsSpent)
+	if hasNeg {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "message doesn't leave room for fees"), nil, nil
+	}
+	// fees are paid in mage, whose transfers call no erc20 contract
+	fees, err := simtypes.RandomFees(r, ctx, sdk.NewCoins(sdk.NewCoin(warmage.AttoMageDenom, spendable.AmountOf(warmage.AttoMageDenom))))
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate fees"), nil, err
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		gas,
+		ctx.ChainID(),
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate mock tx"), nil, err
+	}
+
+	if _, _, err := app.Deliver(txGen.TxEncoder(), tx); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+	}
+	// the codec is only used for messages which are not legacy msgs
+	protoCdc, _ := cdc.(*codec.ProtoCodec)
+	return simtypes.NewOperationMsg(msg, true, "", protoCdc), nil, nil
+}
+
+// getVeIDs returns the ids of all ve owned by the account.
+func getVeIDs(ctx sdk.Context, k keeper.Keeper, owner sdk.AccAddress) []uint64 {
+	res, err := k.VeNfts(sdk.WrapSDKContext(ctx), &types.QueryVeNftsRequest{Owner: owner.String()})
+	if err != nil {
+		return nil
+	}
+	veIDs := make([]uint64, 0, len(res.Nfts))
+	for _, token := range res.Nfts {
+		veIDs = append(veIDs, types.Uint64FromVeID(token.Id))
+	}
+	return veIDs
+}
+
+// randomVe returns a random ve owned by the account whose locked balance
+// satisfies the filter.
+func randomVe(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, owner sdk.AccAddress, filter func(types.LockedBalance) bool) (uint64, types.LockedBalance, bool) {
+	var (
+		veIDs   []uint64
+		lockeds []types.LockedBalance
+	)
+	for _, veID := range getVeIDs(ctx, k, owner) {
+		locked := k.GetLockedAmountByUser(ctx, veID)
+		if filter(locked) {
+			veIDs = append(veIDs, veID)
+			lockeds = append(lockeds, locked)
+		}
+	}
+	if len(veIDs) == 0 {
+		return 0, types.LockedBalance{}, false
+	}
+	i := r.Intn(len(veIDs))
+	return veIDs[i], lockeds[i], true
+}
+
+// randomLockDuration returns a random locking duration of a few weeks, or
+// occasionally up to the max locking time.
+func randomLockDuration(r *rand.Rand) uint64 {
+	weeks := simtypes.RandIntBetween(r, 1, maxShortLockWeeks+1)
+	if r.Intn(4) == 0 {
+		weeks = simtypes.RandIntBetween(r, 1, types.MaxLockTimeWeeks+1)
+	}
+	return uint64(weeks) * types.RegulatedPeriod
+}
+
+// randomCoin returns a coin of denom with a random amount not greater than
+// both the spendable balance and max.
+func randomCoin(r *rand.Rand, spendable sdk.Coins, denom string, max sdk.Int) sdk.Coin {
+	amount := sdk.MinInt(spendable.AmountOf(denom), max)
+	if !amount.IsPositive() {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+	return sdk.NewCoin(denom, simtypes.RandomAmount(r, amount))
+}
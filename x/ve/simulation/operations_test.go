@@ -0,0 +1,240 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	ethermint "github.com/tharsis/ethermint/app"
+
+	"github.com/petri-labs/warmage/app"
+	"github.com/petri-labs/warmage/x/ve/keeper"
+	"github.com/petri-labs/warmage/x/ve/simulation"
+	"github.com/petri-labs/warmage/x/ve/types"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *app.Warmage
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
+
+func (suite *SimTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		ChainID: "warmage_5000-101",
+		Height:  1,
+		Time:    time.Unix(1660000000, 0).UTC(),
+	})
+}
+
+// TestWeightedOperations tests the weights of the operations.
+func (suite *SimTestSuite) TestWeightedOperations() {
+	cdc := suite.app.AppCodec()
+	appParams := make(simtypes.AppParams)
+
+	weightedOps := simulation.WeightedOperations(appParams, cdc, suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.VeKeeper)
+
+	expected := []struct {
+		weight  int
+		msgType string
+	}{
+		{simulation.DefaultWeightMsgCreate, types.TypeMsgCreate},
+		{simulation.DefaultWeightMsgDeposit, types.TypeMsgDeposit},
+		{simulation.DefaultWeightMsgExtendTime, types.TypeMsgExtendTime},
+		{simulation.DefaultWeightMsgMerge, types.TypeMsgMerge},
+		{simulation.DefaultWeightMsgWithdraw, types.TypeMsgWithdraw},
+		{simulation.DefaultWeightMsgSendNft, simulation.TypeMsgSendNft},
+	}
+
+	suite.Require().Len(weightedOps, len(expected))
+	for i, w := range weightedOps {
+		suite.Require().Equal(expected[i].weight, w.Weight())
+	}
+}
+
+// TestSimulateMsgCreate tests the normal scenario of a valid message of type
+// TypeMsgCreate, and the no-op without any coin to lock.
+func (suite *SimTestSuite) TestSimulateMsgCreate() {
+	r := rand.New(rand.NewSource(1))
+	accounts := suite.getTestingAccounts(r, 1)
+	suite.beginBlock()
+
+	op := simulation.SimulateMsgCreate(suite.app.AppCodec(), suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.VeKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+	suite.Require().True(operationMsg.OK, operationMsg.Comment)
+	suite.Require().Equal(types.TypeMsgCreate, operationMsg.Name)
+	suite.Require().Len(futureOperations, 0)
+	suite.Require().Len(suite.getVeIDs(accounts[0].Address), 1)
+	suite.requireInvariants()
+
+	empty := ethermint.RandomAccounts(r, 1)
+	operationMsg, _, err = op(r, suite.app.BaseApp, suite.ctx, empty, "")
+	suite.Require().NoError(err)
+	suite.Require().False(operationMsg.OK)
+}
+
+// TestSimulateMsgDeposit tests the normal scenario of a valid message of type
+// TypeMsgDeposit.
+func (suite *SimTestSuite) TestSimulateMsgDeposit() {
+	r := rand.New(rand.NewSource(1))
+	accounts := suite.getTestingAccounts(r, 1)
+	suite.createVe(accounts[0], types.RegulatedPeriod)
+	locked := suite.app.VeKeeper.GetLockedAmountByUser(suite.ctx, 1)
+	suite.beginBlock()
+
+	op := simulation.SimulateMsgDeposit(suite.app.AppCodec(), suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.VeKeeper)
+	operationMsg, _, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+	suite.Require().True(operationMsg.OK, operationMsg.Comment)
+	suite.Require().Equal(types.TypeMsgDeposit, operationMsg.Name)
+	suite.Require().True(suite.app.VeKeeper.GetLockedAmountByUser(suite.ctx, 1).Amount.GT(locked.Amount))
+	suite.requireInvariants()
+}
+
+// TestSimulateMsgExtendTime tests the normal scenario of a valid message of
+// type TypeMsgExtendTime.
+func (suite *SimTestSuite) TestSimulateMsgExtendTime() {
+	r := rand.New(rand.NewSource(1))
+	accounts := suite.getTestingAccounts(r, 1)
+	suite.createVe(accounts[0], types.RegulatedPeriod)
+	suite.beginBlock()
+
+	op := simulation.SimulateMsgExtendTime(suite.app.AppCodec(), suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.VeKeeper)
+	// the random duration may not extend the lock, which is a no-op
+	var operationMsg simtypes.OperationMsg
+	for i := 0; i < 10 && !operationMsg.OK; i++ {
+		var err error
+		operationMsg, _, err = op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+		suite.Require().NoError(err)
+	}
+	suite.Require().True(operationMsg.OK, operationMsg.Comment)
+	suite.Require().Equal(types.TypeMsgExtendTime, operationMsg.Name)
+	suite.requireInvariants()
+}
+
+// TestSimulateMsgMerge tests the normal scenario of a valid message of type
+// TypeMsgMerge, and the no-op with a single ve.
+func (suite *SimTestSuite) TestSimulateMsgMerge() {
+	r := rand.New(rand.NewSource(1))
+	accounts := suite.getTestingAccounts(r, 1)
+	suite.createVe(accounts[0], types.RegulatedPeriod)
+	suite.beginBlock()
+
+	op := simulation.SimulateMsgMerge(suite.app.AppCodec(), suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.VeKeeper)
+	operationMsg, _, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+	suite.Require().False(operationMsg.OK)
+
+	suite.createVe(accounts[0], 2*types.RegulatedPeriod)
+	operationMsg, _, err = op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+	suite.Require().True(operationMsg.OK, operationMsg.Comment)
+	suite.Require().Equal(types.TypeMsgMerge, operationMsg.Name)
+	suite.Require().Len(suite.getVeIDs(accounts[0].Address), 1)
+	suite.requireInvariants()
+}
+
+// TestSimulateMsgWithdraw tests the normal scenario of a valid message of type
+// TypeMsgWithdraw, which is a no-op until the lock expires.
+func (suite *SimTestSuite) TestSimulateMsgWithdraw() {
+	r := rand.New(rand.NewSource(1))
+	accounts := suite.getTestingAccounts(r, 1)
+	suite.createVe(accounts[0], types.RegulatedPeriod)
+	suite.beginBlock()
+
+	op := simulation.SimulateMsgWithdraw(suite.app.AppCodec(), suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.VeKeeper)
+	operationMsg, _, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+	suite.Require().False(operationMsg.OK)
+
+	locked := suite.app.VeKeeper.GetLockedAmountByUser(suite.ctx, 1)
+	suite.ctx = suite.ctx.WithBlockTime(time.Unix(int64(locked.End), 0))
+	suite.beginBlock()
+	suite.requireInvariants()
+
+	operationMsg, _, err = op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+	suite.Require().True(operationMsg.OK, operationMsg.Comment)
+	suite.Require().Equal(types.TypeMsgWithdraw, operationMsg.Name)
+	suite.Require().Empty(suite.getVeIDs(accounts[0].Address))
+	suite.requireInvariants()
+}
+
+// TestSimulateMsgSendNft tests the normal scenario of a valid nft message of
+// type TypeMsgSendNft.
+func (suite *SimTestSuite) TestSimulateMsgSendNft() {
+	r := rand.New(rand.NewSource(1))
+	accounts := suite.getTestingAccounts(r, 2)
+	suite.createVe(accounts[0], types.RegulatedPeriod)
+	suite.beginBlock()
+
+	op := simulation.SimulateMsgSendNft(suite.app.AppCodec(), suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.VeKeeper)
+	// the random receiver may be the sender, which is a no-op
+	var operationMsg simtypes.OperationMsg
+	for i := 0; i < 10 && !operationMsg.OK; i++ {
+		var err error
+		operationMsg, _, err = op(r, suite.app.BaseApp, suite.ctx, accounts[:1], "")
+		suite.Require().NoError(err)
+		suite.Require().False(operationMsg.OK)
+		operationMsg, _, err = op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+		suite.Require().NoError(err)
+	}
+	suite.Require().True(operationMsg.OK, operationMsg.Comment)
+	suite.Require().Equal(sdk.MsgTypeURL(&nft.MsgSend{}), operationMsg.Name)
+	suite.Require().Equal(accounts[1].Address, suite.app.NftKeeper.GetOwner(suite.ctx, types.VeNftClass.Id, "ve-1"))
+	suite.requireInvariants()
+}
+
+// beginBlock begins a block at the time of the test context.
+func (suite *SimTestSuite) beginBlock() {
+	suite.app.BeginBlock(abci.RequestBeginBlock{Header: suite.ctx.BlockHeader()})
+}
+
+// createVe locks coins of the account into a new ve.
+func (suite *SimTestSuite) createVe(account simtypes.Account, duration uint64) {
+	_, err := keeper.NewMsgServerImpl(suite.app.VeKeeper).Create(sdk.WrapSDKContext(suite.ctx), &types.MsgCreate{
+		Sender:       account.Address.String(),
+		To:           account.Address.String(),
+		Amount:       sdk.NewCoin(suite.app.VeKeeper.LockDenom(suite.ctx), sdk.NewIntWithDecimal(10, 18)),
+		LockDuration: duration,
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *SimTestSuite) getVeIDs(owner sdk.AccAddress) []nft.NFT {
+	return suite.app.NftKeeper.GetNFTsOfClassByOwner(suite.ctx, types.VeNftClass.Id, owner)
+}
+
+func (suite *SimTestSuite) requireInvariants() {
+	msg, broken := keeper.AllInvariants(suite.app.VeKeeper)(suite.ctx)
+	suite.Require().False(broken, msg)
+}
+
+func (suite *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
+	// the ante handler only accepts eth_secp256k1 keys
+	accounts := ethermint.RandomAccounts(r, n)
+
+	coins := sdk.NewCoins(sdk.NewCoin(suite.app.VeKeeper.LockDenom(suite.ctx), sdk.NewIntWithDecimal(1000, 18)))
+
+	// add coins to the accounts
+	for _, account := range accounts {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, account.Address)
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		suite.Require().NoError(app.FundAccount(suite.app.BankKeeper, suite.ctx, account.Address, coins))
+	}
+
+	return accounts
+}
@@ -23,6 +23,9 @@ const (
 
 	EmptyEpoch = 0
 	FirstEpoch = 1
+
+	// Maximum number of points of a queried voting power series
+	MaxVotingPowerSeriesPoints = 1000
 )
 
 var (
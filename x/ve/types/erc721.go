@@ -0,0 +1,83 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/petri-labs/warmage/contracts"
+)
+
+// Storage slots of the mappings of the ve NFT contract, as laid out by
+// contracts/VeNFT.sol, where the value of key is kept at keccak256(key . slot).
+const (
+	// VeNftSlotOwners maps token ids to their owners
+	VeNftSlotOwners = iota
+	// VeNftSlotBalances maps owners to their numbers of tokens
+	VeNftSlotBalances
+	// VeNftSlotApprovals maps token ids to their approved addresses
+	VeNftSlotApprovals
+	// VeNftSlotOperatorApprovals maps owners to operators to whether approved
+	VeNftSlotOperatorApprovals
+	// VeNftSlotLocks maps token ids to their lock structs, whose fields are
+	// kept in consecutive slots from the mapped slot on.
+	VeNftSlotLocks
+)
+
+// Fields of the lock struct of the ve NFT contract, written by the ve module.
+const (
+	VeNftLockAmount = iota
+	VeNftLockEnd
+	VeNftLockIsPermanent
+	VeNftLockBias
+	VeNftLockSlope
+	VeNftLockTimestamp
+	VeNftLockPermanent
+)
+
+// ERC721 interface ids, as of ERC-165
+const (
+	InterfaceIDERC165 = 0x01ffc9a7
+	InterfaceIDERC721 = 0x80ac58cd
+)
+
+// ERC721EventTransfer is the name of the ERC721 Transfer event
+const ERC721EventTransfer = "Transfer"
+
+// VeNftContractABI is the ABI of the ve NFT contract, i.e., the ERC721
+// interface, plus the balanceOfNFT and locked views of ve.
+var VeNftContractABI abi.ABI = contracts.VeNFTContract.ABI
+
+// VeNftOwnerSlot returns the storage key of the owner of the ve.
+func VeNftOwnerSlot(veID uint64) common.Hash {
+	return veNftMappingSlot(new(big.Int).SetUint64(veID).Bytes(), VeNftSlotOwners)
+}
+
+// VeNftBalanceSlot returns the storage key of the number of ve NFTs of owner.
+func VeNftBalanceSlot(owner common.Address) common.Hash {
+	return veNftMappingSlot(owner.Bytes(), VeNftSlotBalances)
+}
+
+// VeNftApprovalSlot returns the storage key of the approved address of the ve.
+func VeNftApprovalSlot(veID uint64) common.Hash {
+	return veNftMappingSlot(new(big.Int).SetUint64(veID).Bytes(), VeNftSlotApprovals)
+}
+
+// VeNftOperatorApprovalSlot returns the storage key of whether operator is
+// approved for all ve NFTs of owner.
+func VeNftOperatorApprovalSlot(owner, operator common.Address) common.Hash {
+	inner := veNftMappingSlot(owner.Bytes(), VeNftSlotOperatorApprovals)
+	return crypto.Keccak256Hash(common.LeftPadBytes(operator.Bytes(), 32), inner.Bytes())
+}
+
+// VeNftLockSlot returns the storage key of the field of the lock struct of the ve.
+func VeNftLockSlot(veID uint64, field int64) common.Hash {
+	base := veNftMappingSlot(new(big.Int).SetUint64(veID).Bytes(), VeNftSlotLocks).Big()
+	return common.BigToHash(base.Add(base, big.NewInt(field)))
+}
+
+func veNftMappingSlot(key []byte, slot int64) common.Hash {
+	return crypto.Keccak256Hash(common.LeftPadBytes(key, 32), common.BigToHash(big.NewInt(slot)).Bytes())
+}
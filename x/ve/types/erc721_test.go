@@ -0,0 +1,256 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/stretchr/testify/require"
+
+	"github.com/petri-labs/warmage/contracts"
+	"github.com/petri-labs/warmage/x/ve/types"
+)
+
+var (
+	nftOwner    = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	nftReceiver = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	nftOperator = common.HexToAddress("0x3000000000000000000000000000000000000003")
+)
+
+func setupVeNftContract(t *testing.T) (common.Address, *runtime.Config) {
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	require.NoError(t, err)
+
+	ctorArgs, err := types.VeNftContractABI.Pack("", types.VeNftClass.Name, types.VeNftClass.Symbol)
+	require.NoError(t, err)
+	_, addr, _, err := runtime.Create(append(contracts.VeNFTContract.Bin, ctorArgs...), &runtime.Config{State: statedb})
+	require.NoError(t, err)
+
+	// ve 1 of owner, locked until 3000 with voting power decaying from 1000 at 1000
+	statedb.SetState(addr, types.VeNftOwnerSlot(1), nftOwner.Hash())
+	statedb.SetState(addr, types.VeNftBalanceSlot(nftOwner), common.BigToHash(big.NewInt(1)))
+	statedb.SetState(addr, types.VeNftLockSlot(1, types.VeNftLockAmount), common.BigToHash(big.NewInt(4000)))
+	statedb.SetState(addr, types.VeNftLockSlot(1, types.VeNftLockEnd), common.BigToHash(big.NewInt(3000)))
+	statedb.SetState(addr, types.VeNftLockSlot(1, types.VeNftLockBias), common.BigToHash(big.NewInt(1000)))
+	statedb.SetState(addr, types.VeNftLockSlot(1, types.VeNftLockSlope), common.BigToHash(big.NewInt(1)))
+	statedb.SetState(addr, types.VeNftLockSlot(1, types.VeNftLockTimestamp), common.BigToHash(big.NewInt(1000)))
+
+	// permanent ve 2 of owner
+	statedb.SetState(addr, types.VeNftOwnerSlot(2), nftOwner.Hash())
+	statedb.SetState(addr, types.VeNftBalanceSlot(nftOwner), common.BigToHash(big.NewInt(2)))
+	statedb.SetState(addr, types.VeNftLockSlot(2, types.VeNftLockAmount), common.BigToHash(big.NewInt(500)))
+	statedb.SetState(addr, types.VeNftLockSlot(2, types.VeNftLockIsPermanent), common.BigToHash(big.NewInt(1)))
+	statedb.SetState(addr, types.VeNftLockSlot(2, types.VeNftLockTimestamp), common.BigToHash(big.NewInt(1000)))
+	statedb.SetState(addr, types.VeNftLockSlot(2, types.VeNftLockPermanent), common.BigToHash(big.NewInt(500)))
+
+	return addr, &runtime.Config{State: statedb, Time: big.NewInt(1500), Origin: nftOwner}
+}
+
+func callVeNftContract(t *testing.T, addr common.Address, cfg *runtime.Config, method string, args ...interface{}) ([]interface{}, error) {
+	input, err := types.VeNftContractABI.Pack(method, args...)
+	require.NoError(t, err)
+
+	ret, _, err := runtime.Call(addr, input, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return types.VeNftContractABI.Unpack(method, ret)
+}
+
+func TestVeNftContract_Views(t *testing.T) {
+	addr, cfg := setupVeNftContract(t)
+
+	res, err := callVeNftContract(t, addr, cfg, "name")
+	require.NoError(t, err)
+	require.Equal(t, types.VeNftClass.Name, res[0])
+
+	res, err = callVeNftContract(t, addr, cfg, "symbol")
+	require.NoError(t, err)
+	require.Equal(t, types.VeNftClass.Symbol, res[0])
+
+	res, err = callVeNftContract(t, addr, cfg, "balanceOf", nftOwner)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(2), res[0])
+	res, err = callVeNftContract(t, addr, cfg, "balanceOf", nftReceiver)
+	require.NoError(t, err)
+	require.Zero(t, res[0].(*big.Int).Sign())
+	_, err = callVeNftContract(t, addr, cfg, "balanceOf", common.Address{})
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+
+	res, err = callVeNftContract(t, addr, cfg, "ownerOf", big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, nftOwner, res[0])
+	_, err = callVeNftContract(t, addr, cfg, "ownerOf", big.NewInt(3))
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+
+	res, err = callVeNftContract(t, addr, cfg, "getApproved", big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, common.Address{}, res[0])
+	_, err = callVeNftContract(t, addr, cfg, "getApproved", big.NewInt(3))
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+
+	res, err = callVeNftContract(t, addr, cfg, "isApprovedForAll", nftOwner, nftOperator)
+	require.NoError(t, err)
+	require.Equal(t, false, res[0])
+
+	for id, supported := range map[uint32]bool{
+		types.InterfaceIDERC165: true,
+		types.InterfaceIDERC721: true,
+		0x5b5e139f:              false,
+		0xffffffff:              false,
+	} {
+		var interfaceID [4]byte
+		big.NewInt(int64(id)).FillBytes(interfaceID[:])
+		res, err = callVeNftContract(t, addr, cfg, "supportsInterface", interfaceID)
+		require.NoError(t, err)
+		require.Equal(t, supported, res[0], "interface %x", id)
+	}
+
+	// voting power decays from the checkpoint, but not of permanent locks
+	res, err = callVeNftContract(t, addr, cfg, "balanceOfNFT", big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(500), res[0])
+	res, err = callVeNftContract(t, addr, cfg, "balanceOfNFT", big.NewInt(2))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(500), res[0])
+	cfg.Time = big.NewInt(2500)
+	res, err = callVeNftContract(t, addr, cfg, "balanceOfNFT", big.NewInt(1))
+	require.NoError(t, err)
+	require.Zero(t, res[0].(*big.Int).Sign())
+	res, err = callVeNftContract(t, addr, cfg, "balanceOfNFT", big.NewInt(2))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(500), res[0])
+	res, err = callVeNftContract(t, addr, cfg, "balanceOfNFT", big.NewInt(3))
+	require.NoError(t, err)
+	require.Zero(t, res[0].(*big.Int).Sign())
+
+	res, err = callVeNftContract(t, addr, cfg, "locked", big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{big.NewInt(4000), big.NewInt(3000), false}, res)
+	res, err = callVeNftContract(t, addr, cfg, "locked", big.NewInt(2))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(500), res[0])
+	require.Zero(t, res[1].(*big.Int).Sign())
+	require.Equal(t, true, res[2])
+
+	// Unknown functions, short call data and value transfers revert
+	_, _, err = runtime.Call(addr, []byte{0x01, 0x02, 0x03, 0x04}, cfg)
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+	_, _, err = runtime.Call(addr, types.VeNftContractABI.Methods["ownerOf"].ID, cfg)
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+	input, err := types.VeNftContractABI.Pack("name")
+	require.NoError(t, err)
+	cfg.State.AddBalance(cfg.Origin, big.NewInt(1))
+	cfg.Value = big.NewInt(1)
+	_, _, err = runtime.Call(addr, input, cfg)
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+}
+
+func TestVeNftContract_Transfer(t *testing.T) {
+	addr, cfg := setupVeNftContract(t)
+	transfer := types.VeNftContractABI.Events[types.ERC721EventTransfer].ID
+
+	requireOwner := func(owner common.Address, ownerBalance, receiverBalance int64) {
+		res, err := callVeNftContract(t, addr, cfg, "ownerOf", big.NewInt(1))
+		require.NoError(t, err)
+		require.Equal(t, owner, res[0])
+		res, err = callVeNftContract(t, addr, cfg, "balanceOf", nftOwner)
+		require.NoError(t, err)
+		require.Zero(t, big.NewInt(ownerBalance).Cmp(res[0].(*big.Int)))
+		res, err = callVeNftContract(t, addr, cfg, "balanceOf", nftReceiver)
+		require.NoError(t, err)
+		require.Zero(t, big.NewInt(receiverBalance).Cmp(res[0].(*big.Int)))
+	}
+
+	// neither owner, approved nor operator
+	cfg.Origin = nftOperator
+	_, err := callVeNftContract(t, addr, cfg, "transferFrom", nftOwner, nftReceiver, big.NewInt(1))
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+
+	// not from the owner
+	cfg.Origin = nftOwner
+	_, err = callVeNftContract(t, addr, cfg, "transferFrom", nftReceiver, nftOperator, big.NewInt(1))
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+	// to the zero address
+	_, err = callVeNftContract(t, addr, cfg, "transferFrom", nftOwner, common.Address{}, big.NewInt(1))
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+
+	// approved
+	_, err = callVeNftContract(t, addr, cfg, "approve", nftOperator, big.NewInt(1))
+	require.NoError(t, err)
+	res, err := callVeNftContract(t, addr, cfg, "getApproved", big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, nftOperator, res[0])
+	cfg.Origin = nftOperator
+	_, err = callVeNftContract(t, addr, cfg, "transferFrom", nftOwner, nftReceiver, big.NewInt(1))
+	require.NoError(t, err)
+	requireOwner(nftReceiver, 1, 1)
+
+	// the approval is cleared
+	res, err = callVeNftContract(t, addr, cfg, "getApproved", big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, common.Address{}, res[0])
+	_, err = callVeNftContract(t, addr, cfg, "transferFrom", nftReceiver, nftOwner, big.NewInt(1))
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+
+	// operator
+	cfg.Origin = nftReceiver
+	_, err = callVeNftContract(t, addr, cfg, "setApprovalForAll", nftOperator, true)
+	require.NoError(t, err)
+	res, err = callVeNftContract(t, addr, cfg, "isApprovedForAll", nftReceiver, nftOperator)
+	require.NoError(t, err)
+	require.Equal(t, true, res[0])
+	cfg.Origin = nftOperator
+	_, err = callVeNftContract(t, addr, cfg, "safeTransferFrom", nftReceiver, nftOwner, big.NewInt(1))
+	require.NoError(t, err)
+	requireOwner(nftOwner, 2, 0)
+
+	// owner
+	cfg.Origin = nftOwner
+	_, err = callVeNftContract(t, addr, cfg, "safeTransferFrom0", nftOwner, nftReceiver, big.NewInt(1), []byte("data"))
+	require.NoError(t, err)
+	requireOwner(nftReceiver, 1, 1)
+
+	var transfers [][]common.Hash
+	for _, log := range cfg.State.Logs() {
+		if log.Topics[0] == transfer {
+			require.Equal(t, addr, log.Address)
+			transfers = append(transfers, log.Topics[1:])
+		}
+	}
+	require.Equal(t, [][]common.Hash{
+		{nftOwner.Hash(), nftReceiver.Hash(), common.BigToHash(big.NewInt(1))},
+		{nftReceiver.Hash(), nftOwner.Hash(), common.BigToHash(big.NewInt(1))},
+		{nftOwner.Hash(), nftReceiver.Hash(), common.BigToHash(big.NewInt(1))},
+	}, transfers)
+}
+
+func TestVeNftContract_SafeTransferToContract(t *testing.T) {
+	addr, cfg := setupVeNftContract(t)
+
+	// returns the onERC721Received selector
+	receiver := common.HexToAddress("0x4000000000000000000000000000000000000004")
+	cfg.State.SetCode(receiver, common.FromHex("63150b7a0260e01b60005260206000f3"))
+	// returns nothing
+	nonReceiver := common.HexToAddress("0x5000000000000000000000000000000000000005")
+	cfg.State.SetCode(nonReceiver, common.FromHex("00"))
+
+	_, err := callVeNftContract(t, addr, cfg, "safeTransferFrom", nftOwner, nonReceiver, big.NewInt(1))
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+	_, err = callVeNftContract(t, addr, cfg, "safeTransferFrom0", nftOwner, nonReceiver, big.NewInt(1), []byte{})
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+
+	// plain transfers do not check the receiver
+	_, err = callVeNftContract(t, addr, cfg, "transferFrom", nftOwner, nonReceiver, big.NewInt(2))
+	require.NoError(t, err)
+
+	_, err = callVeNftContract(t, addr, cfg, "safeTransferFrom0", nftOwner, receiver, big.NewInt(1), []byte("some data longer than one word of 32 bytes"))
+	require.NoError(t, err)
+	res, err := callVeNftContract(t, addr, cfg, "ownerOf", big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, receiver, res[0])
+}
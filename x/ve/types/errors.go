@@ -16,4 +16,8 @@ var (
 	ErrAmountNotPositive    = sdkerrors.Register(ModuleName, 9, "amount must be positive")
 	ErrSameVeID             = sdkerrors.Register(ModuleName, 10, "from ve id and to ve id must be different")
 	ErrVeAttached           = sdkerrors.Register(ModuleName, 11, "ve owner deposited into gauge or ve voted")
+	ErrLockPermanent        = sdkerrors.Register(ModuleName, 12, "lock is permanent")
+	ErrLockNotPermanent     = sdkerrors.Register(ModuleName, 13, "lock is not permanent")
+	ErrVotesNotDelegated    = sdkerrors.Register(ModuleName, 14, "votes of ve are not delegated")
+	ErrLockDenomMismatch    = sdkerrors.Register(ModuleName, 15, "locked denoms mismatch")
 )
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -36,7 +37,7 @@ func (m *EventCreate) Reset()         { *m = EventCreate{} }
 func (m *EventCreate) String() string { return proto.CompactTextString(m) }
 func (*EventCreate) ProtoMessage()    {}
 func (*EventCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a4788112a5f5655, []int{0}
+	return fileDescriptor_e0f9ad34f9da0d30, []int{0}
 }
 func (m *EventCreate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -110,7 +111,7 @@ func (m *EventDeposit) Reset()         { *m = EventDeposit{} }
 func (m *EventDeposit) String() string { return proto.CompactTextString(m) }
 func (*EventDeposit) ProtoMessage()    {}
 func (*EventDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a4788112a5f5655, []int{1}
+	return fileDescriptor_e0f9ad34f9da0d30, []int{1}
 }
 func (m *EventDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -170,7 +171,7 @@ func (m *EventExtendTime) Reset()         { *m = EventExtendTime{} }
 func (m *EventExtendTime) String() string { return proto.CompactTextString(m) }
 func (*EventExtendTime) ProtoMessage()    {}
 func (*EventExtendTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a4788112a5f5655, []int{2}
+	return fileDescriptor_e0f9ad34f9da0d30, []int{2}
 }
 func (m *EventExtendTime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -230,7 +231,7 @@ func (m *EventMerge) Reset()         { *m = EventMerge{} }
 func (m *EventMerge) String() string { return proto.CompactTextString(m) }
 func (*EventMerge) ProtoMessage()    {}
 func (*EventMerge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a4788112a5f5655, []int{3}
+	return fileDescriptor_e0f9ad34f9da0d30, []int{3}
 }
 func (m *EventMerge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,7 +290,7 @@ func (m *EventWithdraw) Reset()         { *m = EventWithdraw{} }
 func (m *EventWithdraw) String() string { return proto.CompactTextString(m) }
 func (*EventWithdraw) ProtoMessage()    {}
 func (*EventWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a4788112a5f5655, []int{4}
+	return fileDescriptor_e0f9ad34f9da0d30, []int{4}
 }
 func (m *EventWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -332,224 +333,524 @@ func (m *EventWithdraw) GetVeId() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventCreate)(nil), "warmage.ve.v1.EventCreate")
-	proto.RegisterType((*EventDeposit)(nil), "warmage.ve.v1.EventDeposit")
-	proto.RegisterType((*EventExtendTime)(nil), "warmage.ve.v1.EventExtendTime")
-	proto.RegisterType((*EventMerge)(nil), "warmage.ve.v1.EventMerge")
-	proto.RegisterType((*EventWithdraw)(nil), "warmage.ve.v1.EventWithdraw")
+type EventWithdrawEarly struct {
+	Sender        string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	VeId          string     `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	Amount        types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	Penalty       types.Coin `protobuf:"bytes,4,opt,name=penalty,proto3" json:"penalty"`
+	PenaltyBurned bool       `protobuf:"varint,5,opt,name=penalty_burned,json=penaltyBurned,proto3" json:"penalty_burned,omitempty"`
 }
 
-func init() { proto.RegisterFile("warmage/ve/v1/event.proto", fileDescriptor_4a4788112a5f5655) }
-
-var fileDescriptor_4a4788112a5f5655 = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x8a, 0x13, 0x41,
-	0x10, 0xc6, 0xd3, 0x9b, 0xd9, 0x21, 0x56, 0x5c, 0x84, 0x51, 0x64, 0x36, 0xc8, 0x6c, 0x98, 0x53,
-	0x10, 0xec, 0x26, 0x7a, 0xf0, 0xe2, 0x69, 0xff, 0x1c, 0x3c, 0x78, 0x09, 0xa2, 0x20, 0xc2, 0x30,
-	0x7f, 0xca, 0xd9, 0xc6, 0x9d, 0xae, 0xd0, 0xd3, 0x69, 0x57, 0x9f, 0xc2, 0x47, 0xf1, 0x31, 0xf6,
-	0xb8, 0x47, 0x4f, 0x22, 0xc9, 0x8b, 0x48, 0xf7, 0x8c, 0xcb, 0xa2, 0x9b, 0x43, 0x6e, 0x5d, 0xf5,
-	0x15, 0xdf, 0xf7, 0x2b, 0xba, 0xe0, 0xb0, 0x41, 0x7d, 0x21, 0x49, 0x09, 0x8b, 0xc2, 0xce, 0x05,
-	0x5a, 0x54, 0x86, 0x2f, 0x35, 0x19, 0x8a, 0x0e, 0x7a, 0x89, 0x5b, 0xe4, 0x76, 0x3e, 0x79, 0x54,
-	0x53, 0x4d, 0x5e, 0x11, 0xee, 0xd5, 0x0d, 0x4d, 0x92, 0x92, 0xda, 0x86, 0x5a, 0x51, 0xe4, 0xad,
-	0x33, 0x28, 0xd0, 0xe4, 0x73, 0x51, 0x92, 0x54, 0x9d, 0x9e, 0xfe, 0x60, 0x30, 0x3e, 0x73, 0xa6,
-	0x27, 0x1a, 0x73, 0x83, 0xd1, 0x63, 0x08, 0x5b, 0x54, 0x15, 0xea, 0x98, 0x4d, 0xd9, 0xec, 0xde,
-	0xa2, 0xaf, 0xa2, 0x09, 0x8c, 0x34, 0x96, 0x28, 0x2d, 0xea, 0x78, 0xcf, 0x2b, 0x37, 0x75, 0xf4,
-	0x10, 0xf6, 0x2d, 0x66, 0xb2, 0x8a, 0x87, 0x5e, 0x08, 0x2c, 0xbe, 0xae, 0xa2, 0x97, 0x10, 0xe6,
-	0x0d, 0xad, 0x94, 0x89, 0x83, 0x29, 0x9b, 0x8d, 0x9f, 0x1f, 0xf2, 0x8e, 0x84, 0x3b, 0x12, 0xde,
-	0x93, 0xf0, 0x13, 0x92, 0xea, 0x38, 0xb8, 0xfa, 0x75, 0x34, 0x58, 0xf4, 0xe3, 0xd1, 0x11, 0x8c,
-	0x57, 0xea, 0x82, 0xca, 0xcf, 0x99, 0x91, 0x0d, 0xc6, 0xfb, 0x53, 0x36, 0x0b, 0x16, 0xd0, 0xb5,
-	0xde, 0xca, 0x06, 0x53, 0x03, 0xf7, 0x3d, 0xf1, 0x29, 0x2e, 0xa9, 0x95, 0x66, 0x2b, 0xf2, 0x0d,
-	0xd6, 0xde, 0x9d, 0x58, 0xc3, 0x9d, 0xb0, 0xd2, 0x0c, 0x1e, 0xf8, 0xd4, 0xb3, 0x4b, 0x83, 0xaa,
-	0x72, 0x20, 0xbb, 0x05, 0xff, 0xb3, 0xd6, 0xf0, 0xbf, 0xb5, 0x3e, 0x02, 0xf8, 0x80, 0x37, 0xa8,
-	0xeb, 0xed, 0xde, 0x4f, 0x00, 0x3e, 0x69, 0x6a, 0xb2, 0xdb, 0x01, 0x23, 0xd7, 0x79, 0xe7, 0x42,
-	0x62, 0x18, 0x19, 0xca, 0x6e, 0x7f, 0x46, 0x68, 0xc8, 0x29, 0xe9, 0x2b, 0x38, 0xf0, 0xee, 0xef,
-	0xa5, 0x39, 0xaf, 0x74, 0xfe, 0x65, 0x27, 0xf8, 0xe3, 0xd3, 0xab, 0x75, 0xc2, 0xae, 0xd7, 0x09,
-	0xfb, 0xbd, 0x4e, 0xd8, 0xf7, 0x4d, 0x32, 0xb8, 0xde, 0x24, 0x83, 0x9f, 0x9b, 0x64, 0xf0, 0xe1,
-	0x69, 0x2d, 0xcd, 0xf9, 0xaa, 0xe0, 0x25, 0x35, 0xa2, 0xbf, 0xc7, 0x67, 0xdf, 0x48, 0xe1, 0xdf,
-	0x42, 0x5c, 0xba, 0xcb, 0x35, 0x5f, 0x97, 0xd8, 0x16, 0xa1, 0x3f, 0xb9, 0x17, 0x7f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x82, 0x01, 0x1d, 0xac, 0xd4, 0x02, 0x00, 0x00,
+func (m *EventWithdrawEarly) Reset()         { *m = EventWithdrawEarly{} }
+func (m *EventWithdrawEarly) String() string { return proto.CompactTextString(m) }
+func (*EventWithdrawEarly) ProtoMessage()    {}
+func (*EventWithdrawEarly) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9ad34f9da0d30, []int{5}
+}
+func (m *EventWithdrawEarly) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventWithdrawEarly) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventWithdrawEarly.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventWithdrawEarly) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventWithdrawEarly.Merge(m, src)
+}
+func (m *EventWithdrawEarly) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventWithdrawEarly) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventWithdrawEarly.DiscardUnknown(m)
 }
 
-func (m *EventCreate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventWithdrawEarly proto.InternalMessageInfo
+
+func (m *EventWithdrawEarly) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventCreate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventWithdrawEarly) GetVeId() string {
+	if m != nil {
+		return m.VeId
+	}
+	return ""
 }
 
-func (m *EventCreate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnlockTime != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.UnlockTime))
-		i--
-		dAtA[i] = 0x28
+func (m *EventWithdrawEarly) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+	return types.Coin{}
+}
+
+func (m *EventWithdrawEarly) GetPenalty() types.Coin {
+	if m != nil {
+		return m.Penalty
+	}
+	return types.Coin{}
+}
+
+func (m *EventWithdrawEarly) GetPenaltyBurned() bool {
+	if m != nil {
+		return m.PenaltyBurned
+	}
+	return false
+}
+
+type EventSplit struct {
+	Sender   string                                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	VeId     string                                   `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	NewVeIds []string                                 `protobuf:"bytes,3,rep,name=new_ve_ids,json=newVeIds,proto3" json:"new_ve_ids,omitempty"`
+	Amounts  []github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,rep,name=amounts,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amounts"`
+}
+
+func (m *EventSplit) Reset()         { *m = EventSplit{} }
+func (m *EventSplit) String() string { return proto.CompactTextString(m) }
+func (*EventSplit) ProtoMessage()    {}
+func (*EventSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9ad34f9da0d30, []int{6}
+}
+func (m *EventSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.VeId) > 0 {
-		i -= len(m.VeId)
-		copy(dAtA[i:], m.VeId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSplit.Merge(m, src)
+}
+func (m *EventSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSplit proto.InternalMessageInfo
+
+func (m *EventSplit) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventSplit) GetVeId() string {
+	if m != nil {
+		return m.VeId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSplit) GetNewVeIds() []string {
+	if m != nil {
+		return m.NewVeIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventLockPermanent struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	VeId   string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
 }
 
-func (m *EventDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventLockPermanent) Reset()         { *m = EventLockPermanent{} }
+func (m *EventLockPermanent) String() string { return proto.CompactTextString(m) }
+func (*EventLockPermanent) ProtoMessage()    {}
+func (*EventLockPermanent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9ad34f9da0d30, []int{7}
+}
+func (m *EventLockPermanent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventLockPermanent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLockPermanent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.VeId) > 0 {
-		i -= len(m.VeId)
-		copy(dAtA[i:], m.VeId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventLockPermanent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLockPermanent.Merge(m, src)
+}
+func (m *EventLockPermanent) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLockPermanent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLockPermanent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLockPermanent proto.InternalMessageInfo
+
+func (m *EventLockPermanent) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventExtendTime) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventLockPermanent) GetVeId() string {
+	if m != nil {
+		return m.VeId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventExtendTime) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventUnlockPermanent struct {
+	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	VeId       string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	UnlockTime uint64 `protobuf:"varint,3,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty"`
 }
 
-func (m *EventExtendTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnlockTime != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.UnlockTime))
-		i--
-		dAtA[i] = 0x18
+func (m *EventUnlockPermanent) Reset()         { *m = EventUnlockPermanent{} }
+func (m *EventUnlockPermanent) String() string { return proto.CompactTextString(m) }
+func (*EventUnlockPermanent) ProtoMessage()    {}
+func (*EventUnlockPermanent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9ad34f9da0d30, []int{8}
+}
+func (m *EventUnlockPermanent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUnlockPermanent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUnlockPermanent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.VeId) > 0 {
-		i -= len(m.VeId)
-		copy(dAtA[i:], m.VeId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventUnlockPermanent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUnlockPermanent.Merge(m, src)
+}
+func (m *EventUnlockPermanent) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUnlockPermanent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUnlockPermanent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUnlockPermanent proto.InternalMessageInfo
+
+func (m *EventUnlockPermanent) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventUnlockPermanent) GetVeId() string {
+	if m != nil {
+		return m.VeId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMerge) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventUnlockPermanent) GetUnlockTime() uint64 {
+	if m != nil {
+		return m.UnlockTime
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventMerge) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventDelegateVotes struct {
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	VeId      string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	Delegatee string `protobuf:"bytes,3,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
 }
 
-func (m *EventMerge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
+func (m *EventDelegateVotes) Reset()         { *m = EventDelegateVotes{} }
+func (m *EventDelegateVotes) String() string { return proto.CompactTextString(m) }
+func (*EventDelegateVotes) ProtoMessage()    {}
+func (*EventDelegateVotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9ad34f9da0d30, []int{9}
+}
+func (m *EventDelegateVotes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDelegateVotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDelegateVotes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDelegateVotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDelegateVotes.Merge(m, src)
+}
+func (m *EventDelegateVotes) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDelegateVotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDelegateVotes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDelegateVotes proto.InternalMessageInfo
+
+func (m *EventDelegateVotes) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventDelegateVotes) GetVeId() string {
+	if m != nil {
+		return m.VeId
+	}
+	return ""
+}
+
+func (m *EventDelegateVotes) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
+	}
+	return ""
+}
+
+type EventUndelegateVotes struct {
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	VeId      string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	Delegatee string `protobuf:"bytes,3,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+}
+
+func (m *EventUndelegateVotes) Reset()         { *m = EventUndelegateVotes{} }
+func (m *EventUndelegateVotes) String() string { return proto.CompactTextString(m) }
+func (*EventUndelegateVotes) ProtoMessage()    {}
+func (*EventUndelegateVotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9ad34f9da0d30, []int{10}
+}
+func (m *EventUndelegateVotes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUndelegateVotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUndelegateVotes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUndelegateVotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUndelegateVotes.Merge(m, src)
+}
+func (m *EventUndelegateVotes) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUndelegateVotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUndelegateVotes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUndelegateVotes proto.InternalMessageInfo
+
+func (m *EventUndelegateVotes) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventUndelegateVotes) GetVeId() string {
+	if m != nil {
+		return m.VeId
+	}
+	return ""
+}
+
+func (m *EventUndelegateVotes) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
+	}
+	return ""
+}
+
+type EventDeployNftContract struct {
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *EventDeployNftContract) Reset()         { *m = EventDeployNftContract{} }
+func (m *EventDeployNftContract) String() string { return proto.CompactTextString(m) }
+func (*EventDeployNftContract) ProtoMessage()    {}
+func (*EventDeployNftContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9ad34f9da0d30, []int{11}
+}
+func (m *EventDeployNftContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDeployNftContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDeployNftContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDeployNftContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDeployNftContract.Merge(m, src)
+}
+func (m *EventDeployNftContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDeployNftContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDeployNftContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDeployNftContract proto.InternalMessageInfo
+
+func (m *EventDeployNftContract) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventCreate)(nil), "warmage.ve.v1.EventCreate")
+	proto.RegisterType((*EventDeposit)(nil), "warmage.ve.v1.EventDeposit")
+	proto.RegisterType((*EventExtendTime)(nil), "warmage.ve.v1.EventExtendTime")
+	proto.RegisterType((*EventMerge)(nil), "warmage.ve.v1.EventMerge")
+	proto.RegisterType((*EventWithdraw)(nil), "warmage.ve.v1.EventWithdraw")
+	proto.RegisterType((*EventWithdrawEarly)(nil), "warmage.ve.v1.EventWithdrawEarly")
+	proto.RegisterType((*EventSplit)(nil), "warmage.ve.v1.EventSplit")
+	proto.RegisterType((*EventLockPermanent)(nil), "warmage.ve.v1.EventLockPermanent")
+	proto.RegisterType((*EventUnlockPermanent)(nil), "warmage.ve.v1.EventUnlockPermanent")
+	proto.RegisterType((*EventDelegateVotes)(nil), "warmage.ve.v1.EventDelegateVotes")
+	proto.RegisterType((*EventUndelegateVotes)(nil), "warmage.ve.v1.EventUndelegateVotes")
+	proto.RegisterType((*EventDeployNftContract)(nil), "warmage.ve.v1.EventDeployNftContract")
+}
+
+func init() { proto.RegisterFile("warmage/ve/v1/event.proto", fileDescriptor_e0f9ad34f9da0d30) }
+
+var fileDescriptor_e0f9ad34f9da0d30 = []byte{
+	// 593 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xdd, 0x6a, 0xd4, 0x40,
+	0x14, 0xde, 0x74, 0xb7, 0xdb, 0xed, 0xa9, 0x55, 0x88, 0xa5, 0xa4, 0xa5, 0xa4, 0x4b, 0x40, 0xd9,
+	0x9b, 0x26, 0x54, 0x05, 0x11, 0xbc, 0x71, 0xdb, 0x82, 0x05, 0x15, 0x59, 0xb5, 0x82, 0x08, 0x61,
+	0x36, 0x39, 0xdd, 0x86, 0x26, 0x33, 0x61, 0x66, 0x36, 0xdb, 0x7d, 0x0b, 0x5f, 0xc1, 0x37, 0xf0,
+	0x31, 0x7a, 0xd9, 0x4b, 0x11, 0x29, 0xd2, 0xbe, 0x88, 0xcc, 0x64, 0x76, 0x5d, 0x7f, 0x8a, 0x46,
+	0xd0, 0xab, 0xcc, 0x9c, 0x6f, 0xce, 0xf9, 0xbe, 0xf3, 0x93, 0x03, 0x6b, 0x23, 0xc2, 0x33, 0x32,
+	0xc0, 0xa0, 0xc0, 0xa0, 0xd8, 0x0e, 0xb0, 0x40, 0x2a, 0xfd, 0x9c, 0x33, 0xc9, 0xec, 0x65, 0x03,
+	0xf9, 0x05, 0xfa, 0xc5, 0xf6, 0xfa, 0xca, 0x80, 0x0d, 0x98, 0x46, 0x02, 0x75, 0x2a, 0x1f, 0xad,
+	0xbb, 0x11, 0x13, 0x19, 0x13, 0x41, 0x9f, 0x08, 0x15, 0xa0, 0x8f, 0x92, 0x6c, 0x07, 0x11, 0x4b,
+	0x68, 0x89, 0x7b, 0x1f, 0x2c, 0x58, 0xda, 0x53, 0x41, 0x77, 0x38, 0x12, 0x89, 0xf6, 0x2a, 0x34,
+	0x05, 0xd2, 0x18, 0xb9, 0x63, 0xb5, 0xad, 0xce, 0x62, 0xcf, 0xdc, 0xec, 0x75, 0x68, 0x71, 0x8c,
+	0x30, 0x29, 0x90, 0x3b, 0x73, 0x1a, 0x99, 0xde, 0xed, 0x9b, 0x30, 0x5f, 0x60, 0x98, 0xc4, 0x4e,
+	0x5d, 0x03, 0x8d, 0x02, 0xf7, 0x63, 0xfb, 0x3e, 0x34, 0x49, 0xc6, 0x86, 0x54, 0x3a, 0x8d, 0xb6,
+	0xd5, 0x59, 0xba, 0xb3, 0xe6, 0x97, 0x4a, 0x7c, 0xa5, 0xc4, 0x37, 0x4a, 0xfc, 0x1d, 0x96, 0xd0,
+	0x6e, 0xe3, 0xf4, 0x7c, 0xb3, 0xd6, 0x33, 0xcf, 0xed, 0x4d, 0x58, 0x1a, 0xd2, 0x94, 0x45, 0xc7,
+	0xa1, 0x4c, 0x32, 0x74, 0xe6, 0xdb, 0x56, 0xa7, 0xd1, 0x83, 0xd2, 0xf4, 0x32, 0xc9, 0xd0, 0x93,
+	0x70, 0x4d, 0x2b, 0xde, 0xc5, 0x9c, 0x89, 0x44, 0x5e, 0x29, 0x79, 0x2a, 0x6b, 0xee, 0x97, 0xb2,
+	0xea, 0x95, 0x64, 0x79, 0x21, 0xdc, 0xd0, 0xac, 0x7b, 0x27, 0x12, 0x69, 0xac, 0x84, 0x54, 0x23,
+	0xfe, 0x21, 0xad, 0xfa, 0x4f, 0x69, 0xbd, 0x05, 0xd0, 0x04, 0x4f, 0x91, 0x0f, 0xae, 0x8e, 0xbd,
+	0x01, 0x70, 0xc8, 0x59, 0x16, 0xce, 0x12, 0xb4, 0x94, 0xe5, 0x40, 0x91, 0x38, 0xd0, 0x92, 0x2c,
+	0x9c, 0x6d, 0x46, 0x53, 0x32, 0x85, 0x78, 0x0f, 0x61, 0x59, 0x47, 0x7f, 0x9d, 0xc8, 0xa3, 0x98,
+	0x93, 0x51, 0x25, 0xf1, 0xde, 0x67, 0x0b, 0xec, 0xef, 0xdc, 0xf7, 0x08, 0x4f, 0xc7, 0xff, 0xa7,
+	0xf2, 0xf6, 0x03, 0x58, 0xc8, 0x91, 0x92, 0x54, 0x8e, 0xff, 0x74, 0x94, 0x26, 0xef, 0xed, 0x5b,
+	0x70, 0xdd, 0x1c, 0xc3, 0xfe, 0x90, 0x53, 0x8c, 0xf5, 0x38, 0xb5, 0x7a, 0xcb, 0xc6, 0xda, 0xd5,
+	0x46, 0xef, 0xbd, 0x65, 0x6a, 0xff, 0x22, 0x4f, 0xab, 0x0e, 0xd4, 0x06, 0x00, 0xc5, 0x51, 0x59,
+	0x73, 0xe1, 0xd4, 0xdb, 0x75, 0xd5, 0x10, 0x8a, 0x23, 0x55, 0x75, 0x61, 0x3f, 0x86, 0x85, 0x32,
+	0x0b, 0xe1, 0x34, 0x14, 0xd4, 0xf5, 0x95, 0xc0, 0x4f, 0xe7, 0x9b, 0xb7, 0x07, 0x89, 0x3c, 0x1a,
+	0xf6, 0xfd, 0x88, 0x65, 0x81, 0xf9, 0x45, 0xcb, 0xcf, 0x96, 0x88, 0x8f, 0x03, 0x39, 0xce, 0x51,
+	0xf8, 0xfb, 0x54, 0xf6, 0x26, 0xee, 0xde, 0x23, 0xd3, 0x81, 0x27, 0x2c, 0x3a, 0x7e, 0x8e, 0x3c,
+	0x23, 0x14, 0x69, 0x35, 0xa9, 0x5e, 0x0c, 0x2b, 0x3a, 0xc4, 0x2b, 0x9a, 0xfe, 0x75, 0x90, 0xdf,
+	0xcf, 0x71, 0x68, 0x84, 0xee, 0x62, 0x8a, 0x03, 0x22, 0xf1, 0x80, 0x49, 0x14, 0x55, 0x6b, 0xba,
+	0x18, 0x1b, 0x6f, 0x34, 0x73, 0xfc, 0xcd, 0xe0, 0x91, 0x69, 0x1a, 0xf1, 0xbf, 0xa2, 0xb8, 0x07,
+	0xab, 0x93, 0x15, 0x93, 0xb2, 0xf1, 0xb3, 0x43, 0xb9, 0xc3, 0xa8, 0xe4, 0x24, 0x92, 0x6a, 0x0f,
+	0x46, 0xe6, 0x6c, 0x68, 0xa6, 0xf7, 0x6e, 0xf7, 0xf4, 0xc2, 0xb5, 0xce, 0x2e, 0x5c, 0xeb, 0xcb,
+	0x85, 0x6b, 0xbd, 0xbb, 0x74, 0x6b, 0x67, 0x97, 0x6e, 0xed, 0xe3, 0xa5, 0x5b, 0x7b, 0xd3, 0x99,
+	0xe9, 0x76, 0x8e, 0x92, 0x27, 0x5b, 0x29, 0xe9, 0x8b, 0x60, 0xb2, 0xdb, 0x4f, 0xd4, 0x76, 0xd7,
+	0x3d, 0xef, 0x37, 0xf5, 0x5a, 0xbe, 0xfb, 0x75, 0x00, 0x02, 0x13, 0x20, 0x1b, 0xf8, 0x05, 0x00,
+	0x00,
+}
+
+func (m *EventCreate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	if len(m.ToVeId) > 0 {
-		i -= len(m.ToVeId)
-		copy(dAtA[i:], m.ToVeId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ToVeId)))
+	if m.UnlockTime != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.UnlockTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.FromVeId) > 0 {
-		i -= len(m.FromVeId)
-		copy(dAtA[i:], m.FromVeId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.FromVeId)))
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Receiver)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -563,7 +864,7 @@ func (m *EventMerge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventWithdraw) Marshal() (dAtA []byte, err error) {
+func (m *EventDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -573,16 +874,26 @@ func (m *EventWithdraw) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventWithdraw) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDeposit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.VeId) > 0 {
 		i -= len(m.VeId)
 		copy(dAtA[i:], m.VeId)
@@ -600,127 +911,1657 @@ func (m *EventWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventExtendTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventCreate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventExtendTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExtendTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.UnlockTime != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.UnlockTime))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	if m.UnlockTime != 0 {
-		n += 1 + sovEvent(uint64(m.UnlockTime))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMerge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMerge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMerge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ToVeId) > 0 {
+		i -= len(m.ToVeId)
+		copy(dAtA[i:], m.ToVeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ToVeId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.FromVeId) > 0 {
+		i -= len(m.FromVeId)
+		copy(dAtA[i:], m.FromVeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FromVeId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventExtendTime) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.UnlockTime != 0 {
-		n += 1 + sovEvent(uint64(m.UnlockTime))
+	return len(dAtA) - i, nil
+}
+
+func (m *EventWithdrawEarly) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventMerge) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventWithdrawEarly) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWithdrawEarly) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PenaltyBurned {
+		i--
+		if m.PenaltyBurned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Penalty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Amounts[iNdEx].Size()
+				i -= size
+				if _, err := m.Amounts[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.FromVeId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.NewVeIds) > 0 {
+		for iNdEx := len(m.NewVeIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NewVeIds[iNdEx])
+			copy(dAtA[i:], m.NewVeIds[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.NewVeIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.ToVeId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventLockPermanent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLockPermanent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLockPermanent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUnlockPermanent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUnlockPermanent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUnlockPermanent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnlockTime != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.UnlockTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDelegateVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDelegateVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDelegateVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegatee) > 0 {
+		i -= len(m.Delegatee)
+		copy(dAtA[i:], m.Delegatee)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Delegatee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUndelegateVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUndelegateVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUndelegateVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegatee) > 0 {
+		i -= len(m.Delegatee)
+		copy(dAtA[i:], m.Delegatee)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Delegatee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDeployNftContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDeployNftContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeployNftContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCreate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.UnlockTime != 0 {
+		n += 1 + sovEvent(uint64(m.UnlockTime))
+	}
+	return n
+}
+
+func (m *EventDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventExtendTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.UnlockTime != 0 {
+		n += 1 + sovEvent(uint64(m.UnlockTime))
+	}
+	return n
+}
+
+func (m *EventMerge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.FromVeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ToVeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventWithdrawEarly) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Penalty.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.PenaltyBurned {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.NewVeIds) > 0 {
+		for _, s := range m.NewVeIds {
+			l = len(s)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventLockPermanent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUnlockPermanent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.UnlockTime != 0 {
+		n += 1 + sovEvent(uint64(m.UnlockTime))
+	}
+	return n
+}
+
+func (m *EventDelegateVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Delegatee)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUndelegateVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Delegatee)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDeployNftContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCreate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			m.UnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventExtendTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventExtendTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventExtendTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			m.UnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMerge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMerge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMerge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromVeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToVeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWithdrawEarly) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWithdrawEarly: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWithdrawEarly: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Penalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyBurned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PenaltyBurned = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventCreate) Unmarshal(dAtA []byte) error {
+func (m *EventSplit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -743,10 +2584,10 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSplit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSplit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -783,7 +2624,7 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -811,11 +2652,11 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewVeIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -843,13 +2684,13 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VeId = string(dAtA[iNdEx:postIndex])
+			m.NewVeIds = append(m.NewVeIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -859,44 +2700,28 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.Amounts = append(m.Amounts, v)
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
-			}
-			m.UnlockTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnlockTime |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -918,7 +2743,7 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDeposit) Unmarshal(dAtA []byte) error {
+func (m *EventLockPermanent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -941,10 +2766,10 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventLockPermanent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventLockPermanent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1011,39 +2836,6 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 			}
 			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1065,7 +2857,7 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventExtendTime) Unmarshal(dAtA []byte) error {
+func (m *EventUnlockPermanent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1088,10 +2880,10 @@ func (m *EventExtendTime) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventExtendTime: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUnlockPermanent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventExtendTime: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUnlockPermanent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1198,7 +2990,7 @@ func (m *EventExtendTime) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMerge) Unmarshal(dAtA []byte) error {
+func (m *EventDelegateVotes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1221,10 +3013,10 @@ func (m *EventMerge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMerge: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDelegateVotes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMerge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDelegateVotes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1261,7 +3053,7 @@ func (m *EventMerge) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromVeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1289,11 +3081,11 @@ func (m *EventMerge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromVeId = string(dAtA[iNdEx:postIndex])
+			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToVeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1321,7 +3113,7 @@ func (m *EventMerge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToVeId = string(dAtA[iNdEx:postIndex])
+			m.Delegatee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1344,7 +3136,7 @@ func (m *EventMerge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
+func (m *EventUndelegateVotes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1367,10 +3159,10 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUndelegateVotes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUndelegateVotes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1437,6 +3229,120 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 			}
 			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegatee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeployNftContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeployNftContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeployNftContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -2,23 +2,34 @@ package types
 
 import (
 	"context"
+	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/tharsis/ethermint/x/evm/statedb"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
 type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) types.AccountI
 	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, moduleName string) types.ModuleAccountI
+	GetSequence(ctx sdk.Context, addr sdk.AccAddress) (uint64, error)
 	// Methods imported from account should be defined here
 }
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
@@ -35,6 +46,7 @@ type NftKeeper interface {
 	HasClass(ctx sdk.Context, classID string) bool
 	Mint(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) error
 	Burn(ctx sdk.Context, classID string, nftID string) error
+	GetNFTsOfClass(ctx sdk.Context, classID string) (nfts []nft.NFT)
 	GetNFTsOfClassByOwner(ctx sdk.Context, classID string, owner sdk.AccAddress) (nfts []nft.NFT)
 	GetOwner(ctx sdk.Context, classID string, nftID string) sdk.AccAddress
 	HasNFT(ctx sdk.Context, classID, id string) bool
@@ -42,3 +54,14 @@ type NftKeeper interface {
 	NFT(goCtx context.Context, r *nft.QueryNFTRequest) (*nft.QueryNFTResponse, error)
 	// Methods imported from nft should be defined here
 }
+
+// EVMKeeper defines the expected EVM keeper, through which the module
+// deploys the ve NFT contract and mirrors the ve NFTs into it
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+	ChainID() *big.Int
+	GetBaseFee(ctx sdk.Context, ethCfg *params.ChainConfig) *big.Int
+	ApplyMessageWithConfig(ctx sdk.Context, msg core.Message, tracer vm.EVMLogger, commit bool, cfg *evmtypes.EVMConfig, txConfig statedb.TxConfig) (*evmtypes.MsgEthereumTxResponse, error)
+	GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash
+	SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte)
+}
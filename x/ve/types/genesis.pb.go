@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -32,7 +33,7 @@ func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0b8a7f3753a833a, []int{0}
+	return fileDescriptor_41f529a5cf6641f1, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -71,12 +72,21 @@ func (m *GenesisState) GetParams() Params {
 // Params defines the parameters for the module.
 type Params struct {
 	LockDenom string `protobuf:"bytes,1,opt,name=lock_denom,json=lockDenom,proto3" json:"lock_denom,omitempty"`
+	// max penalty ratio of the locked amount for withdrawing early, which is
+	// otherwise proportional to the remaining locking time
+	MaxEarlyWithdrawPenalty github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_early_withdraw_penalty,json=maxEarlyWithdrawPenalty,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_early_withdraw_penalty" yaml:"max_early_withdraw_penalty"`
+	// whether to burn the early withdrawal penalty, instead of sending it to the
+	// distribution pool for the remaining ve holders
+	BurnEarlyWithdrawPenalty bool `protobuf:"varint,3,opt,name=burn_early_withdraw_penalty,json=burnEarlyWithdrawPenalty,proto3" json:"burn_early_withdraw_penalty,omitempty" yaml:"burn_early_withdraw_penalty"`
+	// denoms other than the lock denom which can also be locked, e.g., LP
+	// shares, with the weight of their voting power relative to the lock denom
+	LockDenomWeights []LockDenomWeight `protobuf:"bytes,4,rep,name=lock_denom_weights,json=lockDenomWeights,proto3" json:"lock_denom_weights" yaml:"lock_denom_weights"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0b8a7f3753a833a, []int{1}
+	return fileDescriptor_41f529a5cf6641f1, []int{1}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -112,29 +122,103 @@ func (m *Params) GetLockDenom() string {
 	return ""
 }
 
+func (m *Params) GetBurnEarlyWithdrawPenalty() bool {
+	if m != nil {
+		return m.BurnEarlyWithdrawPenalty
+	}
+	return false
+}
+
+func (m *Params) GetLockDenomWeights() []LockDenomWeight {
+	if m != nil {
+		return m.LockDenomWeights
+	}
+	return nil
+}
+
+// LockDenomWeight defines the voting power weight of a lockable denom.
+type LockDenomWeight struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// voting power per locked amount, relative to the lock denom
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *LockDenomWeight) Reset()      { *m = LockDenomWeight{} }
+func (*LockDenomWeight) ProtoMessage() {}
+func (*LockDenomWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f529a5cf6641f1, []int{2}
+}
+func (m *LockDenomWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockDenomWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockDenomWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockDenomWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockDenomWeight.Merge(m, src)
+}
+func (m *LockDenomWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockDenomWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockDenomWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockDenomWeight proto.InternalMessageInfo
+
+func (m *LockDenomWeight) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "warmage.ve.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "warmage.ve.v1.Params")
+	proto.RegisterType((*LockDenomWeight)(nil), "warmage.ve.v1.LockDenomWeight")
 }
 
-func init() { proto.RegisterFile("warmage/ve/v1/genesis.proto", fileDescriptor_c0b8a7f3753a833a) }
+func init() { proto.RegisterFile("warmage/ve/v1/genesis.proto", fileDescriptor_41f529a5cf6641f1) }
 
-var fileDescriptor_c0b8a7f3753a833a = []byte{
-	// 222 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0x4d, 0x2d, 0xca,
-	0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x4b, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce,
-	0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x85, 0x4a, 0xea, 0x95, 0xa5, 0xea, 0x95,
-	0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x65, 0xf4, 0x41, 0x2c, 0x88, 0x22, 0x25, 0x67,
-	0x2e, 0x1e, 0x77, 0x88, 0xae, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x63, 0x2e, 0xb6, 0x82, 0xc4,
-	0xa2, 0xc4, 0xdc, 0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x51, 0x3d, 0x14, 0x53, 0xf4,
-	0x02, 0xc0, 0x92, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x95, 0x2a, 0xe9, 0x72, 0xb1,
-	0x41, 0xc4, 0x85, 0x64, 0xb9, 0xb8, 0x72, 0xf2, 0x93, 0xb3, 0xe3, 0x53, 0x52, 0xf3, 0xf2, 0x73,
-	0xc1, 0x46, 0x70, 0x06, 0x71, 0x82, 0x44, 0x5c, 0x40, 0x02, 0x56, 0x2c, 0x33, 0x16, 0xc8, 0x33,
-	0x38, 0xb9, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13,
-	0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x56, 0x7a, 0x66,
-	0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x5e, 0xdd, 0xaa, 0xfc, 0xbc, 0x54,
-	0x18, 0x47, 0xbf, 0x02, 0xe4, 0xd3, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x07, 0x8c,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x88, 0x5c, 0x2d, 0x65, 0x04, 0x01, 0x00, 0x00,
+var fileDescriptor_41f529a5cf6641f1 = []byte{
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xb1, 0x4e, 0xdc, 0x30,
+	0x18, 0xc7, 0x93, 0xde, 0x35, 0x2a, 0xa6, 0x55, 0x2b, 0x8b, 0xaa, 0x29, 0xa8, 0xc9, 0xe1, 0x01,
+	0x65, 0xc1, 0x11, 0xb0, 0x31, 0xa6, 0xb4, 0x5d, 0x3a, 0xa0, 0x30, 0x20, 0x75, 0x89, 0x9c, 0x9c,
+	0x95, 0x8b, 0x88, 0xe3, 0x28, 0xf6, 0x25, 0x97, 0xb7, 0x60, 0x64, 0xec, 0xe3, 0x30, 0x32, 0x56,
+	0x1d, 0xa2, 0xea, 0xee, 0x0d, 0xee, 0x09, 0x2a, 0xc7, 0x41, 0x05, 0x5a, 0x2a, 0x31, 0x25, 0xf6,
+	0xff, 0xff, 0xfd, 0x3e, 0xeb, 0xff, 0x7d, 0x60, 0xa7, 0x21, 0x15, 0x23, 0x29, 0xf5, 0x6b, 0xea,
+	0xd7, 0x07, 0x7e, 0x4a, 0x0b, 0x2a, 0x32, 0x81, 0xcb, 0x8a, 0x4b, 0x0e, 0x5f, 0x0d, 0x22, 0xae,
+	0x29, 0xae, 0x0f, 0xb6, 0xb7, 0x52, 0x9e, 0xf2, 0x5e, 0xf1, 0xd5, 0x9f, 0x36, 0xa1, 0x8f, 0xe0,
+	0xe5, 0x17, 0x5d, 0x75, 0x26, 0x89, 0xa4, 0xf0, 0x08, 0x58, 0x25, 0xa9, 0x08, 0x13, 0xb6, 0x39,
+	0x31, 0xbd, 0xcd, 0xc3, 0xb7, 0xf8, 0x1e, 0x05, 0x9f, 0xf6, 0x62, 0x30, 0xbe, 0xee, 0x5c, 0x23,
+	0x1c, 0xac, 0xe8, 0x6a, 0x04, 0x2c, 0x2d, 0xc0, 0x0f, 0x00, 0xe4, 0x3c, 0xb9, 0x88, 0xa6, 0xb4,
+	0xe0, 0xac, 0x67, 0x6c, 0x84, 0x1b, 0xea, 0xe6, 0x44, 0x5d, 0xc0, 0x4b, 0x13, 0x6c, 0x33, 0xb2,
+	0x88, 0x28, 0xa9, 0xf2, 0x36, 0x6a, 0x32, 0x39, 0x9b, 0x56, 0xa4, 0x89, 0x4a, 0x5a, 0x90, 0x5c,
+	0xb6, 0xf6, 0x33, 0xe5, 0x0f, 0xce, 0x14, 0xfc, 0x67, 0xe7, 0xee, 0xa5, 0x99, 0x9c, 0xcd, 0x63,
+	0x9c, 0x70, 0xe6, 0x27, 0x5c, 0x30, 0x2e, 0x86, 0xcf, 0xbe, 0x98, 0x5e, 0xf8, 0xb2, 0x2d, 0xa9,
+	0xc0, 0x27, 0x34, 0x59, 0x77, 0xee, 0x6e, 0x4b, 0x58, 0x7e, 0x8c, 0x1e, 0x27, 0xa3, 0xf0, 0x1d,
+	0x23, 0x8b, 0x4f, 0x4a, 0x3b, 0x1f, 0xa4, 0x53, 0xad, 0x40, 0x0a, 0x76, 0xe2, 0x79, 0x55, 0x3c,
+	0xf6, 0xa4, 0xd1, 0xc4, 0xf4, 0x5e, 0x04, 0x7b, 0xeb, 0xce, 0x45, 0xba, 0xc9, 0x7f, 0xcc, 0x28,
+	0xb4, 0x95, 0xfa, 0xcf, 0x36, 0x1c, 0xc0, 0x3f, 0xc1, 0x44, 0x0d, 0xcd, 0xd2, 0x99, 0x14, 0xf6,
+	0x78, 0x32, 0xf2, 0x36, 0x0f, 0x9d, 0x07, 0x21, 0x7f, 0xbd, 0xcd, 0xeb, 0xbc, 0xb7, 0x05, 0xbb,
+	0x2a, 0x90, 0x75, 0xe7, 0xbe, 0xd7, 0x2f, 0xf8, 0x9b, 0x83, 0xc2, 0x37, 0xf9, 0xfd, 0x1a, 0x71,
+	0x3c, 0xbe, 0xfa, 0xee, 0x1a, 0x68, 0x0e, 0x5e, 0x3f, 0xa0, 0xc1, 0x2d, 0xf0, 0xfc, 0xee, 0x74,
+	0xf4, 0x01, 0x7e, 0x06, 0x96, 0x86, 0x0d, 0x43, 0xc0, 0x4f, 0x1b, 0x42, 0x38, 0x54, 0xeb, 0xb6,
+	0x41, 0x70, 0xbd, 0x74, 0xcc, 0x9b, 0xa5, 0x63, 0xfe, 0x5a, 0x3a, 0xe6, 0xe5, 0xca, 0x31, 0x6e,
+	0x56, 0x8e, 0xf1, 0x63, 0xe5, 0x18, 0xdf, 0xbc, 0x3b, 0xbc, 0x92, 0xca, 0x2a, 0xdb, 0xcf, 0x49,
+	0x2c, 0xfc, 0xdb, 0x45, 0x5e, 0xa8, 0x55, 0xee, 0xa9, 0xb1, 0xd5, 0x6f, 0xe8, 0xd1, 0xef, 0x01,
+	0x00, 0x95, 0xa3, 0x6c, 0x97, 0xe5, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -190,6 +274,40 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LockDenomWeights) > 0 {
+		for iNdEx := len(m.LockDenomWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockDenomWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.BurnEarlyWithdrawPenalty {
+		i--
+		if m.BurnEarlyWithdrawPenalty {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxEarlyWithdrawPenalty.Size()
+		i -= size
+		if _, err := m.MaxEarlyWithdrawPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.LockDenom) > 0 {
 		i -= len(m.LockDenom)
 		copy(dAtA[i:], m.LockDenom)
@@ -200,6 +318,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LockDenomWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockDenomWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockDenomWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -232,6 +390,32 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	l = m.MaxEarlyWithdrawPenalty.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.BurnEarlyWithdrawPenalty {
+		n += 2
+	}
+	if len(m.LockDenomWeights) > 0 {
+		for _, e := range m.LockDenomWeights {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockDenomWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -385,6 +569,210 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.LockDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEarlyWithdrawPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxEarlyWithdrawPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnEarlyWithdrawPenalty", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnEarlyWithdrawPenalty = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDenomWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockDenomWeights = append(m.LockDenomWeights, LockDenomWeight{})
+			if err := m.LockDenomWeights[len(m.LockDenomWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockDenomWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockDenomWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockDenomWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
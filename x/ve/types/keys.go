@@ -1,6 +1,9 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName defines the module name
@@ -43,6 +46,14 @@ const (
 	prefixDistributionTotalAmount
 	prefixDistributionPerPeriod
 	prefixDistributionClaimLastTimestampByUser
+
+	prefixVoteDelegatee
+	prefixVoteDelegationByDelegatee
+
+	prefixVeNftContract
+
+	prefixDistributionTotalAmountByDenom
+	prefixDistributionPerPeriodByDenom
 )
 
 var (
@@ -66,9 +77,23 @@ var (
 	KeyPrefixDistributionTotalAmount              = []byte{prefixDistributionTotalAmount}
 	KeyPrefixDistributionPerPeriod                = []byte{prefixDistributionPerPeriod}
 	KeyPrefixDistributionClaimLastTimestampByUser = []byte{prefixDistributionClaimLastTimestampByUser}
+
+	KeyPrefixVoteDelegatee             = []byte{prefixVoteDelegatee}
+	KeyPrefixVoteDelegationByDelegatee = []byte{prefixVoteDelegationByDelegatee}
+
+	KeyPrefixVeNftContract = []byte{prefixVeNftContract}
+
+	KeyPrefixDistributionTotalAmountByDenom = []byte{prefixDistributionTotalAmountByDenom}
+	KeyPrefixDistributionPerPeriodByDenom   = []byte{prefixDistributionPerPeriodByDenom}
 )
 
-func TotalLockedAmountKey() []byte {
+func TotalLockedAmountKey(denom string) []byte {
+	return append(KeyPrefixTotalLockedAmount, []byte(denom)...)
+}
+
+// LegacyTotalLockedAmountKey is the key of the total locked amount of the lock
+// denom, before other denoms were lockable.
+func LegacyTotalLockedAmountKey() []byte {
 	return KeyPrefixTotalLockedAmount
 }
 
@@ -92,8 +117,12 @@ func UserEpochKey(veID uint64) []byte {
 	return append(KeyPrefixUserEpoch, sdk.Uint64ToBigEndian(veID)...)
 }
 
+func UserPointsKey(veID uint64) []byte {
+	return append(KeyPrefixUserPointHistoryByUserEpoch, sdk.Uint64ToBigEndian(veID)...)
+}
+
 func UserPointKey(veID uint64, userEpoch uint64) []byte {
-	return append(append(KeyPrefixUserPointHistoryByUserEpoch, sdk.Uint64ToBigEndian(veID)...), sdk.Uint64ToBigEndian(userEpoch)...)
+	return append(UserPointsKey(veID), sdk.Uint64ToBigEndian(userEpoch)...)
 }
 
 func SlopeChangeKey(timestamp uint64) []byte {
@@ -132,6 +161,34 @@ func DistributionPerPeriodKey(timestamp uint64) []byte {
 	return append(KeyPrefixDistributionPerPeriod, sdk.Uint64ToBigEndian(timestamp)...)
 }
 
+// DistributionTotalAmountByDenomKey is the key of the total amount of a denom
+// other than the lock denom distributed by the distribution pool.
+func DistributionTotalAmountByDenomKey(denom string) []byte {
+	return append(KeyPrefixDistributionTotalAmountByDenom, []byte(denom)...)
+}
+
+// DistributionPerPeriodByDenomKey is the key of the amount of a denom other
+// than the lock denom distributed in the period starting at timestamp.
+func DistributionPerPeriodByDenomKey(denom string, timestamp uint64) []byte {
+	return append(append(KeyPrefixDistributionPerPeriodByDenom, address.MustLengthPrefix([]byte(denom))...), sdk.Uint64ToBigEndian(timestamp)...)
+}
+
 func DistributionClaimLastTimestampByUserKey(veID uint64) []byte {
 	return append(KeyPrefixDistributionClaimLastTimestampByUser, sdk.Uint64ToBigEndian(veID)...)
 }
+
+func VoteDelegateeKey(veID uint64) []byte {
+	return append(KeyPrefixVoteDelegatee, sdk.Uint64ToBigEndian(veID)...)
+}
+
+func VoteDelegationsByDelegateeKey(delegatee sdk.AccAddress) []byte {
+	return append(KeyPrefixVoteDelegationByDelegatee, address.MustLengthPrefix(delegatee)...)
+}
+
+func VoteDelegationByDelegateeKey(delegatee sdk.AccAddress, veID uint64) []byte {
+	return append(VoteDelegationsByDelegateeKey(delegatee), sdk.Uint64ToBigEndian(veID)...)
+}
+
+func VeNftContractKey() []byte {
+	return KeyPrefixVeNftContract
+}
@@ -8,8 +8,8 @@ import (
 )
 
 func TestTotalLockedAmountKey(t *testing.T) {
-	key := TotalLockedAmountKey()
-	require.Equal(t, "01", hex.EncodeToString(key))
+	key := TotalLockedAmountKey("amage")
+	require.Equal(t, "01616d616765", hex.EncodeToString(key))
 }
 
 func TestLockedAmountByUserKey(t *testing.T) {
@@ -37,6 +37,11 @@ func TestUserEpochKey(t *testing.T) {
 	require.Equal(t, "060000000000002710", hex.EncodeToString(key))
 }
 
+func TestUserPointsKey(t *testing.T) {
+	key := UserPointsKey(uint64(10000))
+	require.Equal(t, "070000000000002710", hex.EncodeToString(key))
+}
+
 func TestUserPointKey(t *testing.T) {
 	key := UserPointKey(uint64(10000), uint64(10000))
 	require.Equal(t, "0700000000000027100000000000002710", hex.EncodeToString(key))
@@ -91,3 +96,13 @@ func TestDistributionClaimLastTimestampByUserKey(t *testing.T) {
 	key := DistributionClaimLastTimestampByUserKey(uint64(10000))
 	require.Equal(t, "110000000000002710", hex.EncodeToString(key))
 }
+
+func TestVoteDelegateeKey(t *testing.T) {
+	key := VoteDelegateeKey(uint64(10000))
+	require.Equal(t, "120000000000002710", hex.EncodeToString(key))
+}
+
+func TestVoteDelegationByDelegateeKey(t *testing.T) {
+	key := VoteDelegationByDelegateeKey([]byte{0xaa, 0xbb}, uint64(10000))
+	require.Equal(t, "1302aabb0000000000002710", hex.EncodeToString(key))
+}
@@ -6,5 +6,24 @@ func NewLockedBalance() LockedBalance {
 	return LockedBalance{
 		Amount: sdk.ZeroInt(),
 		End:    0,
+		Weight: sdk.OneDec(),
+	}
+}
+
+// WeightedAmount returns the locked amount multiplied by the voting power
+// weight of the locked denom, which determines the voting power. A lock
+// without weight is weighted one.
+func (l LockedBalance) WeightedAmount() sdk.Int {
+	if l.Weight.IsNil() || l.Weight.IsZero() {
+		return l.Amount
+	}
+	return l.Weight.MulInt(l.Amount).TruncateInt()
+}
+
+func NewCheckpoint() Checkpoint {
+	return Checkpoint{
+		Bias:      sdk.ZeroInt(),
+		Slope:     sdk.ZeroInt(),
+		Permanent: sdk.ZeroInt(),
 	}
 }
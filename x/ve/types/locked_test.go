@@ -11,4 +11,25 @@ func TestNewLockedBalance(t *testing.T) {
 	bal := NewLockedBalance()
 	require.Equal(t, sdk.ZeroInt(), bal.Amount)
 	require.Equal(t, uint64(0), bal.End)
+	require.Equal(t, sdk.OneDec(), bal.Weight)
+}
+
+func TestLockedBalance_WeightedAmount(t *testing.T) {
+	bal := LockedBalance{Amount: sdk.NewInt(1000)}
+	require.Equal(t, sdk.NewInt(1000), bal.WeightedAmount())
+
+	bal.Weight = sdk.NewDecWithPrec(25, 1)
+	require.Equal(t, sdk.NewInt(2500), bal.WeightedAmount())
+
+	bal.Weight = sdk.NewDecWithPrec(3333, 4)
+	require.Equal(t, sdk.NewInt(333), bal.WeightedAmount())
+}
+
+func TestNewCheckpoint(t *testing.T) {
+	point := NewCheckpoint()
+	require.Equal(t, sdk.ZeroInt(), point.Bias)
+	require.Equal(t, sdk.ZeroInt(), point.Slope)
+	require.Equal(t, sdk.ZeroInt(), point.Permanent)
+	require.Equal(t, uint64(0), point.Timestamp)
+	require.Equal(t, int64(0), point.Block)
 }
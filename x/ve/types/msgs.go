@@ -6,11 +6,17 @@ import (
 )
 
 const (
-	TypeMsgCreate     = "create"
-	TypeMsgDeposit    = "deposit"
-	TypeMsgExtendTime = "extend_time"
-	TypeMsgMerge      = "merge"
-	TypeMsgWithdraw   = "withdraw"
+	TypeMsgCreate          = "create"
+	TypeMsgDeposit         = "deposit"
+	TypeMsgExtendTime      = "extend_time"
+	TypeMsgMerge           = "merge"
+	TypeMsgWithdraw        = "withdraw"
+	TypeMsgWithdrawEarly   = "withdraw_early"
+	TypeMsgSplit           = "split"
+	TypeMsgLockPermanent   = "lock_permanent"
+	TypeMsgUnlockPermanent = "unlock_permanent"
+	TypeMsgDelegateVotes   = "delegate_votes"
+	TypeMsgUndelegateVotes = "undelegate_votes"
 )
 
 var (
@@ -19,6 +25,12 @@ var (
 	_ sdk.Msg = &MsgExtendTime{}
 	_ sdk.Msg = &MsgMerge{}
 	_ sdk.Msg = &MsgWithdraw{}
+	_ sdk.Msg = &MsgWithdrawEarly{}
+	_ sdk.Msg = &MsgSplit{}
+	_ sdk.Msg = &MsgLockPermanent{}
+	_ sdk.Msg = &MsgUnlockPermanent{}
+	_ sdk.Msg = &MsgDelegateVotes{}
+	_ sdk.Msg = &MsgUndelegateVotes{}
 )
 
 // Route implements sdk.Msg
@@ -207,3 +219,210 @@ func (m *MsgWithdraw) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// Route implements sdk.Msg
+func (m *MsgWithdrawEarly) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgWithdrawEarly) Type() string { return TypeMsgWithdrawEarly }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgWithdrawEarly) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgWithdrawEarly) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if Uint64FromVeID(m.VeId) == EmptyVeID {
+		return ErrInvalidVeID
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgWithdrawEarly) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgSplit) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgSplit) Type() string { return TypeMsgSplit }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgSplit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgSplit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if Uint64FromVeID(m.VeId) == EmptyVeID {
+		return ErrInvalidVeID
+	}
+	if len(m.Amounts) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no amounts to split")
+	}
+	for _, amount := range m.Amounts {
+		if amount.IsNil() || !amount.IsPositive() {
+			return ErrAmountNotPositive
+		}
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgSplit) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgLockPermanent) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgLockPermanent) Type() string { return TypeMsgLockPermanent }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgLockPermanent) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgLockPermanent) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if Uint64FromVeID(m.VeId) == EmptyVeID {
+		return ErrInvalidVeID
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgLockPermanent) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgUnlockPermanent) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgUnlockPermanent) Type() string { return TypeMsgUnlockPermanent }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgUnlockPermanent) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgUnlockPermanent) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if Uint64FromVeID(m.VeId) == EmptyVeID {
+		return ErrInvalidVeID
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgUnlockPermanent) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgDelegateVotes) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgDelegateVotes) Type() string { return TypeMsgDelegateVotes }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgDelegateVotes) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgDelegateVotes) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if Uint64FromVeID(m.VeId) == EmptyVeID {
+		return ErrInvalidVeID
+	}
+	_, err = sdk.AccAddressFromBech32(m.Delegatee)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegatee address (%s)", err)
+	}
+	if m.Delegatee == m.Sender {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot delegate votes to sender")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgDelegateVotes) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgUndelegateVotes) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgUndelegateVotes) Type() string { return TypeMsgUndelegateVotes }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgUndelegateVotes) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgUndelegateVotes) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if Uint64FromVeID(m.VeId) == EmptyVeID {
+		return ErrInvalidVeID
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgUndelegateVotes) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -32,27 +32,27 @@ func TestMsgCreate_ValidateBasic(t *testing.T) {
 		{
 			desc:   "ErrAmountNotPositive",
 			sender: "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
-			to:     "war1353a4uac03etdylz86tyq9ssm3x2704jr632l3",
+			to:     "war1353a4uac03etdylz86tyq9ssm3x2704j3ju8gt",
 			amount: sdk.NewCoin(wartypes.AttoMageDenom, sdk.NewInt(0)),
 		},
 		{
 			desc:         "ErrPastLockTime",
 			sender:       "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
-			to:           "war1353a4uac03etdylz86tyq9ssm3x2704jr632l3",
+			to:           "war1353a4uac03etdylz86tyq9ssm3x2704j3ju8gt",
 			amount:       sdk.NewCoin(wartypes.AttoMageDenom, sdk.NewInt(1)),
 			lockDuration: 0,
 		},
 		{
 			desc:         "ErrTooLongLockTime",
 			sender:       "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
-			to:           "war1353a4uac03etdylz86tyq9ssm3x2704jr632l3",
+			to:           "war1353a4uac03etdylz86tyq9ssm3x2704j3ju8gt",
 			amount:       sdk.NewCoin(wartypes.AttoMageDenom, sdk.NewInt(1)),
 			lockDuration: types.MaxLockTime + 1,
 		},
 		{
 			desc:         "valid",
 			sender:       "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
-			to:           "war1353a4uac03etdylz86tyq9ssm3x2704jr632l3",
+			to:           "war1353a4uac03etdylz86tyq9ssm3x2704j3ju8gt",
 			amount:       sdk.NewCoin(wartypes.AttoMageDenom, sdk.NewInt(1)),
 			lockDuration: types.MaxLockTime - 1,
 			valid:        true,
@@ -79,7 +79,7 @@ func TestMsgCreate_GetSigners(t *testing.T) {
 	app.Setup(false)
 	msg := &types.MsgCreate{
 		Sender:       "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
-		To:           "war1353a4uac03etdylz86tyq9ssm3x2704jr632l3",
+		To:           "war1353a4uac03etdylz86tyq9ssm3x2704j3ju8gt",
 		Amount:       sdk.NewCoin(wartypes.AttoMageDenom, sdk.NewInt(1)),
 		LockDuration: uint64(100000),
 	}
@@ -334,3 +334,329 @@ func TestMsgWithdraw_GetSigners(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, sender, signers[0])
 }
+
+func TestMsgSplit_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	for _, tc := range []struct {
+		desc    string
+		sender  string
+		veId    string
+		amounts []sdk.Int
+		valid   bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+		},
+		{
+			desc:   "invalid veId",
+			sender: "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw",
+			veId:   "xxx",
+		},
+		{
+			desc:   "no amounts",
+			sender: "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw",
+			veId:   "ve-100",
+		},
+		{
+			desc:    "ErrAmountNotPositive",
+			sender:  "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw",
+			veId:    "ve-100",
+			amounts: []sdk.Int{sdk.NewInt(1), sdk.NewInt(0)},
+		},
+		{
+			desc:    "valid",
+			sender:  "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw",
+			veId:    "ve-100",
+			amounts: []sdk.Int{sdk.NewInt(1), sdk.NewInt(2)},
+			valid:   true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgSplit{
+				Sender:  tc.sender,
+				VeId:    tc.veId,
+				Amounts: tc.amounts,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSplit_GetSigners(t *testing.T) {
+	app.Setup(false)
+	msg := &types.MsgSplit{
+		Sender:  "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw",
+		VeId:    "ve-100",
+		Amounts: []sdk.Int{sdk.NewInt(1)},
+	}
+	signers := msg.GetSigners()
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	require.NoError(t, err)
+	require.Equal(t, sender, signers[0])
+}
+
+func TestMsgLockPermanent_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	for _, tc := range []struct {
+		desc   string
+		sender string
+		veId   string
+		valid  bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+		},
+		{
+			desc:   "invalid veId",
+			sender: "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw",
+			veId:   "xxx",
+		},
+		{
+			desc:   "valid",
+			sender: "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw",
+			veId:   "ve-100",
+			valid:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgLockPermanent{
+				Sender: tc.sender,
+				VeId:   tc.veId,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgLockPermanent_GetSigners(t *testing.T) {
+	app.Setup(false)
+	msg := &types.MsgLockPermanent{
+		Sender: "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw",
+		VeId:   "ve-100",
+	}
+	signers := msg.GetSigners()
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	require.NoError(t, err)
+	require.Equal(t, sender, signers[0])
+}
+
+func TestMsgUnlockPermanent_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	for _, tc := range []struct {
+		desc   string
+		sender string
+		veId   string
+		valid  bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+		},
+		{
+			desc:   "invalid veId",
+			sender: "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw",
+			veId:   "xxx",
+		},
+		{
+			desc:   "valid",
+			sender: "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw",
+			veId:   "ve-100",
+			valid:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgUnlockPermanent{
+				Sender: tc.sender,
+				VeId:   tc.veId,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgUnlockPermanent_GetSigners(t *testing.T) {
+	app.Setup(false)
+	msg := &types.MsgUnlockPermanent{
+		Sender: "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw",
+		VeId:   "ve-100",
+	}
+	signers := msg.GetSigners()
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	require.NoError(t, err)
+	require.Equal(t, sender, signers[0])
+}
+
+func TestMsgWithdrawEarly_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	for _, tc := range []struct {
+		desc   string
+		sender string
+		veId   string
+		valid  bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+		},
+		{
+			desc:   "invalid veId",
+			sender: "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw",
+			veId:   "xxx",
+		},
+		{
+			desc:   "valid",
+			sender: "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw",
+			veId:   "ve-100",
+			valid:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgWithdrawEarly{
+				Sender: tc.sender,
+				VeId:   tc.veId,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgWithdrawEarly_GetSigners(t *testing.T) {
+	app.Setup(false)
+	msg := &types.MsgWithdrawEarly{
+		Sender: "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw",
+		VeId:   "ve-100",
+	}
+	signers := msg.GetSigners()
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	require.NoError(t, err)
+	require.Equal(t, sender, signers[0])
+}
+
+func TestMsgDelegateVotes_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	for _, tc := range []struct {
+		desc      string
+		sender    string
+		veId      string
+		delegatee string
+		valid     bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+		},
+		{
+			desc:      "invalid veId",
+			sender:    "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw",
+			veId:      "xxx",
+			delegatee: "war1353a4uac03etdylz86tyq9ssm3x2704j3ju8gt",
+		},
+		{
+			desc:      "invalid delegatee address",
+			sender:    "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw",
+			veId:      "ve-100",
+			delegatee: "xxx",
+		},
+		{
+			desc:      "delegate to self",
+			sender:    "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw",
+			veId:      "ve-100",
+			delegatee: "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw",
+		},
+		{
+			desc:      "valid",
+			sender:    "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw",
+			veId:      "ve-100",
+			delegatee: "war1353a4uac03etdylz86tyq9ssm3x2704j3ju8gt",
+			valid:     true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgDelegateVotes{
+				Sender:    tc.sender,
+				VeId:      tc.veId,
+				Delegatee: tc.delegatee,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgDelegateVotes_GetSigners(t *testing.T) {
+	app.Setup(false)
+	msg := &types.MsgDelegateVotes{
+		Sender:    "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw",
+		VeId:      "ve-100",
+		Delegatee: "war1353a4uac03etdylz86tyq9ssm3x2704j3ju8gt",
+	}
+	signers := msg.GetSigners()
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	require.NoError(t, err)
+	require.Equal(t, sender, signers[0])
+}
+
+func TestMsgUndelegateVotes_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	for _, tc := range []struct {
+		desc   string
+		sender string
+		veId   string
+		valid  bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+		},
+		{
+			desc:   "invalid veId",
+			sender: "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw",
+			veId:   "xxx",
+		},
+		{
+			desc:   "valid",
+			sender: "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw",
+			veId:   "ve-100",
+			valid:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgUndelegateVotes{
+				Sender: tc.sender,
+				VeId:   tc.veId,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
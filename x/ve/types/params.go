@@ -11,7 +11,16 @@ import (
 
 // Parameter keys
 var (
-	KeyLockDenom = []byte("LockDenom")
+	KeyLockDenom                = []byte("LockDenom")
+	KeyMaxEarlyWithdrawPenalty  = []byte("MaxEarlyWithdrawPenalty")
+	KeyBurnEarlyWithdrawPenalty = []byte("BurnEarlyWithdrawPenalty")
+	KeyLockDenomWeights         = []byte("LockDenomWeights")
+)
+
+// Default parameter values
+var (
+	DefaultMaxEarlyWithdrawPenalty  = sdk.NewDecWithPrec(75, 2) // 75%
+	DefaultBurnEarlyWithdrawPenalty = false
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -24,7 +33,10 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
 	return Params{
-		LockDenom: warmage.BaseDenom,
+		LockDenom:                warmage.BaseDenom,
+		MaxEarlyWithdrawPenalty:  DefaultMaxEarlyWithdrawPenalty,
+		BurnEarlyWithdrawPenalty: DefaultBurnEarlyWithdrawPenalty,
+		LockDenomWeights:         []LockDenomWeight{},
 	}
 }
 
@@ -32,12 +44,40 @@ func DefaultParams() Params {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyLockDenom, &p.LockDenom, validateLockDenom),
+		paramtypes.NewParamSetPair(KeyMaxEarlyWithdrawPenalty, &p.MaxEarlyWithdrawPenalty, validateMaxEarlyWithdrawPenalty),
+		paramtypes.NewParamSetPair(KeyBurnEarlyWithdrawPenalty, &p.BurnEarlyWithdrawPenalty, validateBool),
+		paramtypes.NewParamSetPair(KeyLockDenomWeights, &p.LockDenomWeights, validateLockDenomWeights),
 	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return sdk.ValidateDenom(p.LockDenom)
+	if err := sdk.ValidateDenom(p.LockDenom); err != nil {
+		return err
+	}
+	if err := validateMaxEarlyWithdrawPenalty(p.MaxEarlyWithdrawPenalty); err != nil {
+		return err
+	}
+	for _, w := range p.LockDenomWeights {
+		if w.Denom == p.LockDenom {
+			return fmt.Errorf("lock denom %s is always weighted one", w.Denom)
+		}
+	}
+	return validateLockDenomWeights(p.LockDenomWeights)
+}
+
+// LockDenomWeight returns the voting power weight of the denom, which is one
+// for the lock denom.
+func (p Params) LockDenomWeight(denom string) (sdk.Dec, bool) {
+	if denom == p.LockDenom {
+		return sdk.OneDec(), true
+	}
+	for _, w := range p.LockDenomWeights {
+		if w.Denom == denom {
+			return w.Weight, true
+		}
+	}
+	return sdk.Dec{}, false
 }
 
 func validateLockDenom(i interface{}) error {
@@ -49,8 +89,64 @@ func validateLockDenom(i interface{}) error {
 	return sdk.ValidateDenom(v)
 }
 
+func validateMaxEarlyWithdrawPenalty(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("max early withdrawal penalty must be nonnegative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max early withdrawal penalty is too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateLockDenomWeights(i interface{}) error {
+	v, ok := i.([]LockDenomWeight)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, w := range v {
+		if err := sdk.ValidateDenom(w.Denom); err != nil {
+			return err
+		}
+		if seen[w.Denom] {
+			return fmt.Errorf("duplicate lock denom weight: %s", w.Denom)
+		}
+		seen[w.Denom] = true
+
+		if w.Weight.IsNil() || !w.Weight.IsPositive() {
+			return fmt.Errorf("lock denom weight must be positive: %s", w.Weight)
+		}
+	}
+
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+// String implements the Stringer interface.
+func (w LockDenomWeight) String() string {
+	out, _ := yaml.Marshal(w)
+	return string(out)
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	warmage "github.com/petri-labs/warmage/types"
 	"github.com/stretchr/testify/require"
 )
@@ -10,4 +11,45 @@ import (
 func TestDefaultParams(t *testing.T) {
 	params := DefaultParams()
 	require.Equal(t, warmage.BaseDenom, params.LockDenom)
+	require.Equal(t, DefaultMaxEarlyWithdrawPenalty, params.MaxEarlyWithdrawPenalty)
+	require.NoError(t, params.Validate())
+}
+
+func TestParamsValidate(t *testing.T) {
+	params := DefaultParams()
+	params.MaxEarlyWithdrawPenalty = sdk.NewDec(-1)
+	require.Error(t, params.Validate())
+
+	params.MaxEarlyWithdrawPenalty = sdk.NewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
+
+	params.MaxEarlyWithdrawPenalty = sdk.OneDec()
+	require.NoError(t, params.Validate())
+}
+
+func TestParamsValidate_LockDenomWeights(t *testing.T) {
+	params := DefaultParams()
+	params.LockDenomWeights = []LockDenomWeight{{Denom: "alp", Weight: sdk.NewDec(2)}}
+	require.NoError(t, params.Validate())
+
+	weight, found := params.LockDenomWeight("alp")
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(2), weight)
+	weight, found = params.LockDenomWeight(params.LockDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.OneDec(), weight)
+	_, found = params.LockDenomWeight("other")
+	require.False(t, found)
+
+	params.LockDenomWeights = []LockDenomWeight{{Denom: "alp", Weight: sdk.ZeroDec()}}
+	require.Error(t, params.Validate())
+
+	params.LockDenomWeights = []LockDenomWeight{{Denom: "alp", Weight: sdk.OneDec()}, {Denom: "alp", Weight: sdk.NewDec(2)}}
+	require.Error(t, params.Validate())
+
+	params.LockDenomWeights = []LockDenomWeight{{Denom: params.LockDenom, Weight: sdk.NewDec(2)}}
+	require.Error(t, params.Validate())
+
+	params.LockDenomWeights = []LockDenomWeight{{Denom: "!", Weight: sdk.OneDec()}}
+	require.Error(t, params.Validate())
 }
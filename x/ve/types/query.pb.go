@@ -41,7 +41,7 @@ func (m *QueryTotalVotingPowerRequest) Reset()         { *m = QueryTotalVotingPo
 func (m *QueryTotalVotingPowerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalVotingPowerRequest) ProtoMessage()    {}
 func (*QueryTotalVotingPowerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_256fa148a9e7f65f, []int{0}
+	return fileDescriptor_7b1733d0097e0a15, []int{0}
 }
 func (m *QueryTotalVotingPowerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -92,7 +92,7 @@ func (m *QueryTotalVotingPowerResponse) Reset()         { *m = QueryTotalVotingP
 func (m *QueryTotalVotingPowerResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalVotingPowerResponse) ProtoMessage()    {}
 func (*QueryTotalVotingPowerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_256fa148a9e7f65f, []int{1}
+	return fileDescriptor_7b1733d0097e0a15, []int{1}
 }
 func (m *QueryTotalVotingPowerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -131,7 +131,7 @@ func (m *QueryVotingPowerRequest) Reset()         { *m = QueryVotingPowerRequest
 func (m *QueryVotingPowerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVotingPowerRequest) ProtoMessage()    {}
 func (*QueryVotingPowerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_256fa148a9e7f65f, []int{2}
+	return fileDescriptor_7b1733d0097e0a15, []int{2}
 }
 func (m *QueryVotingPowerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -189,7 +189,7 @@ func (m *QueryVotingPowerResponse) Reset()         { *m = QueryVotingPowerRespon
 func (m *QueryVotingPowerResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVotingPowerResponse) ProtoMessage()    {}
 func (*QueryVotingPowerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_256fa148a9e7f65f, []int{3}
+	return fileDescriptor_7b1733d0097e0a15, []int{3}
 }
 func (m *QueryVotingPowerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,24 +218,29 @@ func (m *QueryVotingPowerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryVotingPowerResponse proto.InternalMessageInfo
 
-// QueryVeNftsRequest is the request type for the Query/VeNfts RPC method
-type QueryVeNftsRequest struct {
-	Owner      string             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryTotalVotingPowerSeriesRequest is the request type for the
+// Query/TotalVotingPowerSeries RPC method
+type QueryTotalVotingPowerSeriesRequest struct {
+	// unix time range, both inclusive, which cannot end later than the max lock
+	// time from now
+	FromTime uint64 `protobuf:"varint,1,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime   uint64 `protobuf:"varint,2,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	// seconds between two points of the series
+	Step uint64 `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
 }
 
-func (m *QueryVeNftsRequest) Reset()         { *m = QueryVeNftsRequest{} }
-func (m *QueryVeNftsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryVeNftsRequest) ProtoMessage()    {}
-func (*QueryVeNftsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_256fa148a9e7f65f, []int{4}
+func (m *QueryTotalVotingPowerSeriesRequest) Reset()         { *m = QueryTotalVotingPowerSeriesRequest{} }
+func (m *QueryTotalVotingPowerSeriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalVotingPowerSeriesRequest) ProtoMessage()    {}
+func (*QueryTotalVotingPowerSeriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{4}
 }
-func (m *QueryVeNftsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTotalVotingPowerSeriesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVeNftsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTotalVotingPowerSeriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVeNftsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTotalVotingPowerSeriesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -245,50 +250,57 @@ func (m *QueryVeNftsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryVeNftsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVeNftsRequest.Merge(m, src)
+func (m *QueryTotalVotingPowerSeriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalVotingPowerSeriesRequest.Merge(m, src)
 }
-func (m *QueryVeNftsRequest) XXX_Size() int {
+func (m *QueryTotalVotingPowerSeriesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVeNftsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVeNftsRequest.DiscardUnknown(m)
+func (m *QueryTotalVotingPowerSeriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalVotingPowerSeriesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVeNftsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTotalVotingPowerSeriesRequest proto.InternalMessageInfo
 
-func (m *QueryVeNftsRequest) GetOwner() string {
+func (m *QueryTotalVotingPowerSeriesRequest) GetFromTime() uint64 {
 	if m != nil {
-		return m.Owner
+		return m.FromTime
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryVeNftsRequest) GetPagination() *query.PageRequest {
+func (m *QueryTotalVotingPowerSeriesRequest) GetToTime() uint64 {
 	if m != nil {
-		return m.Pagination
+		return m.ToTime
 	}
-	return nil
+	return 0
 }
 
-// QueryVeNftsResponse is the response type for the Query/VeNfts RPC methods
-type QueryVeNftsResponse struct {
-	Nfts       []*nft.NFT          `protobuf:"bytes,1,rep,name=nfts,proto3" json:"nfts,omitempty"`
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryTotalVotingPowerSeriesRequest) GetStep() uint64 {
+	if m != nil {
+		return m.Step
+	}
+	return 0
 }
 
-func (m *QueryVeNftsResponse) Reset()         { *m = QueryVeNftsResponse{} }
-func (m *QueryVeNftsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryVeNftsResponse) ProtoMessage()    {}
-func (*QueryVeNftsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_256fa148a9e7f65f, []int{5}
+// TimedVotingPower is the voting power at a unix time.
+type TimedVotingPower struct {
+	Timestamp uint64                                 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Power     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=power,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"power"`
 }
-func (m *QueryVeNftsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *TimedVotingPower) Reset()         { *m = TimedVotingPower{} }
+func (m *TimedVotingPower) String() string { return proto.CompactTextString(m) }
+func (*TimedVotingPower) ProtoMessage()    {}
+func (*TimedVotingPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{5}
+}
+func (m *TimedVotingPower) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVeNftsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TimedVotingPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVeNftsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TimedVotingPower.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -298,49 +310,89 @@ func (m *QueryVeNftsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryVeNftsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVeNftsResponse.Merge(m, src)
+func (m *TimedVotingPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimedVotingPower.Merge(m, src)
 }
-func (m *QueryVeNftsResponse) XXX_Size() int {
+func (m *TimedVotingPower) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVeNftsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVeNftsResponse.DiscardUnknown(m)
+func (m *TimedVotingPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimedVotingPower.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVeNftsResponse proto.InternalMessageInfo
+var xxx_messageInfo_TimedVotingPower proto.InternalMessageInfo
 
-func (m *QueryVeNftsResponse) GetNfts() []*nft.NFT {
+func (m *TimedVotingPower) GetTimestamp() uint64 {
 	if m != nil {
-		return m.Nfts
+		return m.Timestamp
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryVeNftsResponse) GetPagination() *query.PageResponse {
+// QueryTotalVotingPowerSeriesResponse is the response type for the
+// Query/TotalVotingPowerSeries RPC method
+type QueryTotalVotingPowerSeriesResponse struct {
+	Powers []TimedVotingPower `protobuf:"bytes,1,rep,name=powers,proto3" json:"powers"`
+}
+
+func (m *QueryTotalVotingPowerSeriesResponse) Reset()         { *m = QueryTotalVotingPowerSeriesResponse{} }
+func (m *QueryTotalVotingPowerSeriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalVotingPowerSeriesResponse) ProtoMessage()    {}
+func (*QueryTotalVotingPowerSeriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{6}
+}
+func (m *QueryTotalVotingPowerSeriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalVotingPowerSeriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalVotingPowerSeriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalVotingPowerSeriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalVotingPowerSeriesResponse.Merge(m, src)
+}
+func (m *QueryTotalVotingPowerSeriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalVotingPowerSeriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalVotingPowerSeriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalVotingPowerSeriesResponse proto.InternalMessageInfo
+
+func (m *QueryTotalVotingPowerSeriesResponse) GetPowers() []TimedVotingPower {
 	if m != nil {
-		return m.Pagination
+		return m.Powers
 	}
 	return nil
 }
 
-// QueryVeNftRequest is the request type for the Query/VeNft RPC method
-type QueryVeNftRequest struct {
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// EpochCheckpoint is a checkpoint at an epoch.
+type EpochCheckpoint struct {
+	Epoch      uint64     `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Checkpoint Checkpoint `protobuf:"bytes,2,opt,name=checkpoint,proto3" json:"checkpoint"`
 }
 
-func (m *QueryVeNftRequest) Reset()         { *m = QueryVeNftRequest{} }
-func (m *QueryVeNftRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryVeNftRequest) ProtoMessage()    {}
-func (*QueryVeNftRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_256fa148a9e7f65f, []int{6}
+func (m *EpochCheckpoint) Reset()         { *m = EpochCheckpoint{} }
+func (m *EpochCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*EpochCheckpoint) ProtoMessage()    {}
+func (*EpochCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{7}
 }
-func (m *QueryVeNftRequest) XXX_Unmarshal(b []byte) error {
+func (m *EpochCheckpoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVeNftRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EpochCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVeNftRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EpochCheckpoint.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -350,42 +402,53 @@ func (m *QueryVeNftRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *QueryVeNftRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVeNftRequest.Merge(m, src)
+func (m *EpochCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochCheckpoint.Merge(m, src)
 }
-func (m *QueryVeNftRequest) XXX_Size() int {
+func (m *EpochCheckpoint) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVeNftRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVeNftRequest.DiscardUnknown(m)
+func (m *EpochCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochCheckpoint.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVeNftRequest proto.InternalMessageInfo
+var xxx_messageInfo_EpochCheckpoint proto.InternalMessageInfo
 
-func (m *QueryVeNftRequest) GetId() string {
+func (m *EpochCheckpoint) GetEpoch() uint64 {
 	if m != nil {
-		return m.Id
+		return m.Epoch
 	}
-	return ""
+	return 0
 }
 
-// QueryVeNftResponse is the response type for the Query/VeNft RPC method
-type QueryVeNftResponse struct {
-	Nft *nft.NFT `protobuf:"bytes,1,opt,name=nft,proto3" json:"nft,omitempty"`
+func (m *EpochCheckpoint) GetCheckpoint() Checkpoint {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return Checkpoint{}
 }
 
-func (m *QueryVeNftResponse) Reset()         { *m = QueryVeNftResponse{} }
-func (m *QueryVeNftResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryVeNftResponse) ProtoMessage()    {}
-func (*QueryVeNftResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_256fa148a9e7f65f, []int{7}
+// QueryCheckpointsRequest is the request type for the Query/Checkpoints RPC
+// method
+type QueryCheckpointsRequest struct {
+	// epoch range, both inclusive; zero to_epoch means no upper bound
+	FromEpoch  uint64             `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	ToEpoch    uint64             `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *QueryVeNftResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryCheckpointsRequest) Reset()         { *m = QueryCheckpointsRequest{} }
+func (m *QueryCheckpointsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointsRequest) ProtoMessage()    {}
+func (*QueryCheckpointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{8}
+}
+func (m *QueryCheckpointsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVeNftResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCheckpointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVeNftResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCheckpointsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -395,41 +458,58 @@ func (m *QueryVeNftResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryVeNftResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVeNftResponse.Merge(m, src)
+func (m *QueryCheckpointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointsRequest.Merge(m, src)
 }
-func (m *QueryVeNftResponse) XXX_Size() int {
+func (m *QueryCheckpointsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVeNftResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVeNftResponse.DiscardUnknown(m)
+func (m *QueryCheckpointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVeNftResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCheckpointsRequest proto.InternalMessageInfo
 
-func (m *QueryVeNftResponse) GetNft() *nft.NFT {
+func (m *QueryCheckpointsRequest) GetFromEpoch() uint64 {
 	if m != nil {
-		return m.Nft
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *QueryCheckpointsRequest) GetToEpoch() uint64 {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+func (m *QueryCheckpointsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
 	return nil
 }
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+// QueryCheckpointsResponse is the response type for the Query/Checkpoints RPC
+// method
+type QueryCheckpointsResponse struct {
+	Checkpoints []EpochCheckpoint   `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_256fa148a9e7f65f, []int{8}
+func (m *QueryCheckpointsResponse) Reset()         { *m = QueryCheckpointsResponse{} }
+func (m *QueryCheckpointsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointsResponse) ProtoMessage()    {}
+func (*QueryCheckpointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{9}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryCheckpointsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCheckpointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCheckpointsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -439,36 +519,51 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryCheckpointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointsResponse.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryCheckpointsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryCheckpointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryCheckpointsResponse proto.InternalMessageInfo
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params holds all the parameters of this module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *QueryCheckpointsResponse) GetCheckpoints() []EpochCheckpoint {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_256fa148a9e7f65f, []int{9}
+func (m *QueryCheckpointsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+
+// QueryUserCheckpointsRequest is the request type for the
+// Query/UserCheckpoints RPC method
+type QueryUserCheckpointsRequest struct {
+	VeId       string             `protobuf:"bytes,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUserCheckpointsRequest) Reset()         { *m = QueryUserCheckpointsRequest{} }
+func (m *QueryUserCheckpointsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUserCheckpointsRequest) ProtoMessage()    {}
+func (*QueryUserCheckpointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{10}
+}
+func (m *QueryUserCheckpointsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryUserCheckpointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryUserCheckpointsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -478,829 +573,5277 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryUserCheckpointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserCheckpointsRequest.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryUserCheckpointsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryUserCheckpointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserCheckpointsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryUserCheckpointsRequest proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryUserCheckpointsRequest) GetVeId() string {
 	if m != nil {
-		return m.Params
+		return m.VeId
 	}
-	return Params{}
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryTotalVotingPowerRequest)(nil), "warmage.ve.v1.QueryTotalVotingPowerRequest")
-	proto.RegisterType((*QueryTotalVotingPowerResponse)(nil), "warmage.ve.v1.QueryTotalVotingPowerResponse")
-	proto.RegisterType((*QueryVotingPowerRequest)(nil), "warmage.ve.v1.QueryVotingPowerRequest")
-	proto.RegisterType((*QueryVotingPowerResponse)(nil), "warmage.ve.v1.QueryVotingPowerResponse")
-	proto.RegisterType((*QueryVeNftsRequest)(nil), "warmage.ve.v1.QueryVeNftsRequest")
-	proto.RegisterType((*QueryVeNftsResponse)(nil), "warmage.ve.v1.QueryVeNftsResponse")
-	proto.RegisterType((*QueryVeNftRequest)(nil), "warmage.ve.v1.QueryVeNftRequest")
-	proto.RegisterType((*QueryVeNftResponse)(nil), "warmage.ve.v1.QueryVeNftResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "warmage.ve.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "warmage.ve.v1.QueryParamsResponse")
+func (m *QueryUserCheckpointsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("warmage/ve/v1/query.proto", fileDescriptor_256fa148a9e7f65f) }
-
-var fileDescriptor_256fa148a9e7f65f = []byte{
-	// 698 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x4f, 0xdb, 0x30,
-	0x18, 0x6e, 0xfa, 0xc5, 0x66, 0xb4, 0x69, 0x33, 0xa0, 0x96, 0x0e, 0x4a, 0x09, 0x1a, 0x9f, 0x23,
-	0x56, 0xe1, 0x07, 0x4c, 0xaa, 0x10, 0x13, 0x3b, 0x20, 0x16, 0xa1, 0x1d, 0x76, 0xe9, 0x5c, 0xea,
-	0x66, 0x11, 0xad, 0x1d, 0x62, 0x13, 0xc6, 0xd0, 0x2e, 0x5c, 0xb9, 0x4c, 0xda, 0x65, 0x3f, 0x89,
-	0x23, 0xd2, 0x2e, 0xd3, 0x0e, 0x68, 0x82, 0xfd, 0x90, 0x29, 0xb6, 0x53, 0x92, 0x2e, 0x2d, 0x3b,
-	0xec, 0x04, 0x89, 0x9f, 0xf7, 0xf9, 0xb0, 0x1f, 0xa7, 0x60, 0xba, 0x47, 0xfc, 0xae, 0xcb, 0x28,
-	0x0a, 0x08, 0x0a, 0xea, 0xe8, 0xe8, 0x98, 0xf8, 0xa7, 0x96, 0xe7, 0x33, 0xc1, 0xe0, 0x23, 0xbd,
-	0x64, 0x05, 0xc4, 0x0a, 0xea, 0x95, 0x49, 0x87, 0x39, 0x4c, 0xae, 0xa0, 0xf0, 0x3f, 0x05, 0xaa,
-	0xcc, 0x38, 0x8c, 0x39, 0x5d, 0x82, 0xb0, 0xe7, 0x22, 0x4c, 0x29, 0x13, 0x58, 0xb8, 0x8c, 0x72,
-	0xbd, 0xba, 0x7a, 0xc0, 0x78, 0x8f, 0x71, 0xd4, 0xc2, 0x9c, 0x28, 0x6e, 0x14, 0xd4, 0x5b, 0x44,
-	0xe0, 0x3a, 0xf2, 0xb0, 0xe3, 0x52, 0x09, 0x8e, 0x98, 0x34, 0x96, 0x76, 0x44, 0x1f, 0x44, 0x3b,
-	0x42, 0xaf, 0x3e, 0x4b, 0xfa, 0x74, 0x08, 0x25, 0xdc, 0xd5, 0x32, 0xa6, 0x0d, 0x66, 0xde, 0x84,
-	0xe4, 0xfb, 0x4c, 0xe0, 0xee, 0x5b, 0x26, 0x5c, 0xea, 0xec, 0xb1, 0x13, 0xe2, 0xdb, 0xe4, 0xe8,
-	0x98, 0x70, 0x01, 0x4b, 0x60, 0x0c, 0x8b, 0xa6, 0x70, 0x7b, 0xa4, 0x6c, 0xd4, 0x8c, 0xe5, 0xbc,
-	0x5d, 0xc4, 0x62, 0xdf, 0xed, 0x11, 0x38, 0x0d, 0x1e, 0x60, 0xd1, 0x6c, 0x75, 0xd9, 0xc1, 0x61,
-	0x39, 0x5b, 0x33, 0x96, 0x73, 0xf6, 0x18, 0x16, 0x8d, 0xf0, 0xd1, 0x24, 0x60, 0x76, 0x08, 0x27,
-	0xf7, 0x18, 0xe5, 0x04, 0x6e, 0x81, 0x82, 0x17, 0xbe, 0x90, 0x94, 0x0f, 0x1b, 0xd6, 0xe5, 0xf5,
-	0x5c, 0xe6, 0xe7, 0xf5, 0xdc, 0xa2, 0xe3, 0x8a, 0x0f, 0xc7, 0x2d, 0xeb, 0x80, 0xf5, 0x90, 0x4e,
-	0xa4, 0xfe, 0xac, 0xf3, 0xf6, 0x21, 0x12, 0xa7, 0x1e, 0xe1, 0xd6, 0x0e, 0x15, 0xb6, 0x1a, 0x36,
-	0x5b, 0xa0, 0x24, 0x65, 0x52, 0x5c, 0x4f, 0x80, 0x42, 0x40, 0x9a, 0x6e, 0x5b, 0x09, 0xd8, 0xf9,
-	0x80, 0xec, 0xb4, 0xe3, 0x51, 0xb2, 0x43, 0xa3, 0xe4, 0x92, 0x51, 0xde, 0x83, 0xf2, 0xdf, 0x1a,
-	0xff, 0x35, 0x85, 0x0f, 0xa0, 0x52, 0x20, 0xbb, 0x1d, 0xc1, 0xa3, 0x00, 0x93, 0xa0, 0xc0, 0x4e,
-	0x68, 0xc4, 0x6d, 0xab, 0x07, 0xb8, 0x0d, 0xc0, 0xdd, 0xd9, 0xcb, 0x10, 0xe3, 0x1b, 0x8b, 0x96,
-	0x62, 0xb7, 0xc2, 0xa2, 0x58, 0xaa, 0x84, 0xba, 0x03, 0xd6, 0x1e, 0x76, 0x88, 0x66, 0xb4, 0x63,
-	0x93, 0xe6, 0x85, 0x01, 0x26, 0x12, 0xa2, 0x3a, 0xd1, 0x1a, 0xc8, 0xd3, 0x8e, 0xe0, 0x65, 0xa3,
-	0x96, 0x5b, 0x1e, 0xdf, 0x28, 0x45, 0xcc, 0x61, 0x95, 0x22, 0xca, 0xdd, 0xed, 0x7d, 0x5b, 0x82,
-	0xe0, 0xab, 0x14, 0x33, 0x4b, 0xf7, 0x9a, 0x51, 0x4a, 0x09, 0x37, 0x0b, 0xe0, 0xe9, 0x9d, 0x99,
-	0x68, 0x03, 0x1e, 0x83, 0x6c, 0xff, 0xf8, 0xb2, 0x6e, 0xdb, 0x7c, 0x19, 0xdf, 0xa6, 0xbe, 0xe1,
-	0x15, 0x90, 0xa3, 0x1d, 0x21, 0x61, 0x23, 0xfc, 0x86, 0x18, 0x73, 0x52, 0x13, 0xec, 0x61, 0x1f,
-	0xf7, 0xa2, 0x7d, 0x36, 0x5f, 0xeb, 0x8d, 0x88, 0xde, 0x6a, 0xde, 0x4d, 0x50, 0xf4, 0xe4, 0x1b,
-	0x4d, 0x3d, 0x65, 0x25, 0x2e, 0xb4, 0xa5, 0xe0, 0x8d, 0x7c, 0x78, 0xe4, 0xb6, 0x86, 0x6e, 0x9c,
-	0x17, 0x40, 0x41, 0x92, 0xc1, 0x6f, 0x06, 0x78, 0x32, 0x58, 0x7e, 0xb8, 0x36, 0xc0, 0x31, 0xea,
-	0xda, 0x55, 0x5e, 0xfc, 0x1b, 0x58, 0xd9, 0x35, 0x57, 0xce, 0xbf, 0xff, 0xfe, 0x9a, 0x5d, 0x80,
-	0xf3, 0x28, 0x79, 0xd5, 0x45, 0x38, 0xd0, 0x0c, 0xe4, 0x44, 0x53, 0xd6, 0x0d, 0x5e, 0x18, 0x60,
-	0x3c, 0xee, 0x6a, 0x31, 0x4d, 0x28, 0xc5, 0xd0, 0xd2, 0xbd, 0x38, 0xed, 0x65, 0x4d, 0x7a, 0x79,
-	0x0e, 0x17, 0x06, 0xbc, 0xc4, 0x5d, 0xa0, 0x33, 0x79, 0x3b, 0x3f, 0x43, 0x0a, 0x8a, 0xaa, 0x82,
-	0x70, 0x3e, 0x95, 0x3f, 0x7e, 0x27, 0x2a, 0xe6, 0x28, 0x88, 0x56, 0x9f, 0x95, 0xea, 0x25, 0x38,
-	0x35, 0xa8, 0x4e, 0x64, 0x67, 0x3d, 0x50, 0x90, 0x03, 0xb0, 0x36, 0x94, 0x2b, 0x52, 0x9b, 0x1f,
-	0x81, 0xd0, 0x62, 0xa6, 0x14, 0x9b, 0x81, 0x95, 0x54, 0x31, 0x74, 0xa6, 0x13, 0xaa, 0xb2, 0xa4,
-	0x27, 0x4c, 0xb4, 0x31, 0x3d, 0x61, 0xb2, 0x9a, 0x43, 0x13, 0xaa, 0x12, 0x36, 0xb6, 0x2e, 0x6f,
-	0xaa, 0xc6, 0xd5, 0x4d, 0xd5, 0xf8, 0x75, 0x53, 0x35, 0xbe, 0xdc, 0x56, 0x33, 0x57, 0xb7, 0xd5,
-	0xcc, 0x8f, 0xdb, 0x6a, 0xe6, 0xdd, 0x6a, 0xec, 0xbb, 0xa4, 0x47, 0xd7, 0x3f, 0x31, 0x4a, 0xfa,
-	0x3c, 0x1f, 0x43, 0x26, 0xf9, 0x7d, 0x6a, 0x15, 0xe5, 0x8f, 0xc3, 0xe6, 0x9f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x2d, 0xe3, 0xc7, 0x25, 0xe3, 0x06, 0x00, 0x00,
+// QueryUserCheckpointsResponse is the response type for the
+// Query/UserCheckpoints RPC method
+type QueryUserCheckpointsResponse struct {
+	Checkpoints []EpochCheckpoint   `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// TotalVotingPower queries the total voting power.
-	TotalVotingPower(ctx context.Context, in *QueryTotalVotingPowerRequest, opts ...grpc.CallOption) (*QueryTotalVotingPowerResponse, error)
-	// VotingPower queries the voting power of a veNFT.
-	VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error)
-	// VeNfts queries all veNFTs of a given owner.
-	VeNfts(ctx context.Context, in *QueryVeNftsRequest, opts ...grpc.CallOption) (*QueryVeNftsResponse, error)
-	// VeNft queries an veNFT based on its id.
-	VeNft(ctx context.Context, in *QueryVeNftRequest, opts ...grpc.CallOption) (*QueryVeNftResponse, error)
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryUserCheckpointsResponse) Reset()         { *m = QueryUserCheckpointsResponse{} }
+func (m *QueryUserCheckpointsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUserCheckpointsResponse) ProtoMessage()    {}
+func (*QueryUserCheckpointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{11}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryUserCheckpointsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryUserCheckpointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserCheckpointsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUserCheckpointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserCheckpointsResponse.Merge(m, src)
+}
+func (m *QueryUserCheckpointsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUserCheckpointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserCheckpointsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) TotalVotingPower(ctx context.Context, in *QueryTotalVotingPowerRequest, opts ...grpc.CallOption) (*QueryTotalVotingPowerResponse, error) {
-	out := new(QueryTotalVotingPowerResponse)
-	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Query/TotalVotingPower", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUserCheckpointsResponse proto.InternalMessageInfo
+
+func (m *QueryUserCheckpointsResponse) GetCheckpoints() []EpochCheckpoint {
+	if m != nil {
+		return m.Checkpoints
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error) {
-	out := new(QueryVotingPowerResponse)
-	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Query/VotingPower", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryUserCheckpointsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) VeNfts(ctx context.Context, in *QueryVeNftsRequest, opts ...grpc.CallOption) (*QueryVeNftsResponse, error) {
-	out := new(QueryVeNftsResponse)
-	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Query/VeNfts", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// SlopeChange is the slope change scheduled at a unix time.
+type SlopeChange struct {
+	Timestamp   uint64                                 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	SlopeChange github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=slope_change,json=slopeChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"slope_change"`
 }
 
-func (c *queryClient) VeNft(ctx context.Context, in *QueryVeNftRequest, opts ...grpc.CallOption) (*QueryVeNftResponse, error) {
-	out := new(QueryVeNftResponse)
-	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Query/VeNft", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SlopeChange) Reset()         { *m = SlopeChange{} }
+func (m *SlopeChange) String() string { return proto.CompactTextString(m) }
+func (*SlopeChange) ProtoMessage()    {}
+func (*SlopeChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{12}
+}
+func (m *SlopeChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlopeChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlopeChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *SlopeChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlopeChange.Merge(m, src)
+}
+func (m *SlopeChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlopeChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlopeChange.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SlopeChange proto.InternalMessageInfo
+
+func (m *SlopeChange) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// TotalVotingPower queries the total voting power.
-	TotalVotingPower(context.Context, *QueryTotalVotingPowerRequest) (*QueryTotalVotingPowerResponse, error)
-	// VotingPower queries the voting power of a veNFT.
-	VotingPower(context.Context, *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error)
-	// VeNfts queries all veNFTs of a given owner.
-	VeNfts(context.Context, *QueryVeNftsRequest) (*QueryVeNftsResponse, error)
-	// VeNft queries an veNFT based on its id.
-	VeNft(context.Context, *QueryVeNftRequest) (*QueryVeNftResponse, error)
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+// QuerySlopeChangesRequest is the request type for the Query/SlopeChanges RPC
+// method
+type QuerySlopeChangesRequest struct {
+	// unix time range, both inclusive; zero to_time means no upper bound
+	FromTime   uint64             `protobuf:"varint,1,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime     uint64             `protobuf:"varint,2,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QuerySlopeChangesRequest) Reset()         { *m = QuerySlopeChangesRequest{} }
+func (m *QuerySlopeChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlopeChangesRequest) ProtoMessage()    {}
+func (*QuerySlopeChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{13}
 }
-
-func (*UnimplementedQueryServer) TotalVotingPower(ctx context.Context, req *QueryTotalVotingPowerRequest) (*QueryTotalVotingPowerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalVotingPower not implemented")
+func (m *QuerySlopeChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) VotingPower(ctx context.Context, req *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VotingPower not implemented")
+func (m *QuerySlopeChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlopeChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) VeNfts(ctx context.Context, req *QueryVeNftsRequest) (*QueryVeNftsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VeNfts not implemented")
+func (m *QuerySlopeChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlopeChangesRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) VeNft(ctx context.Context, req *QueryVeNftRequest) (*QueryVeNftResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VeNft not implemented")
+func (m *QuerySlopeChangesRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QuerySlopeChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlopeChangesRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QuerySlopeChangesRequest proto.InternalMessageInfo
 
-func _Query_TotalVotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalVotingPowerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalVotingPower(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.ve.v1.Query/TotalVotingPower",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalVotingPower(ctx, req.(*QueryTotalVotingPowerRequest))
+func (m *QuerySlopeChangesRequest) GetFromTime() uint64 {
+	if m != nil {
+		return m.FromTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_VotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVotingPowerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VotingPower(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.ve.v1.Query/VotingPower",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VotingPower(ctx, req.(*QueryVotingPowerRequest))
+func (m *QuerySlopeChangesRequest) GetToTime() uint64 {
+	if m != nil {
+		return m.ToTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_VeNfts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVeNftsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VeNfts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.ve.v1.Query/VeNfts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VeNfts(ctx, req.(*QueryVeNftsRequest))
+func (m *QuerySlopeChangesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_VeNft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVeNftRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VeNft(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.ve.v1.Query/VeNft",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VeNft(ctx, req.(*QueryVeNftRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QuerySlopeChangesResponse is the response type for the Query/SlopeChanges
+// RPC method
+type QuerySlopeChangesResponse struct {
+	SlopeChanges []SlopeChange       `protobuf:"bytes,1,rep,name=slope_changes,json=slopeChanges,proto3" json:"slope_changes"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.ve.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QuerySlopeChangesResponse) Reset()         { *m = QuerySlopeChangesResponse{} }
+func (m *QuerySlopeChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlopeChangesResponse) ProtoMessage()    {}
+func (*QuerySlopeChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{14}
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "warmage.ve.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "TotalVotingPower",
-			Handler:    _Query_TotalVotingPower_Handler,
-		},
-		{
-			MethodName: "VotingPower",
-			Handler:    _Query_VotingPower_Handler,
-		},
-		{
-			MethodName: "VeNfts",
-			Handler:    _Query_VeNfts_Handler,
-		},
-		{
-			MethodName: "VeNft",
-			Handler:    _Query_VeNft_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "warmage/ve/v1/query.proto",
+func (m *QuerySlopeChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryTotalVotingPowerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySlopeChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlopeChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryTotalVotingPowerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySlopeChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlopeChangesResponse.Merge(m, src)
+}
+func (m *QuerySlopeChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlopeChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlopeChangesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTotalVotingPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AtBlock != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AtBlock))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.AtTime != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AtTime))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QuerySlopeChangesResponse proto.InternalMessageInfo
+
+func (m *QuerySlopeChangesResponse) GetSlopeChanges() []SlopeChange {
+	if m != nil {
+		return m.SlopeChanges
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryTotalVotingPowerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySlopeChangesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTotalVotingPowerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVeNftsRequest is the request type for the Query/VeNfts RPC method
+type QueryVeNftsRequest struct {
+	Owner      string             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTotalVotingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Power.Size()
-		i -= size
-		if _, err := m.Power.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *QueryVeNftsRequest) Reset()         { *m = QueryVeNftsRequest{} }
+func (m *QueryVeNftsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVeNftsRequest) ProtoMessage()    {}
+func (*QueryVeNftsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{15}
 }
-
-func (m *QueryVotingPowerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVeNftsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeNftsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeNftsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryVotingPowerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVeNftsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeNftsRequest.Merge(m, src)
+}
+func (m *QueryVeNftsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeNftsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeNftsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryVotingPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AtBlock != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AtBlock))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.AtTime != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AtTime))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.VeId) > 0 {
-		i -= len(m.VeId)
-		copy(dAtA[i:], m.VeId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VeId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryVeNftsRequest proto.InternalMessageInfo
+
+func (m *QueryVeNftsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryVotingPowerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVeNftsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryVotingPowerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVeNftsResponse is the response type for the Query/VeNfts RPC methods
+type QueryVeNftsResponse struct {
+	Nfts       []*nft.NFT          `protobuf:"bytes,1,rep,name=nfts,proto3" json:"nfts,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryVotingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Power.Size()
-		i -= size
-		if _, err := m.Power.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryVeNftsResponse) Reset()         { *m = QueryVeNftsResponse{} }
+func (m *QueryVeNftsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVeNftsResponse) ProtoMessage()    {}
+func (*QueryVeNftsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{16}
+}
+func (m *QueryVeNftsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeNftsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeNftsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryVeNftsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryVeNftsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeNftsResponse.Merge(m, src)
 }
-
-func (m *QueryVeNftsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVeNftsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeNftsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeNftsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryVeNftsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryVeNftsResponse proto.InternalMessageInfo
+
+func (m *QueryVeNftsResponse) GetNfts() []*nft.NFT {
+	if m != nil {
+		return m.Nfts
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryVeNftsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVeNftsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryVeNftsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVeNftRequest is the request type for the Query/VeNft RPC method
+type QueryVeNftRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryVeNftsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Nfts) > 0 {
-		for iNdEx := len(m.Nfts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nfts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryVeNftRequest) Reset()         { *m = QueryVeNftRequest{} }
+func (m *QueryVeNftRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVeNftRequest) ProtoMessage()    {}
+func (*QueryVeNftRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{17}
+}
+func (m *QueryVeNftRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeNftRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeNftRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVeNftRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeNftRequest.Merge(m, src)
+}
+func (m *QueryVeNftRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeNftRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeNftRequest.DiscardUnknown(m)
 }
 
-func (m *QueryVeNftRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVeNftRequest proto.InternalMessageInfo
+
+func (m *QueryVeNftRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryVeNftRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryVeNftRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryVeNftResponse is the response type for the Query/VeNft RPC method
+type QueryVeNftResponse struct {
+	Nft *nft.NFT `protobuf:"bytes,1,opt,name=nft,proto3" json:"nft,omitempty"`
 }
 
-func (m *QueryVeNftResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryVeNftResponse) Reset()         { *m = QueryVeNftResponse{} }
+func (m *QueryVeNftResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVeNftResponse) ProtoMessage()    {}
+func (*QueryVeNftResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{18}
 }
-
-func (m *QueryVeNftResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVeNftResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryVeNftResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nft != nil {
-		{
-			size, err := m.Nft.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryVeNftResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeNftResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryVeNftResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeNftResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVeNftResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryVeNftResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeNftResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVeNftResponse proto.InternalMessageInfo
+
+func (m *QueryVeNftResponse) GetNft() *nft.NFT {
+	if m != nil {
+		return m.Nft
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// VeInfo is the detail of a veNFT.
+type VeInfo struct {
+	VeId  string `protobuf:"bytes,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// locked amount and unlock time
+	Locked LockedBalance `protobuf:"bytes,3,opt,name=locked,proto3" json:"locked"`
+	// voting power at the current block
+	VotingPower github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=voting_power,json=votingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"voting_power"`
+	// voting power at the requested time or block, zero if neither requested
+	HistoricalVotingPower github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=historical_voting_power,json=historicalVotingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"historical_voting_power"`
+	// epoch of the latest user checkpoint
+	UserEpoch uint64 `protobuf:"varint,6,opt,name=user_epoch,json=userEpoch,proto3" json:"user_epoch,omitempty"`
+	// number of gauges attached to
+	Attached uint64 `protobuf:"varint,7,opt,name=attached,proto3" json:"attached,omitempty"`
+	// whether voted for gauges
+	Voted bool `protobuf:"varint,8,opt,name=voted,proto3" json:"voted,omitempty"`
+	// locked amount delegated for staking
+	DelegatedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=delegated_amount,json=delegatedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegated_amount"`
+	// pending amount claimable from the distribution pool
+	Claimable github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=claimable,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"claimable"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *VeInfo) Reset()         { *m = VeInfo{} }
+func (m *VeInfo) String() string { return proto.CompactTextString(m) }
+func (*VeInfo) ProtoMessage()    {}
+func (*VeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{19}
+}
+func (m *VeInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VeInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *VeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VeInfo.Merge(m, src)
 }
-func (m *QueryTotalVotingPowerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AtTime != 0 {
-		n += 1 + sovQuery(uint64(m.AtTime))
-	}
-	if m.AtBlock != 0 {
-		n += 1 + sovQuery(uint64(m.AtBlock))
-	}
-	return n
+func (m *VeInfo) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryTotalVotingPowerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Power.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *VeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_VeInfo.DiscardUnknown(m)
 }
 
-func (m *QueryVotingPowerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.AtTime != 0 {
-		n += 1 + sovQuery(uint64(m.AtTime))
-	}
-	if m.AtBlock != 0 {
-		n += 1 + sovQuery(uint64(m.AtBlock))
+var xxx_messageInfo_VeInfo proto.InternalMessageInfo
+
+func (m *VeInfo) GetVeId() string {
+	if m != nil {
+		return m.VeId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryVotingPowerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VeInfo) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	var l int
-	_ = l
-	l = m.Power.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryVeNftsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *VeInfo) GetLocked() LockedBalance {
+	if m != nil {
+		return m.Locked
 	}
-	return n
+	return LockedBalance{}
 }
 
-func (m *QueryVeNftsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Nfts) > 0 {
-		for _, e := range m.Nfts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *VeInfo) GetUserEpoch() uint64 {
+	if m != nil {
+		return m.UserEpoch
 	}
-	return n
+	return 0
 }
 
-func (m *QueryVeNftRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *VeInfo) GetAttached() uint64 {
+	if m != nil {
+		return m.Attached
 	}
-	return n
+	return 0
 }
 
-func (m *QueryVeNftResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Nft != nil {
-		l = m.Nft.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *VeInfo) GetVoted() bool {
+	if m != nil {
+		return m.Voted
 	}
-	return n
+	return false
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// QueryVeInfoRequest is the request type for the Query/VeInfo RPC method
+type QueryVeInfoRequest struct {
+	VeId string `protobuf:"bytes,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	// optional time or block for the historical voting power
+	AtTime  uint64 `protobuf:"varint,2,opt,name=at_time,json=atTime,proto3" json:"at_time,omitempty"`
+	AtBlock int64  `protobuf:"varint,3,opt,name=at_block,json=atBlock,proto3" json:"at_block,omitempty"`
+}
+
+func (m *QueryVeInfoRequest) Reset()         { *m = QueryVeInfoRequest{} }
+func (m *QueryVeInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVeInfoRequest) ProtoMessage()    {}
+func (*QueryVeInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{20}
+}
+func (m *QueryVeInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeInfoRequest.Merge(m, src)
+}
+func (m *QueryVeInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeInfoRequest proto.InternalMessageInfo
+
+func (m *QueryVeInfoRequest) GetVeId() string {
+	if m != nil {
+		return m.VeId
+	}
+	return ""
+}
+
+func (m *QueryVeInfoRequest) GetAtTime() uint64 {
+	if m != nil {
+		return m.AtTime
+	}
+	return 0
+}
+
+func (m *QueryVeInfoRequest) GetAtBlock() int64 {
+	if m != nil {
+		return m.AtBlock
+	}
+	return 0
+}
+
+// QueryVeInfoResponse is the response type for the Query/VeInfo RPC method
+type QueryVeInfoResponse struct {
+	Info VeInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info"`
+}
+
+func (m *QueryVeInfoResponse) Reset()         { *m = QueryVeInfoResponse{} }
+func (m *QueryVeInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVeInfoResponse) ProtoMessage()    {}
+func (*QueryVeInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{21}
+}
+func (m *QueryVeInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeInfoResponse.Merge(m, src)
+}
+func (m *QueryVeInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeInfoResponse proto.InternalMessageInfo
+
+func (m *QueryVeInfoResponse) GetInfo() VeInfo {
+	if m != nil {
+		return m.Info
+	}
+	return VeInfo{}
+}
+
+// QueryVeInfosRequest is the request type for the Query/VeInfos RPC method
+type QueryVeInfosRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// optional time or block for the historical voting power
+	AtTime     uint64             `protobuf:"varint,2,opt,name=at_time,json=atTime,proto3" json:"at_time,omitempty"`
+	AtBlock    int64              `protobuf:"varint,3,opt,name=at_block,json=atBlock,proto3" json:"at_block,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVeInfosRequest) Reset()         { *m = QueryVeInfosRequest{} }
+func (m *QueryVeInfosRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVeInfosRequest) ProtoMessage()    {}
+func (*QueryVeInfosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{22}
+}
+func (m *QueryVeInfosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeInfosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeInfosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeInfosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeInfosRequest.Merge(m, src)
+}
+func (m *QueryVeInfosRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeInfosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeInfosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeInfosRequest proto.InternalMessageInfo
+
+func (m *QueryVeInfosRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryVeInfosRequest) GetAtTime() uint64 {
+	if m != nil {
+		return m.AtTime
+	}
+	return 0
+}
+
+func (m *QueryVeInfosRequest) GetAtBlock() int64 {
+	if m != nil {
+		return m.AtBlock
+	}
+	return 0
+}
+
+func (m *QueryVeInfosRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVeInfosResponse is the response type for the Query/VeInfos RPC method
+type QueryVeInfosResponse struct {
+	Infos      []VeInfo            `protobuf:"bytes,1,rep,name=infos,proto3" json:"infos"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVeInfosResponse) Reset()         { *m = QueryVeInfosResponse{} }
+func (m *QueryVeInfosResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVeInfosResponse) ProtoMessage()    {}
+func (*QueryVeInfosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{23}
+}
+func (m *QueryVeInfosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeInfosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeInfosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeInfosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeInfosResponse.Merge(m, src)
+}
+func (m *QueryVeInfosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeInfosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeInfosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeInfosResponse proto.InternalMessageInfo
+
+func (m *QueryVeInfosResponse) GetInfos() []VeInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+func (m *QueryVeInfosResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelegatedVesRequest is the request type for the Query/DelegatedVes RPC
+// method
+type QueryDelegatedVesRequest struct {
+	Delegatee  string             `protobuf:"bytes,1,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatedVesRequest) Reset()         { *m = QueryDelegatedVesRequest{} }
+func (m *QueryDelegatedVesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatedVesRequest) ProtoMessage()    {}
+func (*QueryDelegatedVesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{24}
+}
+func (m *QueryDelegatedVesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatedVesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatedVesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatedVesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatedVesRequest.Merge(m, src)
+}
+func (m *QueryDelegatedVesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatedVesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatedVesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatedVesRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatedVesRequest) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
+	}
+	return ""
+}
+
+func (m *QueryDelegatedVesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelegatedVesResponse is the response type for the Query/DelegatedVes
+// RPC method
+type QueryDelegatedVesResponse struct {
+	VeIds      []string            `protobuf:"bytes,1,rep,name=ve_ids,json=veIds,proto3" json:"ve_ids,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatedVesResponse) Reset()         { *m = QueryDelegatedVesResponse{} }
+func (m *QueryDelegatedVesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatedVesResponse) ProtoMessage()    {}
+func (*QueryDelegatedVesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{25}
+}
+func (m *QueryDelegatedVesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatedVesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatedVesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatedVesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatedVesResponse.Merge(m, src)
+}
+func (m *QueryDelegatedVesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatedVesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatedVesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatedVesResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatedVesResponse) GetVeIds() []string {
+	if m != nil {
+		return m.VeIds
+	}
+	return nil
+}
+
+func (m *QueryDelegatedVesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{26}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b1733d0097e0a15, []int{27}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func init() {
+	proto.RegisterType((*QueryTotalVotingPowerRequest)(nil), "warmage.ve.v1.QueryTotalVotingPowerRequest")
+	proto.RegisterType((*QueryTotalVotingPowerResponse)(nil), "warmage.ve.v1.QueryTotalVotingPowerResponse")
+	proto.RegisterType((*QueryVotingPowerRequest)(nil), "warmage.ve.v1.QueryVotingPowerRequest")
+	proto.RegisterType((*QueryVotingPowerResponse)(nil), "warmage.ve.v1.QueryVotingPowerResponse")
+	proto.RegisterType((*QueryTotalVotingPowerSeriesRequest)(nil), "warmage.ve.v1.QueryTotalVotingPowerSeriesRequest")
+	proto.RegisterType((*TimedVotingPower)(nil), "warmage.ve.v1.TimedVotingPower")
+	proto.RegisterType((*QueryTotalVotingPowerSeriesResponse)(nil), "warmage.ve.v1.QueryTotalVotingPowerSeriesResponse")
+	proto.RegisterType((*EpochCheckpoint)(nil), "warmage.ve.v1.EpochCheckpoint")
+	proto.RegisterType((*QueryCheckpointsRequest)(nil), "warmage.ve.v1.QueryCheckpointsRequest")
+	proto.RegisterType((*QueryCheckpointsResponse)(nil), "warmage.ve.v1.QueryCheckpointsResponse")
+	proto.RegisterType((*QueryUserCheckpointsRequest)(nil), "warmage.ve.v1.QueryUserCheckpointsRequest")
+	proto.RegisterType((*QueryUserCheckpointsResponse)(nil), "warmage.ve.v1.QueryUserCheckpointsResponse")
+	proto.RegisterType((*SlopeChange)(nil), "warmage.ve.v1.SlopeChange")
+	proto.RegisterType((*QuerySlopeChangesRequest)(nil), "warmage.ve.v1.QuerySlopeChangesRequest")
+	proto.RegisterType((*QuerySlopeChangesResponse)(nil), "warmage.ve.v1.QuerySlopeChangesResponse")
+	proto.RegisterType((*QueryVeNftsRequest)(nil), "warmage.ve.v1.QueryVeNftsRequest")
+	proto.RegisterType((*QueryVeNftsResponse)(nil), "warmage.ve.v1.QueryVeNftsResponse")
+	proto.RegisterType((*QueryVeNftRequest)(nil), "warmage.ve.v1.QueryVeNftRequest")
+	proto.RegisterType((*QueryVeNftResponse)(nil), "warmage.ve.v1.QueryVeNftResponse")
+	proto.RegisterType((*VeInfo)(nil), "warmage.ve.v1.VeInfo")
+	proto.RegisterType((*QueryVeInfoRequest)(nil), "warmage.ve.v1.QueryVeInfoRequest")
+	proto.RegisterType((*QueryVeInfoResponse)(nil), "warmage.ve.v1.QueryVeInfoResponse")
+	proto.RegisterType((*QueryVeInfosRequest)(nil), "warmage.ve.v1.QueryVeInfosRequest")
+	proto.RegisterType((*QueryVeInfosResponse)(nil), "warmage.ve.v1.QueryVeInfosResponse")
+	proto.RegisterType((*QueryDelegatedVesRequest)(nil), "warmage.ve.v1.QueryDelegatedVesRequest")
+	proto.RegisterType((*QueryDelegatedVesResponse)(nil), "warmage.ve.v1.QueryDelegatedVesResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "warmage.ve.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "warmage.ve.v1.QueryParamsResponse")
+}
+
+func init() { proto.RegisterFile("warmage/ve/v1/query.proto", fileDescriptor_7b1733d0097e0a15) }
+
+var fileDescriptor_7b1733d0097e0a15 = []byte{
+	// 1483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0x13, 0x47,
+	0x14, 0xcf, 0x26, 0xb6, 0x13, 0x3f, 0x87, 0x42, 0x27, 0x09, 0x76, 0x16, 0xc7, 0x49, 0x36, 0x94,
+	0x98, 0xa4, 0x78, 0x9b, 0x70, 0xab, 0x54, 0xa1, 0x06, 0x48, 0x45, 0x85, 0x10, 0x18, 0x8a, 0xd4,
+	0x4a, 0x95, 0x3b, 0xb6, 0xc7, 0xf6, 0x0a, 0x7b, 0x67, 0xf1, 0x4e, 0x16, 0x68, 0x44, 0x55, 0x71,
+	0xe9, 0x81, 0x4b, 0x55, 0xa4, 0x0a, 0xa9, 0xd7, 0x4a, 0x3d, 0xf4, 0x50, 0xf5, 0x5b, 0x70, 0x44,
+	0xea, 0xa5, 0xea, 0x01, 0x55, 0xa4, 0x1f, 0xa4, 0xda, 0x99, 0xd9, 0xbf, 0x59, 0xdb, 0xd4, 0xf8,
+	0xd0, 0x53, 0x3c, 0x33, 0x6f, 0xde, 0xfb, 0xbd, 0xf7, 0x7e, 0xf3, 0xde, 0xdb, 0xc0, 0xf2, 0x03,
+	0xdc, 0xef, 0xe1, 0x36, 0xd1, 0x1d, 0xa2, 0x3b, 0x3b, 0xfa, 0xfd, 0x03, 0xd2, 0x7f, 0x54, 0xb1,
+	0xfa, 0x94, 0x51, 0x74, 0x42, 0x1e, 0x55, 0x1c, 0x52, 0x71, 0x76, 0xd4, 0xc5, 0x36, 0x6d, 0x53,
+	0x7e, 0xa2, 0xbb, 0xbf, 0x84, 0x90, 0x5a, 0x6c, 0x53, 0xda, 0xee, 0x12, 0x1d, 0x5b, 0x86, 0x8e,
+	0x4d, 0x93, 0x32, 0xcc, 0x0c, 0x6a, 0xda, 0xf2, 0x74, 0xab, 0x41, 0xed, 0x1e, 0xb5, 0xf5, 0x3a,
+	0xb6, 0x89, 0xd0, 0xad, 0x3b, 0x3b, 0x75, 0xc2, 0xf0, 0x8e, 0x6e, 0xe1, 0xb6, 0x61, 0x72, 0x61,
+	0x4f, 0x93, 0x94, 0x35, 0x5b, 0xcc, 0x17, 0x32, 0x5b, 0x4c, 0x9e, 0x9e, 0x89, 0xe2, 0x6c, 0x13,
+	0x93, 0xd8, 0x86, 0x67, 0xe6, 0x74, 0xf4, 0xd0, 0x21, 0x62, 0x5f, 0xab, 0x42, 0xf1, 0x96, 0x6b,
+	0xf4, 0x0e, 0x65, 0xb8, 0x7b, 0x97, 0x32, 0xc3, 0x6c, 0xdf, 0xa4, 0x0f, 0x48, 0xbf, 0x4a, 0xee,
+	0x1f, 0x10, 0x9b, 0xa1, 0x3c, 0xcc, 0x62, 0x56, 0x63, 0x46, 0x8f, 0x14, 0x94, 0x35, 0xa5, 0x9c,
+	0xaa, 0x66, 0x30, 0xbb, 0x63, 0xf4, 0x08, 0x5a, 0x86, 0x39, 0xcc, 0x6a, 0xf5, 0x2e, 0x6d, 0xdc,
+	0x2b, 0x4c, 0xaf, 0x29, 0xe5, 0x99, 0xea, 0x2c, 0x66, 0x7b, 0xee, 0x52, 0x23, 0xb0, 0x32, 0x40,
+	0xa7, 0x6d, 0x51, 0xd3, 0x26, 0xe8, 0x0a, 0xa4, 0x2d, 0x77, 0x83, 0xab, 0xcc, 0xee, 0x55, 0x5e,
+	0xbc, 0x5a, 0x9d, 0xfa, 0xeb, 0xd5, 0xea, 0xb9, 0xb6, 0xc1, 0x3a, 0x07, 0xf5, 0x4a, 0x83, 0xf6,
+	0x74, 0xe9, 0xa9, 0xf8, 0x73, 0xc1, 0x6e, 0xde, 0xd3, 0xd9, 0x23, 0x8b, 0xd8, 0x95, 0x6b, 0x26,
+	0xab, 0x8a, 0xcb, 0x5a, 0x1d, 0xf2, 0xdc, 0x4c, 0x02, 0xea, 0x05, 0x48, 0x3b, 0xa4, 0x66, 0x34,
+	0x85, 0x81, 0x6a, 0xca, 0x21, 0xd7, 0x9a, 0x61, 0x57, 0xa6, 0x07, 0xba, 0x32, 0x13, 0x75, 0xe5,
+	0x2b, 0x28, 0x1c, 0xb7, 0x31, 0x51, 0x2f, 0x4c, 0xd0, 0x12, 0x83, 0x75, 0x9b, 0xf4, 0x0d, 0x62,
+	0x7b, 0x0e, 0x9d, 0x81, 0x6c, 0xab, 0x4f, 0x7b, 0xe1, 0x44, 0xcc, 0xb9, 0x1b, 0x1c, 0x7f, 0x1e,
+	0x66, 0x19, 0x8d, 0x38, 0xc6, 0x28, 0x3f, 0x40, 0x90, 0xb2, 0x19, 0xb1, 0xb8, 0x53, 0xa9, 0x2a,
+	0xff, 0xad, 0x39, 0x70, 0xca, 0x3d, 0x6b, 0x86, 0x4c, 0xa1, 0x22, 0x64, 0xdd, 0xdb, 0x36, 0xc3,
+	0x3d, 0x4b, 0x6a, 0x0f, 0x36, 0x02, 0x3f, 0xa7, 0xdf, 0xc6, 0xcf, 0x26, 0x6c, 0x0c, 0xf5, 0x53,
+	0x06, 0xf5, 0x23, 0xc8, 0x70, 0x79, 0xbb, 0xa0, 0xac, 0xcd, 0x94, 0x73, 0xbb, 0xab, 0x95, 0xc8,
+	0x13, 0xab, 0xc4, 0xb1, 0xef, 0xa5, 0x5c, 0x38, 0x55, 0x79, 0x49, 0xeb, 0xc0, 0xc9, 0xab, 0x16,
+	0x6d, 0x74, 0x2e, 0x77, 0x48, 0xe3, 0x9e, 0x45, 0x0d, 0x93, 0xa1, 0x45, 0x48, 0x13, 0x77, 0x4b,
+	0x3a, 0x26, 0x16, 0xe8, 0x12, 0x40, 0xc3, 0x97, 0xe1, 0x9e, 0xe5, 0x76, 0x97, 0x63, 0xb6, 0x02,
+	0x25, 0xd2, 0x4a, 0xe8, 0x8a, 0xf6, 0x93, 0x22, 0xe9, 0x17, 0x48, 0xf9, 0xd9, 0x5a, 0x01, 0xe0,
+	0xd9, 0x0a, 0xdb, 0xe5, 0xf9, 0xe3, 0xd8, 0x5c, 0xbe, 0x31, 0x2a, 0x0f, 0x45, 0xc2, 0x66, 0x19,
+	0x15, 0x47, 0xfb, 0x00, 0xc1, 0xab, 0xe7, 0x79, 0xcb, 0xed, 0x9e, 0xab, 0x88, 0xb8, 0x56, 0xdc,
+	0x12, 0x51, 0x11, 0xe5, 0x47, 0xbe, 0xfe, 0xca, 0x4d, 0xdc, 0x26, 0xd2, 0x6a, 0x35, 0x74, 0x53,
+	0xfb, 0x55, 0x81, 0xc2, 0x71, 0x74, 0x32, 0xc6, 0xfb, 0x90, 0x0b, 0x1c, 0xf1, 0x02, 0x5d, 0x8a,
+	0x39, 0x1f, 0x0b, 0xa3, 0x8c, 0x40, 0xf8, 0x22, 0xfa, 0x24, 0x02, 0x56, 0xc4, 0x70, 0x73, 0x24,
+	0x58, 0x01, 0x22, 0x82, 0xf6, 0x6b, 0x38, 0xc3, 0xc1, 0x7e, 0x66, 0x93, 0x7e, 0x42, 0x38, 0x13,
+	0x5f, 0xf3, 0x7e, 0x82, 0xf1, 0x71, 0x22, 0xf5, 0x9b, 0x02, 0xc5, 0x64, 0xe3, 0xff, 0xd7, 0x68,
+	0x7d, 0x03, 0xb9, 0xdb, 0x5d, 0x6a, 0x91, 0xcb, 0x1d, 0x6c, 0xb6, 0xc9, 0x88, 0xc7, 0x7b, 0x0b,
+	0xe6, 0x6d, 0x57, 0xb8, 0xd6, 0xe0, 0xd2, 0x63, 0xbe, 0xe1, 0x9c, 0x1d, 0x18, 0xd4, 0x9e, 0x7b,
+	0xdc, 0x0a, 0xa1, 0x78, 0xcb, 0x42, 0x35, 0x41, 0xda, 0x2f, 0x27, 0x40, 0x93, 0x99, 0xbc, 0x0a,
+	0x27, 0xc2, 0xb1, 0xf0, 0x72, 0xa9, 0xc6, 0x72, 0x19, 0xba, 0x2b, 0xf3, 0x38, 0x1f, 0x72, 0x7f,
+	0x82, 0x89, 0xec, 0x03, 0x12, 0xcd, 0x85, 0xdc, 0x68, 0x05, 0x6c, 0x5f, 0x84, 0x34, 0x7d, 0x60,
+	0x7a, 0x6d, 0xa5, 0x2a, 0x16, 0x13, 0xa3, 0xfb, 0x53, 0x05, 0x16, 0x22, 0x46, 0x65, 0x6c, 0xb6,
+	0x21, 0x65, 0xb6, 0x7c, 0x7a, 0xe7, 0x3d, 0xcd, 0xee, 0x74, 0xe1, 0xa9, 0xbc, 0xb1, 0x7f, 0xa7,
+	0xca, 0x85, 0x26, 0x17, 0x81, 0x0d, 0x78, 0x37, 0x00, 0xe3, 0x05, 0xe0, 0x1d, 0x98, 0xf6, 0xdf,
+	0xfa, 0xb4, 0xd1, 0xd4, 0x2e, 0x85, 0xc3, 0xe4, 0x03, 0x3e, 0x0f, 0x33, 0x66, 0x8b, 0x71, 0xb1,
+	0x21, 0x78, 0x5d, 0x19, 0xed, 0xe7, 0x14, 0x64, 0xee, 0x92, 0x6b, 0x66, 0x8b, 0x26, 0x97, 0x12,
+	0x3f, 0xe2, 0xd3, 0xe1, 0x88, 0x7f, 0x08, 0x19, 0x77, 0x04, 0x20, 0x4d, 0xc9, 0xc7, 0x62, 0x8c,
+	0x26, 0xd7, 0xf9, 0xe1, 0x1e, 0xee, 0x62, 0xb3, 0xe1, 0x11, 0x45, 0xde, 0x70, 0x5f, 0x9d, 0xc3,
+	0x7b, 0x54, 0x4d, 0x74, 0xce, 0xd4, 0x78, 0xaf, 0xce, 0x09, 0xf5, 0xe8, 0x16, 0xe4, 0x3b, 0x86,
+	0xcd, 0x68, 0xdf, 0x68, 0xe0, 0x6e, 0x2d, 0xa2, 0x3d, 0x3d, 0x96, 0xf6, 0xa5, 0x40, 0x5d, 0x78,
+	0x16, 0x58, 0x01, 0x38, 0xb0, 0x49, 0x5f, 0xb6, 0xa7, 0x8c, 0xa8, 0x27, 0xee, 0x8e, 0x68, 0x50,
+	0xaa, 0x3b, 0x2b, 0x31, 0xdc, 0xe8, 0x90, 0x66, 0x61, 0x56, 0x3c, 0x6f, 0x6f, 0xed, 0xc6, 0xd1,
+	0xa1, 0x8c, 0x34, 0x0b, 0x73, 0x6b, 0x4a, 0x79, 0xae, 0x2a, 0x16, 0xe8, 0x73, 0x38, 0xd5, 0x24,
+	0x5d, 0xd2, 0xc6, 0x8c, 0x34, 0x6b, 0xb8, 0x47, 0x0f, 0x4c, 0x56, 0xc8, 0x8e, 0x85, 0xf8, 0xa4,
+	0xaf, 0xe7, 0x63, 0xae, 0x06, 0x5d, 0x87, 0x6c, 0xa3, 0x8b, 0x8d, 0x1e, 0xae, 0x77, 0x49, 0x01,
+	0xc6, 0xd2, 0x19, 0x28, 0xd0, 0xbe, 0xf4, 0x79, 0xe6, 0x52, 0x65, 0xe2, 0xa3, 0xe4, 0x3e, 0x2c,
+	0x44, 0xd4, 0x4b, 0x1e, 0xeb, 0x90, 0x32, 0xcc, 0x16, 0x95, 0x44, 0x5e, 0x8a, 0x91, 0x4c, 0x08,
+	0x4b, 0x76, 0x71, 0x41, 0xed, 0x17, 0x25, 0xa2, 0x68, 0x44, 0xdd, 0x18, 0x03, 0x69, 0xac, 0xd6,
+	0xa4, 0xc6, 0xae, 0x35, 0x3f, 0x28, 0xb0, 0x18, 0x45, 0x2a, 0x7d, 0xde, 0x81, 0xb4, 0xeb, 0x8a,
+	0x57, 0x6d, 0x86, 0x3a, 0x2d, 0x24, 0x27, 0x57, 0x72, 0xbe, 0xf5, 0xba, 0xd7, 0x15, 0x8f, 0x4c,
+	0x77, 0x83, 0xee, 0x55, 0x84, 0xac, 0xc7, 0x31, 0x22, 0xe3, 0x18, 0x6c, 0x4c, 0xac, 0x06, 0x1f,
+	0xc2, 0x72, 0x02, 0x02, 0x19, 0x9b, 0x25, 0xc8, 0x70, 0xbe, 0x89, 0xe0, 0x64, 0xab, 0x69, 0x97,
+	0x70, 0x13, 0xf4, 0x7f, 0x51, 0xb2, 0xfc, 0x26, 0xee, 0xe3, 0x9e, 0xe7, 0xb8, 0xf6, 0x29, 0x2c,
+	0x44, 0x76, 0x25, 0x98, 0x8b, 0x90, 0xb1, 0xf8, 0xce, 0x00, 0x7a, 0x0a, 0x71, 0x7f, 0x06, 0xe7,
+	0xab, 0xdd, 0xa3, 0x79, 0x48, 0x73, 0x65, 0xe8, 0xb9, 0x02, 0xa7, 0xe2, 0xf3, 0x3e, 0xda, 0x8e,
+	0xe9, 0x18, 0xf6, 0xf9, 0xa9, 0xbe, 0xff, 0x66, 0xc2, 0x02, 0xae, 0x76, 0xfe, 0xc9, 0x1f, 0xff,
+	0x3c, 0x9b, 0xde, 0x40, 0xeb, 0x7a, 0xf4, 0x6b, 0x97, 0x51, 0x16, 0xab, 0x99, 0xe8, 0xa9, 0x02,
+	0xb9, 0x30, 0xaa, 0x73, 0x49, 0x86, 0x12, 0x00, 0x6d, 0x8e, 0x94, 0x93, 0x58, 0xb6, 0x39, 0x96,
+	0xf7, 0xd0, 0x46, 0x0c, 0x4b, 0x18, 0x85, 0x7e, 0xc8, 0x53, 0xfd, 0x18, 0xfd, 0xae, 0xc0, 0xe9,
+	0xe4, 0x0f, 0x23, 0xb4, 0xf3, 0x26, 0x11, 0x88, 0x7c, 0x2c, 0xaa, 0xbb, 0xff, 0xe5, 0x8a, 0x84,
+	0xfb, 0x01, 0x87, 0xbb, 0x85, 0xca, 0x23, 0x43, 0x57, 0xb3, 0x05, 0xb0, 0x27, 0x0a, 0xe4, 0x42,
+	0xf3, 0x72, 0x72, 0x04, 0x8f, 0x4f, 0xf3, 0xea, 0xe6, 0x48, 0x39, 0x09, 0x49, 0xe3, 0x90, 0x8a,
+	0x48, 0x8d, 0x41, 0x0a, 0x0f, 0xd5, 0x3f, 0x2a, 0x70, 0x32, 0x36, 0xb8, 0xa3, 0xad, 0x24, 0x03,
+	0xc9, 0x9f, 0x16, 0xea, 0xf6, 0x1b, 0xc9, 0x4a, 0x40, 0x5b, 0x1c, 0xd0, 0x59, 0xa4, 0x0d, 0x06,
+	0xe4, 0x67, 0xf4, 0x3b, 0x05, 0xe6, 0xc3, 0x43, 0x28, 0x4a, 0x74, 0x3b, 0x61, 0x82, 0x56, 0xcb,
+	0xa3, 0x05, 0x25, 0x9e, 0xb3, 0x1c, 0x4f, 0x09, 0x15, 0x63, 0x78, 0x22, 0x43, 0x2e, 0x32, 0x21,
+	0x23, 0x66, 0x3d, 0xb4, 0x9e, 0xc8, 0xdd, 0xf0, 0xf0, 0xa9, 0x6a, 0xc3, 0x44, 0xa4, 0xd9, 0x15,
+	0x6e, 0x36, 0x8f, 0x96, 0xe2, 0xcc, 0x26, 0x7c, 0x38, 0xb4, 0x20, 0xcd, 0x2f, 0xa0, 0xb5, 0x81,
+	0xba, 0x3c, 0x6b, 0xeb, 0x43, 0x24, 0x46, 0x90, 0x40, 0x18, 0xd3, 0x0f, 0xdd, 0x58, 0x3f, 0xf4,
+	0xc7, 0xbb, 0x01, 0x0a, 0x43, 0xfd, 0x5c, 0xd5, 0x86, 0x89, 0x48, 0xa3, 0x9b, 0xdc, 0xe8, 0x3a,
+	0x5a, 0x3d, 0x66, 0xb4, 0xc6, 0xbb, 0x91, 0x9f, 0x65, 0x1b, 0x66, 0xc5, 0x55, 0x1b, 0x0d, 0xd1,
+	0xeb, 0x47, 0x77, 0x63, 0xa8, 0x8c, 0x34, 0xbe, 0xca, 0x8d, 0x2f, 0xa3, 0xfc, 0x00, 0xe3, 0xe8,
+	0x99, 0x02, 0xf3, 0xe1, 0xd6, 0x91, 0x4c, 0xad, 0x84, 0xf6, 0xa6, 0x96, 0x47, 0x0b, 0x8e, 0x28,
+	0x07, 0xc1, 0x24, 0xe7, 0x10, 0x5b, 0x3f, 0xf4, 0x96, 0xe4, 0xb1, 0x4b, 0x33, 0xd1, 0x0d, 0x92,
+	0x93, 0x10, 0x69, 0x37, 0xaa, 0x36, 0x4c, 0x64, 0x04, 0xcd, 0x44, 0x97, 0xd9, 0xdb, 0x7b, 0xf1,
+	0xba, 0xa4, 0xbc, 0x7c, 0x5d, 0x52, 0xfe, 0x7e, 0x5d, 0x52, 0xbe, 0x3f, 0x2a, 0x4d, 0xbd, 0x3c,
+	0x2a, 0x4d, 0xfd, 0x79, 0x54, 0x9a, 0xfa, 0xa2, 0x1c, 0x1a, 0xfd, 0x2c, 0xc2, 0xfa, 0xc6, 0x85,
+	0x2e, 0xae, 0xdb, 0xbe, 0x96, 0x87, 0xae, 0x1e, 0x3e, 0x00, 0xd6, 0x33, 0xfc, 0x7f, 0xa0, 0x17,
+	0xff, 0x1d, 0x00, 0x2d, 0xbf, 0xc7, 0xb7, 0xe2, 0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// TotalVotingPower queries the total voting power.
+	TotalVotingPower(ctx context.Context, in *QueryTotalVotingPowerRequest, opts ...grpc.CallOption) (*QueryTotalVotingPowerResponse, error)
+	// VotingPower queries the voting power of a veNFT.
+	VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error)
+	// TotalVotingPowerSeries queries the total voting power at every step of a
+	// time range.
+	TotalVotingPowerSeries(ctx context.Context, in *QueryTotalVotingPowerSeriesRequest, opts ...grpc.CallOption) (*QueryTotalVotingPowerSeriesResponse, error)
+	// Checkpoints queries the global checkpoints in an epoch range.
+	Checkpoints(ctx context.Context, in *QueryCheckpointsRequest, opts ...grpc.CallOption) (*QueryCheckpointsResponse, error)
+	// UserCheckpoints queries the checkpoints of a veNFT.
+	UserCheckpoints(ctx context.Context, in *QueryUserCheckpointsRequest, opts ...grpc.CallOption) (*QueryUserCheckpointsResponse, error)
+	// SlopeChanges queries the scheduled slope changes in a time range.
+	SlopeChanges(ctx context.Context, in *QuerySlopeChangesRequest, opts ...grpc.CallOption) (*QuerySlopeChangesResponse, error)
+	// VeNfts queries all veNFTs of a given owner.
+	VeNfts(ctx context.Context, in *QueryVeNftsRequest, opts ...grpc.CallOption) (*QueryVeNftsResponse, error)
+	// VeNft queries an veNFT based on its id.
+	VeNft(ctx context.Context, in *QueryVeNftRequest, opts ...grpc.CallOption) (*QueryVeNftResponse, error)
+	// VeInfo queries the detail of a veNFT.
+	VeInfo(ctx context.Context, in *QueryVeInfoRequest, opts ...grpc.CallOption) (*QueryVeInfoResponse, error)
+	// VeInfos queries the detail of all veNFTs of a given owner.
+	VeInfos(ctx context.Context, in *QueryVeInfosRequest, opts ...grpc.CallOption) (*QueryVeInfosResponse, error)
+	// DelegatedVes queries all veNFTs whose votes are delegated to a given
+	// address.
+	DelegatedVes(ctx context.Context, in *QueryDelegatedVesRequest, opts ...grpc.CallOption) (*QueryDelegatedVesResponse, error)
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) TotalVotingPower(ctx context.Context, in *QueryTotalVotingPowerRequest, opts ...grpc.CallOption) (*QueryTotalVotingPowerResponse, error) {
+	out := new(QueryTotalVotingPowerResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Query/TotalVotingPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error) {
+	out := new(QueryVotingPowerResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Query/VotingPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalVotingPowerSeries(ctx context.Context, in *QueryTotalVotingPowerSeriesRequest, opts ...grpc.CallOption) (*QueryTotalVotingPowerSeriesResponse, error) {
+	out := new(QueryTotalVotingPowerSeriesResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Query/TotalVotingPowerSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Checkpoints(ctx context.Context, in *QueryCheckpointsRequest, opts ...grpc.CallOption) (*QueryCheckpointsResponse, error) {
+	out := new(QueryCheckpointsResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Query/Checkpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserCheckpoints(ctx context.Context, in *QueryUserCheckpointsRequest, opts ...grpc.CallOption) (*QueryUserCheckpointsResponse, error) {
+	out := new(QueryUserCheckpointsResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Query/UserCheckpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlopeChanges(ctx context.Context, in *QuerySlopeChangesRequest, opts ...grpc.CallOption) (*QuerySlopeChangesResponse, error) {
+	out := new(QuerySlopeChangesResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Query/SlopeChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VeNfts(ctx context.Context, in *QueryVeNftsRequest, opts ...grpc.CallOption) (*QueryVeNftsResponse, error) {
+	out := new(QueryVeNftsResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Query/VeNfts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VeNft(ctx context.Context, in *QueryVeNftRequest, opts ...grpc.CallOption) (*QueryVeNftResponse, error) {
+	out := new(QueryVeNftResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Query/VeNft", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VeInfo(ctx context.Context, in *QueryVeInfoRequest, opts ...grpc.CallOption) (*QueryVeInfoResponse, error) {
+	out := new(QueryVeInfoResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Query/VeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VeInfos(ctx context.Context, in *QueryVeInfosRequest, opts ...grpc.CallOption) (*QueryVeInfosResponse, error) {
+	out := new(QueryVeInfosResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Query/VeInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatedVes(ctx context.Context, in *QueryDelegatedVesRequest, opts ...grpc.CallOption) (*QueryDelegatedVesResponse, error) {
+	out := new(QueryDelegatedVesResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Query/DelegatedVes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// TotalVotingPower queries the total voting power.
+	TotalVotingPower(context.Context, *QueryTotalVotingPowerRequest) (*QueryTotalVotingPowerResponse, error)
+	// VotingPower queries the voting power of a veNFT.
+	VotingPower(context.Context, *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error)
+	// TotalVotingPowerSeries queries the total voting power at every step of a
+	// time range.
+	TotalVotingPowerSeries(context.Context, *QueryTotalVotingPowerSeriesRequest) (*QueryTotalVotingPowerSeriesResponse, error)
+	// Checkpoints queries the global checkpoints in an epoch range.
+	Checkpoints(context.Context, *QueryCheckpointsRequest) (*QueryCheckpointsResponse, error)
+	// UserCheckpoints queries the checkpoints of a veNFT.
+	UserCheckpoints(context.Context, *QueryUserCheckpointsRequest) (*QueryUserCheckpointsResponse, error)
+	// SlopeChanges queries the scheduled slope changes in a time range.
+	SlopeChanges(context.Context, *QuerySlopeChangesRequest) (*QuerySlopeChangesResponse, error)
+	// VeNfts queries all veNFTs of a given owner.
+	VeNfts(context.Context, *QueryVeNftsRequest) (*QueryVeNftsResponse, error)
+	// VeNft queries an veNFT based on its id.
+	VeNft(context.Context, *QueryVeNftRequest) (*QueryVeNftResponse, error)
+	// VeInfo queries the detail of a veNFT.
+	VeInfo(context.Context, *QueryVeInfoRequest) (*QueryVeInfoResponse, error)
+	// VeInfos queries the detail of all veNFTs of a given owner.
+	VeInfos(context.Context, *QueryVeInfosRequest) (*QueryVeInfosResponse, error)
+	// DelegatedVes queries all veNFTs whose votes are delegated to a given
+	// address.
+	DelegatedVes(context.Context, *QueryDelegatedVesRequest) (*QueryDelegatedVesResponse, error)
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) TotalVotingPower(ctx context.Context, req *QueryTotalVotingPowerRequest) (*QueryTotalVotingPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalVotingPower not implemented")
+}
+func (*UnimplementedQueryServer) VotingPower(ctx context.Context, req *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingPower not implemented")
+}
+func (*UnimplementedQueryServer) TotalVotingPowerSeries(ctx context.Context, req *QueryTotalVotingPowerSeriesRequest) (*QueryTotalVotingPowerSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalVotingPowerSeries not implemented")
+}
+func (*UnimplementedQueryServer) Checkpoints(ctx context.Context, req *QueryCheckpointsRequest) (*QueryCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkpoints not implemented")
+}
+func (*UnimplementedQueryServer) UserCheckpoints(ctx context.Context, req *QueryUserCheckpointsRequest) (*QueryUserCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserCheckpoints not implemented")
+}
+func (*UnimplementedQueryServer) SlopeChanges(ctx context.Context, req *QuerySlopeChangesRequest) (*QuerySlopeChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlopeChanges not implemented")
+}
+func (*UnimplementedQueryServer) VeNfts(ctx context.Context, req *QueryVeNftsRequest) (*QueryVeNftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeNfts not implemented")
+}
+func (*UnimplementedQueryServer) VeNft(ctx context.Context, req *QueryVeNftRequest) (*QueryVeNftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeNft not implemented")
+}
+func (*UnimplementedQueryServer) VeInfo(ctx context.Context, req *QueryVeInfoRequest) (*QueryVeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeInfo not implemented")
+}
+func (*UnimplementedQueryServer) VeInfos(ctx context.Context, req *QueryVeInfosRequest) (*QueryVeInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeInfos not implemented")
+}
+func (*UnimplementedQueryServer) DelegatedVes(ctx context.Context, req *QueryDelegatedVesRequest) (*QueryDelegatedVesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatedVes not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_TotalVotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalVotingPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalVotingPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Query/TotalVotingPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalVotingPower(ctx, req.(*QueryTotalVotingPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Query/VotingPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingPower(ctx, req.(*QueryVotingPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalVotingPowerSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalVotingPowerSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalVotingPowerSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Query/TotalVotingPowerSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalVotingPowerSeries(ctx, req.(*QueryTotalVotingPowerSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Checkpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Checkpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Query/Checkpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Checkpoints(ctx, req.(*QueryCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserCheckpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Query/UserCheckpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserCheckpoints(ctx, req.(*QueryUserCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlopeChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlopeChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlopeChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Query/SlopeChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlopeChanges(ctx, req.(*QuerySlopeChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VeNfts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVeNftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VeNfts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Query/VeNfts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VeNfts(ctx, req.(*QueryVeNftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VeNft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVeNftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VeNft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Query/VeNft",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VeNft(ctx, req.(*QueryVeNftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVeInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Query/VeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VeInfo(ctx, req.(*QueryVeInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VeInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVeInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VeInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Query/VeInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VeInfos(ctx, req.(*QueryVeInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatedVes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatedVesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatedVes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Query/DelegatedVes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatedVes(ctx, req.(*QueryDelegatedVesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "warmage.ve.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TotalVotingPower",
+			Handler:    _Query_TotalVotingPower_Handler,
+		},
+		{
+			MethodName: "VotingPower",
+			Handler:    _Query_VotingPower_Handler,
+		},
+		{
+			MethodName: "TotalVotingPowerSeries",
+			Handler:    _Query_TotalVotingPowerSeries_Handler,
+		},
+		{
+			MethodName: "Checkpoints",
+			Handler:    _Query_Checkpoints_Handler,
+		},
+		{
+			MethodName: "UserCheckpoints",
+			Handler:    _Query_UserCheckpoints_Handler,
+		},
+		{
+			MethodName: "SlopeChanges",
+			Handler:    _Query_SlopeChanges_Handler,
+		},
+		{
+			MethodName: "VeNfts",
+			Handler:    _Query_VeNfts_Handler,
+		},
+		{
+			MethodName: "VeNft",
+			Handler:    _Query_VeNft_Handler,
+		},
+		{
+			MethodName: "VeInfo",
+			Handler:    _Query_VeInfo_Handler,
+		},
+		{
+			MethodName: "VeInfos",
+			Handler:    _Query_VeInfos_Handler,
+		},
+		{
+			MethodName: "DelegatedVes",
+			Handler:    _Query_DelegatedVes_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "warmage/ve/v1/query.proto",
+}
+
+func (m *QueryTotalVotingPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalVotingPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalVotingPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AtBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AtBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AtTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AtTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalVotingPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalVotingPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalVotingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Power.Size()
+		i -= size
+		if _, err := m.Power.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AtBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AtBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AtTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AtTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Power.Size()
+		i -= size
+		if _, err := m.Power.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalVotingPowerSeriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalVotingPowerSeriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalVotingPowerSeriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Step != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Step))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ToTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimedVotingPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimedVotingPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimedVotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Power.Size()
+		i -= size
+		if _, err := m.Power.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalVotingPowerSeriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalVotingPowerSeriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalVotingPowerSeriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Powers) > 0 {
+		for iNdEx := len(m.Powers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Powers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ToEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserCheckpointsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserCheckpointsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserCheckpointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserCheckpointsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserCheckpointsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserCheckpointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlopeChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlopeChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlopeChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlopeChange.Size()
+		i -= size
+		if _, err := m.SlopeChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlopeChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlopeChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlopeChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ToTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlopeChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlopeChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlopeChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SlopeChanges) > 0 {
+		for iNdEx := len(m.SlopeChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlopeChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeNftsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeNftsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeNftsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeNftsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeNftsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeNftsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nfts) > 0 {
+		for iNdEx := len(m.Nfts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nfts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeNftRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeNftRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeNftRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeNftResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeNftResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeNftResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nft != nil {
+		{
+			size, err := m.Nft.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Claimable.Size()
+		i -= size
+		if _, err := m.Claimable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.DelegatedAmount.Size()
+		i -= size
+		if _, err := m.DelegatedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Voted {
+		i--
+		if m.Voted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Attached != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Attached))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.UserEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UserEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.HistoricalVotingPower.Size()
+		i -= size
+		if _, err := m.HistoricalVotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Locked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AtBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AtBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AtTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AtTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeInfosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeInfosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AtBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AtBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AtTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AtTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeInfosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeInfosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Infos) > 0 {
+		for iNdEx := len(m.Infos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Infos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatedVesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatedVesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatedVesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegatee) > 0 {
+		i -= len(m.Delegatee)
+		copy(dAtA[i:], m.Delegatee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegatee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatedVesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatedVesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatedVesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VeIds) > 0 {
+		for iNdEx := len(m.VeIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VeIds[iNdEx])
+			copy(dAtA[i:], m.VeIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.VeIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTotalVotingPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AtTime != 0 {
+		n += 1 + sovQuery(uint64(m.AtTime))
+	}
+	if m.AtBlock != 0 {
+		n += 1 + sovQuery(uint64(m.AtBlock))
+	}
+	return n
+}
+
+func (m *QueryTotalVotingPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Power.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVotingPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AtTime != 0 {
+		n += 1 + sovQuery(uint64(m.AtTime))
+	}
+	if m.AtBlock != 0 {
+		n += 1 + sovQuery(uint64(m.AtBlock))
+	}
+	return n
+}
+
+func (m *QueryVotingPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Power.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalVotingPowerSeriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromTime != 0 {
+		n += 1 + sovQuery(uint64(m.FromTime))
+	}
+	if m.ToTime != 0 {
+		n += 1 + sovQuery(uint64(m.ToTime))
+	}
+	if m.Step != 0 {
+		n += 1 + sovQuery(uint64(m.Step))
+	}
+	return n
+}
+
+func (m *TimedVotingPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	l = m.Power.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalVotingPowerSeriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Powers) > 0 {
+		for _, e := range m.Powers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	l = m.Checkpoint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCheckpointsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.ToEpoch))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckpointsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserCheckpointsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserCheckpointsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SlopeChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	l = m.SlopeChange.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySlopeChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromTime != 0 {
+		n += 1 + sovQuery(uint64(m.FromTime))
+	}
+	if m.ToTime != 0 {
+		n += 1 + sovQuery(uint64(m.ToTime))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlopeChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SlopeChanges) > 0 {
+		for _, e := range m.SlopeChanges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeNftsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeNftsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nfts) > 0 {
+		for _, e := range m.Nfts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeNftRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeNftResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nft != nil {
+		l = m.Nft.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *VeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Locked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.VotingPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.HistoricalVotingPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.UserEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.UserEpoch))
+	}
+	if m.Attached != 0 {
+		n += 1 + sovQuery(uint64(m.Attached))
+	}
+	if m.Voted {
+		n += 2
+	}
+	l = m.DelegatedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Claimable.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVeInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AtTime != 0 {
+		n += 1 + sovQuery(uint64(m.AtTime))
+	}
+	if m.AtBlock != 0 {
+		n += 1 + sovQuery(uint64(m.AtBlock))
+	}
+	return n
+}
+
+func (m *QueryVeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Info.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVeInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AtTime != 0 {
+		n += 1 + sovQuery(uint64(m.AtTime))
+	}
+	if m.AtBlock != 0 {
+		n += 1 + sovQuery(uint64(m.AtBlock))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Infos) > 0 {
+		for _, e := range m.Infos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatedVesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegatee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatedVesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VeIds) > 0 {
+		for _, s := range m.VeIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *QueryParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTotalVotingPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalVotingPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalVotingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtTime", wireType)
+			}
+			m.AtTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtBlock", wireType)
+			}
+			m.AtBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalVotingPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalVotingPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtTime", wireType)
+			}
+			m.AtTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtBlock", wireType)
+			}
+			m.AtBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalVotingPowerSeriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalVotingPowerSeriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalVotingPowerSeriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTime", wireType)
+			}
+			m.FromTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTime", wireType)
+			}
+			m.ToTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Step |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimedVotingPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimedVotingPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimedVotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalVotingPowerSeriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalVotingPowerSeriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalVotingPowerSeriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Powers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Powers = append(m.Powers, TimedVotingPower{})
+			if err := m.Powers[len(m.Powers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checkpoints = append(m.Checkpoints, EpochCheckpoint{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserCheckpointsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserCheckpointsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserCheckpointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserCheckpointsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserCheckpointsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserCheckpointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checkpoints = append(m.Checkpoints, EpochCheckpoint{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlopeChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlopeChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlopeChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlopeChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlopeChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlopeChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlopeChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlopeChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTime", wireType)
+			}
+			m.FromTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTime", wireType)
+			}
+			m.ToTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlopeChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlopeChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlopeChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlopeChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlopeChanges = append(m.SlopeChanges, SlopeChange{})
+			if err := m.SlopeChanges[len(m.SlopeChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeNftsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeNftsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeNftsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeNftsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeNftsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeNftsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nfts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nfts = append(m.Nfts, &nft.NFT{})
+			if err := m.Nfts[len(m.Nfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeNftRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeNftRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeNftRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeNftResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeNftResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeNftResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nft", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nft == nil {
+				m.Nft = &nft.NFT{}
+			}
+			if err := m.Nft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryTotalVotingPowerRequest) Unmarshal(dAtA []byte) error {
+func (m *VeInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1313,27 +5856,192 @@ func (m *QueryTotalVotingPowerRequest) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalVotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HistoricalVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalVotingPowerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalVotingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AtTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserEpoch", wireType)
 			}
-			m.AtTime = 0
+			m.UserEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1343,16 +6051,16 @@ func (m *QueryTotalVotingPowerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AtTime |= uint64(b&0x7F) << shift
+				m.UserEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AtBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attached", wireType)
 			}
-			m.AtBlock = 0
+			m.Attached = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1362,64 +6070,68 @@ func (m *QueryTotalVotingPowerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AtBlock |= int64(b&0x7F) << shift
+				m.Attached |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voted", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.Voted = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedAmount", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalVotingPowerResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.DelegatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalVotingPowerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1447,7 +6159,7 @@ func (m *QueryTotalVotingPowerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Claimable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1472,7 +6184,7 @@ func (m *QueryTotalVotingPowerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVeInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1495,10 +6207,10 @@ func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotingPowerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVeInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1592,7 +6304,7 @@ func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVeInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1615,17 +6327,17 @@ func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotingPowerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVeInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1635,23 +6347,22 @@ func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1676,7 +6387,7 @@ func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVeNftsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVeInfosRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1699,10 +6410,10 @@ func (m *QueryVeNftsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVeNftsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVeInfosRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVeNftsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVeInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1738,6 +6449,44 @@ func (m *QueryVeNftsRequest) Unmarshal(dAtA []byte) error {
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtTime", wireType)
+			}
+			m.AtTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtBlock", wireType)
+			}
+			m.AtBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1794,7 +6543,7 @@ func (m *QueryVeNftsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVeNftsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVeInfosResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1817,15 +6566,15 @@ func (m *QueryVeNftsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVeNftsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVeInfosResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVeNftsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVeInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nfts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Infos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1852,8 +6601,8 @@ func (m *QueryVeNftsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nfts = append(m.Nfts, &nft.NFT{})
-			if err := m.Nfts[len(m.Nfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Infos = append(m.Infos, VeInfo{})
+			if err := m.Infos[len(m.Infos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1914,7 +6663,7 @@ func (m *QueryVeNftsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVeNftRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatedVesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1937,15 +6686,15 @@ func (m *QueryVeNftRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVeNftRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatedVesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVeNftRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatedVesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1973,7 +6722,43 @@ func (m *QueryVeNftRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Delegatee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1996,7 +6781,7 @@ func (m *QueryVeNftRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVeNftResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatedVesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2019,15 +6804,47 @@ func (m *QueryVeNftResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVeNftResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatedVesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVeNftResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatedVesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nft", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VeIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeIds = append(m.VeIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2054,10 +6871,10 @@ func (m *QueryVeNftResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Nft == nil {
-				m.Nft = &nft.NFT{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Nft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -41,7 +42,7 @@ func (m *MsgCreate) Reset()         { *m = MsgCreate{} }
 func (m *MsgCreate) String() string { return proto.CompactTextString(m) }
 func (*MsgCreate) ProtoMessage()    {}
 func (*MsgCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7eb0badc4e133e0, []int{0}
+	return fileDescriptor_831fe77ee15459b8, []int{0}
 }
 func (m *MsgCreate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -79,7 +80,7 @@ func (m *MsgCreateResponse) Reset()         { *m = MsgCreateResponse{} }
 func (m *MsgCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateResponse) ProtoMessage()    {}
 func (*MsgCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7eb0badc4e133e0, []int{1}
+	return fileDescriptor_831fe77ee15459b8, []int{1}
 }
 func (m *MsgCreateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -133,7 +134,7 @@ func (m *MsgDeposit) Reset()         { *m = MsgDeposit{} }
 func (m *MsgDeposit) String() string { return proto.CompactTextString(m) }
 func (*MsgDeposit) ProtoMessage()    {}
 func (*MsgDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7eb0badc4e133e0, []int{2}
+	return fileDescriptor_831fe77ee15459b8, []int{2}
 }
 func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -169,7 +170,7 @@ func (m *MsgDepositResponse) Reset()         { *m = MsgDepositResponse{} }
 func (m *MsgDepositResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositResponse) ProtoMessage()    {}
 func (*MsgDepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7eb0badc4e133e0, []int{3}
+	return fileDescriptor_831fe77ee15459b8, []int{3}
 }
 func (m *MsgDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -209,7 +210,7 @@ func (m *MsgExtendTime) Reset()         { *m = MsgExtendTime{} }
 func (m *MsgExtendTime) String() string { return proto.CompactTextString(m) }
 func (*MsgExtendTime) ProtoMessage()    {}
 func (*MsgExtendTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7eb0badc4e133e0, []int{4}
+	return fileDescriptor_831fe77ee15459b8, []int{4}
 }
 func (m *MsgExtendTime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -245,7 +246,7 @@ func (m *MsgExtendTimeResponse) Reset()         { *m = MsgExtendTimeResponse{} }
 func (m *MsgExtendTimeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExtendTimeResponse) ProtoMessage()    {}
 func (*MsgExtendTimeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7eb0badc4e133e0, []int{5}
+	return fileDescriptor_831fe77ee15459b8, []int{5}
 }
 func (m *MsgExtendTimeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -284,7 +285,7 @@ func (m *MsgMerge) Reset()         { *m = MsgMerge{} }
 func (m *MsgMerge) String() string { return proto.CompactTextString(m) }
 func (*MsgMerge) ProtoMessage()    {}
 func (*MsgMerge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7eb0badc4e133e0, []int{6}
+	return fileDescriptor_831fe77ee15459b8, []int{6}
 }
 func (m *MsgMerge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -320,7 +321,7 @@ func (m *MsgMergeResponse) Reset()         { *m = MsgMergeResponse{} }
 func (m *MsgMergeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMergeResponse) ProtoMessage()    {}
 func (*MsgMergeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7eb0badc4e133e0, []int{7}
+	return fileDescriptor_831fe77ee15459b8, []int{7}
 }
 func (m *MsgMergeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,7 +359,7 @@ func (m *MsgWithdraw) Reset()         { *m = MsgWithdraw{} }
 func (m *MsgWithdraw) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdraw) ProtoMessage()    {}
 func (*MsgWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7eb0badc4e133e0, []int{8}
+	return fileDescriptor_831fe77ee15459b8, []int{8}
 }
 func (m *MsgWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,7 +395,7 @@ func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
 func (m *MsgWithdrawResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawResponse) ProtoMessage()    {}
 func (*MsgWithdrawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7eb0badc4e133e0, []int{9}
+	return fileDescriptor_831fe77ee15459b8, []int{9}
 }
 func (m *MsgWithdrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -423,822 +424,3409 @@ func (m *MsgWithdrawResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreate)(nil), "warmage.ve.v1.MsgCreate")
-	proto.RegisterType((*MsgCreateResponse)(nil), "warmage.ve.v1.MsgCreateResponse")
-	proto.RegisterType((*MsgDeposit)(nil), "warmage.ve.v1.MsgDeposit")
-	proto.RegisterType((*MsgDepositResponse)(nil), "warmage.ve.v1.MsgDepositResponse")
-	proto.RegisterType((*MsgExtendTime)(nil), "warmage.ve.v1.MsgExtendTime")
-	proto.RegisterType((*MsgExtendTimeResponse)(nil), "warmage.ve.v1.MsgExtendTimeResponse")
-	proto.RegisterType((*MsgMerge)(nil), "warmage.ve.v1.MsgMerge")
-	proto.RegisterType((*MsgMergeResponse)(nil), "warmage.ve.v1.MsgMergeResponse")
-	proto.RegisterType((*MsgWithdraw)(nil), "warmage.ve.v1.MsgWithdraw")
-	proto.RegisterType((*MsgWithdrawResponse)(nil), "warmage.ve.v1.MsgWithdrawResponse")
+type MsgWithdrawEarly struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	VeId   string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty" yaml:"ve_id"`
 }
 
-func init() { proto.RegisterFile("warmage/ve/v1/tx.proto", fileDescriptor_a7eb0badc4e133e0) }
-
-var fileDescriptor_a7eb0badc4e133e0 = []byte{
-	// 709 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x3f, 0x4f, 0xdb, 0x40,
-	0x14, 0x8f, 0x93, 0x90, 0x86, 0x07, 0x51, 0xe1, 0x20, 0x25, 0x71, 0x21, 0x0e, 0x56, 0x91, 0x68,
-	0x25, 0x6c, 0x01, 0x1b, 0x52, 0x97, 0x40, 0xa5, 0x32, 0x78, 0xb1, 0xaa, 0x56, 0xea, 0x82, 0x9c,
-	0xf8, 0x30, 0x6e, 0x63, 0x5f, 0xe4, 0xbb, 0x04, 0xe8, 0xd8, 0xa9, 0x63, 0xa5, 0x7e, 0x01, 0xa4,
-	0x4a, 0x1d, 0x3a, 0xf6, 0x53, 0x30, 0x22, 0x75, 0xe9, 0x64, 0x55, 0xd0, 0x81, 0x39, 0x9f, 0xa0,
-	0xf2, 0x9d, 0xed, 0x84, 0x5a, 0xa9, 0x44, 0x45, 0xb7, 0xdc, 0xfd, 0xde, 0xfb, 0xfd, 0x79, 0xbe,
-	0xbb, 0xc0, 0x03, 0x0f, 0x07, 0x5d, 0x97, 0xf8, 0xfa, 0x00, 0xeb, 0x83, 0x4d, 0x9d, 0x9d, 0x68,
-	0xbd, 0x80, 0x30, 0x82, 0x2a, 0xf1, 0xbe, 0x36, 0xc0, 0xda, 0x60, 0x53, 0x5e, 0x74, 0x88, 0x43,
-	0x38, 0xa2, 0x47, 0xbf, 0x44, 0x91, 0xbc, 0xec, 0x10, 0xe2, 0x74, 0xb1, 0x6e, 0xf5, 0x5c, 0xdd,
-	0xf2, 0x7d, 0xc2, 0x2c, 0xe6, 0x12, 0x9f, 0xc6, 0x68, 0xa3, 0x43, 0xa8, 0x47, 0xa8, 0xde, 0xb6,
-	0x68, 0xc4, 0xdd, 0xc6, 0xcc, 0xda, 0xd4, 0x3b, 0xc4, 0xf5, 0x05, 0xae, 0x5e, 0x4b, 0x30, 0x6d,
-	0x50, 0x67, 0x37, 0xc0, 0x16, 0xc3, 0xe8, 0x31, 0x94, 0x28, 0xf6, 0x6d, 0x1c, 0xd4, 0xa4, 0xa6,
-	0xb4, 0x3e, 0xdd, 0x9a, 0x1f, 0x86, 0x4a, 0xe5, 0xd4, 0xf2, 0xba, 0x3b, 0xaa, 0xd8, 0x57, 0xcd,
-	0xb8, 0x00, 0xad, 0x40, 0x9e, 0x91, 0x5a, 0x9e, 0x97, 0x55, 0x86, 0xa1, 0x32, 0x2d, 0xca, 0x18,
-	0x51, 0xcd, 0x3c, 0x23, 0xe8, 0x39, 0x94, 0x2c, 0x8f, 0xf4, 0x7d, 0x56, 0x2b, 0x34, 0xa5, 0xf5,
-	0x99, 0xad, 0xba, 0x26, 0x8c, 0x68, 0x91, 0x11, 0x2d, 0x36, 0xa2, 0xed, 0x12, 0xd7, 0x6f, 0x55,
-	0xcf, 0x43, 0x25, 0x37, 0x12, 0x12, 0x6d, 0xaa, 0x19, 0xf7, 0xa3, 0xa7, 0x50, 0xe9, 0x92, 0xce,
-	0xdb, 0x03, 0xbb, 0x1f, 0xf0, 0x64, 0xb5, 0x62, 0x53, 0x5a, 0x2f, 0xb6, 0x6a, 0xc3, 0x50, 0x59,
-	0x14, 0x1d, 0x37, 0x60, 0xd5, 0x9c, 0x8d, 0xd6, 0x7b, 0xf1, 0x72, 0xa7, 0xfc, 0xe1, 0x4c, 0xc9,
-	0x5d, 0x9f, 0x29, 0x39, 0x75, 0x1f, 0xe6, 0xd3, 0xa4, 0x26, 0xa6, 0x3d, 0xe2, 0x53, 0x8c, 0x16,
-	0x60, 0x6a, 0x80, 0x0f, 0x5c, 0x5b, 0x04, 0x36, 0x8b, 0x03, 0xbc, 0x6f, 0x23, 0x05, 0x66, 0xfa,
-	0x3e, 0x67, 0x65, 0xae, 0x87, 0x79, 0xc8, 0xa2, 0x09, 0x62, 0xeb, 0x85, 0xeb, 0x61, 0xf5, 0x9b,
-	0x04, 0x60, 0x50, 0x67, 0x0f, 0xf7, 0x08, 0x75, 0xd9, 0x6d, 0xc6, 0xb6, 0x96, 0xe8, 0x89, 0xc9,
-	0xcd, 0x0d, 0x43, 0x65, 0x56, 0x54, 0xf2, 0x6d, 0x35, 0x76, 0x70, 0x67, 0xe3, 0x1b, 0xcb, 0xbf,
-	0x08, 0x68, 0xe4, 0x39, 0x19, 0x80, 0xfa, 0x55, 0x82, 0x8a, 0x41, 0x9d, 0x67, 0x27, 0x0c, 0xfb,
-	0x76, 0x14, 0xee, 0x3f, 0xa4, 0xc9, 0x7c, 0xc2, 0xc2, 0x3f, 0x7e, 0xc2, 0x25, 0xa8, 0xde, 0xf0,
-	0x9a, 0xa6, 0xf8, 0x2c, 0x41, 0xd9, 0xa0, 0x8e, 0x81, 0x03, 0xe7, 0x56, 0x01, 0xb6, 0x01, 0x0e,
-	0x03, 0xe2, 0x1d, 0x8c, 0xa7, 0xa8, 0x0e, 0x43, 0x65, 0x5e, 0x94, 0x8f, 0x30, 0xd5, 0x2c, 0x47,
-	0x8b, 0x97, 0x51, 0x9c, 0x0d, 0x28, 0x33, 0x12, 0xb7, 0x14, 0x78, 0xcb, 0xc2, 0x30, 0x54, 0xee,
-	0x27, 0x17, 0x20, 0x69, 0x28, 0x31, 0x12, 0x95, 0x8f, 0xd9, 0x47, 0x30, 0x97, 0x98, 0x4c, 0x9d,
-	0xbb, 0x30, 0x63, 0x50, 0xe7, 0x95, 0xcb, 0x8e, 0xec, 0xc0, 0x3a, 0xbe, 0xfb, 0xe1, 0x8f, 0xc9,
-	0x57, 0x61, 0x61, 0x4c, 0x2a, 0x71, 0xb0, 0xf5, 0xa5, 0x08, 0x05, 0x83, 0x3a, 0xe8, 0x10, 0x4a,
-	0xf1, 0x33, 0x50, 0xd3, 0x6e, 0x3c, 0x3c, 0x5a, 0x7a, 0x6d, 0xe4, 0xe6, 0x24, 0x24, 0xcd, 0xd3,
-	0x7c, 0xff, 0xfd, 0xd7, 0xa7, 0xbc, 0x8c, 0x6a, 0xfa, 0x9f, 0x8f, 0x9a, 0xde, 0x11, 0xec, 0x6f,
-	0xe0, 0x5e, 0x72, 0x71, 0xea, 0x59, 0xba, 0x18, 0x92, 0x57, 0x27, 0x42, 0xa9, 0xd4, 0x2a, 0x97,
-	0x7a, 0x88, 0xea, 0x59, 0x29, 0x3b, 0x16, 0x38, 0x06, 0x18, 0x3b, 0xd9, 0xcb, 0x59, 0xce, 0x11,
-	0x2a, 0x3f, 0xfa, 0x1b, 0x9a, 0x8a, 0xae, 0x71, 0x51, 0x05, 0xad, 0x64, 0x45, 0x31, 0xaf, 0xe6,
-	0x6f, 0x06, 0x6a, 0xc3, 0x94, 0x38, 0x8c, 0x4b, 0x59, 0x56, 0x0e, 0xc8, 0xca, 0x04, 0x20, 0x55,
-	0x52, 0xb8, 0x52, 0x1d, 0x2d, 0x65, 0x95, 0x3c, 0x4e, 0xed, 0x43, 0x39, 0x3d, 0x37, 0x72, 0x96,
-	0x2d, 0xc1, 0x64, 0x75, 0x32, 0x96, 0x8a, 0xa9, 0x5c, 0x6c, 0x19, 0xc9, 0x59, 0xb1, 0xe3, 0xb8,
-	0xb6, 0xb5, 0x77, 0x7e, 0xd9, 0x90, 0x2e, 0x2e, 0x1b, 0xd2, 0xcf, 0xcb, 0x86, 0xf4, 0xf1, 0xaa,
-	0x91, 0xbb, 0xb8, 0x6a, 0xe4, 0x7e, 0x5c, 0x35, 0x72, 0xaf, 0x9f, 0x38, 0x2e, 0x3b, 0xea, 0xb7,
-	0xb5, 0x0e, 0xf1, 0x92, 0xfe, 0x8d, 0x77, 0xc4, 0xc7, 0x29, 0xd9, 0x49, 0x44, 0xc7, 0x4e, 0x7b,
-	0x98, 0xb6, 0x4b, 0xfc, 0x8f, 0x67, 0xfb, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x60, 0xb3, 0xfe,
-	0x0e, 0xf5, 0x06, 0x00, 0x00,
+func (m *MsgWithdrawEarly) Reset()         { *m = MsgWithdrawEarly{} }
+func (m *MsgWithdrawEarly) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawEarly) ProtoMessage()    {}
+func (*MsgWithdrawEarly) Descriptor() ([]byte, []int) {
+	return fileDescriptor_831fe77ee15459b8, []int{10}
+}
+func (m *MsgWithdrawEarly) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawEarly) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawEarly.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawEarly) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawEarly.Merge(m, src)
+}
+func (m *MsgWithdrawEarly) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawEarly) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawEarly.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgWithdrawEarly proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Create creates a veNFT.
-	Create(ctx context.Context, in *MsgCreate, opts ...grpc.CallOption) (*MsgCreateResponse, error)
-	// Deposit deposits some coin amount for a veNFT.
-	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
-	// ExtendTime extends locking duration for a veNFT.
-	ExtendTime(ctx context.Context, in *MsgExtendTime, opts ...grpc.CallOption) (*MsgExtendTimeResponse, error)
-	// Merge merges a veNFT (burn it) to another veNFT.
-	Merge(ctx context.Context, in *MsgMerge, opts ...grpc.CallOption) (*MsgMergeResponse, error)
-	// Withdraw withdraws all coin amount of a veNFT.
-	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
+type MsgWithdrawEarlyResponse struct {
+	// amount sent to the sender
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	// penalty taken from the locked amount
+	Penalty types.Coin `protobuf:"bytes,2,opt,name=penalty,proto3" json:"penalty"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgWithdrawEarlyResponse) Reset()         { *m = MsgWithdrawEarlyResponse{} }
+func (m *MsgWithdrawEarlyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawEarlyResponse) ProtoMessage()    {}
+func (*MsgWithdrawEarlyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_831fe77ee15459b8, []int{11}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgWithdrawEarlyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) Create(ctx context.Context, in *MsgCreate, opts ...grpc.CallOption) (*MsgCreateResponse, error) {
-	out := new(MsgCreateResponse)
-	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Msg/Create", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawEarlyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawEarlyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgWithdrawEarlyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawEarlyResponse.Merge(m, src)
+}
+func (m *MsgWithdrawEarlyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawEarlyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawEarlyResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
-	out := new(MsgDepositResponse)
-	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Msg/Deposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgWithdrawEarlyResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawEarlyResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *msgClient) ExtendTime(ctx context.Context, in *MsgExtendTime, opts ...grpc.CallOption) (*MsgExtendTimeResponse, error) {
-	out := new(MsgExtendTimeResponse)
-	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Msg/ExtendTime", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawEarlyResponse) GetPenalty() types.Coin {
+	if m != nil {
+		return m.Penalty
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *msgClient) Merge(ctx context.Context, in *MsgMerge, opts ...grpc.CallOption) (*MsgMergeResponse, error) {
-	out := new(MsgMergeResponse)
-	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Msg/Merge", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgSplit struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	VeId   string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty" yaml:"ve_id"`
+	// Amounts to split into new veNFTs, each must be greater than 0, and the
+	// remaining amount of the veNFT must be greater than 0
+	Amounts []github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,rep,name=amounts,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amounts" yaml:"amounts"`
 }
 
-func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
-	out := new(MsgWithdrawResponse)
-	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Msg/Withdraw", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSplit) Reset()         { *m = MsgSplit{} }
+func (m *MsgSplit) String() string { return proto.CompactTextString(m) }
+func (*MsgSplit) ProtoMessage()    {}
+func (*MsgSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_831fe77ee15459b8, []int{12}
+}
+func (m *MsgSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Create creates a veNFT.
-	Create(context.Context, *MsgCreate) (*MsgCreateResponse, error)
-	// Deposit deposits some coin amount for a veNFT.
-	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
-	// ExtendTime extends locking duration for a veNFT.
-	ExtendTime(context.Context, *MsgExtendTime) (*MsgExtendTimeResponse, error)
-	// Merge merges a veNFT (burn it) to another veNFT.
-	Merge(context.Context, *MsgMerge) (*MsgMergeResponse, error)
-	// Withdraw withdraws all coin amount of a veNFT.
-	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
+func (m *MsgSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplit.Merge(m, src)
+}
+func (m *MsgSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplit.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgSplit proto.InternalMessageInfo
+
+type MsgSplitResponse struct {
+	// IDs of the new veNFTs, in the order of the split amounts
+	VeIds []string `protobuf:"bytes,1,rep,name=ve_ids,json=veIds,proto3" json:"ve_ids,omitempty"`
 }
 
-func (*UnimplementedMsgServer) Create(ctx context.Context, req *MsgCreate) (*MsgCreateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+func (m *MsgSplitResponse) Reset()         { *m = MsgSplitResponse{} }
+func (m *MsgSplitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitResponse) ProtoMessage()    {}
+func (*MsgSplitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_831fe77ee15459b8, []int{13}
 }
-func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+func (m *MsgSplitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ExtendTime(ctx context.Context, req *MsgExtendTime) (*MsgExtendTimeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExtendTime not implemented")
+func (m *MsgSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Merge(ctx context.Context, req *MsgMerge) (*MsgMergeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Merge not implemented")
+func (m *MsgSplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+func (m *MsgSplitResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgSplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitResponse.DiscardUnknown(m)
 }
 
-func _Msg_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Create(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.ve.v1.Msg/Create",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Create(ctx, req.(*MsgCreate))
+var xxx_messageInfo_MsgSplitResponse proto.InternalMessageInfo
+
+func (m *MsgSplitResponse) GetVeIds() []string {
+	if m != nil {
+		return m.VeIds
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeposit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Deposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.ve.v1.Msg/Deposit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgLockPermanent struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	VeId   string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty" yaml:"ve_id"`
 }
 
-func _Msg_ExtendTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgExtendTime)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ExtendTime(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.ve.v1.Msg/ExtendTime",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ExtendTime(ctx, req.(*MsgExtendTime))
+func (m *MsgLockPermanent) Reset()         { *m = MsgLockPermanent{} }
+func (m *MsgLockPermanent) String() string { return proto.CompactTextString(m) }
+func (*MsgLockPermanent) ProtoMessage()    {}
+func (*MsgLockPermanent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_831fe77ee15459b8, []int{14}
+}
+func (m *MsgLockPermanent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockPermanent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockPermanent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgLockPermanent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockPermanent.Merge(m, src)
+}
+func (m *MsgLockPermanent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockPermanent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockPermanent.DiscardUnknown(m)
 }
 
-func _Msg_Merge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMerge)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Merge(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.ve.v1.Msg/Merge",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Merge(ctx, req.(*MsgMerge))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgLockPermanent proto.InternalMessageInfo
+
+type MsgLockPermanentResponse struct {
 }
 
-func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdraw)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Withdraw(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warmage.ve.v1.Msg/Withdraw",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdraw))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgLockPermanentResponse) Reset()         { *m = MsgLockPermanentResponse{} }
+func (m *MsgLockPermanentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLockPermanentResponse) ProtoMessage()    {}
+func (*MsgLockPermanentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_831fe77ee15459b8, []int{15}
 }
-
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "warmage.ve.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Create",
-			Handler:    _Msg_Create_Handler,
-		},
-		{
-			MethodName: "Deposit",
-			Handler:    _Msg_Deposit_Handler,
-		},
-		{
-			MethodName: "ExtendTime",
-			Handler:    _Msg_ExtendTime_Handler,
-		},
-		{
-			MethodName: "Merge",
-			Handler:    _Msg_Merge_Handler,
-		},
-		{
-			MethodName: "Withdraw",
-			Handler:    _Msg_Withdraw_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "warmage/ve/v1/tx.proto",
+func (m *MsgLockPermanentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgCreate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgLockPermanentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockPermanentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgLockPermanentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockPermanentResponse.Merge(m, src)
+}
+func (m *MsgLockPermanentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockPermanentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockPermanentResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgLockPermanentResponse proto.InternalMessageInfo
+
+type MsgUnlockPermanent struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	VeId   string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty" yaml:"ve_id"`
 }
 
-func (m *MsgCreate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LockDuration != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LockDuration))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUnlockPermanent) Reset()         { *m = MsgUnlockPermanent{} }
+func (m *MsgUnlockPermanent) String() string { return proto.CompactTextString(m) }
+func (*MsgUnlockPermanent) ProtoMessage()    {}
+func (*MsgUnlockPermanent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_831fe77ee15459b8, []int{16}
+}
+func (m *MsgUnlockPermanent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlockPermanent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlockPermanent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUnlockPermanent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlockPermanent.Merge(m, src)
+}
+func (m *MsgUnlockPermanent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlockPermanent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlockPermanent.DiscardUnknown(m)
 }
 
-func (m *MsgCreateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUnlockPermanent proto.InternalMessageInfo
+
+type MsgUnlockPermanentResponse struct {
+	UnlockTime uint64 `protobuf:"varint,1,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty"`
 }
 
-func (m *MsgCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnlockTime != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UnlockTime))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.VeId) > 0 {
-		i -= len(m.VeId)
-		copy(dAtA[i:], m.VeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgUnlockPermanentResponse) Reset()         { *m = MsgUnlockPermanentResponse{} }
+func (m *MsgUnlockPermanentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnlockPermanentResponse) ProtoMessage()    {}
+func (*MsgUnlockPermanentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_831fe77ee15459b8, []int{17}
+}
+func (m *MsgUnlockPermanentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlockPermanentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlockPermanentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUnlockPermanentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlockPermanentResponse.Merge(m, src)
+}
+func (m *MsgUnlockPermanentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlockPermanentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlockPermanentResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUnlockPermanentResponse proto.InternalMessageInfo
+
+func (m *MsgUnlockPermanentResponse) GetUnlockTime() uint64 {
+	if m != nil {
+		return m.UnlockTime
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgDelegateVotes struct {
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	VeId      string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty" yaml:"ve_id"`
+	Delegatee string `protobuf:"bytes,3,opt,name=delegatee,proto3" json:"delegatee,omitempty" yaml:"delegatee"`
 }
 
-func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgDelegateVotes) Reset()         { *m = MsgDelegateVotes{} }
+func (m *MsgDelegateVotes) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVotes) ProtoMessage()    {}
+func (*MsgDelegateVotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_831fe77ee15459b8, []int{18}
+}
+func (m *MsgDelegateVotes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVotes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.VeId) > 0 {
-		i -= len(m.VeId)
-		copy(dAtA[i:], m.VeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgDelegateVotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVotes.Merge(m, src)
 }
-
-func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDelegateVotes) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVotes.DiscardUnknown(m)
 }
 
-func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgDelegateVotes proto.InternalMessageInfo
+
+type MsgDelegateVotesResponse struct {
 }
 
-func (m *MsgExtendTime) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDelegateVotesResponse) Reset()         { *m = MsgDelegateVotesResponse{} }
+func (m *MsgDelegateVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVotesResponse) ProtoMessage()    {}
+func (*MsgDelegateVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_831fe77ee15459b8, []int{19}
+}
+func (m *MsgDelegateVotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgDelegateVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVotesResponse.Merge(m, src)
+}
+func (m *MsgDelegateVotesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVotesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgExtendTime) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgDelegateVotesResponse proto.InternalMessageInfo
+
+type MsgUndelegateVotes struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	VeId   string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty" yaml:"ve_id"`
 }
 
-func (m *MsgExtendTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LockDuration != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LockDuration))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.VeId) > 0 {
-		i -= len(m.VeId)
-		copy(dAtA[i:], m.VeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgUndelegateVotes) Reset()         { *m = MsgUndelegateVotes{} }
+func (m *MsgUndelegateVotes) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateVotes) ProtoMessage()    {}
+func (*MsgUndelegateVotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_831fe77ee15459b8, []int{20}
+}
+func (m *MsgUndelegateVotes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateVotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateVotes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUndelegateVotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateVotes.Merge(m, src)
+}
+func (m *MsgUndelegateVotes) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateVotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateVotes.DiscardUnknown(m)
 }
 
-func (m *MsgExtendTimeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUndelegateVotes proto.InternalMessageInfo
+
+type MsgUndelegateVotesResponse struct {
+}
+
+func (m *MsgUndelegateVotesResponse) Reset()         { *m = MsgUndelegateVotesResponse{} }
+func (m *MsgUndelegateVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateVotesResponse) ProtoMessage()    {}
+func (*MsgUndelegateVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_831fe77ee15459b8, []int{21}
+}
+func (m *MsgUndelegateVotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateVotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUndelegateVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateVotesResponse.Merge(m, src)
+}
+func (m *MsgUndelegateVotesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateVotesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgExtendTimeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUndelegateVotesResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreate)(nil), "warmage.ve.v1.MsgCreate")
+	proto.RegisterType((*MsgCreateResponse)(nil), "warmage.ve.v1.MsgCreateResponse")
+	proto.RegisterType((*MsgDeposit)(nil), "warmage.ve.v1.MsgDeposit")
+	proto.RegisterType((*MsgDepositResponse)(nil), "warmage.ve.v1.MsgDepositResponse")
+	proto.RegisterType((*MsgExtendTime)(nil), "warmage.ve.v1.MsgExtendTime")
+	proto.RegisterType((*MsgExtendTimeResponse)(nil), "warmage.ve.v1.MsgExtendTimeResponse")
+	proto.RegisterType((*MsgMerge)(nil), "warmage.ve.v1.MsgMerge")
+	proto.RegisterType((*MsgMergeResponse)(nil), "warmage.ve.v1.MsgMergeResponse")
+	proto.RegisterType((*MsgWithdraw)(nil), "warmage.ve.v1.MsgWithdraw")
+	proto.RegisterType((*MsgWithdrawResponse)(nil), "warmage.ve.v1.MsgWithdrawResponse")
+	proto.RegisterType((*MsgWithdrawEarly)(nil), "warmage.ve.v1.MsgWithdrawEarly")
+	proto.RegisterType((*MsgWithdrawEarlyResponse)(nil), "warmage.ve.v1.MsgWithdrawEarlyResponse")
+	proto.RegisterType((*MsgSplit)(nil), "warmage.ve.v1.MsgSplit")
+	proto.RegisterType((*MsgSplitResponse)(nil), "warmage.ve.v1.MsgSplitResponse")
+	proto.RegisterType((*MsgLockPermanent)(nil), "warmage.ve.v1.MsgLockPermanent")
+	proto.RegisterType((*MsgLockPermanentResponse)(nil), "warmage.ve.v1.MsgLockPermanentResponse")
+	proto.RegisterType((*MsgUnlockPermanent)(nil), "warmage.ve.v1.MsgUnlockPermanent")
+	proto.RegisterType((*MsgUnlockPermanentResponse)(nil), "warmage.ve.v1.MsgUnlockPermanentResponse")
+	proto.RegisterType((*MsgDelegateVotes)(nil), "warmage.ve.v1.MsgDelegateVotes")
+	proto.RegisterType((*MsgDelegateVotesResponse)(nil), "warmage.ve.v1.MsgDelegateVotesResponse")
+	proto.RegisterType((*MsgUndelegateVotes)(nil), "warmage.ve.v1.MsgUndelegateVotes")
+	proto.RegisterType((*MsgUndelegateVotesResponse)(nil), "warmage.ve.v1.MsgUndelegateVotesResponse")
 }
 
-func (m *MsgExtendTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("warmage/ve/v1/tx.proto", fileDescriptor_831fe77ee15459b8) }
+
+var fileDescriptor_831fe77ee15459b8 = []byte{
+	// 1081 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0x5e, 0x67, 0x93, 0x4d, 0xf2, 0xd2, 0xa5, 0x89, 0x93, 0x10, 0xc7, 0xa4, 0xeb, 0xad, 0xd5,
+	0x92, 0x0d, 0x52, 0x6c, 0x25, 0x3d, 0x20, 0x2a, 0x55, 0x42, 0xdb, 0x54, 0x22, 0x12, 0x2b, 0x21,
+	0x03, 0x45, 0xea, 0x25, 0xf2, 0xee, 0x4e, 0x5d, 0x93, 0xb5, 0xc7, 0xf2, 0x4c, 0x36, 0xc9, 0x15,
+	0x2e, 0x48, 0x48, 0x08, 0x89, 0x3f, 0x50, 0xc1, 0x8d, 0x23, 0x27, 0xce, 0x9c, 0x7a, 0xac, 0xc4,
+	0x05, 0x71, 0xb0, 0x50, 0xc2, 0xa1, 0xe7, 0xfd, 0x05, 0xc8, 0x33, 0xf6, 0xc4, 0x5e, 0x67, 0xd9,
+	0x6d, 0xd5, 0x3d, 0x25, 0x9e, 0xf7, 0xcd, 0xfb, 0xbe, 0xf7, 0xcd, 0xcc, 0x9b, 0x59, 0x78, 0xf7,
+	0xd4, 0x0e, 0x3d, 0xdb, 0x41, 0x66, 0x1f, 0x99, 0xfd, 0x3d, 0x93, 0x9e, 0x19, 0x41, 0x88, 0x29,
+	0x96, 0xab, 0xc9, 0xb8, 0xd1, 0x47, 0x46, 0x7f, 0x4f, 0x5d, 0x73, 0xb0, 0x83, 0x59, 0xc4, 0x8c,
+	0xff, 0xe3, 0x20, 0x75, 0xcb, 0xc1, 0xd8, 0xe9, 0x21, 0xd3, 0x0e, 0x5c, 0xd3, 0xf6, 0x7d, 0x4c,
+	0x6d, 0xea, 0x62, 0x9f, 0x24, 0xd1, 0x5a, 0x07, 0x13, 0x0f, 0x13, 0xb3, 0x6d, 0x93, 0x38, 0x77,
+	0x1b, 0x51, 0x7b, 0xcf, 0xec, 0x60, 0xd7, 0xe7, 0x71, 0xfd, 0x95, 0x04, 0x8b, 0x2d, 0xe2, 0x3c,
+	0x0c, 0x91, 0x4d, 0x91, 0xbc, 0x03, 0x15, 0x82, 0xfc, 0x2e, 0x0a, 0x15, 0xa9, 0x2e, 0x35, 0x16,
+	0x9b, 0x2b, 0x83, 0x48, 0xab, 0x9e, 0xdb, 0x5e, 0xef, 0xbe, 0xce, 0xc7, 0x75, 0x2b, 0x01, 0xc8,
+	0xb7, 0x60, 0x86, 0x62, 0x65, 0x86, 0xc1, 0xaa, 0x83, 0x48, 0x5b, 0xe4, 0x30, 0x8a, 0x75, 0x6b,
+	0x86, 0x62, 0xf9, 0x13, 0xa8, 0xd8, 0x1e, 0x3e, 0xf1, 0xa9, 0x52, 0xae, 0x4b, 0x8d, 0xa5, 0xfd,
+	0x4d, 0x83, 0x0b, 0x31, 0x62, 0x21, 0x46, 0x22, 0xc4, 0x78, 0x88, 0x5d, 0xbf, 0xb9, 0xfe, 0x22,
+	0xd2, 0x4a, 0x57, 0x44, 0x7c, 0x9a, 0x6e, 0x25, 0xf3, 0xe5, 0x07, 0x50, 0xed, 0xe1, 0xce, 0xf1,
+	0x51, 0xf7, 0x24, 0x64, 0x95, 0x29, 0xb3, 0x75, 0xa9, 0x31, 0xdb, 0x54, 0x06, 0x91, 0xb6, 0xc6,
+	0x67, 0xe4, 0xc2, 0xba, 0x75, 0x23, 0xfe, 0x3e, 0x48, 0x3e, 0xef, 0x2f, 0x7c, 0xf7, 0x5c, 0x2b,
+	0xbd, 0x7a, 0xae, 0x95, 0xf4, 0x43, 0x58, 0x11, 0x95, 0x5a, 0x88, 0x04, 0xd8, 0x27, 0x48, 0x5e,
+	0x85, 0xb9, 0x3e, 0x3a, 0x72, 0xbb, 0xbc, 0x60, 0x6b, 0xb6, 0x8f, 0x0e, 0xbb, 0xb2, 0x06, 0x4b,
+	0x27, 0x3e, 0xcb, 0x4a, 0x5d, 0x0f, 0xb1, 0x22, 0x67, 0x2d, 0xe0, 0x43, 0x5f, 0xb8, 0x1e, 0xd2,
+	0x7f, 0x93, 0x00, 0x5a, 0xc4, 0x39, 0x40, 0x01, 0x26, 0x2e, 0x7d, 0x1d, 0xdb, 0xee, 0xa6, 0x7c,
+	0xdc, 0xb9, 0xe5, 0x41, 0xa4, 0xdd, 0xe0, 0x48, 0x36, 0xac, 0x27, 0x0a, 0xde, 0x9a, 0x7d, 0x99,
+	0xfa, 0xd7, 0x40, 0xbe, 0xd2, 0x9c, 0x1a, 0xa0, 0xff, 0x2a, 0x41, 0xb5, 0x45, 0x9c, 0x47, 0x67,
+	0x14, 0xf9, 0xdd, 0xb8, 0xb8, 0x29, 0x54, 0x53, 0x58, 0xc2, 0xf2, 0x1b, 0x2e, 0xe1, 0x06, 0xac,
+	0xe7, 0xb4, 0x8a, 0x2a, 0x7e, 0x91, 0x60, 0xa1, 0x45, 0x9c, 0x16, 0x0a, 0x9d, 0xd7, 0x2a, 0xe0,
+	0x1e, 0xc0, 0xd3, 0x10, 0x7b, 0x47, 0xd9, 0x2a, 0xd6, 0x07, 0x91, 0xb6, 0xc2, 0xe1, 0x57, 0x31,
+	0xdd, 0x5a, 0x88, 0x3f, 0x1e, 0xc7, 0xe5, 0xec, 0xc2, 0x02, 0xc5, 0xc9, 0x94, 0x32, 0x9b, 0xb2,
+	0x3a, 0x88, 0xb4, 0x9b, 0xe9, 0x01, 0x48, 0x27, 0x54, 0x28, 0x8e, 0xe1, 0x19, 0xf9, 0x32, 0x2c,
+	0xa7, 0x22, 0x85, 0x72, 0x17, 0x96, 0x5a, 0xc4, 0xf9, 0xca, 0xa5, 0xcf, 0xba, 0xa1, 0x7d, 0xfa,
+	0xf6, 0xcd, 0xcf, 0xd0, 0xaf, 0xc3, 0x6a, 0x86, 0x4a, 0x28, 0xf0, 0x61, 0x39, 0x33, 0xfc, 0xc8,
+	0x0e, 0x7b, 0xe7, 0x53, 0x95, 0xf1, 0x83, 0x04, 0xca, 0x30, 0xa1, 0x38, 0x8f, 0x1f, 0x8a, 0x8d,
+	0x2f, 0x8d, 0xdb, 0xf8, 0xb3, 0xf1, 0xc6, 0x17, 0x6d, 0xe2, 0x23, 0x98, 0x0f, 0x90, 0x6f, 0xf7,
+	0xe8, 0xb9, 0x32, 0x33, 0xd9, 0xcc, 0x14, 0xaf, 0xff, 0xc1, 0x37, 0xcf, 0xe7, 0x41, 0x6f, 0x2a,
+	0x67, 0xf9, 0x09, 0xcc, 0x73, 0x8d, 0x44, 0x29, 0xd7, 0xcb, 0x8d, 0xc5, 0xe6, 0xc7, 0x31, 0xfd,
+	0xdf, 0x91, 0xf6, 0xbe, 0xe3, 0xd2, 0x67, 0x27, 0x6d, 0xa3, 0x83, 0x3d, 0x33, 0x69, 0xd3, 0xfc,
+	0xcf, 0x2e, 0xe9, 0x1e, 0x9b, 0xf4, 0x3c, 0x40, 0xc4, 0x38, 0xf4, 0xe9, 0x20, 0xd2, 0xde, 0xc9,
+	0x9e, 0x6d, 0xa2, 0x5b, 0x69, 0xc2, 0x8c, 0xab, 0x3b, 0xb0, 0x9c, 0xd6, 0x20, 0xcc, 0x5c, 0x87,
+	0x0a, 0x53, 0x42, 0x14, 0x29, 0x26, 0xb6, 0xe6, 0x62, 0x3d, 0x24, 0x59, 0xf0, 0x4f, 0x71, 0xe7,
+	0xf8, 0x33, 0x14, 0x7a, 0xb6, 0x8f, 0x7c, 0x3a, 0xd5, 0x05, 0x57, 0x41, 0x19, 0xe6, 0x13, 0x9b,
+	0x2f, 0x60, 0x4d, 0xe9, 0x4b, 0xbf, 0x97, 0x8d, 0x4e, 0x55, 0xcd, 0x03, 0x50, 0x8b, 0x8c, 0xc2,
+	0xb2, 0xa1, 0xd6, 0x2f, 0x15, 0x5a, 0xff, 0xcf, 0x12, 0x73, 0xef, 0x00, 0xf5, 0x90, 0x63, 0x53,
+	0xf4, 0x18, 0x53, 0x44, 0xa6, 0xb0, 0x69, 0xf6, 0x61, 0xb1, 0x9b, 0x50, 0xa0, 0xa4, 0xc9, 0xac,
+	0x0d, 0x22, 0x6d, 0x99, 0x43, 0x45, 0x48, 0xb7, 0xae, 0x60, 0x05, 0xc7, 0x73, 0x1a, 0x0b, 0x8e,
+	0x77, 0xa7, 0x5b, 0x41, 0x46, 0xcd, 0x16, 0xa8, 0x45, 0xc6, 0x54, 0xcf, 0xfe, 0xef, 0x00, 0xe5,
+	0x16, 0x71, 0xe4, 0xa7, 0x50, 0x49, 0x5e, 0x21, 0x8a, 0x91, 0x7b, 0xf7, 0x18, 0xe2, 0xd6, 0x56,
+	0xeb, 0xa3, 0x22, 0xa2, 0xba, 0xfa, 0x37, 0x7f, 0xfe, 0xfb, 0xd3, 0x8c, 0x2a, 0x2b, 0xe6, 0xf0,
+	0x9b, 0xca, 0xec, 0xf0, 0xec, 0x5f, 0xc3, 0x7c, 0x7a, 0x6f, 0x6f, 0x16, 0xd3, 0x25, 0x21, 0xf5,
+	0xf6, 0xc8, 0x90, 0xa0, 0xba, 0xcd, 0xa8, 0xde, 0x93, 0x37, 0x8b, 0x54, 0xdd, 0x84, 0xe0, 0x14,
+	0x20, 0x73, 0xb1, 0x6e, 0x15, 0x73, 0x5e, 0x45, 0xd5, 0x3b, 0xff, 0x17, 0x15, 0xa4, 0x77, 0x19,
+	0xa9, 0x26, 0xdf, 0x2a, 0x92, 0x22, 0x86, 0x66, 0xfb, 0x56, 0x6e, 0xc3, 0x1c, 0xbf, 0x0b, 0x37,
+	0x8a, 0x59, 0x59, 0x40, 0xd5, 0x46, 0x04, 0x04, 0x93, 0xc6, 0x98, 0x36, 0xe5, 0x8d, 0x22, 0x93,
+	0xc7, 0x52, 0xfb, 0xb0, 0x20, 0xae, 0x2d, 0xb5, 0x98, 0x2d, 0x8d, 0xa9, 0xfa, 0xe8, 0x98, 0x20,
+	0xd3, 0x19, 0xd9, 0x96, 0xac, 0x16, 0xc9, 0x4e, 0x53, 0x8e, 0x6f, 0x25, 0xa8, 0xe6, 0x6f, 0x29,
+	0x6d, 0x74, 0x66, 0x06, 0x50, 0xb7, 0xc7, 0x00, 0x04, 0x7f, 0x83, 0xf1, 0xeb, 0x72, 0x7d, 0x34,
+	0xff, 0x11, 0x62, 0x9c, 0x6d, 0x98, 0xe3, 0x17, 0xc5, 0x35, 0xce, 0xb2, 0x80, 0xaa, 0x8d, 0x08,
+	0x4c, 0xe2, 0x2c, 0x61, 0xa9, 0xe3, 0x4a, 0xf3, 0xed, 0xf9, 0x9a, 0x9c, 0x39, 0x80, 0xba, 0x3d,
+	0x06, 0x30, 0x49, 0xa5, 0xac, 0xed, 0x05, 0x82, 0xf3, 0x7b, 0x09, 0x6e, 0x0e, 0x37, 0xe6, 0x6b,
+	0x8e, 0xc5, 0x10, 0x44, 0xdd, 0x19, 0x0b, 0x11, 0x5a, 0x3e, 0x60, 0x5a, 0xee, 0xc8, 0x7a, 0x51,
+	0xcb, 0x89, 0x3f, 0xa4, 0x26, 0xf6, 0x24, 0xdf, 0x74, 0xb5, 0xeb, 0x8e, 0x68, 0x06, 0xa0, 0x6e,
+	0x8f, 0x01, 0x4c, 0xe2, 0x49, 0xda, 0xb3, 0x8e, 0xfa, 0x8c, 0x93, 0x7b, 0x92, 0x6f, 0x9d, 0xd7,
+	0x7a, 0x92, 0x83, 0xa8, 0x3b, 0x63, 0x21, 0x93, 0x79, 0x92, 0x57, 0xd3, 0x6c, 0xbe, 0xb8, 0xa8,
+	0x49, 0x2f, 0x2f, 0x6a, 0xd2, 0x3f, 0x17, 0x35, 0xe9, 0xc7, 0xcb, 0x5a, 0xe9, 0xe5, 0x65, 0xad,
+	0xf4, 0xd7, 0x65, 0xad, 0xf4, 0xa4, 0x91, 0x79, 0x5a, 0x04, 0x88, 0x86, 0xee, 0x6e, 0xcf, 0x6e,
+	0x13, 0x91, 0xf2, 0x2c, 0x4e, 0xca, 0x1e, 0x18, 0xed, 0x0a, 0xfb, 0x1d, 0x78, 0xef, 0xbf, 0x01,
+	0x00, 0x7c, 0xc2, 0x82, 0xf1, 0x84, 0x0e, 0x00, 0x00,
 }
 
-func (m *MsgMerge) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Create creates a veNFT.
+	Create(ctx context.Context, in *MsgCreate, opts ...grpc.CallOption) (*MsgCreateResponse, error)
+	// Deposit deposits some coin amount for a veNFT.
+	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
+	// ExtendTime extends locking duration for a veNFT.
+	ExtendTime(ctx context.Context, in *MsgExtendTime, opts ...grpc.CallOption) (*MsgExtendTimeResponse, error)
+	// Merge merges a veNFT (burn it) to another veNFT.
+	Merge(ctx context.Context, in *MsgMerge, opts ...grpc.CallOption) (*MsgMergeResponse, error)
+	// Withdraw withdraws all coin amount of a veNFT.
+	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
+	// WithdrawEarly withdraws all coin amount of a veNFT before its unlocking
+	// time, minus a penalty proportional to the remaining locking time.
+	WithdrawEarly(ctx context.Context, in *MsgWithdrawEarly, opts ...grpc.CallOption) (*MsgWithdrawEarlyResponse, error)
+	// Split splits some coin amounts of a veNFT into new veNFTs with the same
+	// unlocking time.
+	Split(ctx context.Context, in *MsgSplit, opts ...grpc.CallOption) (*MsgSplitResponse, error)
+	// LockPermanent locks a veNFT permanently, keeping its voting power equal
+	// to its locked amount without decay.
+	LockPermanent(ctx context.Context, in *MsgLockPermanent, opts ...grpc.CallOption) (*MsgLockPermanentResponse, error)
+	// UnlockPermanent unlocks a permanently locked veNFT, which starts decaying
+	// from the max locking duration.
+	UnlockPermanent(ctx context.Context, in *MsgUnlockPermanent, opts ...grpc.CallOption) (*MsgUnlockPermanentResponse, error)
+	// DelegateVotes delegates the voting power of a veNFT to another address
+	// for voting in the voter module, without transferring the veNFT.
+	DelegateVotes(ctx context.Context, in *MsgDelegateVotes, opts ...grpc.CallOption) (*MsgDelegateVotesResponse, error)
+	// UndelegateVotes revokes the vote delegation of a veNFT.
+	UndelegateVotes(ctx context.Context, in *MsgUndelegateVotes, opts ...grpc.CallOption) (*MsgUndelegateVotesResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Create(ctx context.Context, in *MsgCreate, opts ...grpc.CallOption) (*MsgCreateResponse, error) {
+	out := new(MsgCreateResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Msg/Create", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgMerge) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
+	out := new(MsgDepositResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Msg/Deposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgMerge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ToVeId) > 0 {
-		i -= len(m.ToVeId)
-		copy(dAtA[i:], m.ToVeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToVeId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.FromVeId) > 0 {
-		i -= len(m.FromVeId)
-		copy(dAtA[i:], m.FromVeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromVeId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ExtendTime(ctx context.Context, in *MsgExtendTime, opts ...grpc.CallOption) (*MsgExtendTimeResponse, error) {
+	out := new(MsgExtendTimeResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Msg/ExtendTime", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgMergeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Merge(ctx context.Context, in *MsgMerge, opts ...grpc.CallOption) (*MsgMergeResponse, error) {
+	out := new(MsgMergeResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Msg/Merge", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgMergeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
+	out := new(MsgWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Msg/Withdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgMergeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) WithdrawEarly(ctx context.Context, in *MsgWithdrawEarly, opts ...grpc.CallOption) (*MsgWithdrawEarlyResponse, error) {
+	out := new(MsgWithdrawEarlyResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Msg/WithdrawEarly", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgWithdraw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Split(ctx context.Context, in *MsgSplit, opts ...grpc.CallOption) (*MsgSplitResponse, error) {
+	out := new(MsgSplitResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Msg/Split", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgWithdraw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) LockPermanent(ctx context.Context, in *MsgLockPermanent, opts ...grpc.CallOption) (*MsgLockPermanentResponse, error) {
+	out := new(MsgLockPermanentResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Msg/LockPermanent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VeId) > 0 {
-		i -= len(m.VeId)
-		copy(dAtA[i:], m.VeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) UnlockPermanent(ctx context.Context, in *MsgUnlockPermanent, opts ...grpc.CallOption) (*MsgUnlockPermanentResponse, error) {
+	out := new(MsgUnlockPermanentResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Msg/UnlockPermanent", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) DelegateVotes(ctx context.Context, in *MsgDelegateVotes, opts ...grpc.CallOption) (*MsgDelegateVotesResponse, error) {
+	out := new(MsgDelegateVotesResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Msg/DelegateVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UndelegateVotes(ctx context.Context, in *MsgUndelegateVotes, opts ...grpc.CallOption) (*MsgUndelegateVotesResponse, error) {
+	out := new(MsgUndelegateVotesResponse)
+	err := c.cc.Invoke(ctx, "/warmage.ve.v1.Msg/UndelegateVotes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Create creates a veNFT.
+	Create(context.Context, *MsgCreate) (*MsgCreateResponse, error)
+	// Deposit deposits some coin amount for a veNFT.
+	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
+	// ExtendTime extends locking duration for a veNFT.
+	ExtendTime(context.Context, *MsgExtendTime) (*MsgExtendTimeResponse, error)
+	// Merge merges a veNFT (burn it) to another veNFT.
+	Merge(context.Context, *MsgMerge) (*MsgMergeResponse, error)
+	// Withdraw withdraws all coin amount of a veNFT.
+	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
+	// WithdrawEarly withdraws all coin amount of a veNFT before its unlocking
+	// time, minus a penalty proportional to the remaining locking time.
+	WithdrawEarly(context.Context, *MsgWithdrawEarly) (*MsgWithdrawEarlyResponse, error)
+	// Split splits some coin amounts of a veNFT into new veNFTs with the same
+	// unlocking time.
+	Split(context.Context, *MsgSplit) (*MsgSplitResponse, error)
+	// LockPermanent locks a veNFT permanently, keeping its voting power equal
+	// to its locked amount without decay.
+	LockPermanent(context.Context, *MsgLockPermanent) (*MsgLockPermanentResponse, error)
+	// UnlockPermanent unlocks a permanently locked veNFT, which starts decaying
+	// from the max locking duration.
+	UnlockPermanent(context.Context, *MsgUnlockPermanent) (*MsgUnlockPermanentResponse, error)
+	// DelegateVotes delegates the voting power of a veNFT to another address
+	// for voting in the voter module, without transferring the veNFT.
+	DelegateVotes(context.Context, *MsgDelegateVotes) (*MsgDelegateVotesResponse, error)
+	// UndelegateVotes revokes the vote delegation of a veNFT.
+	UndelegateVotes(context.Context, *MsgUndelegateVotes) (*MsgUndelegateVotesResponse, error)
 }
 
-func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (*UnimplementedMsgServer) Create(ctx context.Context, req *MsgCreate) (*MsgCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (*UnimplementedMsgServer) ExtendTime(ctx context.Context, req *MsgExtendTime) (*MsgExtendTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendTime not implemented")
+}
+func (*UnimplementedMsgServer) Merge(ctx context.Context, req *MsgMerge) (*MsgMergeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Merge not implemented")
+}
+func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawEarly(ctx context.Context, req *MsgWithdrawEarly) (*MsgWithdrawEarlyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawEarly not implemented")
+}
+func (*UnimplementedMsgServer) Split(ctx context.Context, req *MsgSplit) (*MsgSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Split not implemented")
+}
+func (*UnimplementedMsgServer) LockPermanent(ctx context.Context, req *MsgLockPermanent) (*MsgLockPermanentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockPermanent not implemented")
+}
+func (*UnimplementedMsgServer) UnlockPermanent(ctx context.Context, req *MsgUnlockPermanent) (*MsgUnlockPermanentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockPermanent not implemented")
+}
+func (*UnimplementedMsgServer) DelegateVotes(ctx context.Context, req *MsgDelegateVotes) (*MsgDelegateVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateVotes not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateVotes(ctx context.Context, req *MsgUndelegateVotes) (*MsgUndelegateVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateVotes not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreate)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	if interceptor == nil {
+		return srv.(MsgServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Msg/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Create(ctx, req.(*MsgCreate))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *MsgCreate) Size() (n int) {
-	if m == nil {
-		return 0
+
+func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).Deposit(ctx, in)
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Msg/Deposit",
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.LockDuration != 0 {
-		n += 1 + sovTx(uint64(m.LockDuration))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ExtendTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendTime)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendTime(ctx, in)
 	}
-	if m.UnlockTime != 0 {
-		n += 1 + sovTx(uint64(m.UnlockTime))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Msg/ExtendTime",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendTime(ctx, req.(*MsgExtendTime))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Merge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMerge)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).Merge(ctx, in)
 	}
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Msg/Merge",
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Merge(ctx, req.(*MsgMerge))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdraw)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Msg/Withdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdraw))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgExtendTime) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_WithdrawEarly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawEarly)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawEarly(ctx, in)
 	}
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Msg/WithdrawEarly",
 	}
-	if m.LockDuration != 0 {
-		n += 1 + sovTx(uint64(m.LockDuration))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawEarly(ctx, req.(*MsgWithdrawEarly))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgExtendTimeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Split_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSplit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).Split(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Msg/Split",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Split(ctx, req.(*MsgSplit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMerge) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_LockPermanent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockPermanent)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).LockPermanent(ctx, in)
 	}
-	l = len(m.FromVeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Msg/LockPermanent",
 	}
-	l = len(m.ToVeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockPermanent(ctx, req.(*MsgLockPermanent))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMergeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UnlockPermanent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnlockPermanent)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UnlockPermanent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Msg/UnlockPermanent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnlockPermanent(ctx, req.(*MsgUnlockPermanent))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DelegateVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateVotes)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateVotes(ctx, in)
 	}
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Msg/DelegateVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateVotes(ctx, req.(*MsgDelegateVotes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateVotes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warmage.ve.v1.Msg/UndelegateVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateVotes(ctx, req.(*MsgUndelegateVotes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "warmage.ve.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _Msg_Create_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _Msg_Deposit_Handler,
+		},
+		{
+			MethodName: "ExtendTime",
+			Handler:    _Msg_ExtendTime_Handler,
+		},
+		{
+			MethodName: "Merge",
+			Handler:    _Msg_Merge_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _Msg_Withdraw_Handler,
+		},
+		{
+			MethodName: "WithdrawEarly",
+			Handler:    _Msg_WithdrawEarly_Handler,
+		},
+		{
+			MethodName: "Split",
+			Handler:    _Msg_Split_Handler,
+		},
+		{
+			MethodName: "LockPermanent",
+			Handler:    _Msg_LockPermanent_Handler,
+		},
+		{
+			MethodName: "UnlockPermanent",
+			Handler:    _Msg_UnlockPermanent_Handler,
+		},
+		{
+			MethodName: "DelegateVotes",
+			Handler:    _Msg_DelegateVotes_Handler,
+		},
+		{
+			MethodName: "UndelegateVotes",
+			Handler:    _Msg_UndelegateVotes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "warmage/ve/v1/tx.proto",
+}
+
+func (m *MsgCreate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockDuration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockDuration))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnlockTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnlockTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockDuration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockDuration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMerge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMerge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMerge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToVeId) > 0 {
+		i -= len(m.ToVeId)
+		copy(dAtA[i:], m.ToVeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToVeId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromVeId) > 0 {
+		i -= len(m.FromVeId)
+		copy(dAtA[i:], m.FromVeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromVeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMergeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMergeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMergeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawEarly) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawEarly) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawEarly) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawEarlyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawEarlyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawEarlyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Penalty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Amounts[iNdEx].Size()
+				i -= size
+				if _, err := m.Amounts[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeIds) > 0 {
+		for iNdEx := len(m.VeIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VeIds[iNdEx])
+			copy(dAtA[i:], m.VeIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.VeIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockPermanent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockPermanent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockPermanent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockPermanentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockPermanentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockPermanentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlockPermanent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlockPermanent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlockPermanent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlockPermanentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlockPermanentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlockPermanentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnlockTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnlockTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegatee) > 0 {
+		i -= len(m.Delegatee)
+		copy(dAtA[i:], m.Delegatee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegatee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.LockDuration != 0 {
+		n += 1 + sovTx(uint64(m.LockDuration))
+	}
+	return n
+}
+
+func (m *MsgCreateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnlockTime != 0 {
+		n += 1 + sovTx(uint64(m.UnlockTime))
+	}
+	return n
+}
+
+func (m *MsgDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExtendTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockDuration != 0 {
+		n += 1 + sovTx(uint64(m.LockDuration))
+	}
+	return n
+}
+
+func (m *MsgExtendTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMerge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromVeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToVeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMergeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawEarly) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawEarlyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Penalty.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VeIds) > 0 {
+		for _, s := range m.VeIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgLockPermanent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLockPermanentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnlockPermanent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnlockPermanentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnlockTime != 0 {
+		n += 1 + sovTx(uint64(m.UnlockTime))
+	}
+	return n
+}
+
+func (m *MsgDelegateVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegatee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegateVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegateVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUndelegateVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			m.LockDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			m.UnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			m.LockDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMerge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMerge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMerge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromVeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToVeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMergeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMergeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMergeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawEarly) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawEarly: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawEarly: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawEarlyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawEarlyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawEarlyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Penalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgWithdrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreate) Unmarshal(dAtA []byte) error {
+func (m *MsgSplit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1261,10 +3849,10 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1301,7 +3889,7 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1329,13 +3917,13 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1345,44 +3933,28 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.Amounts = append(m.Amounts, v)
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
-			}
-			m.LockDuration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LockDuration |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1404,7 +3976,7 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1427,15 +3999,15 @@ func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VeIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1463,27 +4035,8 @@ func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VeId = string(dAtA[iNdEx:postIndex])
+			m.VeIds = append(m.VeIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
-			}
-			m.UnlockTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnlockTime |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1505,7 +4058,7 @@ func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgLockPermanent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1528,10 +4081,10 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLockPermanent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLockPermanent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1598,39 +4151,6 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			}
 			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1652,7 +4172,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLockPermanentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1675,10 +4195,10 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLockPermanentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLockPermanentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1702,7 +4222,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendTime) Unmarshal(dAtA []byte) error {
+func (m *MsgUnlockPermanent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1725,10 +4245,10 @@ func (m *MsgExtendTime) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendTime: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnlockPermanent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendTime: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnlockPermanent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1795,25 +4315,6 @@ func (m *MsgExtendTime) Unmarshal(dAtA []byte) error {
 			}
 			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
-			}
-			m.LockDuration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LockDuration |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1835,7 +4336,7 @@ func (m *MsgExtendTime) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendTimeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnlockPermanentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1858,12 +4359,31 @@ func (m *MsgExtendTimeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendTimeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnlockPermanentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnlockPermanentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			m.UnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1885,7 +4405,7 @@ func (m *MsgExtendTimeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMerge) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateVotes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1908,10 +4428,10 @@ func (m *MsgMerge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMerge: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateVotes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMerge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateVotes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1948,7 +4468,7 @@ func (m *MsgMerge) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromVeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1976,11 +4496,11 @@ func (m *MsgMerge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromVeId = string(dAtA[iNdEx:postIndex])
+			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToVeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2008,7 +4528,7 @@ func (m *MsgMerge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToVeId = string(dAtA[iNdEx:postIndex])
+			m.Delegatee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2031,7 +4551,7 @@ func (m *MsgMerge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMergeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateVotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2054,10 +4574,10 @@ func (m *MsgMergeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMergeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateVotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMergeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2081,7 +4601,7 @@ func (m *MsgMergeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateVotes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2104,10 +4624,10 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateVotes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateVotes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2195,7 +4715,7 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateVotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2218,10 +4738,10 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateVotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
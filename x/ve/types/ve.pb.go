@@ -29,15 +29,25 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type LockedBalance struct {
 	// locked amount
 	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
-	// unlocking unix time
+	// unlocking unix time, zero if permanently locked
 	End uint64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	// whether permanently locked, i.e., voting power equals locked amount
+	// without decay until unlocked
+	IsPermanent bool `protobuf:"varint,3,opt,name=is_permanent,json=isPermanent,proto3" json:"is_permanent,omitempty"`
+	// locked denom; empty for locks created before other denoms were lockable,
+	// which lock the lock denom
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// voting power weight of the locked denom, fixed when the lock is created;
+	// nil for locks created before other denoms were lockable, which are weighted
+	// one
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
 }
 
 func (m *LockedBalance) Reset()         { *m = LockedBalance{} }
 func (m *LockedBalance) String() string { return proto.CompactTextString(m) }
 func (*LockedBalance) ProtoMessage()    {}
 func (*LockedBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05643485793599a7, []int{0}
+	return fileDescriptor_4f3fe893280a38d7, []int{0}
 }
 func (m *LockedBalance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -73,6 +83,20 @@ func (m *LockedBalance) GetEnd() uint64 {
 	return 0
 }
 
+func (m *LockedBalance) GetIsPermanent() bool {
+	if m != nil {
+		return m.IsPermanent
+	}
+	return false
+}
+
+func (m *LockedBalance) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // Checkpoint defines a checkpoint of voting power.
 type Checkpoint struct {
 	// voting power at checkpoint
@@ -84,13 +108,16 @@ type Checkpoint struct {
 	Timestamp uint64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	// block height at checkpoint
 	Block int64 `protobuf:"varint,4,opt,name=block,proto3" json:"block,omitempty"`
+	// permanently locked amount at checkpoint, which adds to voting power
+	// without decay
+	Permanent github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=permanent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"permanent"`
 }
 
 func (m *Checkpoint) Reset()         { *m = Checkpoint{} }
 func (m *Checkpoint) String() string { return proto.CompactTextString(m) }
 func (*Checkpoint) ProtoMessage()    {}
 func (*Checkpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05643485793599a7, []int{1}
+	return fileDescriptor_4f3fe893280a38d7, []int{1}
 }
 func (m *Checkpoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -138,30 +165,34 @@ func init() {
 	proto.RegisterType((*Checkpoint)(nil), "warmage.ve.v1.Checkpoint")
 }
 
-func init() { proto.RegisterFile("warmage/ve/v1/ve.proto", fileDescriptor_05643485793599a7) }
+func init() { proto.RegisterFile("warmage/ve/v1/ve.proto", fileDescriptor_4f3fe893280a38d7) }
 
-var fileDescriptor_05643485793599a7 = []byte{
-	// 310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xcb, 0x4a, 0x03, 0x31,
-	0x14, 0x86, 0x27, 0xf6, 0x02, 0x0d, 0x14, 0x24, 0x14, 0x19, 0x8a, 0xa4, 0xa5, 0x0b, 0x29, 0x42,
-	0x27, 0x14, 0xdf, 0x60, 0x2c, 0x82, 0xe0, 0xaa, 0x4b, 0x77, 0x99, 0xf4, 0xd0, 0x86, 0x99, 0xc9,
-	0x19, 0x9a, 0x74, 0x50, 0x9f, 0xc2, 0xc7, 0x2a, 0xae, 0xba, 0x14, 0x17, 0x45, 0x3a, 0x2f, 0x22,
-	0x73, 0x11, 0xdd, 0xea, 0x2a, 0xe7, 0x92, 0xef, 0xfc, 0x3f, 0xfc, 0xf4, 0x22, 0x85, 0x6d, 0xa2,
-	0xd1, 0x88, 0x1c, 0x44, 0x3e, 0x17, 0x39, 0x04, 0xd9, 0x16, 0x1d, 0xb2, 0x7e, 0x33, 0x0f, 0x72,
-	0x08, 0xf2, 0xf9, 0x70, 0xb0, 0xc6, 0x35, 0x56, 0x1b, 0x51, 0x56, 0xf5, 0xa7, 0x21, 0x57, 0x68,
-	0x53, 0xb4, 0x22, 0x92, 0xb6, 0xa4, 0x23, 0x70, 0x72, 0x2e, 0x14, 0x6a, 0x53, 0xef, 0x27, 0x9a,
-	0xf6, 0x1f, 0x50, 0xc5, 0xb0, 0x0a, 0x65, 0x22, 0x8d, 0x02, 0x76, 0x47, 0xbb, 0x32, 0xc5, 0x9d,
-	0x71, 0x3e, 0x19, 0x93, 0x69, 0x2f, 0x0c, 0xf6, 0xc7, 0x91, 0xf7, 0x71, 0x1c, 0x5d, 0xad, 0xb5,
-	0xdb, 0xec, 0xa2, 0x40, 0x61, 0x2a, 0x9a, 0x9b, 0xf5, 0x33, 0xb3, 0xab, 0x58, 0xb8, 0xe7, 0x0c,
-	0x6c, 0x70, 0x6f, 0xdc, 0xb2, 0xa1, 0xd9, 0x39, 0x6d, 0x81, 0x59, 0xf9, 0x67, 0x63, 0x32, 0x6d,
-	0x2f, 0xcb, 0x72, 0xf2, 0x46, 0x28, 0xbd, 0xdd, 0x80, 0x8a, 0x33, 0xd4, 0xc6, 0xb1, 0x90, 0xb6,
-	0x23, 0x2d, 0xed, 0x3f, 0x65, 0x2a, 0x96, 0x2d, 0x68, 0xc7, 0x26, 0x98, 0x41, 0x25, 0xf3, 0xf7,
-	0x23, 0x35, 0xcc, 0x2e, 0x69, 0xcf, 0xe9, 0x14, 0xac, 0x93, 0x69, 0xe6, 0xb7, 0x2a, 0xc3, 0x3f,
-	0x03, 0x36, 0xa0, 0x9d, 0x28, 0x41, 0x15, 0xfb, 0xed, 0x31, 0x99, 0xb6, 0x96, 0x75, 0x13, 0x2e,
-	0xf6, 0x27, 0x4e, 0x0e, 0x27, 0x4e, 0x3e, 0x4f, 0x9c, 0xbc, 0x16, 0xdc, 0x3b, 0x14, 0xdc, 0x7b,
-	0x2f, 0xb8, 0xf7, 0x78, 0xfd, 0x4b, 0xbc, 0x49, 0x68, 0xf6, 0x82, 0x06, 0xbe, 0x1b, 0xf1, 0x54,
-	0x06, 0x59, 0x99, 0x88, 0xba, 0x55, 0x08, 0x37, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x6b,
-	0x0d, 0x12, 0xe3, 0x01, 0x00, 0x00,
+var fileDescriptor_4f3fe893280a38d7 = []byte{
+	// 374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xcd, 0xca, 0xd3, 0x40,
+	0x14, 0xcd, 0xb4, 0xc9, 0x87, 0x1d, 0xfd, 0x40, 0x86, 0x0f, 0x09, 0x45, 0xd2, 0xda, 0x85, 0x64,
+	0xd3, 0x0c, 0xc5, 0x37, 0x88, 0x45, 0x10, 0xba, 0x90, 0x2c, 0xdd, 0xc8, 0x64, 0x72, 0x49, 0x87,
+	0x64, 0x66, 0x42, 0x66, 0x9a, 0xea, 0x5b, 0xb8, 0xf4, 0x91, 0xba, 0xec, 0x52, 0x5c, 0x14, 0x69,
+	0x77, 0x3e, 0x85, 0xe4, 0xa7, 0xd4, 0xad, 0x5d, 0xe5, 0xde, 0x73, 0x73, 0xce, 0xbd, 0x73, 0x38,
+	0xf8, 0xd5, 0x9e, 0xd5, 0x92, 0xe5, 0x40, 0x1b, 0xa0, 0xcd, 0x8a, 0x36, 0x10, 0x55, 0xb5, 0xb6,
+	0x9a, 0x3c, 0x0e, 0x78, 0xd4, 0x40, 0xd4, 0xac, 0xa6, 0x4f, 0xb9, 0xce, 0x75, 0x37, 0xa1, 0x6d,
+	0xd5, 0xff, 0x34, 0x0d, 0xb8, 0x36, 0x52, 0x1b, 0x9a, 0x32, 0xd3, 0xb2, 0x53, 0xb0, 0x6c, 0x45,
+	0xb9, 0x16, 0xaa, 0x9f, 0x2f, 0xfe, 0x20, 0xfc, 0xb8, 0xd1, 0xbc, 0x80, 0x2c, 0x66, 0x25, 0x53,
+	0x1c, 0xc8, 0x07, 0xfc, 0xc0, 0xa4, 0xde, 0x29, 0xeb, 0xa3, 0x39, 0x0a, 0x27, 0x71, 0x74, 0x38,
+	0xcd, 0x9c, 0x5f, 0xa7, 0xd9, 0xdb, 0x5c, 0xd8, 0xed, 0x2e, 0x8d, 0xb8, 0x96, 0x74, 0x10, 0xed,
+	0x3f, 0x4b, 0x93, 0x15, 0xd4, 0x7e, 0xab, 0xc0, 0x44, 0x1f, 0x95, 0x4d, 0x06, 0x36, 0x79, 0x89,
+	0xc7, 0xa0, 0x32, 0x7f, 0x34, 0x47, 0xa1, 0x9b, 0xb4, 0x25, 0x79, 0x83, 0x5f, 0x08, 0xf3, 0xa5,
+	0x82, 0x5a, 0x32, 0x05, 0xca, 0xfa, 0xe3, 0x39, 0x0a, 0x9f, 0x25, 0xcf, 0x85, 0xf9, 0x74, 0x85,
+	0xc8, 0x13, 0xf6, 0x32, 0x50, 0x5a, 0xfa, 0x6e, 0xbb, 0x3b, 0xe9, 0x9b, 0xf6, 0xa4, 0x3d, 0x88,
+	0x7c, 0x6b, 0x7d, 0xef, 0xbf, 0x4f, 0x5a, 0x03, 0x4f, 0x06, 0xf6, 0xe2, 0xc7, 0x08, 0xe3, 0xf7,
+	0x5b, 0xe0, 0x45, 0xa5, 0x85, 0xb2, 0x24, 0xc6, 0x6e, 0x2a, 0x98, 0xb9, 0xf3, 0x9d, 0x1d, 0x97,
+	0xac, 0xb1, 0x67, 0x4a, 0x5d, 0x81, 0x3f, 0xba, 0x4b, 0xa4, 0x27, 0x93, 0xd7, 0x78, 0x62, 0x85,
+	0x04, 0x63, 0x99, 0xac, 0x3a, 0x5b, 0xdc, 0xe4, 0x06, 0xb4, 0xa6, 0xa4, 0xa5, 0xe6, 0x45, 0x67,
+	0xca, 0x38, 0xe9, 0x1b, 0xb2, 0xc1, 0x93, 0x9b, 0x95, 0xde, 0x5d, 0xdb, 0x6f, 0x02, 0x71, 0x7c,
+	0x38, 0x07, 0xe8, 0x78, 0x0e, 0xd0, 0xef, 0x73, 0x80, 0xbe, 0x5f, 0x02, 0xe7, 0x78, 0x09, 0x9c,
+	0x9f, 0x97, 0xc0, 0xf9, 0x1c, 0xfe, 0x23, 0x56, 0x81, 0xad, 0xc5, 0xb2, 0x64, 0xa9, 0xa1, 0xd7,
+	0x50, 0x7e, 0x6d, 0x63, 0xd9, 0x49, 0xa6, 0x0f, 0x5d, 0xa4, 0xde, 0xfd, 0x1d, 0x00, 0x9d, 0x58,
+	0x0a, 0xfe, 0xb1, 0x02, 0x00, 0x00,
 }
 
 func (m *LockedBalance) Marshal() (dAtA []byte, err error) {
@@ -184,6 +215,33 @@ func (m *LockedBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVe(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IsPermanent {
+		i--
+		if m.IsPermanent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.End != 0 {
 		i = encodeVarintVe(dAtA, i, uint64(m.End))
 		i--
@@ -222,6 +280,16 @@ func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Permanent.Size()
+		i -= size
+		if _, err := m.Permanent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.Block != 0 {
 		i = encodeVarintVe(dAtA, i, uint64(m.Block))
 		i--
@@ -277,6 +345,15 @@ func (m *LockedBalance) Size() (n int) {
 	if m.End != 0 {
 		n += 1 + sovVe(uint64(m.End))
 	}
+	if m.IsPermanent {
+		n += 2
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovVe(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovVe(uint64(l))
 	return n
 }
 
@@ -296,6 +373,8 @@ func (m *Checkpoint) Size() (n int) {
 	if m.Block != 0 {
 		n += 1 + sovVe(uint64(m.Block))
 	}
+	l = m.Permanent.Size()
+	n += 1 + l + sovVe(uint64(l))
 	return n
 }
 
@@ -387,6 +466,92 @@ func (m *LockedBalance) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPermanent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPermanent = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVe(dAtA[iNdEx:])
@@ -543,6 +708,40 @@ func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permanent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Permanent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVe(dAtA[iNdEx:])
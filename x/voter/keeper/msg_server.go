@@ -1,6 +1,11 @@
 package keeper
 
 import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vetypes "github.com/petri-labs/warmage/x/ve/types"
 	"github.com/petri-labs/warmage/x/voter/types"
 )
 
@@ -15,3 +20,90 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 }
 
 var _ types.MsgServer = msgServer{}
+
+func (m msgServer) Vote(c context.Context, msg *types.MsgVote) (*types.MsgVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	veID := vetypes.Uint64FromVeID(msg.VeId)
+
+	// either the owner or the delegatee can vote
+	err = m.Keeper.veKeeper.CheckVoteAuthorized(ctx, veID, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	votingPower := m.Keeper.veKeeper.GetVotingPower(ctx, veID, uint64(ctx.BlockTime().Unix()), 0)
+	if !votingPower.IsPositive() {
+		return nil, sdkerrors.Wrapf(types.ErrNoVotingPower, "ve %s", msg.VeId)
+	}
+
+	poolWeights := make(map[string]sdk.Dec)
+	for _, pw := range msg.PoolWeights {
+		if !m.Keeper.gaugeKeeper.HasGauge(ctx, pw.PoolDenom) {
+			return nil, sdkerrors.Wrapf(types.ErrGaugeNotFound, "pool denom %s", pw.PoolDenom)
+		}
+		if votingPower.ToDec().Mul(pw.Weight).TruncateInt().IsZero() {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidPoolWeights, "too small weight %s for pool denom %s", pw.Weight, pw.PoolDenom)
+		}
+		poolWeights[pw.PoolDenom] = pw.Weight
+	}
+
+	m.Keeper.Vote(ctx, veID, poolWeights)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventVote{
+		Sender:      sender.String(),
+		VeId:        msg.VeId,
+		PoolWeights: msg.PoolWeights,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgVoteResponse{}, nil
+}
+
+func (m msgServer) Abstain(c context.Context, msg *types.MsgAbstain) (*types.MsgAbstainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	veID := vetypes.Uint64FromVeID(msg.VeId)
+
+	// either the owner or the delegatee can abstain
+	err = m.Keeper.veKeeper.CheckVoteAuthorized(ctx, veID, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Keeper.Abstain(ctx, veID)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAbstain{
+		Sender: sender.String(),
+		VeId:   msg.VeId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgAbstainResponse{}, nil
+}
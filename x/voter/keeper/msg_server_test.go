@@ -3,14 +3,88 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/petri-labs/warmage/app"
 	keepertest "github.com/petri-labs/warmage/testutil/keeper"
+	warmage "github.com/petri-labs/warmage/types"
+	vekeeper "github.com/petri-labs/warmage/x/ve/keeper"
+	vetypes "github.com/petri-labs/warmage/x/ve/types"
 	"github.com/petri-labs/warmage/x/voter/keeper"
 	"github.com/petri-labs/warmage/x/voter/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tharsis/ethermint/tests"
 )
 
 func setupMsgServer(t testing.TB) (types.MsgServer, context.Context) {
 	k, ctx := keepertest.VoterKeeper(t)
 	return keeper.NewMsgServerImpl(*k), sdk.WrapSDKContext(ctx)
 }
+
+func TestMsgServerVote(t *testing.T) {
+	a := app.Setup(false)
+	ctx := a.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	wctx := sdk.WrapSDKContext(ctx)
+	veImpl := vekeeper.NewMsgServerImpl(a.VeKeeper)
+	impl := keeper.NewMsgServerImpl(a.VoterKeeper)
+
+	owner := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	delegatee := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	other := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	amount := sdk.NewCoin(warmage.BaseDenom, sdk.NewIntWithDecimal(100, 18))
+	require.NoError(t, app.FundAccount(a.BankKeeper, ctx, owner, sdk.NewCoins(amount)))
+
+	res, err := veImpl.Create(wctx, &vetypes.MsgCreate{
+		Sender:       owner.String(),
+		Amount:       amount,
+		LockDuration: vetypes.MaxLockTime,
+	})
+	require.NoError(t, err)
+	veID := vetypes.Uint64FromVeID(res.VeId)
+
+	a.VoterKeeper.CreateGauge(ctx, "pool1")
+	a.VoterKeeper.CreateGauge(ctx, "pool2")
+	poolWeights := []types.PoolWeight{
+		{PoolDenom: "pool1", Weight: sdk.NewDecWithPrec(6, 1)},
+		{PoolDenom: "pool2", Weight: sdk.NewDecWithPrec(4, 1)},
+	}
+
+	// Not delegated yet
+	_, err = impl.Vote(wctx, &types.MsgVote{Sender: delegatee.String(), VeId: res.VeId, PoolWeights: poolWeights})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = veImpl.DelegateVotes(wctx, &vetypes.MsgDelegateVotes{Sender: owner.String(), VeId: res.VeId, Delegatee: delegatee.String()})
+	require.NoError(t, err)
+
+	// Unknown gauge
+	_, err = impl.Vote(wctx, &types.MsgVote{Sender: delegatee.String(), VeId: res.VeId, PoolWeights: []types.PoolWeight{
+		{PoolDenom: "pool3", Weight: sdk.OneDec()},
+	}})
+	require.ErrorIs(t, err, types.ErrGaugeNotFound)
+
+	// Neither owner nor delegatee
+	_, err = impl.Vote(wctx, &types.MsgVote{Sender: other.String(), VeId: res.VeId, PoolWeights: poolWeights})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// The delegatee votes
+	_, err = impl.Vote(wctx, &types.MsgVote{Sender: delegatee.String(), VeId: res.VeId, PoolWeights: poolWeights})
+	require.NoError(t, err)
+	require.True(t, a.VeKeeper.GetVeVoted(ctx, veID))
+	require.True(t, a.VoterKeeper.GetPoolWeightedVotesByUser(ctx, veID, "pool1").IsPositive())
+	require.True(t, a.VoterKeeper.GetPoolWeightedVotesByUser(ctx, veID, "pool2").IsPositive())
+
+	// The owner can still abstain
+	_, err = impl.Abstain(wctx, &types.MsgAbstain{Sender: owner.String(), VeId: res.VeId})
+	require.NoError(t, err)
+	require.False(t, a.VeKeeper.GetVeVoted(ctx, veID))
+	require.True(t, a.VoterKeeper.GetTotalVotesByUser(ctx, veID).IsZero())
+
+	// Revoked delegation
+	_, err = veImpl.UndelegateVotes(wctx, &vetypes.MsgUndelegateVotes{Sender: owner.String(), VeId: res.VeId})
+	require.NoError(t, err)
+	_, err = impl.Abstain(wctx, &types.MsgAbstain{Sender: delegatee.String(), VeId: res.VeId})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
@@ -8,5 +8,8 @@ import (
 
 // x/voter module sentinel errors
 var (
-	ErrSample = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrSample             = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrInvalidPoolWeights = sdkerrors.Register(ModuleName, 2, "invalid pool weights")
+	ErrGaugeNotFound      = sdkerrors.Register(ModuleName, 3, "gauge not found")
+	ErrNoVotingPower      = sdkerrors.Register(ModuleName, 4, "no voting power")
 )
@@ -0,0 +1,102 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vetypes "github.com/petri-labs/warmage/x/ve/types"
+)
+
+const (
+	TypeMsgVote    = "vote"
+	TypeMsgAbstain = "abstain"
+)
+
+var (
+	_ sdk.Msg = &MsgVote{}
+	_ sdk.Msg = &MsgAbstain{}
+)
+
+// Route implements sdk.Msg
+func (m *MsgVote) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgVote) Type() string { return TypeMsgVote }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgVote) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if vetypes.Uint64FromVeID(m.VeId) == vetypes.EmptyVeID {
+		return vetypes.ErrInvalidVeID
+	}
+	if len(m.PoolWeights) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPoolWeights, "no pool weights")
+	}
+	totalWeights := sdk.ZeroDec()
+	seen := make(map[string]bool)
+	for _, pw := range m.PoolWeights {
+		if err := sdk.ValidateDenom(pw.PoolDenom); err != nil {
+			return sdkerrors.Wrap(ErrInvalidPoolWeights, err.Error())
+		}
+		if seen[pw.PoolDenom] {
+			return sdkerrors.Wrapf(ErrInvalidPoolWeights, "duplicate pool denom %s", pw.PoolDenom)
+		}
+		seen[pw.PoolDenom] = true
+		if pw.Weight.IsNil() || pw.Weight.IsZero() {
+			return sdkerrors.Wrapf(ErrInvalidPoolWeights, "zero weight for pool denom %s", pw.PoolDenom)
+		}
+		totalWeights = totalWeights.Add(pw.Weight.Abs())
+	}
+	if !totalWeights.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidPoolWeights, "sum of absolute weights %s must be one", totalWeights)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgVote) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgAbstain) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgAbstain) Type() string { return TypeMsgAbstain }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgAbstain) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgAbstain) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if vetypes.Uint64FromVeID(m.VeId) == vetypes.EmptyVeID {
+		return vetypes.ErrInvalidVeID
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgAbstain) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
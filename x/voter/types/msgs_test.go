@@ -0,0 +1,103 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/petri-labs/warmage/app"
+	"github.com/petri-labs/warmage/x/voter/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgVote_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	sender := "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw"
+	for _, tc := range []struct {
+		desc        string
+		sender      string
+		veId        string
+		poolWeights []types.PoolWeight
+		valid       bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+		},
+		{
+			desc:   "invalid veId",
+			sender: sender,
+			veId:   "xxx",
+		},
+		{
+			desc:   "no pool weights",
+			sender: sender,
+			veId:   "ve-1",
+		},
+		{
+			desc:   "invalid pool denom",
+			sender: sender,
+			veId:   "ve-1",
+			poolWeights: []types.PoolWeight{
+				{PoolDenom: "1", Weight: sdk.OneDec()},
+			},
+		},
+		{
+			desc:   "duplicate pool denom",
+			sender: sender,
+			veId:   "ve-1",
+			poolWeights: []types.PoolWeight{
+				{PoolDenom: "pool1", Weight: sdk.NewDecWithPrec(5, 1)},
+				{PoolDenom: "pool1", Weight: sdk.NewDecWithPrec(5, 1)},
+			},
+		},
+		{
+			desc:   "zero weight",
+			sender: sender,
+			veId:   "ve-1",
+			poolWeights: []types.PoolWeight{
+				{PoolDenom: "pool1", Weight: sdk.OneDec()},
+				{PoolDenom: "pool2", Weight: sdk.ZeroDec()},
+			},
+		},
+		{
+			desc:   "weights not summing to one",
+			sender: sender,
+			veId:   "ve-1",
+			poolWeights: []types.PoolWeight{
+				{PoolDenom: "pool1", Weight: sdk.NewDecWithPrec(5, 1)},
+			},
+		},
+		{
+			desc:   "valid",
+			sender: sender,
+			veId:   "ve-1",
+			poolWeights: []types.PoolWeight{
+				{PoolDenom: "pool1", Weight: sdk.NewDecWithPrec(7, 1)},
+				{PoolDenom: "pool2", Weight: sdk.NewDecWithPrec(-3, 1)},
+			},
+			valid: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgVote{
+				Sender:      tc.sender,
+				VeId:        tc.veId,
+				PoolWeights: tc.poolWeights,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgAbstain_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	msg := &types.MsgAbstain{Sender: "war1mnfm9c7cdgqnkk66sganp78m0ydmcr4pn3s5lw", VeId: "ve-1"}
+	require.NoError(t, msg.ValidateBasic())
+	msg.VeId = "xxx"
+	require.Error(t, msg.ValidateBasic())
+}